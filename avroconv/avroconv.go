@@ -0,0 +1,140 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package avroconv converts the date and time of day types in this module to and from the Avro
+// logical types that represent them, so records can be written and read with Avro libraries such as
+// github.com/hamba/avro and github.com/linkedin/goavro without hand-written conversions.
+//
+//   - date.Value maps to the "date" logical type, an int containing the number of days since
+//     1970-01-01.  NullableDays() and DateFromNullableDays() map date.Nil to null for ["null", date]
+//     unions, using the *int32 representation that hamba/avro uses for nullable fields.
+//   - timeofday.Value maps to the "time-micros" logical type, a long containing the number of
+//     microseconds since midnight, and to the "time-millis" logical type, an int containing the
+//     number of milliseconds since midnight.
+//   - A date.Value and a timeofday.Value together map to the "local-timestamp-micros" logical type, a
+//     long containing the number of microseconds since 1970-01-01T00:00:00 with no time zone.
+//
+// Avro times have microsecond or millisecond precision, so the conversions to them truncate any
+// smaller units.
+package avroconv
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// Avro schemas for the logical types used by this package
+const (
+	DateSchema                 = `{"type":"int","logicalType":"date"}`
+	TimeMicrosSchema           = `{"type":"long","logicalType":"time-micros"}`
+	TimeMillisSchema           = `{"type":"int","logicalType":"time-millis"}`
+	LocalTimestampMicrosSchema = `{"type":"long","logicalType":"local-timestamp-micros"}`
+)
+
+var (
+	// ErrOutOfRange is returned when a value cannot be represented by the target type
+	ErrOutOfRange = errors.Errorf("avroconv: the value is out of range for the target type")
+)
+
+// epoch is the date.Value for 1970-01-01
+var epoch = date.Must(date.FromUnits(1970, 1, 1))
+
+// DateToDays converts a date.Value to the number of days since 1970-01-01.  If the date is date.Nil or
+// is otherwise not valid, ErrOutOfRange is returned.
+func DateToDays(d date.Value) (int32, error) {
+	if !d.IsValid() {
+		return 0, errors.Wrapf(ErrOutOfRange, "date.Value(%d)", int64(d))
+	}
+	return int32(d - epoch), nil
+}
+
+// DateFromDays converts a number of days since 1970-01-01 to a date.Value.  If the resulting date is
+// outside of the range supported by date.Value, ErrOutOfRange is returned.
+func DateFromDays(days int32) (date.Value, error) {
+	d := epoch + date.Value(days)
+	if !d.IsValid() {
+		return date.Nil, errors.Wrapf(ErrOutOfRange, "%d days since the Unix epoch", days)
+	}
+	return d, nil
+}
+
+// NullableDays converts a date.Value to a pointer to the number of days since 1970-01-01, or nil for
+// date.Nil and other invalid dates
+func NullableDays(d date.Value) *int32 {
+	days, err := DateToDays(d)
+	if err != nil {
+		return nil
+	}
+	return &days
+}
+
+// DateFromNullableDays converts a pointer to a number of days since 1970-01-01 to a date.Value.  A nil
+// pointer is converted to date.Nil.
+func DateFromNullableDays(days *int32) (date.Value, error) {
+	if days == nil {
+		return date.Nil, nil
+	}
+	return DateFromDays(*days)
+}
+
+// TimeOfDayToMicros converts a timeofday.Value to the number of microseconds since midnight
+func TimeOfDayToMicros(t timeofday.Value) int64 {
+	return timeofday.ToDuration(t).Microseconds()
+}
+
+// TimeOfDayFromMicros converts a number of microseconds since midnight to a timeofday.Value.  If the
+// value is negative or is 24 hours or more, ErrOutOfRange is returned.
+func TimeOfDayFromMicros(us int64) (timeofday.Value, error) {
+	if us < 0 || us >= int64(24*time.Hour/time.Microsecond) {
+		return timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%d microseconds since midnight", us)
+	}
+	return timeofday.FromDuration(time.Duration(us) * time.Microsecond)
+}
+
+// TimeOfDayToMillis converts a timeofday.Value to the number of milliseconds since midnight
+func TimeOfDayToMillis(t timeofday.Value) int32 {
+	return int32(timeofday.ToDuration(t).Milliseconds())
+}
+
+// TimeOfDayFromMillis converts a number of milliseconds since midnight to a timeofday.Value.  If the
+// value is negative or is 24 hours or more, ErrOutOfRange is returned.
+func TimeOfDayFromMillis(ms int32) (timeofday.Value, error) {
+	if ms < 0 || int64(ms) >= int64(24*time.Hour/time.Millisecond) {
+		return timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%d milliseconds since midnight", ms)
+	}
+	return timeofday.FromDuration(time.Duration(ms) * time.Millisecond)
+}
+
+// DateTimeToLocalMicros combines a date.Value and a timeofday.Value into the number of microseconds
+// since 1970-01-01T00:00:00, with no time zone.  If the date is not valid, ErrOutOfRange is returned.
+func DateTimeToLocalMicros(d date.Value, t timeofday.Value) (int64, error) {
+	days, err := DateToDays(d)
+	if err != nil {
+		return 0, err
+	}
+	return int64(days)*int64(24*time.Hour/time.Microsecond) + TimeOfDayToMicros(t), nil
+}
+
+// DateTimeFromLocalMicros splits a number of microseconds since 1970-01-01T00:00:00, with no time zone,
+// into a date.Value and a timeofday.Value.  If the date is outside of the range supported by
+// date.Value, ErrOutOfRange is returned.
+func DateTimeFromLocalMicros(us int64) (date.Value, timeofday.Value, error) {
+	const perDay = int64(24 * time.Hour / time.Microsecond)
+	days, rem := us/perDay, us%perDay
+	if rem < 0 {
+		days, rem = days-1, rem+perDay
+	}
+	d := epoch + date.Value(days)
+	if !d.IsValid() {
+		return date.Nil, timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%d microseconds since the Unix epoch", us)
+	}
+	t, err := TimeOfDayFromMicros(rem)
+	if err != nil {
+		return date.Nil, timeofday.Zero, err
+	}
+	return d, t, nil
+}
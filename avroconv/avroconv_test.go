@@ -0,0 +1,170 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package avroconv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/linkedin/goavro/v2"
+	"github.com/pkg/errors"
+)
+
+// roundTrip encodes native with the writer schema and decodes the data with the reader schema, which
+// must have the same underlying Avro type, to verify the conversions against goavro
+func roundTrip(t *testing.T, writer, reader string, native interface{}) interface{} {
+	t.Helper()
+	w, err := goavro.NewCodec(writer)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	r, err := goavro.NewCodec(reader)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, err := w.BinaryFromNative(nil, native)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	res, _, err := r.NativeFromBinary(data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return res
+}
+
+func TestDateMatchesGoavro(t *testing.T) {
+	cases := []struct {
+		name string
+		d    date.Value
+	}{
+		{"min", date.Min},
+		{"epoch", epoch},
+		{"day", date.Must(date.FromUnits(2024, 7, 14))},
+		{"max", date.Max},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			days, err := DateToDays(tc.d)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got := roundTrip(tt, `"int"`, DateSchema, days).(time.Time); !got.Equal(tc.d.ToTime()) {
+				tt.Errorf("Expected %v, got %v", tc.d.ToTime(), got)
+			}
+			if got := roundTrip(tt, DateSchema, `"int"`, tc.d.ToTime()).(int32); got != days {
+				tt.Errorf("Expected %d, got %d", days, got)
+			}
+			if d, err := DateFromDays(days); err != nil || d != tc.d {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.d, d, err)
+			}
+		})
+	}
+}
+
+func TestDateErrors(t *testing.T) {
+	if _, err := DateToDays(date.Nil); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := DateFromDays(-100000); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+}
+
+func TestNullableDays(t *testing.T) {
+	if p := NullableDays(date.Nil); p != nil {
+		t.Errorf("Expected nil, got %d", *p)
+	}
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	p := NullableDays(d)
+	if p == nil || *p != 19918 {
+		t.Fatalf("Expected 19918, got %v", p)
+	}
+	if got, err := DateFromNullableDays(p); err != nil || got != d {
+		t.Errorf("Expected %v, got %v (err = %v)", d, got, err)
+	}
+	if got, err := DateFromNullableDays(nil); err != nil || got != date.Nil {
+		t.Errorf("Expected %v, got %v (err = %v)", date.Nil, got, err)
+	}
+}
+
+func TestTimeOfDayMatchesGoavro(t *testing.T) {
+	cases := []struct {
+		name      string
+		t         timeofday.Value
+		truncated timeofday.Value
+	}{
+		{"midnight", timeofday.Zero, timeofday.Zero},
+		{"afternoon", timeofday.Must(timeofday.FromUnits(13, 45, 30, 123456789)), timeofday.Must(timeofday.FromUnits(13, 45, 30, 123456000))},
+		{"max", timeofday.Max, timeofday.Must(timeofday.FromUnits(23, 59, 59, 999999000))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			us := TimeOfDayToMicros(tc.t)
+			if got := roundTrip(tt, `"long"`, TimeMicrosSchema, us).(time.Duration); got != timeofday.ToDuration(tc.truncated) {
+				tt.Errorf("Expected %v, got %v", timeofday.ToDuration(tc.truncated), got)
+			}
+			if got, err := TimeOfDayFromMicros(us); err != nil || got != tc.truncated {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.truncated, got, err)
+			}
+			ms := TimeOfDayToMillis(tc.t)
+			expected := timeofday.ToDuration(tc.t).Truncate(time.Millisecond)
+			if got := roundTrip(tt, `"int"`, TimeMillisSchema, ms).(time.Duration); got != expected {
+				tt.Errorf("Expected %v, got %v", expected, got)
+			}
+			if got, err := TimeOfDayFromMillis(ms); err != nil || timeofday.ToDuration(got) != expected {
+				tt.Errorf("Expected %v, got %v (err = %v)", expected, got, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayErrors(t *testing.T) {
+	if _, err := TimeOfDayFromMicros(-1); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := TimeOfDayFromMicros(86400000000); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := TimeOfDayFromMillis(86400000); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+}
+
+func TestLocalMicros(t *testing.T) {
+	cases := []struct {
+		name string
+		d    date.Value
+		t    timeofday.Value
+	}{
+		{"epoch", epoch, timeofday.Zero},
+		{"after epoch", date.Must(date.FromUnits(2024, 7, 14)), timeofday.Must(timeofday.FromUnits(13, 45, 30, 250000000))},
+		{"before epoch", date.Must(date.FromUnits(1952, 5, 1)), timeofday.Must(timeofday.FromUnits(6, 30, 0, 1000))},
+		{"min", date.Min, timeofday.Zero},
+		{"max", date.Max, timeofday.Must(timeofday.FromUnits(23, 59, 59, 999999000))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			y, m, d := date.ToUnits(tc.d)
+			expected := tc.t.ToDateTimeUTC(y, time.Month(m), d).UnixMicro()
+			us, err := DateTimeToLocalMicros(tc.d, tc.t)
+			if err != nil || us != expected {
+				tt.Errorf("Expected %d, got %d (err = %v)", expected, us, err)
+			}
+			gotD, gotT, err := DateTimeFromLocalMicros(us)
+			if err != nil || gotD != tc.d || gotT != tc.t {
+				tt.Errorf("Expected (%v, %v), got (%v, %v) (err = %v)", tc.d, tc.t, gotD, gotT, err)
+			}
+		})
+	}
+	if _, err := DateTimeToLocalMicros(date.Nil, timeofday.Zero); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, _, err := DateTimeFromLocalMicros(-1 << 62); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/dylan-bourque/go-types/avroconv
+
+go 1.25.0
+
+require (
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	github.com/linkedin/goavro/v2 v2.12.0
+	github.com/pkg/errors v0.9.1
+)
+
+require github.com/golang/snappy v0.0.4 // indirect
+
+replace github.com/dylan-bourque/go-types => ../
@@ -0,0 +1,82 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package b64bytes
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextData is returned from b64bytes.Value.UnmarshalJSON() when the passed-in byte
+	// slice does not contain a string
+	ErrInvalidTextData = errors.Errorf("b64bytes.Value: can only decode JSON strings")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface for b64bytes.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(make([]byte, 0, base64.StdEncoding.EncodedLen(len(v))))
+}
+
+// AppendText implements the encoding.TextAppender interface for b64bytes.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	return base64.StdEncoding.AppendEncode(b, v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for b64bytes.Value values.
+//
+// The text is decoded by Parse().
+func (v *Value) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for b64bytes.Value values.
+//
+// Values are encoded as a JSON string containing the same value as MarshalText().  A nil Value is
+// encoded as the special JSON null token.
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	res := make([]byte, 0, base64.StdEncoding.EncodedLen(len(v))+2)
+	res = append(res, '"')
+	res = base64.StdEncoding.AppendEncode(res, v)
+	return append(res, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for b64bytes.Value values.
+//
+// If the value is the special JSON null token, v is set to nil.  All other values are delegated to
+// UnmarshalText().
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		*v = nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return v.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,63 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package b64bytes
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	type doc struct {
+		Data Value `json:"data"`
+	}
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		encoded  string
+		err      error
+	}{
+		{"padded", `{"data":"+/8="}`, Value{0xfb, 0xff}, `{"data":"+/8="}`, nil},
+		{"unpadded", `{"data":"+/8"}`, Value{0xfb, 0xff}, `{"data":"+/8="}`, nil},
+		{"empty", `{"data":""}`, Value{}, `{"data":""}`, nil},
+		{"null", `{"data":null}`, nil, `{"data":null}`, nil},
+		{"number", `{"data":12}`, nil, "", ErrInvalidTextData},
+		{"url-safe alphabet", `{"data":"-_8="}`, nil, "", ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := doc{Data: Value{1}}
+			err := json.Unmarshal([]byte(tc.data), &d)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if !bytes.Equal(d.Data, tc.expected) || (d.Data == nil) != (tc.expected == nil) {
+				tt.Errorf("Expected %#v, got %#v", tc.expected, d.Data)
+			}
+			if data, err := json.Marshal(d); err != nil || string(data) != tc.encoded {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.encoded, data, err)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	v := Value{0xfb, 0xff, 0xbf}
+	text, _ := v.MarshalText()
+	if string(text) != "+/+/" {
+		t.Errorf("Expected +/+/, got %s", text)
+	}
+	var got Value
+	if err := got.UnmarshalText(text); err != nil || !bytes.Equal(got, v) {
+		t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+	}
+}
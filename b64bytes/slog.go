@@ -0,0 +1,21 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package b64bytes
+
+import (
+	"log/slog"
+)
+
+// interface validations
+var _ slog.LogValuer = (*Value)(nil)
+
+// LogValue implements the slog.LogValuer interface for b64bytes.Value values, so structured logs
+// show the padded base64 string returned by String().  A nil Value is logged as null.
+func (v Value) LogValue() slog.Value {
+	if v == nil {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(v.String())
+}
@@ -0,0 +1,36 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package b64bytes
+
+import (
+	"log/slog"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected interface{}
+	}{
+		{"bytes", Value{0xfb, 0xff}, "+/8="},
+		{"empty", Value{}, ""},
+		{"nil", Value(nil), nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			lv := tc.v.LogValue()
+			if tc.expected == nil {
+				if lv.Kind() != slog.KindAny || lv.Any() != nil {
+					tt.Errorf("Expected null, got %v", lv)
+				}
+				return
+			}
+			if lv.Kind() != slog.KindString || lv.String() != tc.expected {
+				tt.Errorf("Expected %q, got %v", tc.expected, lv)
+			}
+		})
+	}
+}
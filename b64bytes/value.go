@@ -0,0 +1,54 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package b64bytes
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Value is a byte slice whose text and JSON form is always the standard base64 alphabet from
+// RFC 4648, section 4, with padding, such as "+/8=".  Use b64urlbytes.Value for the URL-safe alphabet.
+//
+// A nil Value is encoded as JSON null.  An empty, non-nil Value is encoded as "".
+type Value []byte
+
+var (
+	// ErrInvalidTextFormat is returned when a string is not valid standard base64
+	ErrInvalidTextFormat = errors.Errorf("b64bytes: the specified text is not valid standard base64")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in b64bytes.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Parse decodes a string encoded with the standard base64 alphabet.  Padding is optional, but if it
+// is present it must be correct.  Strings that use the URL-safe alphabet are rejected.  An empty
+// string decodes as an empty, non-nil Value.
+func Parse(s string) (Value, error) {
+	enc := base64.RawStdEncoding
+	if strings.HasSuffix(s, "=") {
+		enc = base64.StdEncoding
+	}
+	res := make(Value, enc.DecodedLen(len(s)))
+	n, err := enc.Decode(res, []byte(s))
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidTextFormat, "%v", err)
+	}
+	return res[:n], nil
+}
+
+// String implements fmt.Stringer for b64bytes.Value instances.
+//
+// The returned string is the padded, standard base64 encoding of v.
+func (v Value) String() string {
+	return base64.StdEncoding.EncodeToString(v)
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package b64bytes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected Value
+		err      error
+	}{
+		{"empty", "", Value{}, nil},
+		{"padded", "+/8=", Value{0xfb, 0xff}, nil},
+		{"unpadded", "+/8", Value{0xfb, 0xff}, nil},
+		{"double padding", "/w==", Value{0xff}, nil},
+		{"no padding needed", "+/+/", Value{0xfb, 0xff, 0xbf}, nil},
+		{"url-safe alphabet", "-_8", nil, ErrInvalidTextFormat},
+		{"wrong padding", "/w=", nil, ErrInvalidTextFormat},
+		{"invalid character", "ab$d", nil, ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if !bytes.Equal(v, tc.expected) || (v == nil) != (tc.expected == nil) {
+				tt.Errorf("Expected %#v, got %#v", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"nil", nil, ""},
+		{"one byte", Value{0xff}, "/w=="},
+		{"two bytes", Value{0xfb, 0xff}, "+/8="},
+		{"three bytes", Value{0xfb, 0xff, 0xbf}, "+/+/"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if s := tc.v.String(); s != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, s)
+			}
+		})
+	}
+}
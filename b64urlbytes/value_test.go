@@ -0,0 +1,61 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package b64urlbytes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected Value
+		err      error
+	}{
+		{"empty", "", Value{}, nil},
+		{"padded", "-_8=", Value{0xfb, 0xff}, nil},
+		{"unpadded", "-_8", Value{0xfb, 0xff}, nil},
+		{"double padding", "_w==", Value{0xff}, nil},
+		{"no padding needed", "-_-_", Value{0xfb, 0xff, 0xbf}, nil},
+		{"standard alphabet", "+/8", nil, ErrInvalidTextFormat},
+		{"wrong padding", "_w=", nil, ErrInvalidTextFormat},
+		{"invalid character", "ab$d", nil, ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if !bytes.Equal(v, tc.expected) || (v == nil) != (tc.expected == nil) {
+				tt.Errorf("Expected %#v, got %#v", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"nil", nil, ""},
+		{"one byte", Value{0xff}, "_w"},
+		{"two bytes", Value{0xfb, 0xff}, "-_8"},
+		{"three bytes", Value{0xfb, 0xff, 0xbf}, "-_-_"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if s := tc.v.String(); s != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, s)
+			}
+		})
+	}
+}
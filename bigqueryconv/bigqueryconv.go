@@ -0,0 +1,148 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package bigqueryconv converts the date and time of day types in this module to and from the
+// representations that the BigQuery clients use for DATE, TIME and DATETIME columns, so that rows can
+// be loaded and saved without a conversion for every field.
+//
+// The ...Value() functions return a bigquery.Value, which is an interface{}, for use in
+// bigquery.ValueSaver implementations and query parameters.  The ...FromValue() functions accept the
+// values produced by the query client (civil.Date, civil.Time and civil.DateTime), their canonical
+// string forms, and the integer encodings used by the Storage Read API (days since the Unix epoch for
+// DATE and microseconds since midnight for TIME), so they can be used in bigquery.ValueLoader
+// implementations for any of those sources.  A nil value represents NULL.
+//
+// The BigQuery client package is not imported.
+package bigqueryconv
+
+import (
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/dylan-bourque/go-types/civilconv"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by the ...FromValue() functions when the provided value
+	// cannot be converted to the target type
+	ErrUnsupportedSourceType = errors.Errorf("bigqueryconv: cannot convert the source data to the target type")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// DateValue converts a date.Value to a civil.Date for a DATE column.  date.Nil is converted to nil.
+func DateValue(d date.Value) interface{} {
+	if d == date.Nil {
+		return nil
+	}
+	return civilconv.DateToCivil(d)
+}
+
+// DateFromValue converts a DATE column value to a date.Value.  NULL is converted to date.Nil.
+func DateFromValue(v interface{}) (date.Value, error) {
+	switch tv := v.(type) {
+	case nil:
+		return date.Nil, nil
+	case civil.Date:
+		return civilconv.DateFromCivil(tv)
+	case string:
+		cd, err := civil.ParseDate(tv)
+		if err != nil {
+			return date.Nil, errors.Wrapf(civilconv.ErrOutOfRange, "%q", tv)
+		}
+		return civilconv.DateFromCivil(cd)
+	case int32:
+		return dateFromEpochDays(int64(tv))
+	case int64:
+		return dateFromEpochDays(tv)
+	default:
+		return date.Nil, unsupportedSourceType.Of(v)
+	}
+}
+
+// TimeOfDayValue converts a timeofday.Value to a civil.Time for a TIME column.  BigQuery stores times
+// with microsecond precision, so any nanoseconds are truncated when the value is written.
+func TimeOfDayValue(t timeofday.Value) interface{} {
+	return civilconv.TimeOfDayToCivil(t)
+}
+
+// TimeOfDayFromValue converts a TIME column value to a timeofday.NullTimeOfDay.  NULL is converted to
+// a NullTimeOfDay with Valid set to false.
+func TimeOfDayFromValue(v interface{}) (timeofday.NullTimeOfDay, error) {
+	var (
+		t   timeofday.Value
+		err error
+	)
+	switch tv := v.(type) {
+	case nil:
+		return timeofday.NullTimeOfDay{}, nil
+	case civil.Time:
+		t, err = civilconv.TimeOfDayFromCivil(tv)
+	case string:
+		var ct civil.Time
+		if ct, err = civil.ParseTime(tv); err != nil {
+			return timeofday.NullTimeOfDay{}, errors.Wrapf(civilconv.ErrOutOfRange, "%q", tv)
+		}
+		t, err = civilconv.TimeOfDayFromCivil(ct)
+	case int64:
+		if tv < 0 || tv >= int64(24*time.Hour/time.Microsecond) {
+			return timeofday.NullTimeOfDay{}, errors.Wrapf(civilconv.ErrOutOfRange, "%d microseconds since midnight", tv)
+		}
+		t, err = timeofday.FromDuration(time.Duration(tv) * time.Microsecond)
+	default:
+		err = unsupportedSourceType.Of(v)
+	}
+	if err != nil {
+		return timeofday.NullTimeOfDay{}, err
+	}
+	return timeofday.NullTimeOfDay{TimeOfDay: t, Valid: true}, nil
+}
+
+// DateTimeValue combines a date.Value and a timeofday.Value into a civil.DateTime for a DATETIME
+// column.  If the date is date.Nil, nil is returned.
+func DateTimeValue(d date.Value, t timeofday.Value) interface{} {
+	if d == date.Nil {
+		return nil
+	}
+	return civilconv.DateTimeToCivil(d, t)
+}
+
+// DateTimeFromValue splits a DATETIME column value into a date.Value and a timeofday.Value.  Strings
+// may separate the date and time with either "T" or a space, as BigQuery does.  NULL is converted to
+// date.Nil and midnight.
+func DateTimeFromValue(v interface{}) (date.Value, timeofday.Value, error) {
+	switch tv := v.(type) {
+	case nil:
+		return date.Nil, timeofday.Zero, nil
+	case civil.DateTime:
+		return civilconv.DateTimeFromCivil(tv)
+	case string:
+		dt, err := civil.ParseDateTime(strings.Replace(tv, " ", "T", 1))
+		if err != nil {
+			return date.Nil, timeofday.Zero, errors.Wrapf(civilconv.ErrOutOfRange, "%q", tv)
+		}
+		return civilconv.DateTimeFromCivil(dt)
+	default:
+		return date.Nil, timeofday.Zero, unsupportedSourceType.Of(v)
+	}
+}
+
+// dateFromEpochDays converts a number of days since 1970-01-01 to a date.Value
+func dateFromEpochDays(days int64) (date.Value, error) {
+	// bound the value so the conversion to seconds cannot overflow, well beyond the range of date.Value
+	const maxDays = 1 << 32
+	if days <= -maxDays || days >= maxDays {
+		return date.Nil, errors.Wrapf(civilconv.ErrOutOfRange, "%d days since the Unix epoch", days)
+	}
+	d, err := date.FromTime(time.Unix(days*86400, 0).UTC())
+	if err != nil {
+		return date.Nil, errors.Wrapf(civilconv.ErrOutOfRange, "%d days since the Unix epoch", days)
+	}
+	return d, nil
+}
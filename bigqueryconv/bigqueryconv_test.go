@@ -0,0 +1,123 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bigqueryconv
+
+import (
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/dylan-bourque/go-types/civilconv"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestDateValue(t *testing.T) {
+	if v := DateValue(date.Nil); v != nil {
+		t.Errorf("Expected nil, got %v", v)
+	}
+	if v := DateValue(date.Must(date.FromUnits(2024, 7, 14))); v != (civil.Date{Year: 2024, Month: 7, Day: 14}) {
+		t.Errorf("Expected 2024-07-14, got %v", v)
+	}
+}
+
+func TestDateFromValue(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	cases := []struct {
+		name     string
+		v        interface{}
+		expected date.Value
+		err      error
+	}{
+		{"null", nil, date.Nil, nil},
+		{"civil date", civil.Date{Year: 2024, Month: 7, Day: 14}, d, nil},
+		{"string", "2024-07-14", d, nil},
+		{"epoch days int32", int32(19918), d, nil},
+		{"epoch days int64", int64(19918), d, nil},
+		{"negative epoch days", int64(-6454), date.Must(date.FromUnits(1952, 5, 1)), nil},
+		{"invalid string", "2024-13-01", date.Nil, civilconv.ErrOutOfRange},
+		{"before min", civil.Date{Year: 1066, Month: 10, Day: 14}, date.Nil, civilconv.ErrOutOfRange},
+		{"huge epoch days", int64(1) << 62, date.Nil, civilconv.ErrOutOfRange},
+		{"unsupported type", 3.14, date.Nil, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := DateFromValue(tc.v)
+			if errors.Cause(err) != tc.err || got != tc.expected {
+				tt.Errorf("Expected (%v, %v), got (%v, %v)", tc.expected, tc.err, got, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayValue(t *testing.T) {
+	v := TimeOfDayValue(timeofday.Must(timeofday.FromUnits(13, 45, 30, 500)))
+	if v != (civil.Time{Hour: 13, Minute: 45, Second: 30, Nanosecond: 500}) {
+		t.Errorf("Expected 13:45:30.0000005, got %v", v)
+	}
+}
+
+func TestTimeOfDayFromValue(t *testing.T) {
+	tod := timeofday.Must(timeofday.FromUnits(13, 45, 30, 250000000))
+	cases := []struct {
+		name     string
+		v        interface{}
+		expected timeofday.NullTimeOfDay
+		err      error
+	}{
+		{"null", nil, timeofday.NullTimeOfDay{}, nil},
+		{"civil time", civil.Time{Hour: 13, Minute: 45, Second: 30, Nanosecond: 250000000}, timeofday.NullTimeOfDay{TimeOfDay: tod, Valid: true}, nil},
+		{"string", "13:45:30.250000", timeofday.NullTimeOfDay{TimeOfDay: tod, Valid: true}, nil},
+		{"micros", int64(49530250000), timeofday.NullTimeOfDay{TimeOfDay: tod, Valid: true}, nil},
+		{"negative micros", int64(-1), timeofday.NullTimeOfDay{}, civilconv.ErrOutOfRange},
+		{"micros past midnight", int64(86400000000), timeofday.NullTimeOfDay{}, civilconv.ErrOutOfRange},
+		{"invalid string", "25:00:00", timeofday.NullTimeOfDay{}, civilconv.ErrOutOfRange},
+		{"leap second", civil.Time{Hour: 23, Minute: 59, Second: 60}, timeofday.NullTimeOfDay{}, civilconv.ErrOutOfRange},
+		{"unsupported type", int32(42), timeofday.NullTimeOfDay{}, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := TimeOfDayFromValue(tc.v)
+			if errors.Cause(err) != tc.err || got != tc.expected {
+				tt.Errorf("Expected (%v, %v), got (%v, %v)", tc.expected, tc.err, got, err)
+			}
+		})
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	tod := timeofday.Must(timeofday.FromUnits(13, 45, 30, 0))
+	if v := DateTimeValue(date.Nil, tod); v != nil {
+		t.Errorf("Expected nil, got %v", v)
+	}
+	cdt := civil.DateTime{Date: civil.Date{Year: 2024, Month: 7, Day: 14}, Time: civil.Time{Hour: 13, Minute: 45, Second: 30}}
+	if v := DateTimeValue(d, tod); v != cdt {
+		t.Errorf("Expected %v, got %v", cdt, v)
+	}
+
+	cases := []struct {
+		name string
+		v    interface{}
+		expD date.Value
+		expT timeofday.Value
+		err  error
+	}{
+		{"null", nil, date.Nil, timeofday.Zero, nil},
+		{"civil datetime", cdt, d, tod, nil},
+		{"string with T", "2024-07-14T13:45:30", d, tod, nil},
+		{"string with space", "2024-07-14 13:45:30", d, tod, nil},
+		{"invalid string", "2024-07-14", date.Nil, timeofday.Zero, civilconv.ErrOutOfRange},
+		{"unsupported type", int64(42), date.Nil, timeofday.Zero, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			gotD, gotT, err := DateTimeFromValue(tc.v)
+			if errors.Cause(err) != tc.err || gotD != tc.expD || gotT != tc.expT {
+				tt.Errorf("Expected (%v, %v, %v), got (%v, %v, %v)", tc.expD, tc.expT, tc.err, gotD, gotT, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bloom
+
+import (
+	"encoding"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidBinaryDataLen is returned from bloom.Value.UnmarshalBinary() when the passed-in byte
+	// slice is not the correct length for its version and bit count
+	ErrInvalidBinaryDataLen = errors.Errorf("bloom.Value: binary data is not the correct length")
+	// ErrUnsupportedBinaryVersion is returned from bloom.Value.UnmarshalBinary() when the version byte
+	// of the passed-in data is not recognized
+	ErrUnsupportedBinaryVersion = errors.Errorf("bloom.Value: unsupported binary encoding version")
+	// ErrInvalidBinaryData is returned from bloom.Value.UnmarshalBinary() when the encoded bit count
+	// or number of hash functions is not valid
+	ErrInvalidBinaryData = errors.Errorf("bloom.Value: binary data does not contain a valid filter")
+)
+
+const (
+	// binaryVersion1 identifies the binary encoding that contains a version byte, the number of hash
+	// functions as a 32-bit integer, the number of bits as a 64-bit integer and the bit array as 64-bit
+	// words, all in big-endian byte order
+	binaryVersion1 byte = 1
+	// binaryVersion is the version of the binary encoding that is written by MarshalBinary()
+	binaryVersion = binaryVersion1
+	// headerLen is the length of the version 1 encoding before the bit array
+	headerLen = 1 + 4 + 8
+)
+
+// interface validations
+var _ encoding.BinaryMarshaler = (*Value)(nil)
+var _ encoding.BinaryAppender = (*Value)(nil)
+var _ encoding.BinaryUnmarshaler = (*Value)(nil)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for bloom.Value values.
+//
+// The resulting data is a single version byte, currently 1, followed by the number of hash functions
+// as a 32-bit integer, the number of bits as a 64-bit integer and the bit array as 64-bit words, all in
+// big-endian byte order.
+func (v *Value) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, headerLen+8*len(v.words)))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for bloom.Value values.  It appends the
+// same encoding as MarshalBinary() to b.
+func (v *Value) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, binaryVersion)
+	b = binary.BigEndian.AppendUint32(b, v.k)
+	b = binary.BigEndian.AppendUint64(b, v.m)
+	for _, w := range v.words {
+		b = binary.BigEndian.AppendUint64(b, w)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for bloom.Value values.
+//
+// The provided value must be a version byte followed by the payload for that version.  If data is
+// empty or the wrong length for its version and bit count, ErrInvalidBinaryDataLen is returned.  If
+// the version byte is not recognized, ErrUnsupportedBinaryVersion is returned.  If the bit count is not
+// a positive multiple of 64 or there are no hash functions, ErrInvalidBinaryData is returned.
+func (v *Value) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidBinaryDataLen
+	}
+	switch data[0] {
+	case binaryVersion1:
+		if len(data) < headerLen {
+			return ErrInvalidBinaryDataLen
+		}
+		k := binary.BigEndian.Uint32(data[1:])
+		m := binary.BigEndian.Uint64(data[5:])
+		if k == 0 || m == 0 || m%64 != 0 {
+			return errors.Wrapf(ErrInvalidBinaryData, "%d bits, %d hashes", m, k)
+		}
+		if uint64(len(data)-headerLen) != m/8 {
+			return ErrInvalidBinaryDataLen
+		}
+		res := newValue(m, k)
+		for i := range res.words {
+			res.words[i] = binary.BigEndian.Uint64(data[headerLen+8*i:])
+		}
+		*v = *res
+		return nil
+	default:
+		return errors.Wrapf(ErrUnsupportedBinaryVersion, "version: %d", data[0])
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bloom
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	v, _ := New(100, 0.01)
+	v.AddString("2024-07-14")
+	v.AddString("2024-07-15")
+	data, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(data) != headerLen+int(v.Bits()/8) || data[0] != binaryVersion1 {
+		t.Fatalf("Unexpected encoding length %d or version %d", len(data), data[0])
+	}
+	var got Value
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got.Bits() != v.Bits() || got.Hashes() != v.Hashes() {
+		t.Errorf("Expected (%d, %d), got (%d, %d)", v.Bits(), v.Hashes(), got.Bits(), got.Hashes())
+	}
+	if !got.MaybeContainsString("2024-07-14") || !got.MaybeContainsString("2024-07-15") {
+		t.Errorf("Expected the decoded filter to contain the original items")
+	}
+	if err := got.Merge(v); err != nil {
+		t.Errorf("Expected the decoded filter to be compatible with the original, got %v", err)
+	}
+}
+
+func TestAppendBinary(t *testing.T) {
+	v, _ := New(100, 0.01)
+	v.AddString("2024-07-14")
+	data, _ := v.MarshalBinary()
+	got, err := v.AppendBinary([]byte("prefix"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(got) != "prefix"+string(data) {
+		t.Errorf("Expected %x, got %x", append([]byte("prefix"), data...), got)
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"empty", nil, ErrInvalidBinaryDataLen},
+		{"unsupported version", []byte{2}, ErrUnsupportedBinaryVersion},
+		{"short header", []byte{1, 0, 0, 0, 1}, ErrInvalidBinaryDataLen},
+		{"zero hashes", []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0}, ErrInvalidBinaryData},
+		{"partial word", []byte{1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 63, 0, 0, 0, 0, 0, 0, 0, 0}, ErrInvalidBinaryData},
+		{"missing words", []byte{1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0}, ErrInvalidBinaryDataLen},
+		{"valid", []byte{1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 1}, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			if err := v.UnmarshalBinary(tc.data); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
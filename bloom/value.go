@@ -0,0 +1,165 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package bloom provides Value, a Bloom filter for space-efficient, probabilistic set membership tests.
+package bloom
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidCapacity is returned by New() when the expected number of items is zero
+	ErrInvalidCapacity = errors.Errorf("bloom: the expected number of items must be greater than zero")
+	// ErrInvalidFalsePositiveRate is returned by New() when the false positive rate is not between 0 and 1
+	ErrInvalidFalsePositiveRate = errors.Errorf("bloom: the false positive rate must be between 0 and 1, exclusive")
+	// ErrIncompatibleFilters is returned by Merge() when the two filters have different sizes or
+	// numbers of hash functions
+	ErrIncompatibleFilters = errors.Errorf("bloom: filters with different parameters cannot be merged")
+)
+
+// Value is a Bloom filter, which records a set of items in a fixed-size bit array.  MaybeContains()
+// never returns false for an item that was added, but may return true for an item that was not, with
+// a probability that depends on the size of the filter and the number of items added.  Items cannot be
+// removed.
+//
+// Items are hashed with a fixed, seedless hash function so that filters persisted with MarshalBinary()
+// can be reloaded and merged by other processes.
+//
+// Values are created by New() and are not safe for concurrent use without external synchronization.
+type Value struct {
+	words []uint64
+	m     uint64 // number of bits
+	k     uint32 // number of hash functions
+}
+
+// New returns an empty filter sized to hold n items with a false positive rate of at most p, such as
+// 0.01 for 1%.  The filter may hold more than n items, but the false positive rate increases as it fills.
+func New(n uint, p float64) (*Value, error) {
+	if n == 0 {
+		return nil, ErrInvalidCapacity
+	}
+	if !(p > 0 && p < 1) {
+		return nil, errors.Wrapf(ErrInvalidFalsePositiveRate, "%v", p)
+	}
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/float64(n)*math.Ln2))
+	return newValue(uint64(m), uint32(k)), nil
+}
+
+// newValue returns an empty filter with at least m bits and k hash functions.  The bit count is rounded
+// up to a whole number of 64-bit words.
+func newValue(m uint64, k uint32) *Value {
+	words := (m + 63) / 64
+	return &Value{words: make([]uint64, words), m: words * 64, k: k}
+}
+
+// Add records item in the filter
+func (v *Value) Add(item []byte) {
+	h1, h2 := hash(item)
+	for i := uint32(0); i < v.k; i++ {
+		bit := (h1 + uint64(i)*h2) % v.m
+		v.words[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// AddString records item in the filter
+func (v *Value) AddString(item string) {
+	v.Add([]byte(item))
+}
+
+// MaybeContains returns false if item has definitely not been added to the filter, or true if it
+// probably has
+func (v *Value) MaybeContains(item []byte) bool {
+	h1, h2 := hash(item)
+	for i := uint32(0); i < v.k; i++ {
+		bit := (h1 + uint64(i)*h2) % v.m
+		if v.words[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MaybeContainsString returns false if item has definitely not been added to the filter, or true if it
+// probably has
+func (v *Value) MaybeContainsString(item string) bool {
+	return v.MaybeContains([]byte(item))
+}
+
+// Merge adds all of the items recorded in other to v, so that v represents the union of the two sets.
+// Both filters must have been created with the same parameters, otherwise ErrIncompatibleFilters is
+// returned and v is unchanged.
+func (v *Value) Merge(other *Value) error {
+	if v.m != other.m || v.k != other.k {
+		return errors.Wrapf(ErrIncompatibleFilters, "(%d bits, %d hashes) vs (%d bits, %d hashes)", v.m, v.k, other.m, other.k)
+	}
+	for i, w := range other.words {
+		v.words[i] |= w
+	}
+	return nil
+}
+
+// Bits returns the size of the filter in bits
+func (v *Value) Bits() uint64 {
+	return v.m
+}
+
+// Hashes returns the number of hash functions, which is the number of bits set for each item
+func (v *Value) Hashes() uint32 {
+	return v.k
+}
+
+// EstimatedCount returns an estimate of the number of distinct items that have been added, based on the
+// number of bits that are set
+func (v *Value) EstimatedCount() uint64 {
+	set := 0
+	for _, w := range v.words {
+		set += bits.OnesCount64(w)
+	}
+	if uint64(set) == v.m {
+		return math.MaxUint64
+	}
+	m, k := float64(v.m), float64(v.k)
+	return uint64(math.Round(-m / k * math.Log(1-float64(set)/m)))
+}
+
+// Clear removes all items from the filter
+func (v *Value) Clear() {
+	clear(v.words)
+}
+
+// Clone returns an independent copy of v
+func (v *Value) Clone() *Value {
+	c := *v
+	c.words = append([]uint64(nil), v.words...)
+	return &c
+}
+
+// hash returns the two 64-bit hashes of item that are combined to derive the k bit positions, using
+// the technique described by Kirsch and Mitzenmacher.  The first is the FNV-1a hash of item and the
+// second is derived from the first with the SplitMix64 finalizer.  The second hash is forced to be odd
+// so that it is never zero.
+//
+// These functions are part of the binary encoding, since changing them would invalidate persisted
+// filters.
+func hash(item []byte) (uint64, uint64) {
+	const (
+		offset64 = 14695981039346656037
+		prime64  = 1099511628211
+	)
+	h := uint64(offset64)
+	for _, b := range item {
+		h ^= uint64(b)
+		h *= prime64
+	}
+	z := h + 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	return h, z | 1
+}
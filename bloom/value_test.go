@@ -0,0 +1,104 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bloom
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name   string
+		n      uint
+		p      float64
+		bits   uint64
+		hashes uint32
+		err    error
+	}{
+		{"1000 at 1%", 1000, 0.01, 9600, 7, nil},
+		{"1 at 50%", 1, 0.5, 64, 1, nil},
+		{"zero items", 0, 0.01, 0, 0, ErrInvalidCapacity},
+		{"zero rate", 10, 0, 0, 0, ErrInvalidFalsePositiveRate},
+		{"rate of one", 10, 1, 0, 0, ErrInvalidFalsePositiveRate},
+		{"NaN rate", 10, math.NaN(), 0, 0, ErrInvalidFalsePositiveRate},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := New(tc.n, tc.p)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && (v.Bits() != tc.bits || v.Hashes() != tc.hashes) {
+				tt.Errorf("Expected (%d, %d), got (%d, %d)", tc.bits, tc.hashes, v.Bits(), v.Hashes())
+			}
+		})
+	}
+}
+
+func TestMembership(t *testing.T) {
+	const n = 10000
+	v, _ := New(n, 0.01)
+	for i := 0; i < n; i++ {
+		v.AddString("id-" + strconv.Itoa(i))
+	}
+	for i := 0; i < n; i++ {
+		if !v.MaybeContainsString("id-" + strconv.Itoa(i)) {
+			t.Fatalf("Expected id-%d to be reported as present", i)
+		}
+	}
+
+	// the observed false positive rate should be close to the configured one
+	fp := 0
+	for i := n; i < 2*n; i++ {
+		if v.MaybeContains([]byte("id-" + strconv.Itoa(i))) {
+			fp++
+		}
+	}
+	if rate := float64(fp) / n; rate > 0.02 {
+		t.Errorf("Expected a false positive rate near 1%%, got %.2f%%", rate*100)
+	}
+	if c := v.EstimatedCount(); c < n*95/100 || c > n*105/100 {
+		t.Errorf("Expected an estimated count near %d, got %d", n, c)
+	}
+
+	v.Clear()
+	if v.MaybeContainsString("id-0") || v.EstimatedCount() != 0 {
+		t.Errorf("Expected an empty filter after Clear()")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a, _ := New(100, 0.01)
+	b, _ := New(100, 0.01)
+	a.AddString("a")
+	b.AddString("b")
+	c := a.Clone()
+	if err := c.Merge(b); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !c.MaybeContainsString("a") || !c.MaybeContainsString("b") {
+		t.Errorf("Expected the merged filter to contain both items")
+	}
+	if a.MaybeContainsString("b") {
+		t.Errorf("Expected Clone() to return an independent copy")
+	}
+
+	other, _ := New(1000, 0.01)
+	if err := a.Merge(other); errors.Cause(err) != ErrIncompatibleFilters {
+		t.Errorf("Expected ErrIncompatibleFilters, got %v", err)
+	}
+}
+
+func TestHashIsStable(t *testing.T) {
+	// the hash is part of the binary encoding, so it must never change
+	h1, h2 := hash([]byte("hello"))
+	if h1 != 0xa430d84680aabd0b || h2 != 0xf3e8eec5eb46e501 {
+		t.Errorf("Unexpected hash values %#x, %#x", h1, h2)
+	}
+}
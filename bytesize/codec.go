@@ -0,0 +1,96 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bytesize
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextData is returned from bytesize.Value.UnmarshalJSON() when the passed-in byte slice
+	// does not contain a string or an integer
+	ErrInvalidTextData = errors.Errorf("bytesize.Value: can only decode JSON strings and integers")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+var _ flag.Value = (*Value)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface for bytesize.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for bytesize.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	return v.appendText(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for bytesize.Value values.
+//
+// The text is parsed by Parse(), so suffixes without an "i" are decimal.
+func (v *Value) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for bytesize.Value values.
+//
+// Values are encoded as a JSON string containing the same value as MarshalText(), such as "10MiB".
+func (v Value) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for bytesize.Value values.
+//
+// JSON strings are delegated to UnmarshalText() and JSON integers are a number of bytes.  As with the
+// built-in integer types, the JSON null token leaves v unchanged.
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		return nil
+	}
+	if len(p) > 0 && p[0] == '"' {
+		var s string
+		if err := json.Unmarshal(p, &s); err != nil {
+			return errors.Wrapf(ErrInvalidTextData, "%v", err)
+		}
+		return v.UnmarshalText([]byte(s))
+	}
+	n, err := strconv.ParseInt(string(p), 10, 64)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	*v = Value(n)
+	return nil
+}
+
+// Set implements the flag.Value interface for bytesize.Value values, so sizes can be passed on the
+// command line with flag.Var().  The text is parsed by Parse().
+func (v *Value) Set(s string) error {
+	return v.UnmarshalText([]byte(s))
+}
+
+// Type returns the name of the type, for compatibility with the pflag.Value interface used by
+// github.com/spf13/pflag
+func (v *Value) Type() string {
+	return "bytesize"
+}
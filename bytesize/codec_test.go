@@ -0,0 +1,94 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bytesize
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	type config struct {
+		Limit Value `json:"limit"`
+	}
+	data, err := json.Marshal(config{Limit: 10 * MiB})
+	if err != nil || string(data) != `{"limit":"10MiB"}` {
+		t.Fatalf(`Expected {"limit":"10MiB"}, got %s (err = %v)`, data, err)
+	}
+
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		err      error
+	}{
+		{"string", `"1.5GB"`, 1500 * MB, nil},
+		{"integer", `4096`, 4 * KiB, nil},
+		{"null", `null`, 1, nil},
+		{"fractional number", `1.5`, 1, ErrInvalidTextData},
+		{"bool", `true`, 1, ErrInvalidTextData},
+		{"invalid string", `"lots"`, 1, ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Value(1)
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	size := 64 * MiB
+	fs.Var(&size, "max-size", "the maximum size")
+	if err := fs.Parse([]string{"-max-size", "2GiB"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if size != 2*GiB {
+		t.Errorf("Expected %d, got %d", 2*GiB, size)
+	}
+	if f := fs.Lookup("max-size"); f.DefValue != "64MiB" {
+		t.Errorf("Expected the default to be 64MiB, got %s", f.DefValue)
+	}
+	if size.Type() != "bytesize" {
+		t.Errorf("Unexpected type name %s", size.Type())
+	}
+}
+
+func TestAppendTextDoesNotAllocate(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"zero", 0, "0B"},
+		{"bytes", 1500, "1500B"},
+		{"unit", 10 * MiB, "10MiB"},
+		{"negative", -2 * KiB, "-2KiB"},
+	}
+	buf := make([]byte, 0, 64)
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				buf, _ = tc.v.AppendText(buf[:0])
+			})
+			if allocs != 0 {
+				tt.Errorf("Expected no allocations, got %v", allocs)
+			}
+			if string(buf) != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, buf)
+			}
+		})
+	}
+}
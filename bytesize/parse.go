@@ -0,0 +1,146 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bytesize
+
+import (
+	"math"
+	"strings"
+
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextFormat is returned by Parse() and UnmarshalText() when the text is not a number
+	// followed by an optional, recognized unit
+	ErrInvalidTextFormat = errors.Errorf("bytesize.Value: text data was not in the correct format")
+)
+
+const (
+	// maxFracDigits is the number of fractional digits that are used when parsing.  Further digits
+	// represent less than one byte, even for exabytes, and are ignored.
+	maxFracDigits = 20
+)
+
+// Parse parses a size in bytes with an optional unit suffix, such as "512", "512B", "1.5GB", "10MiB"
+// or "512k".  Suffixes are case-insensitive and may be separated from the number by spaces.  A
+// leading sign is allowed and fractions are rounded to the nearest byte.
+//
+// The IEC suffixes (Ki, KiB, Mi, MiB, ...) are always binary.  The other suffixes (K, KB, M, MB, ...)
+// are decimal, following SI.  Use ParseSystem() to treat them as binary.
+func Parse(s string) (Value, error) {
+	return ParseSystem(s, Decimal)
+}
+
+// ParseSystem is the same as Parse() but interprets the suffixes K, KB, M, MB, G, GB, T, TB, P, PB,
+// E and EB using the specified unit system.  With Binary, "1MB" is parsed as 1048576 bytes, which is
+// the convention used by many operating systems and configuration files.
+func ParseSystem(s string, sys System) (Value, error) {
+	if _, err := unitsFor(sys); err != nil {
+		return 0, err
+	}
+	text := strings.TrimSpace(s)
+	neg := false
+	if len(text) > 0 && (text[0] == '+' || text[0] == '-') {
+		neg, text = text[0] == '-', text[1:]
+	}
+
+	// split into whole digits, fractional digits and the unit suffix
+	i := 0
+	for i < len(text) && isDigit(text[i]) {
+		i++
+	}
+	whole, frac := text[:i], ""
+	if i < len(text) && text[i] == '.' {
+		j := i + 1
+		for j < len(text) && isDigit(text[j]) {
+			j++
+		}
+		frac, i = text[i+1:j], j
+	}
+	if whole == "" && frac == "" {
+		return 0, errors.Wrapf(ErrInvalidTextFormat, "%q", s)
+	}
+	mult, ok := parseUnit(strings.TrimSpace(text[i:]), sys)
+	if !ok {
+		return 0, errors.Wrapf(ErrInvalidTextFormat, "unknown unit in %q", s)
+	}
+
+	// whole * mult + round(frac * mult / 10^len(frac)), computed with 128 bits
+	m := uint128.From64(uint64(mult))
+	total := uint128.Zero
+	if whole != "" {
+		w, err := uint128.Parse(whole, 10)
+		if err != nil {
+			return 0, ErrOverflow
+		}
+		if total, err = w.MulChecked(m); err != nil {
+			return 0, ErrOverflow
+		}
+	}
+	if len(frac) > maxFracDigits {
+		frac = frac[:maxFracDigits]
+	}
+	if frac != "" {
+		f := uint128.Must(uint128.Parse(frac, 10))
+		scale := uint128.One
+		for range frac {
+			scale = scale.Mul(uint128.From64(10))
+		}
+		q, r := f.Mul(m).QuoRem(scale)
+		if uint128.Compare(r.Add(r), scale) >= 0 {
+			q = q.Add(uint128.One)
+		}
+		total = total.Add(q)
+	}
+
+	limit := uint128.From64(math.MaxInt64)
+	if neg {
+		limit = limit.Add(uint128.One)
+	}
+	if uint128.Compare(total, limit) > 0 {
+		return 0, ErrOverflow
+	}
+	if neg {
+		return Value(-total.Uint64()), nil
+	}
+	return Value(total.Uint64()), nil
+}
+
+// isDigit returns true if c is an ASCII decimal digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parseUnit returns the multiplier for a case-insensitive unit suffix
+func parseUnit(u string, sys System) (Value, bool) {
+	u = strings.ToLower(u)
+	if u == "" || u == "b" {
+		return B, true
+	}
+	const prefixes = "kmgtpe"
+	p := strings.IndexByte(prefixes, u[0])
+	if p < 0 {
+		return 0, false
+	}
+	var binary bool
+	switch u[1:] {
+	case "", "b":
+		binary = sys == Binary
+	case "i", "ib":
+		binary = true
+	default:
+		return 0, false
+	}
+	mult := B
+	for i := 0; i <= p; i++ {
+		if binary {
+			mult *= 1024
+		} else {
+			mult *= 1000
+		}
+	}
+	return mult, true
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bytesize
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseSystem(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		sys      System
+		expected Value
+		err      error
+	}{
+		{"bytes", "512", Decimal, 512, nil},
+		{"bytes suffix", "512B", Decimal, 512, nil},
+		{"short decimal", "512k", Decimal, 512 * KB, nil},
+		{"short binary", "512k", Binary, 512 * KiB, nil},
+		{"decimal", "1.5GB", Decimal, 1500 * MB, nil},
+		{"decimal as binary", "1.5GB", Binary, 1536 * MiB, nil},
+		{"iec", "10MiB", Decimal, 10 * MiB, nil},
+		{"short iec", "10Mi", Decimal, 10 * MiB, nil},
+		{"lower case", "10mib", Decimal, 10 * MiB, nil},
+		{"spaces", " 2 TB ", Decimal, 2 * TB, nil},
+		{"fraction only", ".5KiB", Decimal, 512, nil},
+		{"trailing dot", "1.KB", Decimal, KB, nil},
+		{"rounded up", "1.0005kB", Decimal, 1001, nil},
+		{"rounded down", "1.0004kB", Decimal, 1000, nil},
+		{"long fraction", "0.1234567890123456789012345EiB", Decimal, 142335986942043634, nil},
+		{"negative", "-1.5MB", Decimal, -1500 * KB, nil},
+		{"positive sign", "+1K", Decimal, KB, nil},
+		{"max", "9223372036854775807", Decimal, math.MaxInt64, nil},
+		{"min", "-8EiB", Decimal, math.MinInt64, nil},
+		{"overflow", "8EiB", Decimal, 0, ErrOverflow},
+		{"huge", "99999999999999999999999999999999999999999EB", Decimal, 0, ErrOverflow},
+		{"empty", "", Decimal, 0, ErrInvalidTextFormat},
+		{"unit only", "MB", Decimal, 0, ErrInvalidTextFormat},
+		{"dot only", ".KB", Decimal, 0, ErrInvalidTextFormat},
+		{"unknown unit", "10XB", Decimal, 0, ErrInvalidTextFormat},
+		{"bits", "10Mbit", Decimal, 0, ErrInvalidTextFormat},
+		{"two numbers", "1 2", Decimal, 0, ErrInvalidTextFormat},
+		{"invalid system", "1", System(42), 0, ErrInvalidSystem},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := ParseSystem(tc.s, tc.sys)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestParseIsDecimal(t *testing.T) {
+	if v := Must(Parse("1MB")); v != MB {
+		t.Errorf("Expected %d, got %d", MB, v)
+	}
+}
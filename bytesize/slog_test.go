@@ -0,0 +1,29 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bytesize
+
+import (
+	"log/slog"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"unit", 10 * MiB, "10MiB"},
+		{"bytes", Value(1500), "1500B"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			lv := tc.v.LogValue()
+			if lv.Kind() != slog.KindString || lv.String() != tc.expected {
+				tt.Errorf("Expected %q, got %v", tc.expected, lv)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bytesize
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Value represents a number of bytes, such as the size of a file, a buffer or a storage quota.
+//
+// As with time.Duration, Value is a signed integer so that differences between sizes can be
+// represented, and the built-in arithmetic operators can be used directly.  Add(), Sub() and Mul()
+// report overflow instead of wrapping.
+type Value int64
+
+// decimal (SI) units
+const (
+	B  Value = 1
+	KB       = 1000 * B
+	MB       = 1000 * KB
+	GB       = 1000 * MB
+	TB       = 1000 * GB
+	PB       = 1000 * TB
+	EB       = 1000 * PB
+)
+
+// binary (IEC) units
+const (
+	KiB Value = 1 << (10 * (iota + 1))
+	MiB
+	GiB
+	TiB
+	PiB
+	EiB
+)
+
+// System identifies a family of units, which determines the unit chosen by Format() and the meaning
+// of ambiguous suffixes such as "K" and "MB" when parsing.
+type System int
+
+const (
+	// Decimal is the SI system, where each unit is 1000 times the previous one: kB, MB, GB, ...
+	Decimal System = iota
+	// Binary is the IEC system, where each unit is 1024 times the previous one: KiB, MiB, GiB, ...
+	Binary
+)
+
+var (
+	// ErrOverflow is returned when a size does not fit in a signed 64-bit integer
+	ErrOverflow = errors.Errorf("bytesize: the size overflows 64 bits")
+	// ErrInvalidSystem is returned when a System value is not Decimal or Binary
+	ErrInvalidSystem = errors.Errorf("bytesize: the unit system must be Decimal or Binary")
+)
+
+// unit describes a unit suffix used for formatting
+type unit struct {
+	size   Value
+	suffix string
+}
+
+var (
+	decimalUnits = []unit{{EB, "EB"}, {PB, "PB"}, {TB, "TB"}, {GB, "GB"}, {MB, "MB"}, {KB, "kB"}}
+	binaryUnits  = []unit{{EiB, "EiB"}, {PiB, "PiB"}, {TiB, "TiB"}, {GiB, "GiB"}, {MiB, "MiB"}, {KiB, "KiB"}}
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in bytesize.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bytes returns v as a number of bytes
+func (v Value) Bytes() int64 {
+	return int64(v)
+}
+
+// In returns v as a floating point number of the specified unit, so GiB.In(MiB) is 1024
+func (v Value) In(u Value) float64 {
+	return float64(v) / float64(u)
+}
+
+// Add returns v + v2, or ErrOverflow if the result does not fit in 64 bits
+func (v Value) Add(v2 Value) (Value, error) {
+	r := v + v2
+	if (v2 > 0 && r < v) || (v2 < 0 && r > v) {
+		return 0, ErrOverflow
+	}
+	return r, nil
+}
+
+// Sub returns v - v2, or ErrOverflow if the result does not fit in 64 bits
+func (v Value) Sub(v2 Value) (Value, error) {
+	r := v - v2
+	if (v2 > 0 && r > v) || (v2 < 0 && r < v) {
+		return 0, ErrOverflow
+	}
+	return r, nil
+}
+
+// Mul returns v * n, or ErrOverflow if the result does not fit in 64 bits
+func (v Value) Mul(n int64) (Value, error) {
+	hi, lo := bits.Mul64(abs(int64(v)), abs(n))
+	neg := (v < 0) != (n < 0)
+	if hi != 0 || lo > math.MaxInt64+boolToUint(neg) {
+		return 0, ErrOverflow
+	}
+	if neg {
+		return Value(-lo), nil
+	}
+	return Value(lo), nil
+}
+
+// abs returns the magnitude of n, which is correct for math.MinInt64 when treated as unsigned
+func abs(n int64) uint64 {
+	if n < 0 {
+		return uint64(-n)
+	}
+	return uint64(n)
+}
+
+// boolToUint returns 1 for true and 0 for false
+func boolToUint(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Format returns v in the largest unit of the specified system whose magnitude does not exceed the
+// magnitude of v, with prec digits after the decimal point, such as "1.50GiB".  A negative prec
+// uses the fewest digits needed to represent the value of the float64 quotient exactly.  Sizes below
+// one kilobyte are formatted in bytes, such as "512B".
+//
+// Format panics with ErrInvalidSystem if sys is not Decimal or Binary.
+func (v Value) Format(sys System, prec int) string {
+	units, err := unitsFor(sys)
+	if err != nil {
+		panic(err)
+	}
+	mag := abs(int64(v))
+	for _, u := range units {
+		if mag >= uint64(u.size) {
+			return strconv.FormatFloat(v.In(u.size), 'f', prec, 64) + u.suffix
+		}
+	}
+	return strconv.FormatInt(int64(v), 10) + "B"
+}
+
+// unitsFor returns the formatting units of the specified system, from largest to smallest
+func unitsFor(sys System) ([]unit, error) {
+	switch sys {
+	case Decimal:
+		return decimalUnits, nil
+	case Binary:
+		return binaryUnits, nil
+	default:
+		return nil, ErrInvalidSystem
+	}
+}
+
+// String implements fmt.Stringer for bytesize.Value instances.
+//
+// The returned string is exact: it uses the largest binary unit that evenly divides v, such as
+// "10MiB", or bytes, such as "1500B", so that it can always be parsed back to the same value.
+func (v Value) String() string {
+	var buf [24]byte
+	return string(v.appendText(buf[:0]))
+}
+
+// appendText appends the text representation of v, as returned by String(), to b
+func (v Value) appendText(b []byte) []byte {
+	if v != 0 {
+		for _, u := range binaryUnits {
+			if v%u.size == 0 {
+				return append(strconv.AppendInt(b, int64(v/u.size), 10), u.suffix...)
+			}
+		}
+	}
+	return append(strconv.AppendInt(b, int64(v), 10), 'B')
+}
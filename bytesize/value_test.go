@@ -0,0 +1,113 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package bytesize
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUnits(t *testing.T) {
+	if KB != 1000 || MB != 1000000 || EB != 1000000000000000000 {
+		t.Errorf("Unexpected decimal units")
+	}
+	if KiB != 1024 || MiB != 1048576 || EiB != 1<<60 {
+		t.Errorf("Unexpected binary units")
+	}
+	if GiB.In(MiB) != 1024 {
+		t.Errorf("Expected 1024, got %v", GiB.In(MiB))
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	cases := []struct {
+		name     string
+		fn       func() (Value, error)
+		expected Value
+		err      error
+	}{
+		{"add", func() (Value, error) { return MiB.Add(KiB) }, MiB + KiB, nil},
+		{"add overflow", func() (Value, error) { return Value(math.MaxInt64).Add(B) }, 0, ErrOverflow},
+		{"add negative overflow", func() (Value, error) { return Value(math.MinInt64).Add(-B) }, 0, ErrOverflow},
+		{"sub", func() (Value, error) { return KiB.Sub(MiB) }, KiB - MiB, nil},
+		{"sub overflow", func() (Value, error) { return Value(math.MinInt64).Sub(B) }, 0, ErrOverflow},
+		{"sub negative overflow", func() (Value, error) { return Value(math.MaxInt64).Sub(-B) }, 0, ErrOverflow},
+		{"mul", func() (Value, error) { return GiB.Mul(3) }, 3 * GiB, nil},
+		{"mul negative", func() (Value, error) { return GiB.Mul(-3) }, -3 * GiB, nil},
+		{"mul to min", func() (Value, error) { return Value(math.MinInt64 / 2).Mul(2) }, math.MinInt64, nil},
+		{"mul overflow", func() (Value, error) { return EiB.Mul(8) }, 0, ErrOverflow},
+		{"mul min overflow", func() (Value, error) { return Value(math.MinInt64).Mul(-1) }, 0, ErrOverflow},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := tc.fn()
+			if err != tc.err || v != tc.expected {
+				tt.Errorf("Expected (%d, %v), got (%d, %v)", tc.expected, tc.err, v, err)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		sys      System
+		prec     int
+		expected string
+	}{
+		{"zero", 0, Decimal, 2, "0B"},
+		{"bytes", 999, Decimal, 2, "999B"},
+		{"decimal kilobytes", 1500, Decimal, 1, "1.5kB"},
+		{"binary bytes", 1000, Binary, 1, "1000B"},
+		{"binary", 1536 * MiB, Binary, 2, "1.50GiB"},
+		{"shortest", 1536 * MiB, Binary, -1, "1.5GiB"},
+		{"negative", -1500 * KB, Decimal, 1, "-1.5MB"},
+		{"max", math.MaxInt64, Binary, 0, "8EiB"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.v.Format(tc.sys, tc.prec); got != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatInvalidSystem(t *testing.T) {
+	defer func() {
+		if recover() != ErrInvalidSystem {
+			t.Errorf("Expected a panic with %v", ErrInvalidSystem)
+		}
+	}()
+	KB.Format(System(42), 0)
+}
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"zero", 0, "0B"},
+		{"bytes", 1500, "1500B"},
+		{"kibibytes", 1536, "1536B"},
+		{"mebibytes", 10 * MiB, "10MiB"},
+		{"mixed", MiB + KiB, "1025KiB"},
+		{"negative", -2 * GiB, "-2GiB"},
+		{"min", math.MinInt64, "-8EiB"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			s := tc.v.String()
+			if s != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, s)
+			}
+			if got := Must(Parse(s)); got != tc.v {
+				tt.Errorf("Expected %s to parse back to %d, got %d", s, tc.v, got)
+			}
+		})
+	}
+}
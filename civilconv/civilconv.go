@@ -0,0 +1,81 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package civilconv converts between the date and time of day types in this module and the types in
+// the cloud.google.com/go/civil package, which the Google Cloud client libraries use for DATE, TIME
+// and DATETIME values.
+package civilconv
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrOutOfRange is returned when a civil value cannot be represented by the target type
+	ErrOutOfRange = errors.Errorf("civilconv: the value is out of range for the target type")
+)
+
+// DateToCivil converts a date.Value to a civil.Date.  date.Nil and other invalid dates are converted
+// to the zero civil.Date.
+func DateToCivil(v date.Value) civil.Date {
+	if !v.IsValid() {
+		return civil.Date{}
+	}
+	y, m, d := date.ToUnits(v)
+	return civil.Date{Year: y, Month: time.Month(m), Day: d}
+}
+
+// DateFromCivil converts a civil.Date to a date.Value.  The zero civil.Date is converted to date.Nil.
+// If the date is not valid or is outside of the range supported by date.Value, ErrOutOfRange is
+// returned.
+func DateFromCivil(d civil.Date) (date.Value, error) {
+	if d.IsZero() {
+		return date.Nil, nil
+	}
+	v, err := date.FromUnits(d.Year, int(d.Month), d.Day)
+	if err != nil {
+		return date.Nil, errors.Wrapf(ErrOutOfRange, "%v", d)
+	}
+	return v, nil
+}
+
+// TimeOfDayToCivil converts a timeofday.Value to a civil.Time
+func TimeOfDayToCivil(v timeofday.Value) civil.Time {
+	h, m, s, ns := v.ToUnits()
+	return civil.Time{Hour: h, Minute: m, Second: s, Nanosecond: int(ns)}
+}
+
+// TimeOfDayFromCivil converts a civil.Time to a timeofday.Value.  If the time is not valid,
+// ErrOutOfRange is returned.
+func TimeOfDayFromCivil(t civil.Time) (timeofday.Value, error) {
+	v, err := timeofday.FromUnits(t.Hour, t.Minute, t.Second, int64(t.Nanosecond))
+	if err != nil {
+		return timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%v", t)
+	}
+	return v, nil
+}
+
+// DateTimeToCivil combines a date.Value and a timeofday.Value into a civil.DateTime
+func DateTimeToCivil(d date.Value, t timeofday.Value) civil.DateTime {
+	return civil.DateTime{Date: DateToCivil(d), Time: TimeOfDayToCivil(t)}
+}
+
+// DateTimeFromCivil splits a civil.DateTime into a date.Value and a timeofday.Value.  If either part
+// cannot be converted, ErrOutOfRange is returned.
+func DateTimeFromCivil(dt civil.DateTime) (date.Value, timeofday.Value, error) {
+	d, err := DateFromCivil(dt.Date)
+	if err != nil {
+		return date.Nil, timeofday.Zero, err
+	}
+	t, err := TimeOfDayFromCivil(dt.Time)
+	if err != nil {
+		return date.Nil, timeofday.Zero, err
+	}
+	return d, t, nil
+}
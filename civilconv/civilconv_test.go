@@ -0,0 +1,114 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package civilconv
+
+import (
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestDateRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		v    date.Value
+		c    civil.Date
+	}{
+		{"nil", date.Nil, civil.Date{}},
+		{"min", date.Min, civil.Date{Year: 1753, Month: 1, Day: 1}},
+		{"max", date.Max, civil.Date{Year: 9999, Month: 12, Day: 31}},
+		{"leap day", date.Must(date.FromUnits(2024, 2, 29)), civil.Date{Year: 2024, Month: 2, Day: 29}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if c := DateToCivil(tc.v); c != tc.c {
+				tt.Errorf("Expected %v, got %v", tc.c, c)
+			}
+			if v, err := DateFromCivil(tc.c); err != nil || v != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, v, err)
+			}
+		})
+	}
+}
+
+func TestDateFromCivilErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		c    civil.Date
+	}{
+		{"invalid day", civil.Date{Year: 2023, Month: 2, Day: 29}},
+		{"before min", civil.Date{Year: 1752, Month: 12, Day: 31}},
+		{"after max", civil.Date{Year: 10000, Month: 1, Day: 1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if v, err := DateFromCivil(tc.c); errors.Cause(err) != ErrOutOfRange || v != date.Nil {
+				tt.Errorf("Expected (%v, %v), got (%v, %v)", date.Nil, ErrOutOfRange, v, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		v    timeofday.Value
+		c    civil.Time
+	}{
+		{"midnight", timeofday.Zero, civil.Time{}},
+		{"afternoon", timeofday.Must(timeofday.FromUnits(13, 45, 30, 500)), civil.Time{Hour: 13, Minute: 45, Second: 30, Nanosecond: 500}},
+		{"max", timeofday.Max, civil.Time{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if c := TimeOfDayToCivil(tc.v); c != tc.c {
+				tt.Errorf("Expected %v, got %v", tc.c, c)
+			}
+			if v, err := TimeOfDayFromCivil(tc.c); err != nil || v != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, v, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayFromCivilErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		c    civil.Time
+	}{
+		{"hour", civil.Time{Hour: 24}},
+		{"leap second", civil.Time{Hour: 23, Minute: 59, Second: 60}},
+		{"negative", civil.Time{Nanosecond: -1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if _, err := TimeOfDayFromCivil(tc.c); errors.Cause(err) != ErrOutOfRange {
+				tt.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+			}
+		})
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	tod := timeofday.Must(timeofday.FromUnits(13, 45, 30, 0))
+	dt := DateTimeToCivil(d, tod)
+	if expected := "2024-07-14T13:45:30"; dt.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, dt)
+	}
+	gotD, gotT, err := DateTimeFromCivil(dt)
+	if err != nil || gotD != d || gotT != tod {
+		t.Errorf("Expected (%v, %v), got (%v, %v) (err = %v)", d, tod, gotD, gotT, err)
+	}
+	if _, _, err := DateTimeFromCivil(civil.DateTime{Date: dt.Date, Time: civil.Time{Hour: 25}}); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, _, err := DateTimeFromCivil(civil.DateTime{Date: civil.Date{Year: 1, Month: 1, Day: 1}}); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+}
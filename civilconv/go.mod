@@ -0,0 +1,11 @@
+module github.com/dylan-bourque/go-types/civilconv
+
+go 1.25.0
+
+require (
+	cloud.google.com/go v0.123.0
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	github.com/pkg/errors v0.9.1
+)
+
+replace github.com/dylan-bourque/go-types => ../
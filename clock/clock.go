@@ -0,0 +1,76 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package clock provides the Clock interface, which abstracts reading the current time so that
+// time-dependent code, such as cache expiration, can be tested deterministically with a Fake clock.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a source of the current time
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+}
+
+// System is the Clock that reads the system clock using time.Now()
+var System Clock = systemClock{}
+
+// systemClock implements Clock using time.Now()
+type systemClock struct{}
+
+// Now returns time.Now()
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Func adapts an ordinary function, such as time.Now, to the Clock interface
+type Func func() time.Time
+
+// Now returns f()
+func (f Func) Now() time.Time {
+	return f()
+}
+
+// Fake is a Clock whose time only changes when Set() or Advance() is called.  A Fake is safe for
+// concurrent use.
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// interface validations
+var _ Clock = (*Fake)(nil)
+var _ Clock = Func(nil)
+
+// NewFake returns a Fake clock that is set to t
+func NewFake(t time.Time) *Fake {
+	return &Fake{now: t}
+}
+
+// Now returns the current time of the fake clock
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Set sets the current time of the fake clock to t, which may be earlier than the current time
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = t
+}
+
+// Advance moves the current time of the fake clock forward by d, or backward if d is negative, and
+// returns the new time
+func (f *Fake) Advance(d time.Duration) time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+	return f.now
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSystem(t *testing.T) {
+	before := time.Now()
+	now := System.Now()
+	if now.Before(before) || now.After(time.Now()) {
+		t.Errorf("Expected System.Now() to return the current time, got %v", now)
+	}
+}
+
+func TestFunc(t *testing.T) {
+	epoch := time.Unix(0, 0)
+	if got := Func(func() time.Time { return epoch }).Now(); !got.Equal(epoch) {
+		t.Errorf("Expected %v, got %v", epoch, got)
+	}
+}
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, time.July, 14, 12, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+	if got := f.Now(); !got.Equal(start) {
+		t.Errorf("Expected %v, got %v", start, got)
+	}
+	if got := f.Advance(90 * time.Second); !got.Equal(start.Add(90*time.Second)) || !f.Now().Equal(got) {
+		t.Errorf("Expected Advance() to move the clock forward, got %v", got)
+	}
+	f.Set(start.Add(-time.Hour))
+	if got := f.Now(); !got.Equal(start.Add(-time.Hour)) {
+		t.Errorf("Expected Set() to move the clock backward, got %v", got)
+	}
+	var zero Fake
+	if !zero.Now().IsZero() {
+		t.Errorf("Expected the zero Fake to return the zero time")
+	}
+}
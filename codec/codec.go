@@ -0,0 +1,211 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package codec provides a registry of encodings, keyed by type and format name, and the Marshal()
+// and Unmarshal() entry points that consult it.
+//
+// Every type in this module implements the standard encoding interfaces, which Marshal() and
+// Unmarshal() use by default for the "text", "binary" and "json" formats.  Registering a Codec
+// overrides the default for one type and format, such as to write dates as "20240714" for a legacy
+// system, and registering a new format name adds an encoding, such as a company-specific wire format,
+// without changing the types themselves.
+//
+// The MarshalJSON(), MarshalText() and similar methods of the types are not affected by the registry,
+// so the standard encoders keep their documented behavior.  Code that needs the overrides calls this
+// package instead.
+package codec
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Format identifies an encoding, such as "json"
+type Format string
+
+const (
+	// Text is the format provided by encoding.TextMarshaler and encoding.TextUnmarshaler
+	Text Format = "text"
+	// Binary is the format provided by encoding.BinaryMarshaler and encoding.BinaryUnmarshaler
+	Binary Format = "binary"
+	// JSON is the format provided by encoding/json
+	JSON Format = "json"
+)
+
+var (
+	// ErrUnsupportedFormat is returned when no codec is registered for a type and format and the type
+	// does not implement the standard interface for the format
+	ErrUnsupportedFormat = errors.Errorf("codec: the type does not support the requested format")
+	// ErrInvalidTarget is returned by Unmarshal() when the destination is not a non-nil pointer
+	ErrInvalidTarget = errors.Errorf("codec: the destination must be a non-nil pointer")
+)
+
+// Codec encodes and decodes the values of one type in one format
+type Codec struct {
+	// Encode returns the encoding of v, which is a value of the registered type
+	Encode func(v interface{}) ([]byte, error)
+	// Decode decodes data into dst, which is a non-nil pointer to a value of the registered type
+	Decode func(data []byte, dst interface{}) error
+}
+
+// key identifies a registration
+type key struct {
+	t reflect.Type
+	f Format
+}
+
+var (
+	mu     sync.RWMutex
+	codecs = make(map[key]Codec)
+)
+
+// Register associates the specified codec with a type and format, replacing any existing registration
+// and the default for the standard formats.
+func Register(t reflect.Type, f Format, c Codec) {
+	mu.Lock()
+	defer mu.Unlock()
+	codecs[key{t, f}] = c
+}
+
+// RegisterFuncs registers a codec for the type T that uses the specified strongly-typed functions
+func RegisterFuncs[T any](f Format, encode func(T) ([]byte, error), decode func([]byte) (T, error)) {
+	Register(reflect.TypeFor[T](), f, Codec{
+		Encode: func(v interface{}) ([]byte, error) {
+			return encode(v.(T))
+		},
+		Decode: func(data []byte, dst interface{}) error {
+			v, err := decode(data)
+			if err != nil {
+				return err
+			}
+			*dst.(*T) = v
+			return nil
+		},
+	})
+}
+
+// Unregister removes the codec registered for a type and format, restoring the default for the
+// standard formats
+func Unregister(t reflect.Type, f Format) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(codecs, key{t, f})
+}
+
+// Lookup returns the codec for a type and format.  A registered codec is returned if there is one.
+// Otherwise, for the standard formats, a codec that uses the standard interfaces is returned if the
+// type implements them.
+//
+// The second return value is false if the type does not support the format.
+func Lookup(t reflect.Type, f Format) (Codec, bool) {
+	if t == nil {
+		return Codec{}, false
+	}
+	mu.RLock()
+	c, ok := codecs[key{t, f}]
+	mu.RUnlock()
+	if ok {
+		return c, true
+	}
+	return defaultCodec(t, f)
+}
+
+// Marshal returns the encoding of v in the specified format.  If v is a non-nil pointer and there is no
+// codec for the pointer type, the value it points to is encoded.
+func Marshal(f Format, v interface{}) ([]byte, error) {
+	for {
+		c, ok := Lookup(reflect.TypeOf(v), f)
+		if ok && c.Encode != nil {
+			return c.Encode(v)
+		}
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Ptr || rv.IsNil() {
+			return nil, errors.Wrapf(ErrUnsupportedFormat, "%T as %s", v, f)
+		}
+		v = rv.Elem().Interface()
+	}
+}
+
+// Unmarshal decodes data, in the specified format, into the value that dst points to.  The codec is
+// looked up by the type that dst points to.  If there is no codec for that type and it is also a
+// pointer, the value it points to is decoded, allocating it if necessary.
+func Unmarshal(f Format, data []byte, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Wrapf(ErrInvalidTarget, "%T", dst)
+	}
+	for {
+		c, ok := Lookup(rv.Type().Elem(), f)
+		if ok && c.Decode != nil {
+			return c.Decode(data, rv.Interface())
+		}
+		if rv.Elem().Kind() != reflect.Ptr {
+			return errors.Wrapf(ErrUnsupportedFormat, "%s as %s", rv.Type().Elem(), f)
+		}
+		if rv.Elem().IsNil() {
+			rv.Elem().Set(reflect.New(rv.Type().Elem().Elem()))
+		}
+		rv = rv.Elem()
+	}
+}
+
+var (
+	textMarshalerType     = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType   = reflect.TypeFor[encoding.TextUnmarshaler]()
+	binaryMarshalerType   = reflect.TypeFor[encoding.BinaryMarshaler]()
+	binaryUnmarshalerType = reflect.TypeFor[encoding.BinaryUnmarshaler]()
+)
+
+// defaultCodec returns a codec for one of the standard formats that uses the interfaces implemented
+// by t.  Encode or Decode is nil if t only implements one direction.
+func defaultCodec(t reflect.Type, f Format) (Codec, bool) {
+	var c Codec
+	pt := reflect.PointerTo(t)
+	switch f {
+	case Text:
+		if t.Implements(textMarshalerType) {
+			c.Encode = func(v interface{}) ([]byte, error) {
+				if isNilPointer(v) {
+					return nil, errors.Wrapf(ErrUnsupportedFormat, "nil %T as %s", v, f)
+				}
+				return v.(encoding.TextMarshaler).MarshalText()
+			}
+		}
+		if pt.Implements(textUnmarshalerType) {
+			c.Decode = func(data []byte, dst interface{}) error {
+				return dst.(encoding.TextUnmarshaler).UnmarshalText(data)
+			}
+		}
+	case Binary:
+		if t.Implements(binaryMarshalerType) {
+			c.Encode = func(v interface{}) ([]byte, error) {
+				if isNilPointer(v) {
+					return nil, errors.Wrapf(ErrUnsupportedFormat, "nil %T as %s", v, f)
+				}
+				return v.(encoding.BinaryMarshaler).MarshalBinary()
+			}
+		}
+		if pt.Implements(binaryUnmarshalerType) {
+			c.Decode = func(data []byte, dst interface{}) error {
+				return dst.(encoding.BinaryUnmarshaler).UnmarshalBinary(data)
+			}
+		}
+	case JSON:
+		// every type can be handled by encoding/json, which reports its own errors
+		c.Encode = json.Marshal
+		c.Decode = json.Unmarshal
+	}
+	return c, c.Encode != nil || c.Decode != nil
+}
+
+// isNilPointer returns true if v is a nil pointer, which the text and binary interfaces cannot encode
+// when they are implemented with value receivers
+func isNilPointer(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
@@ -0,0 +1,133 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package codec
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/pkg/errors"
+)
+
+func TestDefaults(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	cases := []struct {
+		name     string
+		f        Format
+		v        interface{}
+		expected string
+	}{
+		{"text", Text, ratio.Must(ratio.New(3, 4)), "3/4"},
+		{"binary", Binary, d, string([]byte{1, 0x00, 0x25, 0x8b, 0x5a})},
+		{"text date", Text, d, "2024-07-14"},
+		{"json", JSON, d, `"2024-07-14"`},
+		{"json pointer", JSON, &d, `"2024-07-14"`},
+		{"binary pointer", Binary, &d, string([]byte{1, 0x00, 0x25, 0x8b, 0x5a})},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := Marshal(tc.f, tc.v)
+			if err != nil || string(data) != tc.expected {
+				tt.Fatalf("Expected %q, got %q (err = %v)", tc.expected, data, err)
+			}
+			dst := reflect.New(reflect.TypeOf(tc.v))
+			if err := Unmarshal(tc.f, data, dst.Interface()); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(dst.Elem().Interface(), tc.v) {
+				tt.Errorf("Expected %v, got %v", tc.v, dst.Elem().Interface())
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	// override the JSON encoding of dates with a compact "yyyymmdd" number
+	RegisterFuncs(JSON,
+		func(d date.Value) ([]byte, error) {
+			y, m, dd := date.ToUnits(d)
+			return []byte(strconv.Itoa(y*10000 + m*100 + dd)), nil
+		},
+		func(data []byte) (date.Value, error) {
+			n, err := strconv.Atoi(string(data))
+			if err != nil {
+				return date.Nil, err
+			}
+			return date.FromUnits(n/10000, n/100%100, n%100)
+		},
+	)
+	defer Unregister(reflect.TypeFor[date.Value](), JSON)
+
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	data, err := Marshal(JSON, d)
+	if err != nil || string(data) != "20240714" {
+		t.Fatalf("Expected the registered encoding, got %s (err = %v)", data, err)
+	}
+	var got date.Value
+	if err := Unmarshal(JSON, data, &got); err != nil || got != d {
+		t.Errorf("Expected %v, got %v (err = %v)", d, got, err)
+	}
+
+	// encoding/json is unaffected
+	if std, _ := json.Marshal(d); string(std) != `"2024-07-14"` {
+		t.Errorf("Expected encoding/json to be unaffected, got %s", std)
+	}
+
+	Unregister(reflect.TypeFor[date.Value](), JSON)
+	if data, _ := Marshal(JSON, d); string(data) != `"2024-07-14"` {
+		t.Errorf("Expected the default encoding after Unregister(), got %s", data)
+	}
+}
+
+func TestCustomFormat(t *testing.T) {
+	const hex Format = "hex"
+	type id uint32
+	RegisterFuncs(hex,
+		func(v id) ([]byte, error) { return []byte(strconv.FormatUint(uint64(v), 16)), nil },
+		func(data []byte) (id, error) {
+			n, err := strconv.ParseUint(string(data), 16, 32)
+			return id(n), err
+		},
+	)
+	data, err := Marshal(hex, id(0xbeef))
+	if err != nil || string(data) != "beef" {
+		t.Fatalf("Expected beef, got %s (err = %v)", data, err)
+	}
+	var got id
+	if err := Unmarshal(hex, []byte("cafe"), &got); err != nil || got != 0xcafe {
+		t.Errorf("Expected 0xcafe, got %#x (err = %v)", got, err)
+	}
+	if _, err := Marshal(hex, 42); errors.Cause(err) != ErrUnsupportedFormat {
+		t.Errorf("Expected ErrUnsupportedFormat for an unregistered type, got %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var d date.Value
+	cases := []struct {
+		name string
+		err  error
+		fn   func() error
+	}{
+		{"nil value", ErrUnsupportedFormat, func() error { _, err := Marshal(Text, nil); return err }},
+		{"no text marshaler", ErrUnsupportedFormat, func() error { _, err := Marshal(Text, 42); return err }},
+		{"unknown format", ErrUnsupportedFormat, func() error { _, err := Marshal("yaml", d); return err }},
+		{"nil pointer", ErrUnsupportedFormat, func() error { _, err := Marshal(Binary, (*date.Value)(nil)); return err }},
+		{"non-pointer target", ErrInvalidTarget, func() error { return Unmarshal(JSON, []byte("1"), d) }},
+		{"nil target", ErrInvalidTarget, func() error { return Unmarshal(JSON, []byte("1"), (*date.Value)(nil)) }},
+		{"no text unmarshaler", ErrUnsupportedFormat, func() error { var n int; return Unmarshal(Text, []byte("1"), &n) }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if err := tc.fn(); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package color
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		err      error
+	}{
+		{"hex", `"#336699"`, RGB(0x33, 0x66, 0x99), nil},
+		{"css", `"rgba(51, 102, 153, 0.5)"`, Value{R: 0x33, G: 0x66, B: 0x99, A: 0x80}, nil},
+		{"named", `"navy"`, RGB(0x00, 0x00, 0x80), nil},
+		{"null", `null`, White, nil},
+		{"number", `42`, White, ErrInvalidTextData},
+		{"invalid", `"#nope"`, White, ErrInvalidColor},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := White
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, v)
+			}
+		})
+	}
+
+	data, err := json.Marshal(Value{R: 0x33, G: 0x66, B: 0x99, A: 0x80})
+	if err != nil || string(data) != `"#33669980"` {
+		t.Errorf("Unexpected encoding %s (err = %v)", data, err)
+	}
+}
+
+func TestText(t *testing.T) {
+	v := RGB(0x33, 0x66, 0x99)
+	text, _ := v.MarshalText()
+	var got Value
+	if err := got.UnmarshalText(text); err != nil || got != v {
+		t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+	}
+}
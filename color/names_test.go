@@ -0,0 +1,25 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package color
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestNames(t *testing.T) {
+	names := Names()
+	if len(names) != len(namedColors) {
+		t.Fatalf("Expected %d names, got %d", len(namedColors), len(names))
+	}
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("Expected the names to be sorted")
+	}
+	for _, name := range names {
+		if _, err := Parse(name); err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", name, err)
+		}
+	}
+}
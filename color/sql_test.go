@@ -0,0 +1,67 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package color
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"string", "#336699", RGB(0x33, 0x66, 0x99), nil},
+		{"bytes", []byte("red"), RGB(0xff, 0x00, 0x00), nil},
+		{"invalid", "nope", Transparent, ErrInvalidColor},
+		{"null", nil, Transparent, ErrUnsupportedSourceType},
+		{"unsupported type", 42, Transparent, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestNullColor(t *testing.T) {
+	cases := []struct {
+		name   string
+		v      NullColor
+		sqlVal driver.Value
+		json   string
+	}{
+		{"null", NullColor{}, nil, "null"},
+		{"valid", NullColor{V: Black, Valid: true}, "#000000", `"#000000"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got, err := tc.v.Value(); err != nil || got != tc.sqlVal {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.sqlVal, got, err)
+			}
+			var scanned NullColor
+			if err := scanned.Scan(tc.sqlVal); err != nil || scanned != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, scanned, err)
+			}
+			data, err := json.Marshal(tc.v)
+			if err != nil || string(data) != tc.json {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.json, data, err)
+			}
+		})
+	}
+}
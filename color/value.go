@@ -0,0 +1,292 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package color provides Value, an 8-bit per channel RGBA color that is parsed from and formatted as
+// CSS color strings.
+package color
+
+import (
+	imagecolor "image/color"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Value represents a color as 8-bit red, green, blue and alpha channels.  The channels are not
+// premultiplied by alpha, so they match the values written in CSS and configuration files.
+//
+// The zero value is fully transparent black, which is the same as the CSS "transparent" keyword.
+type Value struct {
+	R, G, B, A uint8
+}
+
+var (
+	// Transparent is fully transparent black
+	Transparent = Value{}
+	// Black is opaque black
+	Black = Value{A: 0xff}
+	// White is opaque white
+	White = Value{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+)
+
+var (
+	// ErrInvalidColor is returned when a string is not a supported color format
+	ErrInvalidColor = errors.Errorf("color: the specified text is not a valid color")
+)
+
+// interface validations
+var _ imagecolor.Color = Value{}
+
+// canonicalNames maps colors to their names.  When several names have the same value, such as "gray"
+// and "grey", the name that sorts first is used.
+var canonicalNames = func() map[Value]string {
+	names := make([]string, 0, len(namedColors))
+	for name := range namedColors {
+		names = append(names, name)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	res := make(map[Value]string, len(names))
+	for _, name := range names {
+		res[namedColors[name]] = name
+	}
+	return res
+}()
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in color.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// RGB returns an opaque color with the specified channels
+func RGB(r, g, b uint8) Value {
+	return Value{R: r, G: g, B: b, A: 0xff}
+}
+
+// FromColor converts any image/color.Color to a color.Value, undoing the alpha premultiplication
+func FromColor(c imagecolor.Color) Value {
+	n := imagecolor.NRGBAModel.Convert(c).(imagecolor.NRGBA)
+	return Value{R: n.R, G: n.G, B: n.B, A: n.A}
+}
+
+// Parse parses a CSS color, which may be any of the following, without regard to case or surrounding
+// whitespace:
+//   - a hex color in the "#RGB", "#RGBA", "#RRGGBB" or "#RRGGBBAA" forms
+//   - an "rgb()" or "rgba()" function, with the channels separated by commas, such as
+//     "rgba(255, 0, 0, 0.5)", or by spaces with the alpha after a slash, such as "rgb(255 0 0 / 50%)".
+//     Red, green and blue are integers from 0 to 255 or percentages, and alpha is a number from 0 to 1
+//     or a percentage.
+//   - one of the CSS named colors, such as "rebeccapurple", or "transparent"
+func Parse(s string) (Value, error) {
+	text := strings.ToLower(strings.TrimSpace(s))
+	var (
+		v  Value
+		ok bool
+	)
+	switch {
+	case strings.HasPrefix(text, "#"):
+		v, ok = parseHex(text[1:])
+	case strings.HasPrefix(text, "rgb(") || strings.HasPrefix(text, "rgba("):
+		v, ok = parseFunc(text)
+	default:
+		v, ok = namedColors[text]
+	}
+	if !ok {
+		return Transparent, errors.Wrapf(ErrInvalidColor, "%q", s)
+	}
+	return v, nil
+}
+
+// Hex returns v in the "#rrggbb" form if it is opaque, or the "#rrggbbaa" form otherwise
+func (v Value) Hex() string {
+	const digits = "0123456789abcdef"
+	buf := []byte{'#'}
+	for _, c := range []uint8{v.R, v.G, v.B, v.A} {
+		buf = append(buf, digits[c>>4], digits[c&0x0f])
+	}
+	if v.A == 0xff {
+		buf = buf[:7]
+	}
+	return string(buf)
+}
+
+// CSS returns v as a CSS "rgb()" function if it is opaque, such as "rgb(255, 0, 0)", or as an
+// "rgba()" function otherwise, such as "rgba(255, 0, 0, 0.5)".  The alpha is rounded to the fewest
+// digits that parse back to the same 8-bit value.
+func (v Value) CSS() string {
+	buf := []byte("rgb(")
+	if v.A != 0xff {
+		buf = []byte("rgba(")
+	}
+	buf = strconv.AppendUint(buf, uint64(v.R), 10)
+	buf = append(buf, ", "...)
+	buf = strconv.AppendUint(buf, uint64(v.G), 10)
+	buf = append(buf, ", "...)
+	buf = strconv.AppendUint(buf, uint64(v.B), 10)
+	if v.A != 0xff {
+		buf = append(buf, ", "...)
+		buf = append(buf, formatAlpha(v.A)...)
+	}
+	return string(append(buf, ')'))
+}
+
+// Name returns the CSS name of v, or false if v is not a named color.  When several names have the same
+// value, the one that sorts first is returned, such as "aqua" rather than "cyan".
+func (v Value) Name() (string, bool) {
+	name, ok := canonicalNames[v]
+	return name, ok
+}
+
+// IsOpaque returns true if v is fully opaque
+func (v Value) IsOpaque() bool {
+	return v.A == 0xff
+}
+
+// WithAlpha returns a copy of v with the specified alpha
+func (v Value) WithAlpha(a uint8) Value {
+	v.A = a
+	return v
+}
+
+// RGBA implements the image/color.Color interface for color.Value values.  As required by that
+// interface, the returned channels are 16-bit and premultiplied by alpha.
+func (v Value) RGBA() (r, g, b, a uint32) {
+	return imagecolor.NRGBA{R: v.R, G: v.G, B: v.B, A: v.A}.RGBA()
+}
+
+// String implements fmt.Stringer for color.Value values.
+//
+// The returned string is the same as is returned by Hex().
+func (v Value) String() string {
+	return v.Hex()
+}
+
+// parseHex parses the digits of a hex color, which must be 3, 4, 6 or 8 characters long
+func parseHex(s string) (Value, bool) {
+	var ch [4]uint8
+	ch[3] = 0xff
+	switch len(s) {
+	case 3, 4:
+		for i := range s {
+			d, ok := hexDigit(s[i])
+			if !ok {
+				return Transparent, false
+			}
+			ch[i] = d<<4 | d
+		}
+	case 6, 8:
+		for i := 0; i < len(s); i += 2 {
+			hi, ok1 := hexDigit(s[i])
+			lo, ok2 := hexDigit(s[i+1])
+			if !ok1 || !ok2 {
+				return Transparent, false
+			}
+			ch[i/2] = hi<<4 | lo
+		}
+	default:
+		return Transparent, false
+	}
+	return Value{R: ch[0], G: ch[1], B: ch[2], A: ch[3]}, true
+}
+
+// hexDigit returns the value of a lower case hex digit
+func hexDigit(c byte) (uint8, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	default:
+		return 0, false
+	}
+}
+
+// parseFunc parses a lower case "rgb()" or "rgba()" function.  Both names accept an optional alpha, as
+// in CSS Color Module Level 4.
+func parseFunc(s string) (Value, bool) {
+	if !strings.HasSuffix(s, ")") {
+		return Transparent, false
+	}
+	args := s[strings.IndexByte(s, '(')+1 : len(s)-1]
+
+	var parts []string
+	if strings.Contains(args, ",") {
+		// legacy syntax: "r, g, b" or "r, g, b, a"
+		parts = strings.Split(args, ",")
+		if strings.Contains(args, "/") {
+			return Transparent, false
+		}
+	} else {
+		// modern syntax: "r g b" or "r g b / a"
+		rgb, alpha, hasAlpha := strings.Cut(args, "/")
+		parts = strings.Fields(rgb)
+		if hasAlpha {
+			parts = append(parts, alpha)
+		}
+	}
+	if len(parts) != 3 && len(parts) != 4 {
+		return Transparent, false
+	}
+
+	var ch [4]uint8
+	ch[3] = 0xff
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		var ok bool
+		if i < 3 {
+			ch[i], ok = parseChannel(p)
+		} else {
+			ch[i], ok = parseAlpha(p)
+		}
+		if !ok {
+			return Transparent, false
+		}
+	}
+	return Value{R: ch[0], G: ch[1], B: ch[2], A: ch[3]}, true
+}
+
+// parseChannel parses a red, green or blue channel, which is an integer from 0 to 255 or a percentage
+func parseChannel(s string) (uint8, bool) {
+	if pct, ok := strings.CutSuffix(s, "%"); ok {
+		return parseFraction(pct, 100)
+	}
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, false
+	}
+	return uint8(n), true
+}
+
+// parseAlpha parses an alpha channel, which is a number from 0 to 1 or a percentage
+func parseAlpha(s string) (uint8, bool) {
+	if pct, ok := strings.CutSuffix(s, "%"); ok {
+		return parseFraction(pct, 100)
+	}
+	return parseFraction(s, 1)
+}
+
+// parseFraction parses a number from 0 to max and scales it to 0 to 255
+func parseFraction(s string, max float64) (uint8, bool) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || !(f >= 0 && f <= max) {
+		return 0, false
+	}
+	return uint8(math.Round(f / max * 255)), true
+}
+
+// formatAlpha formats an 8-bit alpha as a number from 0 to 1 with the fewest digits that parse back to
+// the same value
+func formatAlpha(a uint8) string {
+	for prec := 1; ; prec++ {
+		s := strconv.FormatFloat(float64(a)/255, 'f', prec, 64)
+		if v, _ := parseAlpha(s); v == a {
+			return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package color
+
+import (
+	imagecolor "image/color"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected Value
+		err      error
+	}{
+		{"short hex", "#f80", RGB(0xff, 0x88, 0x00), nil},
+		{"short hex with alpha", "#f808", Value{R: 0xff, G: 0x88, B: 0x00, A: 0x88}, nil},
+		{"hex", "#FF8800", RGB(0xff, 0x88, 0x00), nil},
+		{"hex with alpha", "#ff880080", Value{R: 0xff, G: 0x88, B: 0x00, A: 0x80}, nil},
+		{"surrounding whitespace", "  #ff8800\n", RGB(0xff, 0x88, 0x00), nil},
+		{"rgb", "rgb(255, 136, 0)", RGB(0xff, 0x88, 0x00), nil},
+		{"rgb without spaces", "RGB(255,136,0)", RGB(0xff, 0x88, 0x00), nil},
+		{"rgba", "rgba(255, 136, 0, 0.5)", Value{R: 0xff, G: 0x88, B: 0x00, A: 0x80}, nil},
+		{"rgba with percent alpha", "rgba(255, 136, 0, 25%)", Value{R: 0xff, G: 0x88, B: 0x00, A: 0x40}, nil},
+		{"rgb with alpha", "rgb(255, 136, 0, 1)", RGB(0xff, 0x88, 0x00), nil},
+		{"rgb percentages", "rgb(100%, 50%, 0%)", RGB(0xff, 0x80, 0x00), nil},
+		{"space separated", "rgb(255 136 0)", RGB(0xff, 0x88, 0x00), nil},
+		{"space separated with alpha", "rgb(255 136 0 / 50%)", Value{R: 0xff, G: 0x88, B: 0x00, A: 0x80}, nil},
+		{"named", "RebeccaPurple", RGB(0x66, 0x33, 0x99), nil},
+		{"transparent", "transparent", Transparent, nil},
+		{"empty", "", Transparent, ErrInvalidColor},
+		{"unknown name", "blurple", Transparent, ErrInvalidColor},
+		{"bad hex digit", "#ff880g", Transparent, ErrInvalidColor},
+		{"hex length 5", "#ff880", Transparent, ErrInvalidColor},
+		{"channel out of range", "rgb(256, 0, 0)", Transparent, ErrInvalidColor},
+		{"negative channel", "rgb(-1, 0, 0)", Transparent, ErrInvalidColor},
+		{"alpha out of range", "rgba(0, 0, 0, 1.5)", Transparent, ErrInvalidColor},
+		{"too few channels", "rgb(0, 0)", Transparent, ErrInvalidColor},
+		{"too many channels", "rgba(0, 0, 0, 1, 1)", Transparent, ErrInvalidColor},
+		{"mixed separators", "rgb(0, 0, 0 / 1)", Transparent, ErrInvalidColor},
+		{"missing paren", "rgb(0, 0, 0", Transparent, ErrInvalidColor},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		hex, css string
+		named    string
+	}{
+		{"opaque", RGB(0xff, 0x88, 0x00), "#ff8800", "rgb(255, 136, 0)", ""},
+		{"half transparent", Value{R: 0xff, G: 0x88, B: 0x00, A: 0x80}, "#ff880080", "rgba(255, 136, 0, 0.5)", ""},
+		{"alpha needing more digits", Value{A: 0x01}, "#00000001", "rgba(0, 0, 0, 0.004)", ""},
+		{"transparent", Transparent, "#00000000", "rgba(0, 0, 0, 0)", "transparent"},
+		{"named", White, "#ffffff", "rgb(255, 255, 255)", "white"},
+		{"alias", RGB(0x80, 0x80, 0x80), "#808080", "rgb(128, 128, 128)", "gray"},
+		{"alias sorting first", RGB(0x00, 0xff, 0xff), "#00ffff", "rgb(0, 255, 255)", "aqua"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.v.Hex(); got != tc.hex || tc.v.String() != tc.hex {
+				tt.Errorf("Expected %s, got %s", tc.hex, got)
+			}
+			if got := tc.v.CSS(); got != tc.css {
+				tt.Errorf("Expected %s, got %s", tc.css, got)
+			}
+			if got, ok := tc.v.Name(); got != tc.named || ok != (tc.named != "") {
+				tt.Errorf("Expected %q, got %q", tc.named, got)
+			}
+			for _, s := range []string{tc.hex, tc.css} {
+				if v := Must(Parse(s)); v != tc.v {
+					tt.Errorf("Expected %q to parse back to %v, got %v", s, tc.v, v)
+				}
+			}
+		})
+	}
+}
+
+func TestCSSRoundTripsEveryAlpha(t *testing.T) {
+	for a := 0; a < 256; a++ {
+		v := Value{R: 1, G: 2, B: 3, A: uint8(a)}
+		if got := Must(Parse(v.CSS())); got != v {
+			t.Errorf("Expected %s to parse back to %v, got %v", v.CSS(), v, got)
+		}
+	}
+}
+
+func TestImageColor(t *testing.T) {
+	v := Value{R: 0xff, G: 0x80, B: 0x00, A: 0x80}
+	r, g, b, a := v.RGBA()
+	if a != 0x8080 || r != 0x8080 || b != 0 || g != 0x4080 {
+		t.Errorf("Expected premultiplied channels, got (%#x, %#x, %#x, %#x)", r, g, b, a)
+	}
+	if got := FromColor(v); got != v {
+		t.Errorf("Expected %v, got %v", v, got)
+	}
+	if got := FromColor(imagecolor.Gray{Y: 0x80}); got != RGB(0x80, 0x80, 0x80) {
+		t.Errorf("Expected #808080, got %v", got)
+	}
+	if !White.IsOpaque() || White.WithAlpha(0).IsOpaque() || White.WithAlpha(0) != (Value{R: 0xff, G: 0xff, B: 0xff}) {
+		t.Errorf("Unexpected IsOpaque()/WithAlpha() results")
+	}
+}
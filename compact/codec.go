@@ -0,0 +1,246 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package compact
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Date)(nil)
+var _ encoding.TextAppender = (*Date)(nil)
+var _ encoding.TextUnmarshaler = (*Date)(nil)
+var _ encoding.BinaryMarshaler = (*Date)(nil)
+var _ encoding.BinaryAppender = (*Date)(nil)
+var _ encoding.BinaryUnmarshaler = (*Date)(nil)
+var _ json.Marshaler = (*Date)(nil)
+var _ json.Unmarshaler = (*Date)(nil)
+var _ encoding.TextMarshaler = (*TimeOfDay)(nil)
+var _ encoding.TextAppender = (*TimeOfDay)(nil)
+var _ encoding.TextUnmarshaler = (*TimeOfDay)(nil)
+var _ encoding.BinaryMarshaler = (*TimeOfDay)(nil)
+var _ encoding.BinaryAppender = (*TimeOfDay)(nil)
+var _ encoding.BinaryUnmarshaler = (*TimeOfDay)(nil)
+var _ json.Marshaler = (*TimeOfDay)(nil)
+var _ json.Unmarshaler = (*TimeOfDay)(nil)
+var _ encoding.TextMarshaler = (*TimeOfDayNanos)(nil)
+var _ encoding.TextAppender = (*TimeOfDayNanos)(nil)
+var _ encoding.TextUnmarshaler = (*TimeOfDayNanos)(nil)
+var _ encoding.BinaryMarshaler = (*TimeOfDayNanos)(nil)
+var _ encoding.BinaryAppender = (*TimeOfDayNanos)(nil)
+var _ encoding.BinaryUnmarshaler = (*TimeOfDayNanos)(nil)
+var _ json.Marshaler = (*TimeOfDayNanos)(nil)
+var _ json.Unmarshaler = (*TimeOfDayNanos)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface for compact.Date values.  The encoded
+// value is the same as is returned by the String() method.
+func (v Date) MarshalText() ([]byte, error) {
+	return v.AppendText(make([]byte, 0, 10))
+}
+
+// AppendText implements the encoding.TextAppender interface for compact.Date values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Date) AppendText(b []byte) ([]byte, error) {
+	if v.IsNil() {
+		return b, nil
+	}
+	return v.Date().AppendText(b)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for compact.Date values.  Empty text
+// is decoded as NilDate and all other text must be a "YYYY-MM-DD" date.
+func (v *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = NilDate
+		return nil
+	}
+	d, err := date.Parse("2006-01-02", string(text))
+	if err != nil {
+		return errors.Wrapf(ErrOutOfRange, "%q: %v", text, err)
+	}
+	*v = DateOf(d)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for compact.Date values.  NilDate is encoded as
+// null and all other values as a "YYYY-MM-DD" string.
+func (v Date) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return []byte("null"), nil
+	}
+	return appendJSONString(nil, v.AppendText)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for compact.Date values.  null is decoded
+// as NilDate and strings are decoded by UnmarshalText().
+func (v *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, v.UnmarshalText)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for compact.Date values.  The
+// encoded value is the number of days since 1970-01-01 as a 32-bit big-endian integer.
+func (v Date) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, 4))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for compact.Date values.  It appends
+// the same encoding as MarshalBinary() to b.
+func (v Date) AppendBinary(b []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint32(b, uint32(v)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for compact.Date values.  The
+// data must be 4 bytes long and contain NilDate or a date in the range supported by date.Value.
+func (v *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return ErrInvalidBinaryDataLen
+	}
+	d := Date(int32(binary.BigEndian.Uint32(data)))
+	if d != NilDate && d.IsNil() {
+		return errors.Wrapf(ErrOutOfRange, "%d days since the Unix epoch", int32(d))
+	}
+	*v = d
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for compact.TimeOfDay values.  The
+// encoded value is the same as is returned by the String() method.
+func (v TimeOfDay) MarshalText() ([]byte, error) {
+	return v.AppendText(make([]byte, 0, 8))
+}
+
+// AppendText implements the encoding.TextAppender interface for compact.TimeOfDay values.  It
+// appends the same encoding as MarshalText() to b.
+func (v TimeOfDay) AppendText(b []byte) ([]byte, error) {
+	return v.TimeOfDay().AppendText(b)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for compact.TimeOfDay values.  The
+// text is parsed by timeofday.ParseText() and any fractional seconds are truncated.
+func (v *TimeOfDay) UnmarshalText(text []byte) error {
+	t, err := timeofday.ParseText(string(text))
+	if err != nil {
+		return err
+	}
+	*v = TimeOfDayOf(t)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for compact.TimeOfDay values.  The value is
+// encoded as an "hh:mm:ss" string.
+func (v TimeOfDay) MarshalJSON() ([]byte, error) {
+	return appendJSONString(make([]byte, 0, 10), v.AppendText)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for compact.TimeOfDay values.  Strings are
+// decoded by UnmarshalText() and null is decoded as midnight.
+func (v *TimeOfDay) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, func(text []byte) error {
+		if text == nil {
+			*v = 0
+			return nil
+		}
+		return v.UnmarshalText(text)
+	})
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for compact.TimeOfDay values.  The
+// encoded value is the number of seconds since midnight as a 32-bit big-endian integer.
+func (v TimeOfDay) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, 4))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for compact.TimeOfDay values.  It
+// appends the same encoding as MarshalBinary() to b.
+func (v TimeOfDay) AppendBinary(b []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint32(b, uint32(v)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for compact.TimeOfDay values.
+// The data must be 4 bytes long and contain a number of seconds less than 86,400.
+func (v *TimeOfDay) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return ErrInvalidBinaryDataLen
+	}
+	t := TimeOfDay(binary.BigEndian.Uint32(data))
+	if !t.IsValid() {
+		return errors.Wrapf(ErrOutOfRange, "%d seconds since midnight", uint32(t))
+	}
+	*v = t
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for compact.TimeOfDayNanos values.  The
+// encoded value is the same as is returned by the String() method.
+func (v TimeOfDayNanos) MarshalText() ([]byte, error) {
+	return v.AppendText(make([]byte, 0, 18))
+}
+
+// AppendText implements the encoding.TextAppender interface for compact.TimeOfDayNanos values.  It
+// appends the same encoding as MarshalText() to b.
+func (v TimeOfDayNanos) AppendText(b []byte) ([]byte, error) {
+	return v.TimeOfDay().AppendText(b)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for compact.TimeOfDayNanos values.
+// The text is parsed by timeofday.ParseText().
+func (v *TimeOfDayNanos) UnmarshalText(text []byte) error {
+	t, err := timeofday.ParseText(string(text))
+	if err != nil {
+		return err
+	}
+	*v = TimeOfDayNanosOf(t)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for compact.TimeOfDayNanos values.  The value
+// is encoded as an "hh:mm:ss.fffffffff" string.
+func (v TimeOfDayNanos) MarshalJSON() ([]byte, error) {
+	return appendJSONString(make([]byte, 0, 20), v.AppendText)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for compact.TimeOfDayNanos values.  Strings
+// are decoded by UnmarshalText() and null is decoded as midnight.
+func (v *TimeOfDayNanos) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, func(text []byte) error {
+		if text == nil {
+			*v = 0
+			return nil
+		}
+		return v.UnmarshalText(text)
+	})
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for compact.TimeOfDayNanos values.
+// The encoded value is the number of nanoseconds since midnight as a 64-bit big-endian integer.
+func (v TimeOfDayNanos) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, 8))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for compact.TimeOfDayNanos values.
+// It appends the same encoding as MarshalBinary() to b.
+func (v TimeOfDayNanos) AppendBinary(b []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(b, uint64(v)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for compact.TimeOfDayNanos
+// values.  The data must be 8 bytes long and contain a number of nanoseconds less than 24 hours.
+func (v *TimeOfDayNanos) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return ErrInvalidBinaryDataLen
+	}
+	t := TimeOfDayNanos(binary.BigEndian.Uint64(data))
+	if !t.IsValid() {
+		return errors.Wrapf(ErrOutOfRange, "%d nanoseconds since midnight", uint64(t))
+	}
+	*v = t
+	return nil
+}
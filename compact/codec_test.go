@@ -0,0 +1,150 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package compact
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestDateCodecs(t *testing.T) {
+	cases := []struct {
+		name   string
+		v      Date
+		text   string
+		json   string
+		binary []byte
+	}{
+		{"epoch", 0, "1970-01-01", `"1970-01-01"`, []byte{0, 0, 0, 0}},
+		{"before epoch", -1, "1969-12-31", `"1969-12-31"`, []byte{0xff, 0xff, 0xff, 0xff}},
+		{"nil", NilDate, "", "null", []byte{0x80, 0, 0, 0}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			text, _ := tc.v.MarshalText()
+			data, _ := tc.v.MarshalJSON()
+			bin, _ := tc.v.MarshalBinary()
+			if string(text) != tc.text || string(data) != tc.json || string(bin) != string(tc.binary) {
+				tt.Errorf("Expected %q, %s and %x, got %q, %s and %x", tc.text, tc.json, tc.binary, text, data, bin)
+			}
+			var got Date
+			if err := got.UnmarshalText(text); err != nil || got != tc.v {
+				tt.Errorf("Expected %d from text, got %d (err = %v)", tc.v, got, err)
+			}
+			got = 1
+			if err := json.Unmarshal(data, &got); err != nil || got != tc.v {
+				tt.Errorf("Expected %d from JSON, got %d (err = %v)", tc.v, got, err)
+			}
+			got = 1
+			if err := got.UnmarshalBinary(bin); err != nil || got != tc.v {
+				tt.Errorf("Expected %d from binary, got %d (err = %v)", tc.v, got, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayCodecs(t *testing.T) {
+	v := TimeOfDayOf(timeofday.Must(timeofday.FromUnits(13, 45, 30, 0)))
+	n := TimeOfDayNanosOf(timeofday.Must(timeofday.FromUnits(13, 45, 30, 5)))
+	if data, _ := json.Marshal(v); string(data) != `"13:45:30"` {
+		t.Errorf("Expected %q, got %s", `"13:45:30"`, data)
+	}
+	if data, _ := json.Marshal(n); string(data) != `"13:45:30.000000005"` {
+		t.Errorf("Expected %q, got %s", `"13:45:30.000000005"`, data)
+	}
+	if bin, _ := v.MarshalBinary(); string(bin) != string([]byte{0, 0, 0xc1, 0x7a}) {
+		t.Errorf("Expected 0000c17a, got %x", bin)
+	}
+
+	var gotV TimeOfDay
+	if err := json.Unmarshal([]byte(`"13:45:30.999"`), &gotV); err != nil || gotV != v {
+		t.Errorf("Expected %v, got %v (err = %v)", v, gotV, err)
+	}
+	var gotN TimeOfDayNanos
+	text, _ := n.MarshalText()
+	if err := gotN.UnmarshalText(text); err != nil || gotN != n {
+		t.Errorf("Expected %v from text, got %v (err = %v)", n, gotN, err)
+	}
+	gotN = 0
+	bin, _ := n.MarshalBinary()
+	if err := gotN.UnmarshalBinary(bin); err != nil || gotN != n {
+		t.Errorf("Expected %v from binary, got %v (err = %v)", n, gotN, err)
+	}
+	gotN = n
+	if err := json.Unmarshal([]byte("null"), &gotN); err != nil || gotN != 0 {
+		t.Errorf("Expected null to decode as midnight, got %v (err = %v)", gotN, err)
+	}
+}
+
+func TestAppendText(t *testing.T) {
+	d := DateOf(date.Must(date.FromUnits(2019, 7, 14)))
+	got, _ := d.AppendText([]byte("prefix:"))
+	if string(got) != "prefix:2019-07-14" {
+		t.Errorf("Expected %q, got %q", "prefix:2019-07-14", got)
+	}
+	got, _ = TimeOfDay(1).AppendBinary([]byte("prefix:"))
+	if string(got) != "prefix:\x00\x00\x00\x01" {
+		t.Errorf("Expected %q, got %q", "prefix:\x00\x00\x00\x01", got)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		f    func() error
+		err  error
+	}{
+		{"date binary length", func() error { var v Date; return v.UnmarshalBinary([]byte{0, 0, 0}) }, ErrInvalidBinaryDataLen},
+		{"date binary range", func() error { var v Date; return v.UnmarshalBinary([]byte{0x7f, 0xff, 0xff, 0xff}) }, ErrOutOfRange},
+		{"date text", func() error { var v Date; return v.UnmarshalText([]byte("1600-01-01")) }, ErrOutOfRange},
+		{"date JSON", func() error { var v Date; return v.UnmarshalJSON([]byte("42")) }, ErrInvalidJSON},
+		{"time of day binary length", func() error { var v TimeOfDay; return v.UnmarshalBinary(nil) }, ErrInvalidBinaryDataLen},
+		{"time of day binary range", func() error { var v TimeOfDay; return v.UnmarshalBinary([]byte{0, 1, 0x51, 0x80}) }, ErrOutOfRange},
+		{"time of day text", func() error { var v TimeOfDay; return v.UnmarshalText([]byte("24:00:00")) }, timeofday.ErrInvalidTimeFormat},
+		{"nanos binary length", func() error { var v TimeOfDayNanos; return v.UnmarshalBinary([]byte{0, 0, 0, 0}) }, ErrInvalidBinaryDataLen},
+		{"nanos binary range", func() error {
+			var v TimeOfDayNanos
+			return v.UnmarshalBinary([]byte{0xff, 0, 0, 0, 0, 0, 0, 0})
+		}, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if err := tc.f(); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestAppendTextDoesNotAllocate(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        interface{ AppendText([]byte) ([]byte, error) }
+		expected string
+	}{
+		{"nil date", NilDate, ""},
+		{"date", DateOf(date.Must(date.FromUnits(2019, 7, 14))), "2019-07-14"},
+		{"time of day", TimeOfDay(3661), "01:01:01"},
+		{"time of day nanos", TimeOfDayNanos(3661000000001), "01:01:01.000000001"},
+	}
+	buf := make([]byte, 0, 64)
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				buf, _ = tc.v.AppendText(buf[:0])
+			})
+			if allocs != 0 {
+				tt.Errorf("Expected no allocations, got %v", allocs)
+			}
+			if string(buf) != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package compact provides opt-in, memory-compact storage forms of the date and time of day types for
+// programs that hold tens of millions of values in memory, such as caches and in-memory columns.
+//
+//   - Date stores a date.Value as an int32 number of days since 1970-01-01, half the size of a
+//     date.Value.
+//   - TimeOfDay stores a timeofday.Value as a uint32 number of seconds since midnight, half the size of
+//     a timeofday.Value, and truncates any fractional seconds.
+//   - TimeOfDayNanos stores a timeofday.Value as a uint64 number of nanoseconds since midnight.  It is
+//     the same size as a timeofday.Value but is lossless and has a fixed, documented layout for code
+//     that serializes raw memory, such as memory-mapped files.
+//
+// The compact forms are plain integers, so slices of them can be sorted, compared and hashed directly.
+// Convert to the canonical types for any other operations.  All three types implement the same text,
+// JSON and binary encodings as each other: the text and JSON forms are the same as the canonical
+// types, and the binary forms are the big-endian integer.
+package compact
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidBinaryDataLen is returned by UnmarshalBinary() when the data is not the correct length
+	ErrInvalidBinaryDataLen = errors.Errorf("compact: binary data is not the correct length")
+	// ErrOutOfRange is returned when decoded data is not a valid value
+	ErrOutOfRange = errors.Errorf("compact: the value is out of range")
+	// ErrInvalidJSON is returned by UnmarshalJSON() when the data is not a JSON string or null
+	ErrInvalidJSON = errors.Errorf("compact: can only decode JSON strings or null")
+)
+
+// epoch is the date.Value for 1970-01-01
+var epoch = date.Must(date.FromUnits(1970, 1, 1))
+
+// Date is a compact date, the number of days since 1970-01-01
+type Date int32
+
+// NilDate is the compact form of date.Nil
+const NilDate Date = math.MinInt32
+
+// DateOf converts a date.Value to a Date.  date.Nil and other invalid dates are converted to NilDate.
+func DateOf(d date.Value) Date {
+	if !d.IsValid() {
+		return NilDate
+	}
+	return Date(d - epoch)
+}
+
+// Date converts v to a date.Value.  NilDate and any other value outside the range supported by
+// date.Value are converted to date.Nil.
+func (v Date) Date() date.Value {
+	if v == NilDate {
+		return date.Nil
+	}
+	d := epoch + date.Value(v)
+	if !d.IsValid() {
+		return date.Nil
+	}
+	return d
+}
+
+// IsNil returns true if v represents date.Nil
+func (v Date) IsNil() bool {
+	return v.Date() == date.Nil
+}
+
+// String returns the "YYYY-MM-DD" form of the date, or an empty string if v is nil
+func (v Date) String() string {
+	if v.IsNil() {
+		return ""
+	}
+	return v.Date().String()
+}
+
+// DatesOf converts a slice of date.Value values to a newly allocated slice of Date values
+func DatesOf(vs []date.Value) []Date {
+	res := make([]Date, len(vs))
+	for i, v := range vs {
+		res[i] = DateOf(v)
+	}
+	return res
+}
+
+// ToDates converts a slice of Date values to a newly allocated slice of date.Value values
+func ToDates(vs []Date) []date.Value {
+	res := make([]date.Value, len(vs))
+	for i, v := range vs {
+		res[i] = v.Date()
+	}
+	return res
+}
+
+// unmarshalJSONString decodes a JSON string or null and passes the contents, or nil for null, to
+// unmarshalText
+func unmarshalJSONString(data []byte, unmarshalText func([]byte) error) error {
+	if bytes.Equal(data, []byte("null")) {
+		return unmarshalText(nil)
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrapf(ErrInvalidJSON, "%v", err)
+	}
+	return unmarshalText([]byte(s))
+}
+
+// appendJSONString appends the output of appendText to b as a JSON string
+func appendJSONString(b []byte, appendText func([]byte) ([]byte, error)) ([]byte, error) {
+	b = append(b, '"')
+	b, err := appendText(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '"'), nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package compact
+
+import (
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+)
+
+func TestDateOf(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        date.Value
+		expected Date
+	}{
+		{"epoch", epoch, 0},
+		{"after epoch", date.Must(date.FromUnits(2019, 7, 14)), 18091},
+		{"before epoch", date.Must(date.FromUnits(1969, 12, 31)), -1},
+		{"min", date.Min, Date(date.Min - epoch)},
+		{"max", date.Max, Date(date.Max - epoch)},
+		{"nil", date.Nil, NilDate},
+		{"invalid", date.Max + 1, NilDate},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := DateOf(tc.d)
+			if got != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, got)
+			}
+			expected := tc.d
+			if !expected.IsValid() {
+				expected = date.Nil
+			}
+			if back := got.Date(); back != expected {
+				tt.Errorf("Expected the round trip to return %v, got %v", expected, back)
+			}
+		})
+	}
+}
+
+func TestDateOutOfRange(t *testing.T) {
+	cases := []struct {
+		name string
+		v    Date
+	}{
+		{"before min", DateOf(date.Min) - 1},
+		{"after max", DateOf(date.Max) + 1},
+		{"nil", NilDate},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if !tc.v.IsNil() || tc.v.Date() != date.Nil || tc.v.String() != "" {
+				tt.Errorf("Expected %d to be nil, got %v", tc.v, tc.v.Date())
+			}
+		})
+	}
+}
+
+func TestDateOrdering(t *testing.T) {
+	a, b := date.Must(date.FromUnits(1955, 11, 5)), date.Must(date.FromUnits(2015, 10, 21))
+	if !(DateOf(a) < DateOf(b)) {
+		t.Errorf("Expected compact dates to sort in the same order as date.Value")
+	}
+}
+
+func TestDateSlices(t *testing.T) {
+	ds := []date.Value{epoch, date.Min, date.Max, date.Nil}
+	got := ToDates(DatesOf(ds))
+	for i := range ds {
+		if got[i] != ds[i] {
+			t.Errorf("Expected %v at index %d, got %v", ds[i], i, got[i])
+		}
+	}
+}
+
+func TestSizes(t *testing.T) {
+	cases := []struct {
+		name     string
+		size     uintptr
+		expected uintptr
+	}{
+		{"date.Value", unsafe.Sizeof(date.Value(0)), 8},
+		{"Date", unsafe.Sizeof(Date(0)), 4},
+		{"timeofday.Value", unsafe.Sizeof(timeofday.Zero), 8},
+		{"TimeOfDay", unsafe.Sizeof(TimeOfDay(0)), 4},
+		{"TimeOfDayNanos", unsafe.Sizeof(TimeOfDayNanos(0)), 8},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if tc.size != tc.expected {
+				tt.Errorf("Expected %d bytes, got %d", tc.expected, tc.size)
+			}
+		})
+	}
+}
+
+// benchLen is the number of values in each slice allocated by the benchmarks
+const benchLen = 1 << 20
+
+// benchSink keeps the compiler from optimizing away the benchmarked work
+var benchSink int64
+
+// The slice benchmarks report the memory used by 1Mi values in the "B/op" column:
+//
+//	BenchmarkDateValueSlice         8 MiB
+//	BenchmarkCompactDateSlice       4 MiB
+//	BenchmarkTimeOfDayValueSlice    8 MiB
+//	BenchmarkCompactTimeOfDaySlice  4 MiB
+//
+// The scan benchmarks measure the cost of reading every value of a slice through its canonical form,
+// which for Date includes converting back to a date.Value.
+
+func BenchmarkDateValueSlice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := make([]date.Value, benchLen)
+		benchSink += int64(len(s))
+	}
+}
+
+func BenchmarkCompactDateSlice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := make([]Date, benchLen)
+		benchSink += int64(len(s))
+	}
+}
+
+func BenchmarkTimeOfDayValueSlice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := make([]timeofday.Value, benchLen)
+		benchSink += int64(len(s))
+	}
+}
+
+func BenchmarkCompactTimeOfDaySlice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := make([]TimeOfDay, benchLen)
+		benchSink += int64(len(s))
+	}
+}
+
+func BenchmarkDateValueScan(b *testing.B) {
+	s := make([]date.Value, benchLen)
+	for i := range s {
+		s[i] = epoch + date.Value(i%36500)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range s {
+			benchSink += int64(v.Day())
+		}
+	}
+}
+
+func BenchmarkCompactDateScan(b *testing.B) {
+	s := make([]Date, benchLen)
+	for i := range s {
+		s[i] = Date(i % 36500)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range s {
+			benchSink += int64(v.Date().Day())
+		}
+	}
+}
+
+func BenchmarkCompactTimeOfDayScan(b *testing.B) {
+	s := make([]TimeOfDay, benchLen)
+	for i := range s {
+		s[i] = TimeOfDay(i % secondsPerDay)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range s {
+			benchSink += int64(timeofday.ToDuration(v.TimeOfDay()) / time.Second)
+		}
+	}
+}
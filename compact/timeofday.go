@@ -0,0 +1,88 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package compact
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/timeofday"
+)
+
+// secondsPerDay is the number of seconds in a day
+const secondsPerDay = 24 * 60 * 60
+
+// TimeOfDay is a compact time of day, the number of seconds since midnight
+type TimeOfDay uint32
+
+// TimeOfDayOf converts a timeofday.Value to a TimeOfDay, truncating any fractional seconds
+func TimeOfDayOf(t timeofday.Value) TimeOfDay {
+	return TimeOfDay(timeofday.ToDuration(t) / time.Second)
+}
+
+// IsValid returns true if v is less than 24 hours
+func (v TimeOfDay) IsValid() bool {
+	return v < secondsPerDay
+}
+
+// TimeOfDay converts v to a timeofday.Value.  Values of 24 hours or more, which can only be created
+// by converting an integer directly, are converted to timeofday.Zero.
+func (v TimeOfDay) TimeOfDay() timeofday.Value {
+	if !v.IsValid() {
+		return timeofday.Zero
+	}
+	return timeofday.Must(timeofday.FromDuration(time.Duration(v) * time.Second))
+}
+
+// String returns the "hh:mm:ss" form of the time of day
+func (v TimeOfDay) String() string {
+	return v.TimeOfDay().String()
+}
+
+// TimesOfDayOf converts a slice of timeofday.Value values to a newly allocated slice of TimeOfDay
+// values, truncating any fractional seconds
+func TimesOfDayOf(vs []timeofday.Value) []TimeOfDay {
+	res := make([]TimeOfDay, len(vs))
+	for i, v := range vs {
+		res[i] = TimeOfDayOf(v)
+	}
+	return res
+}
+
+// ToTimesOfDay converts a slice of TimeOfDay values to a newly allocated slice of timeofday.Value
+// values
+func ToTimesOfDay(vs []TimeOfDay) []timeofday.Value {
+	res := make([]timeofday.Value, len(vs))
+	for i, v := range vs {
+		res[i] = v.TimeOfDay()
+	}
+	return res
+}
+
+// TimeOfDayNanos is a lossless time of day, the number of nanoseconds since midnight
+type TimeOfDayNanos uint64
+
+// TimeOfDayNanosOf converts a timeofday.Value to a TimeOfDayNanos
+func TimeOfDayNanosOf(t timeofday.Value) TimeOfDayNanos {
+	return TimeOfDayNanos(timeofday.ToDuration(t))
+}
+
+// IsValid returns true if v is less than 24 hours
+func (v TimeOfDayNanos) IsValid() bool {
+	return v < TimeOfDayNanos(24*time.Hour)
+}
+
+// TimeOfDay converts v to a timeofday.Value.  Values of 24 hours or more, which can only be created
+// by converting an integer directly, are converted to timeofday.Zero.
+func (v TimeOfDayNanos) TimeOfDay() timeofday.Value {
+	if !v.IsValid() {
+		return timeofday.Zero
+	}
+	return timeofday.Must(timeofday.FromDuration(time.Duration(v)))
+}
+
+// String returns the "hh:mm:ss.fffffffff" form of the time of day
+func (v TimeOfDayNanos) String() string {
+	return v.TimeOfDay().String()
+}
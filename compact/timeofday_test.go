@@ -0,0 +1,77 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package compact
+
+import (
+	"testing"
+
+	"github.com/dylan-bourque/go-types/timeofday"
+)
+
+func TestTimeOfDayOf(t *testing.T) {
+	cases := []struct {
+		name     string
+		t        timeofday.Value
+		expected TimeOfDay
+		back     timeofday.Value
+	}{
+		{"zero", timeofday.Zero, 0, timeofday.Zero},
+		{"whole seconds", timeofday.Must(timeofday.FromUnits(13, 45, 30, 0)), 49530, timeofday.Must(timeofday.FromUnits(13, 45, 30, 0))},
+		{"truncated", timeofday.Must(timeofday.FromUnits(13, 45, 30, 999999999)), 49530, timeofday.Must(timeofday.FromUnits(13, 45, 30, 0))},
+		{"max", timeofday.Max, secondsPerDay - 1, timeofday.Must(timeofday.FromUnits(23, 59, 59, 0))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := TimeOfDayOf(tc.t)
+			if got != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, got)
+			}
+			if back := got.TimeOfDay(); back != tc.back {
+				tt.Errorf("Expected %v, got %v", tc.back, back)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayNanosOf(t *testing.T) {
+	cases := []struct {
+		name string
+		t    timeofday.Value
+	}{
+		{"zero", timeofday.Zero},
+		{"fractional", timeofday.Must(timeofday.FromUnits(13, 45, 30, 123456789))},
+		{"max", timeofday.Max},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := TimeOfDayNanosOf(tc.t)
+			if back := v.TimeOfDay(); back != tc.t {
+				tt.Errorf("Expected %v, got %v", tc.t, back)
+			}
+			if v.String() != tc.t.String() {
+				tt.Errorf("Expected %q, got %q", tc.t.String(), v.String())
+			}
+		})
+	}
+}
+
+func TestTimeOfDayInvalid(t *testing.T) {
+	if v := TimeOfDay(secondsPerDay); v.IsValid() || v.TimeOfDay() != timeofday.Zero {
+		t.Errorf("Expected %d to be invalid and convert to midnight, got %v", v, v.TimeOfDay())
+	}
+	if v := TimeOfDayNanosOf(timeofday.Max) + 1; v.IsValid() || v.TimeOfDay() != timeofday.Zero {
+		t.Errorf("Expected %d to be invalid and convert to midnight, got %v", v, v.TimeOfDay())
+	}
+}
+
+func TestTimeOfDaySlices(t *testing.T) {
+	ts := []timeofday.Value{timeofday.Zero, timeofday.Must(timeofday.FromUnits(9, 30, 0, 0))}
+	got := ToTimesOfDay(TimesOfDayOf(ts))
+	for i := range ts {
+		if got[i] != ts[i] {
+			t.Errorf("Expected %v at index %d, got %v", ts[i], i, got[i])
+		}
+	}
+}
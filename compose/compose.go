@@ -0,0 +1,175 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package compose bridges the date and timeofday packages and time.Time, centralizing how a wall
+// clock date and time that falls into a Daylight Savings Time gap (a skipped, non-existent time) or
+// overlap (a repeated, ambiguous time) is resolved.
+//
+// Composing values via time.Date() silently normalizes both cases, and which instant is chosen is
+// not guaranteed.  Combine() makes the choice explicit through a Policy.
+package compose
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// GapPolicy controls how Combine() resolves a wall clock time that does not exist because it was
+// skipped by a transition, such as 02:30 on the day Daylight Savings Time starts in the US.
+type GapPolicy int
+
+const (
+	// GapShiftForward moves the time forward by the length of the gap, so 02:30 becomes 03:30 for a
+	// one hour gap.  This matches the behavior of most operating systems and databases.
+	GapShiftForward GapPolicy = iota
+	// GapNextValid returns the instant of the transition, which is the first valid wall clock time
+	// after the requested one.
+	GapNextValid
+	// GapError returns ErrNonexistentTime
+	GapError
+)
+
+// OverlapPolicy controls how Combine() resolves a wall clock time that occurs twice because it was
+// repeated by a transition, such as 01:30 on the day Daylight Savings Time ends in the US.
+type OverlapPolicy int
+
+const (
+	// OverlapEarlier returns the first occurrence, which uses the offset in effect before the transition
+	OverlapEarlier OverlapPolicy = iota
+	// OverlapLater returns the second occurrence, which uses the offset in effect after the transition
+	OverlapLater
+	// OverlapError returns ErrAmbiguousTime
+	OverlapError
+)
+
+// Policy defines how Combine() resolves non-existent and ambiguous wall clock times
+type Policy struct {
+	Gap     GapPolicy
+	Overlap OverlapPolicy
+}
+
+var (
+	// Default shifts non-existent times forward and picks the earlier of two ambiguous times
+	Default = Policy{Gap: GapShiftForward, Overlap: OverlapEarlier}
+	// Strict rejects both non-existent and ambiguous times
+	Strict = Policy{Gap: GapError, Overlap: OverlapError}
+)
+
+var (
+	// ErrInvalidDate is returned by Combine() when the date is date.Nil or otherwise invalid
+	ErrInvalidDate = errors.Errorf("compose: the date is not valid")
+	// ErrInvalidTimeOfDay is returned by Combine() when the time of day is not valid
+	ErrInvalidTimeOfDay = errors.Errorf("compose: the time of day is not valid")
+	// ErrNonexistentTime is returned by Combine() when the wall clock time was skipped in the
+	// location and the policy is GapError
+	ErrNonexistentTime = errors.Errorf("compose: the time does not exist in the specified location")
+	// ErrAmbiguousTime is returned by Combine() when the wall clock time occurs twice in the location
+	// and the policy is OverlapError
+	ErrAmbiguousTime = errors.Errorf("compose: the time is ambiguous in the specified location")
+)
+
+// Combine composes the specified date and time of day into an instant in the specified location,
+// using p to resolve times that do not exist or that occur twice.  If loc is nil, UTC is used.
+func Combine(d date.Value, t timeofday.Value, loc *time.Location, p Policy) (time.Time, error) {
+	if !d.IsValid() {
+		return time.Time{}, ErrInvalidDate
+	}
+	if !t.IsValid() {
+		return time.Time{}, ErrInvalidTimeOfDay
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	y, m, dd := date.ToUnits(d)
+	// the wall clock time, expressed as if it were UTC
+	wall := t.ToDateTimeUTC(y, time.Month(m), dd)
+
+	// the offsets in effect a day before and a day after, which covers any single transition
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	// collect the instants that display as the requested wall clock time
+	var matches []time.Time
+	for _, off := range []int{before, after} {
+		c := wall.Add(-time.Duration(off) * time.Second).In(loc)
+		if sameWallClock(c, wall) && (len(matches) == 0 || !matches[0].Equal(c)) {
+			matches = append(matches, c)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Before(matches[j]) })
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 2:
+		switch p.Overlap {
+		case OverlapLater:
+			return matches[1], nil
+		case OverlapError:
+			return time.Time{}, errors.Wrapf(ErrAmbiguousTime, "%s %s in %s", d, t, loc)
+		default:
+			return matches[0], nil
+		}
+	default:
+		// the time falls into a gap: interpreting it with the earlier offset lands after the
+		// transition and interpreting it with the later offset lands before it
+		shifted := wall.Add(-time.Duration(before) * time.Second).In(loc)
+		switch p.Gap {
+		case GapNextValid:
+			return transition(wall.Add(-time.Duration(after)*time.Second), shifted, loc), nil
+		case GapError:
+			return time.Time{}, errors.Wrapf(ErrNonexistentTime, "%s %s in %s", d, t, loc)
+		default:
+			return shifted, nil
+		}
+	}
+}
+
+// Split is the inverse of Combine().  It returns the date and time of day of the wall clock time of t
+// in the specified location.  If loc is nil, the location of t is used.
+func Split(t time.Time, loc *time.Location) (date.Value, timeofday.Value, error) {
+	if loc != nil {
+		t = t.In(loc)
+	}
+	d, err := date.FromTime(t)
+	if err != nil {
+		return date.Nil, timeofday.Zero, err
+	}
+	hh, mm, ss := t.Clock()
+	tod, err := timeofday.FromUnits(hh, mm, ss, int64(t.Nanosecond()))
+	if err != nil {
+		return date.Nil, timeofday.Zero, err
+	}
+	return d, tod, nil
+}
+
+// sameWallClock returns true if t, in its own location, displays the same date and time as wall,
+// which is in UTC
+func sameWallClock(t, wall time.Time) bool {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := wall.Date()
+	h1, mi1, s1 := t.Clock()
+	h2, mi2, s2 := wall.Clock()
+	return y1 == y2 && m1 == m2 && d1 == d2 && h1 == h2 && mi1 == mi2 && s1 == s2 && t.Nanosecond() == wall.Nanosecond()
+}
+
+// transition returns the instant, between lo and hi, at which the UTC offset of loc changes
+func transition(lo, hi time.Time, loc *time.Location) time.Time {
+	_, off := lo.In(loc).Zone()
+	// binary search down to 1 second, which is the resolution of time zone transitions
+	lo, hi = lo.Truncate(time.Second), hi.Truncate(time.Second).Add(time.Second)
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+		if _, o := mid.In(loc).Zone(); o == off {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi.In(loc)
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package compose
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestCombine(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data is not available: %v", err)
+	}
+	var (
+		normal    = date.Must(date.FromUnits(2024, 7, 1))
+		springFwd = date.Must(date.FromUnits(2024, 3, 10))
+		fallBack  = date.Must(date.FromUnits(2024, 11, 3))
+		oneThirty = timeofday.Must(timeofday.FromUnits(1, 30, 0, 0))
+		twoThirty = timeofday.Must(timeofday.FromUnits(2, 30, 0, 0))
+	)
+	utc := func(m, d, h, mi int) time.Time {
+		return time.Date(2024, time.Month(m), d, h, mi, 0, 0, time.UTC)
+	}
+	cases := []struct {
+		name     string
+		d        date.Value
+		t        timeofday.Value
+		loc      *time.Location
+		p        Policy
+		expected time.Time
+		err      error
+	}{
+		{"invalid date", date.Nil, oneThirty, ny, Default, time.Time{}, ErrInvalidDate},
+		{"nil location", normal, oneThirty, nil, Strict, utc(7, 1, 1, 30), nil},
+		{"unambiguous", normal, oneThirty, ny, Strict, utc(7, 1, 5, 30), nil},
+		{"gap/shift forward", springFwd, twoThirty, ny, Default, utc(3, 10, 7, 30), nil},
+		{"gap/next valid", springFwd, twoThirty, ny, Policy{Gap: GapNextValid}, utc(3, 10, 7, 0), nil},
+		{"gap/error", springFwd, twoThirty, ny, Strict, time.Time{}, ErrNonexistentTime},
+		{"overlap/earlier", fallBack, oneThirty, ny, Default, utc(11, 3, 5, 30), nil},
+		{"overlap/later", fallBack, oneThirty, ny, Policy{Overlap: OverlapLater}, utc(11, 3, 6, 30), nil},
+		{"overlap/error", fallBack, oneThirty, ny, Strict, time.Time{}, ErrAmbiguousTime},
+		{"transition day/unaffected time", fallBack, twoThirty, ny, Strict, utc(11, 3, 7, 30), nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := Combine(tc.d, tc.t, tc.loc, tc.p)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if !got.Equal(tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("time zone data is not available: %v", err)
+	}
+	in := time.Date(2024, time.December, 31, 20, 15, 30, 500, time.UTC)
+	d, tod, err := Split(in, tokyo)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := date.Must(date.FromUnits(2025, 1, 1)); d != expected {
+		t.Errorf("Expected %v, got %v", expected, d)
+	}
+	if expected := timeofday.Must(timeofday.FromUnits(5, 15, 30, 500)); tod != expected {
+		t.Errorf("Expected %v, got %v", expected, tod)
+	}
+	back, err := Combine(d, tod, tokyo, Strict)
+	if err != nil || !back.Equal(in) {
+		t.Errorf("Expected round trip to %v, got %v (err = %v)", in, back, err)
+	}
+}
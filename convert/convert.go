@@ -0,0 +1,147 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package convert provides checked numeric conversions that return an error on overflow or loss of
+// precision instead of silently truncating or wrapping, for use at API and database boundaries where
+// a value from an external source must fit into a narrower type.
+package convert
+
+import (
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrOverflow is returned when a value is outside the range of the target type
+	ErrOverflow = errors.Errorf("convert: the value is out of range for the target type")
+	// ErrPrecisionLoss is returned when a value cannot be represented exactly by the target type
+	ErrPrecisionLoss = errors.Errorf("convert: the value cannot be represented exactly by the target type")
+)
+
+// integer is the set of built-in integer types
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer converts v to the integer type To, returning ErrOverflow if v is outside the range of To.
+// The named functions in this package, such as Int64ToInt32(), are shortcuts for common instances.
+func Integer[To, From integer](v From) (To, error) {
+	res := To(v)
+	// the conversion is exact if it round trips and does not change the sign
+	if From(res) != v || (v < 0) != (res < 0) {
+		return 0, errors.Wrapf(ErrOverflow, "%d", v)
+	}
+	return res, nil
+}
+
+// Int64ToInt32 converts v to an int32, returning ErrOverflow if it is out of range
+func Int64ToInt32(v int64) (int32, error) {
+	return Integer[int32](v)
+}
+
+// Int64ToInt converts v to an int, returning ErrOverflow if it is out of range on 32-bit platforms
+func Int64ToInt(v int64) (int, error) {
+	return Integer[int](v)
+}
+
+// IntToInt32 converts v to an int32, returning ErrOverflow if it is out of range
+func IntToInt32(v int) (int32, error) {
+	return Integer[int32](v)
+}
+
+// Int64ToUint32 converts v to a uint32, returning ErrOverflow if it is negative or out of range
+func Int64ToUint32(v int64) (uint32, error) {
+	return Integer[uint32](v)
+}
+
+// Int64ToUint64 converts v to a uint64, returning ErrOverflow if it is negative
+func Int64ToUint64(v int64) (uint64, error) {
+	return Integer[uint64](v)
+}
+
+// Uint64ToInt64 converts v to an int64, returning ErrOverflow if it is greater than math.MaxInt64
+func Uint64ToInt64(v uint64) (int64, error) {
+	return Integer[int64](v)
+}
+
+// Float64ToInt64 converts f to an int64.  ErrOverflow is returned if f is NaN, infinite or out of
+// range, and ErrPrecisionLoss if f has a fractional part.
+func Float64ToInt64(f float64) (int64, error) {
+	// -2^63 is exactly representable, but 2^63 - 1 is not, so the upper bound is exclusive
+	if math.IsNaN(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, errors.Wrapf(ErrOverflow, "%v", f)
+	}
+	if f != math.Trunc(f) {
+		return 0, errors.Wrapf(ErrPrecisionLoss, "%v", f)
+	}
+	return int64(f), nil
+}
+
+// Int64ToFloat64 converts v to a float64, returning ErrPrecisionLoss if v has more significant bits
+// than a float64 can hold, which is only possible when its magnitude is greater than 2^53
+func Int64ToFloat64(v int64) (float64, error) {
+	f := float64(v)
+	// float64(math.MaxInt64) rounds up to 2^63, which can't be converted back
+	if f >= math.MaxInt64 || int64(f) != v {
+		return 0, errors.Wrapf(ErrPrecisionLoss, "%d", v)
+	}
+	return f, nil
+}
+
+// Float64ToFloat32 converts f to a float32.  ErrOverflow is returned if f is finite but out of range,
+// and ErrPrecisionLoss if f cannot be represented exactly.  NaN and infinities are converted as is.
+func Float64ToFloat32(f float64) (float32, error) {
+	res := float32(f)
+	switch {
+	case math.IsNaN(f):
+		return res, nil
+	case !math.IsInf(f, 0) && math.IsInf(float64(res), 0):
+		return 0, errors.Wrapf(ErrOverflow, "%v", f)
+	case float64(res) != f:
+		return 0, errors.Wrapf(ErrPrecisionLoss, "%v", f)
+	}
+	return res, nil
+}
+
+// DurationToMillis converts d to a whole number of milliseconds, returning ErrPrecisionLoss if d has
+// a sub-millisecond part
+func DurationToMillis(d time.Duration) (int64, error) {
+	return durationTo(d, time.Millisecond)
+}
+
+// DurationToSeconds converts d to a whole number of seconds, returning ErrPrecisionLoss if d has a
+// fractional second part
+func DurationToSeconds(d time.Duration) (int64, error) {
+	return durationTo(d, time.Second)
+}
+
+// durationTo converts d to a whole number of units
+func durationTo(d, unit time.Duration) (int64, error) {
+	if d%unit != 0 {
+		return 0, errors.Wrapf(ErrPrecisionLoss, "%v is not a whole number of %v", d, unit)
+	}
+	return int64(d / unit), nil
+}
+
+// MillisToDuration converts a number of milliseconds to a time.Duration, returning ErrOverflow if the
+// result is outside the range of a time.Duration, roughly 292 years
+func MillisToDuration(ms int64) (time.Duration, error) {
+	return durationFrom(ms, time.Millisecond)
+}
+
+// SecondsToDuration converts a number of seconds to a time.Duration, returning ErrOverflow if the
+// result is outside the range of a time.Duration, roughly 292 years
+func SecondsToDuration(s int64) (time.Duration, error) {
+	return durationFrom(s, time.Second)
+}
+
+// durationFrom converts a number of units to a time.Duration
+func durationFrom(n int64, unit time.Duration) (time.Duration, error) {
+	if n > int64(math.MaxInt64/unit) || n < int64(math.MinInt64/unit) {
+		return 0, errors.Wrapf(ErrOverflow, "%d x %v", n, unit)
+	}
+	return time.Duration(n) * unit, nil
+}
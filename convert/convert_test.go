@@ -0,0 +1,115 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package convert
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestIntegerConversions(t *testing.T) {
+	cases := []struct {
+		name     string
+		f        func() (int64, error)
+		expected int64
+		err      error
+	}{
+		{"int32 in range", func() (int64, error) { v, err := Int64ToInt32(-12345); return int64(v), err }, -12345, nil},
+		{"int32 max", func() (int64, error) { v, err := Int64ToInt32(math.MaxInt32); return int64(v), err }, math.MaxInt32, nil},
+		{"int32 overflow", func() (int64, error) { v, err := Int64ToInt32(math.MaxInt32 + 1); return int64(v), err }, 0, ErrOverflow},
+		{"int32 underflow", func() (int64, error) { v, err := Int64ToInt32(math.MinInt32 - 1); return int64(v), err }, 0, ErrOverflow},
+		{"int to int32", func() (int64, error) { v, err := IntToInt32(1 << 40); return int64(v), err }, 0, ErrOverflow},
+		{"int64 to int", func() (int64, error) { v, err := Int64ToInt(42); return int64(v), err }, 42, nil},
+		{"uint32", func() (int64, error) { v, err := Int64ToUint32(math.MaxUint32); return int64(v), err }, math.MaxUint32, nil},
+		{"uint32 negative", func() (int64, error) { v, err := Int64ToUint32(-1); return int64(v), err }, 0, ErrOverflow},
+		{"uint32 overflow", func() (int64, error) { v, err := Int64ToUint32(math.MaxUint32 + 1); return int64(v), err }, 0, ErrOverflow},
+		{"uint64 negative", func() (int64, error) { v, err := Int64ToUint64(-1); return int64(v), err }, 0, ErrOverflow},
+		{"uint64 to int64", func() (int64, error) { return Uint64ToInt64(math.MaxInt64) }, math.MaxInt64, nil},
+		{"uint64 to int64 overflow", func() (int64, error) { return Uint64ToInt64(math.MaxInt64 + 1) }, 0, ErrOverflow},
+		{"generic int8", func() (int64, error) { v, err := Integer[int8](uint16(200)); return int64(v), err }, 0, ErrOverflow},
+		{"generic uint8", func() (int64, error) { v, err := Integer[uint8](int16(200)); return int64(v), err }, 200, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.f()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFloatConversions(t *testing.T) {
+	cases := []struct {
+		name     string
+		f        func() (float64, error)
+		expected float64
+		err      error
+	}{
+		{"float to int64", func() (float64, error) { v, err := Float64ToInt64(-42); return float64(v), err }, -42, nil},
+		{"float to int64 min", func() (float64, error) { v, err := Float64ToInt64(math.MinInt64); return float64(v), err }, math.MinInt64, nil},
+		{"float to int64 fraction", func() (float64, error) { v, err := Float64ToInt64(1.5); return float64(v), err }, 0, ErrPrecisionLoss},
+		{"float to int64 overflow", func() (float64, error) { v, err := Float64ToInt64(math.MaxInt64); return float64(v), err }, 0, ErrOverflow},
+		{"float to int64 NaN", func() (float64, error) { v, err := Float64ToInt64(math.NaN()); return float64(v), err }, 0, ErrOverflow},
+		{"float to int64 infinity", func() (float64, error) { v, err := Float64ToInt64(math.Inf(-1)); return float64(v), err }, 0, ErrOverflow},
+		{"int64 to float", func() (float64, error) { return Int64ToFloat64(1 << 53) }, 1 << 53, nil},
+		{"int64 to float precision", func() (float64, error) { return Int64ToFloat64(1<<53 + 1) }, 0, ErrPrecisionLoss},
+		{"int64 to float max", func() (float64, error) { return Int64ToFloat64(math.MaxInt64) }, 0, ErrPrecisionLoss},
+		{"float32", func() (float64, error) { v, err := Float64ToFloat32(0.5); return float64(v), err }, 0.5, nil},
+		{"float32 infinity", func() (float64, error) { v, err := Float64ToFloat32(math.Inf(1)); return float64(v), err }, math.Inf(1), nil},
+		{"float32 precision", func() (float64, error) { v, err := Float64ToFloat32(0.1); return float64(v), err }, 0, ErrPrecisionLoss},
+		{"float32 overflow", func() (float64, error) { v, err := Float64ToFloat32(1e39); return float64(v), err }, 0, ErrOverflow},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.f()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+	if v, err := Float64ToFloat32(math.NaN()); err != nil || !math.IsNaN(float64(v)) {
+		t.Errorf("Expected NaN, got %v (err = %v)", v, err)
+	}
+}
+
+func TestDurationConversions(t *testing.T) {
+	cases := []struct {
+		name     string
+		f        func() (int64, error)
+		expected int64
+		err      error
+	}{
+		{"to millis", func() (int64, error) { return DurationToMillis(1500 * time.Millisecond) }, 1500, nil},
+		{"to millis negative", func() (int64, error) { return DurationToMillis(-2 * time.Second) }, -2000, nil},
+		{"to millis precision", func() (int64, error) { return DurationToMillis(1500 * time.Microsecond) }, 0, ErrPrecisionLoss},
+		{"to seconds", func() (int64, error) { return DurationToSeconds(time.Hour) }, 3600, nil},
+		{"to seconds precision", func() (int64, error) { return DurationToSeconds(1500 * time.Millisecond) }, 0, ErrPrecisionLoss},
+		{"from millis", func() (int64, error) { d, err := MillisToDuration(1500); return int64(d), err }, int64(1500 * time.Millisecond), nil},
+		{"from millis overflow", func() (int64, error) { d, err := MillisToDuration(math.MaxInt64 / 1000); return int64(d), err }, 0, ErrOverflow},
+		{"from seconds", func() (int64, error) { d, err := SecondsToDuration(-60); return int64(d), err }, int64(-time.Minute), nil},
+		{"from seconds overflow", func() (int64, error) { d, err := SecondsToDuration(math.MinInt64 / 1000); return int64(d), err }, 0, ErrOverflow},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.f()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
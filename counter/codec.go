@@ -0,0 +1,58 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package counter
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNegativeCount is returned from counter.Value.UnmarshalJSON() when a count is negative
+	ErrNegativeCount = errors.Errorf("counter.Value: counts cannot be negative")
+)
+
+// interface validations
+var _ json.Marshaler = Value[string]{}
+var _ json.Unmarshaler = (*Value[string])(nil)
+
+// MarshalJSON implements the json.Marshaler interface for counter.Value values.
+//
+// Counters are encoded as a JSON object that maps each item to its count, such as {"a":2,"b":1}, using
+// the encoding/json rules for map keys.  T must be a string or integer type or implement
+// encoding.TextMarshaler.
+func (c Value[T]) MarshalJSON() ([]byte, error) {
+	if c.counts == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(c.counts)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for counter.Value values.
+//
+// The JSON value must be an object that maps items to non-negative counts.  Items with a count of zero
+// are ignored.  The special JSON null token sets c to an empty counter.  The existing counts of c are
+// replaced.
+func (c *Value[T]) UnmarshalJSON(d []byte) error {
+	if bytes.Equal(d, []byte("null")) {
+		*c = Value[T]{}
+		return nil
+	}
+	var counts map[T]int
+	if err := json.Unmarshal(d, &counts); err != nil {
+		return err
+	}
+	var res Value[T]
+	for item, n := range counts {
+		if n < 0 {
+			return errors.Wrapf(ErrNegativeCount, "%v: %d", item, n)
+		}
+		res.AddN(item, n)
+	}
+	*c = res
+	return nil
+}
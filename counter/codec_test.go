@@ -0,0 +1,68 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package counter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        interface{}
+		expected string
+	}{
+		{"empty", Value[string]{}, `{}`},
+		{"strings", Of("b", "a", "b"), `{"a":1,"b":2}`},
+		{"weekdays", Of(time.Monday, time.Sunday), `{"0":1,"1":1}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := json.Marshal(tc.v)
+			if err != nil || string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.expected, data, err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Value[string]
+		err      error
+	}{
+		{"object", `{"a":2,"b":1,"c":0}`, Of("a", "a", "b"), nil},
+		{"empty", `{}`, Value[string]{}, nil},
+		{"null", `null`, Value[string]{}, nil},
+		{"negative", `{"a":-1}`, Of("z"), ErrNegativeCount},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			c := Of("z")
+			err := json.Unmarshal([]byte(tc.data), &c)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if !c.Equal(tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected.Entries(), c.Entries())
+			}
+		})
+	}
+
+	var days Value[time.Weekday]
+	if err := json.Unmarshal([]byte(`{"1":3}`), &days); err != nil || days.Count(time.Monday) != 3 {
+		t.Errorf("Expected 3 Mondays, got %v (err = %v)", days.Entries(), err)
+	}
+	c := Of("z")
+	if err := json.Unmarshal([]byte(`["a"]`), &c); err == nil {
+		t.Errorf("Expected an error for a JSON array")
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package counter provides Value[T], a generic multiset that tallies the number of occurrences of
+// each distinct value.
+package counter
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Value counts occurrences of distinct T values, such as the number of events per date.Value or per
+// time.Weekday.  Only positive counts are stored.  Adding a negative amount that brings a count to
+// zero or below removes the item.
+//
+// The zero value is an empty counter that is ready to use.  Copies of a Value may share storage, so
+// use Clone() to make an independent copy.  A Value is not safe for concurrent use without external
+// synchronization.
+type Value[T comparable] struct {
+	counts map[T]int
+	total  int
+}
+
+// Entry is an item and its count
+type Entry[T comparable] struct {
+	Item  T
+	Count int
+}
+
+// Of returns a new counter that has counted each of the specified items
+func Of[T comparable](items ...T) Value[T] {
+	var c Value[T]
+	c.Add(items...)
+	return c
+}
+
+// Add increments the count of each of the specified items by one
+func (c *Value[T]) Add(items ...T) {
+	for _, item := range items {
+		c.AddN(item, 1)
+	}
+}
+
+// AddN adds n to the count of item.  n can be negative, and the item is removed if its count drops to
+// zero or below.
+func (c *Value[T]) AddN(item T, n int) {
+	if n == 0 {
+		return
+	}
+	if c.counts == nil {
+		c.counts = make(map[T]int)
+	}
+	old := c.counts[item]
+	if updated := old + n; updated > 0 {
+		c.counts[item] = updated
+		c.total += n
+	} else {
+		delete(c.counts, item)
+		c.total -= old
+	}
+}
+
+// Remove removes the specified item and its count
+func (c *Value[T]) Remove(item T) {
+	c.total -= c.counts[item]
+	delete(c.counts, item)
+}
+
+// Clear removes all items
+func (c *Value[T]) Clear() {
+	clear(c.counts)
+	c.total = 0
+}
+
+// Count returns the number of times item was counted, or 0 if it was not
+func (c Value[T]) Count(item T) int {
+	return c.counts[item]
+}
+
+// Total returns the sum of all counts
+func (c Value[T]) Total() int {
+	return c.total
+}
+
+// Len returns the number of distinct items
+func (c Value[T]) Len() int {
+	return len(c.counts)
+}
+
+// All returns an iterator over the items and their counts, in no particular order
+func (c Value[T]) All() iter.Seq2[T, int] {
+	return maps.All(c.counts)
+}
+
+// Entries returns the items and their counts, ordered by descending count.  Items with the same
+// count are returned in no particular order.
+func (c Value[T]) Entries() []Entry[T] {
+	return c.sortedEntries(func(a, b T) int { return 0 })
+}
+
+// TopN returns the n items with the highest counts, ordered by descending count.  Items with the same
+// count are returned in no particular order, so use TopNFunc() when a stable order is needed.
+func (c Value[T]) TopN(n int) []Entry[T] {
+	res := c.Entries()
+	return res[:min(max(n, 0), len(res))]
+}
+
+// TopNFunc returns the n items with the highest counts, ordered by descending count, with ties ordered
+// by the specified comparison function
+func (c Value[T]) TopNFunc(n int, compare func(a, b T) int) []Entry[T] {
+	res := c.sortedEntries(compare)
+	return res[:min(max(n, 0), len(res))]
+}
+
+// sortedEntries returns the items and their counts ordered by descending count and then by compare
+func (c Value[T]) sortedEntries(compare func(a, b T) int) []Entry[T] {
+	res := make([]Entry[T], 0, len(c.counts))
+	for item, n := range c.counts {
+		res = append(res, Entry[T]{Item: item, Count: n})
+	}
+	slices.SortFunc(res, func(a, b Entry[T]) int {
+		if r := cmp.Compare(b.Count, a.Count); r != 0 {
+			return r
+		}
+		return compare(a.Item, b.Item)
+	})
+	return res
+}
+
+// Clone returns a new counter with the same items and counts as c
+func (c Value[T]) Clone() Value[T] {
+	return Value[T]{counts: maps.Clone(c.counts), total: c.total}
+}
+
+// Equal returns true if c and other have the same items and counts
+func (c Value[T]) Equal(other Value[T]) bool {
+	return c.total == other.total && maps.Equal(c.counts, other.counts)
+}
+
+// Merge adds the counts from other to c
+func (c *Value[T]) Merge(other Value[T]) {
+	for item, n := range other.counts {
+		c.AddN(item, n)
+	}
+}
+
+// Subtract subtracts the counts in other from c, removing items whose counts drop to zero or below
+func (c *Value[T]) Subtract(other Value[T]) {
+	for item, n := range other.counts {
+		c.AddN(item, -n)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package counter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAdd(t *testing.T) {
+	var c Value[string]
+	if c.Len() != 0 || c.Total() != 0 || c.Count("a") != 0 {
+		t.Errorf("Expected the zero value to be an empty counter")
+	}
+	c.Add("a", "b", "a")
+	c.AddN("c", 5)
+	c.AddN("d", 0)
+	c.AddN("e", -1)
+	if c.Count("a") != 2 || c.Count("b") != 1 || c.Count("c") != 5 || c.Len() != 3 || c.Total() != 8 {
+		t.Errorf("Unexpected counts %v (total %d)", c.Entries(), c.Total())
+	}
+	c.AddN("c", -2)
+	c.AddN("b", -5)
+	if c.Count("c") != 3 || c.Count("b") != 0 || c.Len() != 2 || c.Total() != 5 {
+		t.Errorf("Unexpected counts %v (total %d)", c.Entries(), c.Total())
+	}
+	c.Remove("a")
+	c.Remove("z")
+	if c.Count("a") != 0 || c.Total() != 3 {
+		t.Errorf("Unexpected counts %v (total %d)", c.Entries(), c.Total())
+	}
+	c.Clear()
+	if c.Len() != 0 || c.Total() != 0 {
+		t.Errorf("Expected an empty counter, got %v", c.Entries())
+	}
+}
+
+func TestTopN(t *testing.T) {
+	c := Of(strings.Split("the cat and the dog and the bird", " ")...)
+	cases := []struct {
+		name     string
+		n        int
+		expected []Entry[string]
+	}{
+		{"none", 0, []Entry[string]{}},
+		{"negative", -1, []Entry[string]{}},
+		{"top", 1, []Entry[string]{{"the", 3}}},
+		{"ties", 4, []Entry[string]{{"the", 3}, {"and", 2}, {"bird", 1}, {"cat", 1}}},
+		{"all", 10, []Entry[string]{{"the", 3}, {"and", 2}, {"bird", 1}, {"cat", 1}, {"dog", 1}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := c.TopNFunc(tc.n, strings.Compare); !slices.Equal(got, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			got := c.TopN(tc.n)
+			if len(got) != len(tc.expected) {
+				tt.Fatalf("Expected %d entries, got %d", len(tc.expected), len(got))
+			}
+			for i := range got {
+				if got[i].Count != tc.expected[i].Count {
+					tt.Errorf("Expected count %d at %d, got %d", tc.expected[i].Count, i, got[i].Count)
+				}
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := Of(time.Monday, time.Monday, time.Friday)
+	b := Of(time.Monday, time.Sunday)
+
+	m := a.Clone()
+	m.Merge(b)
+	if !m.Equal(Of(time.Monday, time.Monday, time.Monday, time.Friday, time.Sunday)) || m.Total() != 5 {
+		t.Errorf("Unexpected merge result %v", m.Entries())
+	}
+	if !a.Equal(Of(time.Monday, time.Monday, time.Friday)) {
+		t.Errorf("Expected Clone() to make an independent copy")
+	}
+
+	a.Subtract(b)
+	if !a.Equal(Of(time.Monday, time.Friday)) || a.Total() != 2 {
+		t.Errorf("Unexpected subtract result %v", a.Entries())
+	}
+
+	n := 0
+	for _, count := range m.All() {
+		n += count
+	}
+	if n != m.Total() {
+		t.Errorf("Expected All() counts to add up to %d, got %d", m.Total(), n)
+	}
+}
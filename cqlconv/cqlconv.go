@@ -0,0 +1,30 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package cqlconv provides adapters that implement the gocql.Marshaler and gocql.Unmarshaler
+// interfaces for the date and time of day types in this module, so values can be written to and read
+// from Cassandra date and time columns natively.
+//
+// The adapters are defined types with the same underlying type as the values they wrap, so converting
+// a value, or a pointer to one, is free:
+//
+//	session.Query(`SELECT ship_date FROM orders WHERE id = ?`, id).Scan((*cqlconv.Date)(&order.ShipDate))
+//
+// Text columns (ascii, text and varchar) are also supported, using the same string formats as the
+// String() methods of the wrapped types.
+package cqlconv
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedCQLType is returned when a value is marshaled to or unmarshaled from a CQL type
+	// that the adapter does not support
+	ErrUnsupportedCQLType = errors.Errorf("cqlconv: unsupported CQL type")
+	// ErrInvalidCQLData is returned when the data for a CQL value is not correctly encoded
+	ErrInvalidCQLData = errors.Errorf("cqlconv: invalid CQL data")
+	// ErrOutOfRange is returned when a value cannot be represented by the target type
+	ErrOutOfRange = errors.Errorf("cqlconv: the value is out of range for the target type")
+)
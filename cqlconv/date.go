@@ -0,0 +1,83 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package cqlconv
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/gocql/gocql"
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ gocql.Marshaler = Date(0)
+var _ gocql.Unmarshaler = (*Date)(nil)
+
+// cqlDateEpoch is the encoded value of 1970-01-01 in a CQL date, which is an unsigned number of days
+// centered on the Unix epoch
+const cqlDateEpoch = 1 << 31
+
+// epochDate is the date.Value for 1970-01-01
+var epochDate = date.Must(date.FromTime(time.Unix(0, 0).UTC()))
+
+// Date adapts a date.Value to a Cassandra date column.  date.Nil is written and read as null.
+type Date date.Value
+
+// MarshalCQL implements the gocql.Marshaler interface
+func (d Date) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	v := date.Value(d)
+	if v == date.Nil {
+		return nil, nil
+	}
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "date.Value(%d)", int64(v))
+	}
+	switch info.Type() {
+	case gocql.TypeDate:
+		res := make([]byte, 4)
+		binary.BigEndian.PutUint32(res, uint32(int64(v-epochDate)+cqlDateEpoch))
+		return res, nil
+	case gocql.TypeAscii, gocql.TypeText, gocql.TypeVarchar:
+		return []byte(v.String()), nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedCQLType, "cannot marshal date.Value to %s", info.Type())
+	}
+}
+
+// UnmarshalCQL implements the gocql.Unmarshaler interface.  Text is parsed as YYYY-MM-DD.
+func (d *Date) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if data == nil {
+		*d = Date(date.Nil)
+		return nil
+	}
+	var (
+		v   date.Value
+		err error
+	)
+	switch info.Type() {
+	case gocql.TypeDate:
+		if len(data) != 4 {
+			return errors.Wrapf(ErrInvalidCQLData, "expected 4 bytes for a date, got %d", len(data))
+		}
+		v = epochDate + date.Value(int64(binary.BigEndian.Uint32(data))-cqlDateEpoch)
+		if !v.IsValid() {
+			return errors.Wrapf(ErrOutOfRange, "%d days from the Unix epoch", int64(v-epochDate))
+		}
+	case gocql.TypeAscii, gocql.TypeText, gocql.TypeVarchar:
+		var t time.Time
+		if t, err = time.Parse(time.DateOnly, string(data)); err == nil {
+			v, err = date.FromTime(t)
+		}
+		if err != nil {
+			return errors.Wrapf(ErrOutOfRange, "%q", data)
+		}
+	default:
+		return errors.Wrapf(ErrUnsupportedCQLType, "cannot unmarshal %s to date.Value", info.Type())
+	}
+	*d = Date(v)
+	return nil
+}
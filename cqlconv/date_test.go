@@ -0,0 +1,104 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package cqlconv
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/gocql/gocql"
+	"github.com/pkg/errors"
+)
+
+var (
+	dateType    = gocql.NewNativeType(4, gocql.TypeDate, "")
+	varcharType = gocql.NewNativeType(4, gocql.TypeVarchar, "")
+	intType     = gocql.NewNativeType(4, gocql.TypeInt, "")
+)
+
+func TestDateMatchesGocql(t *testing.T) {
+	cases := []struct {
+		name string
+		v    date.Value
+	}{
+		{"min", date.Min},
+		{"epoch", epochDate},
+		{"day", date.Must(date.FromUnits(2024, 7, 14))},
+		{"max", date.Max},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			expected, err := gocql.Marshal(dateType, tc.v.ToTime())
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			got, err := gocql.Marshal(dateType, Date(tc.v))
+			if err != nil || !bytes.Equal(got, expected) {
+				tt.Errorf("Expected %x, got %x (err = %v)", expected, got, err)
+			}
+			var v date.Value
+			if err := gocql.Unmarshal(dateType, expected, (*Date)(&v)); err != nil || v != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, v, err)
+			}
+		})
+	}
+}
+
+func TestDateText(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	got, err := Date(d).MarshalCQL(varcharType)
+	if err != nil || string(got) != "2024-07-14" {
+		t.Errorf("Expected 2024-07-14, got %s (err = %v)", got, err)
+	}
+	var v Date
+	if err := v.UnmarshalCQL(varcharType, got); err != nil || date.Value(v) != d {
+		t.Errorf("Expected %v, got %v (err = %v)", d, date.Value(v), err)
+	}
+}
+
+func TestDateNull(t *testing.T) {
+	got, err := Date(date.Nil).MarshalCQL(dateType)
+	if err != nil || got != nil {
+		t.Errorf("Expected nil, got %x (err = %v)", got, err)
+	}
+	v := Date(date.Max)
+	if err := v.UnmarshalCQL(dateType, nil); err != nil || date.Value(v) != date.Nil {
+		t.Errorf("Expected %v, got %v (err = %v)", date.Nil, date.Value(v), err)
+	}
+}
+
+func TestDateErrors(t *testing.T) {
+	if _, err := Date(date.Max + 1).MarshalCQL(dateType); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := Date(date.Min).MarshalCQL(intType); errors.Cause(err) != ErrUnsupportedCQLType {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedCQLType, err)
+	}
+	beforeMin, _ := gocql.Marshal(dateType, time.Date(1752, 12, 31, 0, 0, 0, 0, time.UTC))
+	cases := []struct {
+		name string
+		info gocql.TypeInfo
+		data []byte
+		err  error
+	}{
+		{"short", dateType, []byte{0x80, 0, 0}, ErrInvalidCQLData},
+		{"before min", dateType, beforeMin, ErrOutOfRange},
+		{"invalid text", varcharType, []byte("2024-02-30"), ErrOutOfRange},
+		{"unsupported type", intType, []byte{0, 0, 0, 1}, ErrUnsupportedCQLType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Date(date.Min)
+			if err := v.UnmarshalCQL(tc.info, tc.data); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected %v, got %v", tc.err, err)
+			}
+			if date.Value(v) != date.Min {
+				tt.Errorf("Expected the value to be unchanged, got %v", date.Value(v))
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+module github.com/dylan-bourque/go-types/cqlconv
+
+go 1.25.0
+
+require (
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	github.com/gocql/gocql v1.7.0
+	github.com/pkg/errors v0.9.1
+)
+
+require (
+	github.com/golang/snappy v0.0.4 // indirect
+	github.com/hailocab/go-hostpool v0.0.0-20160125115350-e80d13ce29ed // indirect
+	gopkg.in/inf.v0 v0.9.1 // indirect
+)
+
+replace github.com/dylan-bourque/go-types => ../
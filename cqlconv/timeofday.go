@@ -0,0 +1,100 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package cqlconv
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/gocql/gocql"
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ gocql.Marshaler = TimeOfDay{}
+var _ gocql.Unmarshaler = (*TimeOfDay)(nil)
+var _ gocql.Marshaler = NullTimeOfDay{}
+var _ gocql.Unmarshaler = (*NullTimeOfDay)(nil)
+
+// TimeOfDay adapts a timeofday.Value to a Cassandra time column, which stores the number of
+// nanoseconds since midnight.  Null is read as midnight; use NullTimeOfDay to tell the two apart.
+type TimeOfDay timeofday.Value
+
+// MarshalCQL implements the gocql.Marshaler interface
+func (t TimeOfDay) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	v := timeofday.Value(t)
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "%v", timeofday.ToDuration(v))
+	}
+	switch info.Type() {
+	case gocql.TypeTime:
+		res := make([]byte, 8)
+		binary.BigEndian.PutUint64(res, uint64(timeofday.ToDuration(v)))
+		return res, nil
+	case gocql.TypeAscii, gocql.TypeText, gocql.TypeVarchar:
+		return []byte(v.String()), nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedCQLType, "cannot marshal timeofday.Value to %s", info.Type())
+	}
+}
+
+// UnmarshalCQL implements the gocql.Unmarshaler interface.  Text is parsed by timeofday.ParseTime().
+func (t *TimeOfDay) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if data == nil {
+		*t = TimeOfDay(timeofday.Zero)
+		return nil
+	}
+	var (
+		v   timeofday.Value
+		err error
+	)
+	switch info.Type() {
+	case gocql.TypeTime:
+		if len(data) != 8 {
+			return errors.Wrapf(ErrInvalidCQLData, "expected 8 bytes for a time, got %d", len(data))
+		}
+		if v, err = timeofday.FromDuration(time.Duration(binary.BigEndian.Uint64(data))); err != nil {
+			return errors.Wrapf(ErrOutOfRange, "%d nanoseconds since midnight", int64(binary.BigEndian.Uint64(data)))
+		}
+	case gocql.TypeAscii, gocql.TypeText, gocql.TypeVarchar:
+		if v, err = timeofday.ParseTime(string(data)); err != nil {
+			return errors.Wrapf(ErrOutOfRange, "%q", data)
+		}
+	default:
+		return errors.Wrapf(ErrUnsupportedCQLType, "cannot unmarshal %s to timeofday.Value", info.Type())
+	}
+	*t = TimeOfDay(v)
+	return nil
+}
+
+// NullTimeOfDay adapts a null.Value[timeofday.Value] to a Cassandra time column.  Unlike TimeOfDay, it
+// keeps null distinct from midnight: null is read with Valid set to false, and an invalid value is
+// written as null.
+type NullTimeOfDay null.Value[timeofday.Value]
+
+// MarshalCQL implements the gocql.Marshaler interface
+func (t NullTimeOfDay) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return TimeOfDay(t.V).MarshalCQL(info)
+}
+
+// UnmarshalCQL implements the gocql.Unmarshaler interface.  Non-null data is decoded by
+// TimeOfDay.UnmarshalCQL().
+func (t *NullTimeOfDay) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if data == nil {
+		*t = NullTimeOfDay{}
+		return nil
+	}
+	var v TimeOfDay
+	if err := v.UnmarshalCQL(info, data); err != nil {
+		return err
+	}
+	*t = NullTimeOfDay{V: timeofday.Value(v), Valid: true}
+	return nil
+}
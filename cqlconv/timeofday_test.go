@@ -0,0 +1,124 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package cqlconv
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/gocql/gocql"
+	"github.com/pkg/errors"
+)
+
+var timeType = gocql.NewNativeType(4, gocql.TypeTime, "")
+
+func TestTimeOfDayMatchesGocql(t *testing.T) {
+	cases := []struct {
+		name string
+		v    timeofday.Value
+	}{
+		{"midnight", timeofday.Zero},
+		{"afternoon", timeofday.Must(timeofday.FromUnits(13, 45, 30, 500))},
+		{"max", timeofday.Max},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			expected, err := gocql.Marshal(timeType, timeofday.ToDuration(tc.v))
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			got, err := gocql.Marshal(timeType, TimeOfDay(tc.v))
+			if err != nil || !bytes.Equal(got, expected) {
+				tt.Errorf("Expected %x, got %x (err = %v)", expected, got, err)
+			}
+			var v timeofday.Value
+			if err := gocql.Unmarshal(timeType, expected, (*TimeOfDay)(&v)); err != nil || v != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, v, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayText(t *testing.T) {
+	tod := timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000))
+	got, err := TimeOfDay(tod).MarshalCQL(varcharType)
+	if err != nil || string(got) != "13:45:30.5" {
+		t.Errorf("Expected 13:45:30.5, got %s (err = %v)", got, err)
+	}
+	var v TimeOfDay
+	if err := v.UnmarshalCQL(varcharType, got); err != nil || timeofday.Value(v) != tod {
+		t.Errorf("Expected %v, got %v (err = %v)", tod, timeofday.Value(v), err)
+	}
+}
+
+func TestTimeOfDayErrors(t *testing.T) {
+	if _, err := TimeOfDay(timeofday.Zero).MarshalCQL(intType); errors.Cause(err) != ErrUnsupportedCQLType {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedCQLType, err)
+	}
+	v := TimeOfDay(timeofday.Max)
+	if err := v.UnmarshalCQL(timeType, nil); err != nil || timeofday.Value(v) != timeofday.Zero {
+		t.Errorf("Expected %v, got %v (err = %v)", timeofday.Zero, timeofday.Value(v), err)
+	}
+	day, _ := gocql.Marshal(timeType, 24*time.Hour)
+	cases := []struct {
+		name string
+		info gocql.TypeInfo
+		data []byte
+		err  error
+	}{
+		{"short", timeType, []byte{0, 0, 0, 1}, ErrInvalidCQLData},
+		{"24 hours", timeType, day, ErrOutOfRange},
+		{"negative", timeType, bytes.Repeat([]byte{0xff}, 8), ErrOutOfRange},
+		{"unsupported type", intType, []byte{0, 0, 0, 1}, ErrUnsupportedCQLType},
+		{"invalid text", varcharType, []byte("25:00:00"), ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := TimeOfDay(timeofday.Max)
+			if err := v.UnmarshalCQL(tc.info, tc.data); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected %v, got %v", tc.err, err)
+			}
+			if timeofday.Value(v) != timeofday.Max {
+				tt.Errorf("Expected the value to be unchanged, got %v", timeofday.Value(v))
+			}
+		})
+	}
+}
+
+func TestNullTimeOfDay(t *testing.T) {
+	midnight, _ := gocql.Marshal(timeType, time.Duration(0))
+	cases := []struct {
+		name     string
+		v        null.Value[timeofday.Value]
+		data     []byte
+		expected null.Value[timeofday.Value]
+	}{
+		{"null", null.Value[timeofday.Value]{}, nil, null.Value[timeofday.Value]{}},
+		{"midnight", null.From(timeofday.Zero), midnight, null.From(timeofday.Zero)},
+		{"max", null.From(timeofday.Max), nil, null.From(timeofday.Max)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := gocql.Marshal(timeType, NullTimeOfDay(tc.v))
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.data != nil && !bytes.Equal(data, tc.data) {
+				tt.Errorf("Expected %x, got %x", tc.data, data)
+			}
+			got := null.From(timeofday.Max)
+			if err := gocql.Unmarshal(timeType, data, (*NullTimeOfDay)(&got)); err != nil || got != tc.expected {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.expected, got, err)
+			}
+		})
+	}
+	v := NullTimeOfDay(null.From(timeofday.Max))
+	if err := v.UnmarshalCQL(timeType, []byte{0, 0, 0, 1}); errors.Cause(err) != ErrInvalidCQLData || !v.Valid || v.V != timeofday.Max {
+		t.Errorf("Expected %v and an unchanged value, got %v (err = %v)", ErrInvalidCQLData, v, err)
+	}
+}
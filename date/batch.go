@@ -0,0 +1,49 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/internal/batcherr"
+)
+
+// IndexError records the error for a single element of a batch conversion
+type IndexError = batcherr.IndexError
+
+// BatchError is returned by the batch conversion functions when one or more elements could not be
+// converted.  It contains one IndexError for each failed element, in input order.
+type BatchError = batcherr.BatchError
+
+// FromTimes converts each time.Time in ts to a date.Value with FromTime(), allocating the result
+// once for the whole batch.
+//
+// The returned slice always has the same length as ts.  Elements that could not be converted are
+// set to Nil and reported in the returned BatchError, so callers can keep the valid results.
+func FromTimes(ts []time.Time) ([]Value, error) {
+	var errs BatchError
+	res := make([]Value, len(ts))
+	for i, t := range ts {
+		v, err := FromTime(t)
+		if err != nil {
+			errs = append(errs, IndexError{Index: i, Err: err})
+		}
+		res[i] = v
+	}
+	if len(errs) > 0 {
+		return res, errs
+	}
+	return res, nil
+}
+
+// ToTimes converts each date.Value in vs to a time.Time at midnight UTC with ToTime(), allocating
+// the result once for the whole batch.  Invalid values, including Nil, become the zero time.Time.
+func ToTimes(vs []Value) []time.Time {
+	res := make([]time.Time, len(vs))
+	for i, v := range vs {
+		res[i] = v.ToTime()
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestFromTimes(t *testing.T) {
+	ts := []time.Time{
+		time.Date(2019, 7, 14, 10, 30, 0, 0, time.UTC),
+		time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	got, err := FromTimes(ts)
+	expected := []Value{Must(FromUnits(2019, 7, 14)), Nil, Must(FromUnits(1999, 12, 31)), Nil}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d values, got %d", len(expected), len(got))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Index %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+	be, ok := err.(BatchError)
+	if !ok {
+		t.Fatalf("Expected a BatchError, got %T (%v)", err, err)
+	}
+	if len(be) != 2 || be[0].Index != 1 || be[1].Index != 3 {
+		t.Fatalf("Expected errors at indexes 1 and 3, got %v", be)
+	}
+	for _, ie := range be {
+		if errors.Cause(ie) != ErrInvalidDateUnit {
+			t.Errorf("Expected %v, got %v", ErrInvalidDateUnit, ie.Err)
+		}
+	}
+	if !stderrors.Is(err, ErrInvalidDateUnit) {
+		t.Errorf("Expected errors.Is() to match %v through the BatchError", ErrInvalidDateUnit)
+	}
+	var re *RangeError
+	if !stderrors.As(err, &re) || re.Field != "year" || re.Value != 1700 {
+		t.Errorf("Expected errors.As() to return the first RangeError, got %+v", re)
+	}
+}
+
+func TestFromTimesNoErrors(t *testing.T) {
+	got, err := FromTimes([]time.Time{time.Date(2019, 7, 14, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if got[0] != Must(FromUnits(2019, 7, 14)) {
+		t.Errorf("Expected 2019-07-14, got %v", got[0])
+	}
+	if got, err := FromTimes(nil); len(got) != 0 || err != nil {
+		t.Errorf("Expected an empty result and no error, got %v, %v", got, err)
+	}
+}
+
+func TestToTimes(t *testing.T) {
+	got := ToTimes([]Value{Must(FromUnits(2019, 7, 14)), Nil})
+	expected := []time.Time{time.Date(2019, 7, 14, 0, 0, 0, 0, time.UTC), {}}
+	for i := range expected {
+		if !got[i].Equal(expected[i]) {
+			t.Errorf("Index %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+}
+
+func BenchmarkFromTimes(b *testing.B) {
+	ts := make([]time.Time, 1000)
+	for i := range ts {
+		ts[i] = time.Date(2019, 1, 1+i, 0, 0, 0, 0, time.UTC)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = FromTimes(ts)
+	}
+}
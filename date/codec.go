@@ -0,0 +1,136 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding"
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidBinaryDataLen is returned from date.Value.UnmarshalBinary() when the passed-in byte slice
+	// is not the correct length for its version
+	ErrInvalidBinaryDataLen = errors.Errorf("date.Value: binary data must be a version byte plus 4 bytes")
+	// ErrUnsupportedBinaryVersion is returned from date.Value.UnmarshalBinary() when the version byte
+	// of the passed-in data is not recognized
+	ErrUnsupportedBinaryVersion = errors.Errorf("date.Value: unsupported binary encoding version")
+	// ErrInvalidBinaryData is returned from date.Value.UnmarshalBinary() when the decoded value is
+	// neither date.Nil nor a valid date
+	ErrInvalidBinaryData = errors.Errorf("date.Value: binary data does not contain a valid date")
+	// ErrInvalidDateFormat is returned from date.Value.UnmarshalText() when the passed-in byte slice
+	// is not formatted as YYYY-MM-DD
+	ErrInvalidDateFormat = errors.Errorf("date.Value: text data was not in the correct format")
+	// ErrInvalidDate is returned from date.Value.MarshalText() when the value is neither date.Nil nor a
+	// valid date
+	ErrInvalidDate = errors.Errorf("date.Value: the value is neither date.Nil nor a valid date")
+)
+
+const (
+	// binaryVersion1 identifies the binary encoding that contains a version byte followed by a 32-bit
+	// integer in big-endian byte order that contains the Julian day number
+	binaryVersion1 byte = 1
+	// binaryVersion is the version of the binary encoding that is written by MarshalBinary()
+	binaryVersion = binaryVersion1
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ encoding.BinaryMarshaler = (*Value)(nil)
+var _ encoding.BinaryAppender = (*Value)(nil)
+var _ encoding.BinaryUnmarshaler = (*Value)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface for date.Value values.
+//
+// The resulting text is the ISO 8601 calendar date, YYYY-MM-DD, and date.Nil is encoded as an empty
+// string.  Any other invalid value returns ErrInvalidDate.
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(make([]byte, 0, textLen))
+}
+
+// AppendText implements the encoding.TextAppender interface for date.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	if v == Nil {
+		return b, nil
+	}
+	if !v.IsValid() {
+		return b, errors.Wrapf(ErrInvalidDate, "date.Value(%d)", int64(v))
+	}
+	return v.appendText(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for date.Value values.
+//
+// The provided text must be an ISO 8601 calendar date, YYYY-MM-DD, or empty, which is decoded as
+// date.Nil.  If the text is not in that format, ErrInvalidDateFormat is returned.  If the date is
+// outside of the supported range, a *RangeError is returned.
+func (v *Value) UnmarshalText(text []byte) error {
+	d, err := parseText(string(text))
+	if err != nil {
+		return err
+	}
+	*v = d
+	return nil
+}
+
+// parseText parses the YYYY-MM-DD text form of a date, with an empty string parsed as date.Nil
+func parseText(s string) (Value, error) {
+	if s == "" {
+		return Nil, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return Nil, errors.Wrapf(ErrInvalidDateFormat, "%q", s)
+	}
+	return FromTime(t)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for date.Value values.
+//
+// The resulting data is a single version byte, currently 1, followed by a 32-bit integer in big-endian
+// byte order that contains the Julian day number.  date.Nil is encoded as -2.
+func (v Value) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, 5))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for date.Value values.  It appends the
+// same encoding as MarshalBinary() to b.
+func (v Value) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, binaryVersion)
+	return binary.BigEndian.AppendUint32(b, uint32(int32(v))), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for date.Value values.
+//
+// The provided value must be a version byte followed by the payload for that version.  Decoders accept
+// every version that has ever been written so that values persisted by older releases remain readable.
+//
+// If data is empty or the wrong length for its version, ErrInvalidBinaryDataLen is returned.  If the
+// version byte is not recognized, ErrUnsupportedBinaryVersion is returned.  If the decoded value is
+// neither date.Nil nor between date.Min and date.Max, ErrInvalidBinaryData is returned.
+func (v *Value) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidBinaryDataLen
+	}
+	switch data[0] {
+	case binaryVersion1:
+		if len(data) != 5 {
+			return ErrInvalidBinaryDataLen
+		}
+		d := Value(int32(binary.BigEndian.Uint32(data[1:])))
+		if d != Nil && !d.IsValid() {
+			return ErrInvalidBinaryData
+		}
+		*v = d
+		return nil
+	default:
+		return errors.Wrapf(ErrUnsupportedBinaryVersion, "version: %d", data[0])
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected []byte
+	}{
+		{"nil value", Nil, []byte{1, 0xff, 0xff, 0xff, 0xfe}},
+		{"min value", Min, []byte{1, 0x00, 0x24, 0x07, 0xf3}},
+		{"max value", Max, []byte{1, 0x00, 0x51, 0xfe, 0x2c}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.v.MarshalBinary()
+			if err != nil {
+				tt.Errorf("Unexpected error %v", err)
+			}
+			if !bytes.Equal(got, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        []byte
+		expected Value
+		err      error
+	}{
+		{"nil buffer", nil, Value(0), ErrInvalidBinaryDataLen},
+		{"empty buffer", []byte{}, Value(0), ErrInvalidBinaryDataLen},
+		{"short buffer", []byte{1, 0, 0}, Value(0), ErrInvalidBinaryDataLen},
+		{"long buffer", []byte{1, 0, 0, 0, 0, 0}, Value(0), ErrInvalidBinaryDataLen},
+		{"unsupported version", []byte{2, 0, 0x24, 0x07, 0xf3}, Value(0), ErrUnsupportedBinaryVersion},
+		{"out of range", []byte{1, 0, 0, 0, 1}, Value(0), ErrInvalidBinaryData},
+		{"nil value", []byte{1, 0xff, 0xff, 0xff, 0xfe}, Nil, nil},
+		{"min value", []byte{1, 0x00, 0x24, 0x07, 0xf3}, Min, nil},
+		{"max value", []byte{1, 0x00, 0x51, 0xfe, 0x2c}, Max, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got Value
+			err := got.UnmarshalBinary(tc.d)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, v := range []Value{Nil, Min, Max, Must(FromUnits(2019, 7, 14))} {
+		data, _ := v.MarshalBinary()
+		var got Value
+		if err := got.UnmarshalBinary(data); err != nil || got != v {
+			t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+		}
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+		err      error
+	}{
+		{"nil value", Nil, "", nil},
+		{"min value", Min, "1753-01-01", nil},
+		{"max value", Max, "9999-12-31", nil},
+		{"valid value", Must(FromUnits(2019, 7, 14)), "2019-07-14", nil},
+		{"invalid value", Max + 1, "", ErrInvalidDate},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.v.MarshalText()
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if string(got) != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+	got, _ := Must(FromUnits(2019, 7, 14)).AppendText([]byte("date="))
+	if string(got) != "date=2019-07-14" {
+		t.Errorf("Expected %q, got %q", "date=2019-07-14", got)
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected Value
+		err      error
+	}{
+		{"empty", "", Nil, nil},
+		{"valid", "2019-07-14", Must(FromUnits(2019, 7, 14)), nil},
+		{"min", "1753-01-01", Min, nil},
+		{"wrong format", "07/14/2019", Max, ErrInvalidDateFormat},
+		{"timestamp", "2019-07-14T00:00:00Z", Max, ErrInvalidDateFormat},
+		{"invalid day", "2019-02-30", Max, ErrInvalidDateFormat},
+		{"out of range", "1600-01-01", Max, ErrInvalidDateUnit},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := Max
+			err := got.UnmarshalText([]byte(tc.text))
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
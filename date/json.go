@@ -0,0 +1,129 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidJSONData is returned from date.Value.UnmarshalJSON() when the passed-in byte slice does
+	// not contain a date in any of the supported JSON forms
+	ErrInvalidJSONData = errors.Errorf("date.Value: JSON data was not a date string, Julian day number or MongoDB $date")
+)
+
+var unsupportedJSONType = typeerr.New(ErrInvalidJSONData)
+
+// interface validations
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// MarshalJSON implements the json.Marshaler interface for date.Value values.  The JSON encoding is a
+// string containing the same text as MarshalText(), except that date.Nil is encoded as null.
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v == Nil {
+		return []byte("null"), nil
+	}
+	b := append(make([]byte, 0, textLen+2), '"')
+	b, err := v.AppendText(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for date.Value values.
+//
+// Decoding is tolerant so that data from several sources can be read without conversion.  The
+// following forms are accepted:
+//   - null, which is decoded as date.Nil
+//   - a string formatted as "YYYY-MM-DD", as written by MarshalJSON(), or an empty string, which is
+//     decoded as date.Nil
+//   - an integer Julian day number, which is how encoding/json wrote date.Value values before
+//     MarshalJSON() was added
+//   - a MongoDB Extended JSON date, as written by mongoexport, in the canonical form
+//     {"$date": {"$numberLong": "<milliseconds>"}}, the relaxed form {"$date": "<RFC 3339 timestamp>"}
+//     or the legacy form {"$date": <milliseconds>}
+//
+// MongoDB dates are instants, so the date is taken from the instant in UTC.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return errors.Wrapf(ErrInvalidJSONData, "%v", err)
+	}
+	d, err := fromJSON(raw)
+	if errors.Cause(err) == ErrInvalidJSONData {
+		return err
+	}
+	if err != nil {
+		return errors.Wrapf(ErrInvalidJSONData, "%s", data)
+	}
+	*v = d
+	return nil
+}
+
+// fromJSON converts a decoded JSON value to a date.Value
+func fromJSON(raw interface{}) (Value, error) {
+	switch tv := raw.(type) {
+	case nil:
+		return Nil, nil
+	case string:
+		return parseText(tv)
+	case json.Number:
+		n, err := tv.Int64()
+		if err != nil {
+			return Nil, err
+		}
+		if d := Value(n); d == Nil || d.IsValid() {
+			return d, nil
+		}
+		return Nil, errors.Errorf("%d is not a valid Julian day number", n)
+	case map[string]interface{}:
+		if ext, ok := tv["$date"]; ok && len(tv) == 1 {
+			return fromMongoDate(ext)
+		}
+	}
+	return Nil, unsupportedJSONType.Of(raw)
+}
+
+// fromMongoDate converts the value of a MongoDB Extended JSON $date to a date.Value
+func fromMongoDate(ext interface{}) (Value, error) {
+	var ms int64
+	switch tv := ext.(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339, tv)
+		if err != nil {
+			return Nil, err
+		}
+		return FromTime(t.UTC())
+	case json.Number:
+		n, err := tv.Int64()
+		if err != nil {
+			return Nil, err
+		}
+		ms = n
+	case map[string]interface{}:
+		s, ok := tv["$numberLong"].(string)
+		if !ok || len(tv) != 1 {
+			return Nil, unsupportedJSONType.Of(ext)
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return Nil, err
+		}
+		ms = n
+	default:
+		return Nil, unsupportedJSONType.Of(ext)
+	}
+	return FromTime(time.UnixMilli(ms).UTC())
+}
@@ -0,0 +1,110 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestUnmarshalJSON(t *testing.T) {
+	d := Must(FromUnits(2019, 7, 14))
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		err      error
+	}{
+		{"null", "null", Nil, nil},
+		{"string", `"2019-07-14"`, d, nil},
+		{"empty string", `""`, Nil, nil},
+		{"Julian day", "2458679", d, nil},
+		{"Julian day for Nil", "-2", Nil, nil},
+		{"mongo canonical", `{"$date": {"$numberLong": "1563062400000"}}`, d, nil},
+		{"mongo relaxed", `{"$date": "2019-07-14T00:00:00Z"}`, d, nil},
+		{"mongo relaxed with millis", `{"$date":"2019-07-14T23:59:59.999Z"}`, d, nil},
+		{"mongo relaxed with offset", `{"$date":"2019-07-13T20:00:00-04:00"}`, d, nil},
+		{"mongo legacy", `{"$date": 1563062400000}`, d, nil},
+		{"invalid string", `"2019-02-30"`, Max, ErrInvalidJSONData},
+		{"invalid Julian day", "42", Max, ErrInvalidJSONData},
+		{"fractional number", "2458679.5", Max, ErrInvalidJSONData},
+		{"boolean", "true", Max, ErrInvalidJSONData},
+		{"other object", `{"date": "2019-07-14"}`, Max, ErrInvalidJSONData},
+		{"extra keys", `{"$date": "2019-07-14T00:00:00Z", "x": 1}`, Max, ErrInvalidJSONData},
+		{"mongo bad timestamp", `{"$date": "2019-07-14"}`, Max, ErrInvalidJSONData},
+		{"mongo bad numberLong", `{"$date": {"$numberLong": 1563062400000}}`, Max, ErrInvalidJSONData},
+		{"mongo out of range", `{"$date": {"$numberLong": "-8000000000000"}}`, Max, ErrInvalidJSONData},
+		{"malformed", `{"$date"`, Max, ErrInvalidJSONData},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := Max
+			err := got.UnmarshalJSON([]byte(tc.data))
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"nil value", Nil, "null"},
+		{"valid value", Must(FromUnits(2019, 7, 14)), `"2019-07-14"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := json.Marshal(tc.v)
+			if err != nil || string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.expected, data, err)
+			}
+			var got Value
+			if err := json.Unmarshal(data, &got); err != nil || got != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, got, err)
+			}
+		})
+	}
+	if _, err := json.Marshal(Max + 1); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("Expected %v, got %v", ErrInvalidDate, err)
+	}
+}
+
+func TestUnmarshalMongoExport(t *testing.T) {
+	var doc struct {
+		Name     string `json:"name"`
+		Birthday Value  `json:"birthday"`
+	}
+	line := `{"_id":{"$oid":"5d2a8f4e1c9d440000a1b2c3"},"name":"alice","birthday":{"$date":"1990-05-17T00:00:00Z"}}`
+	if err := json.Unmarshal([]byte(line), &doc); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := Must(FromUnits(1990, 5, 17)); doc.Birthday != expected {
+		t.Errorf("Expected %v, got %v", expected, doc.Birthday)
+	}
+}
+
+func TestUnmarshalJSONUnsupportedTypeIsCached(t *testing.T) {
+	var v Value
+	err1 := v.UnmarshalJSON([]byte("true"))
+	err2 := v.UnmarshalJSON([]byte("false"))
+	if errors.Cause(err1) != ErrInvalidJSONData {
+		t.Fatalf("Expected %v, got %v", ErrInvalidJSONData, err1)
+	}
+	if err1 != err2 {
+		t.Errorf("Expected the same cached error for both booleans, got %v and %v", err1, err2)
+	}
+	if err := v.UnmarshalJSON([]byte(`{"$date": true}`)); errors.Cause(err) != ErrInvalidJSONData || err != err1 {
+		t.Errorf("Expected the cached boolean error for an unsupported $date value, got %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package date
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+)
+
+// interface validations
+var _ jsonv2.MarshalerTo = (*Value)(nil)
+var _ jsonv2.UnmarshalerFrom = (*Value)(nil)
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface for date.Value values.  The JSON
+// encoding is the same as MarshalJSON().
+func (v Value) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if v == Nil {
+		return enc.WriteToken(jsontext.Null)
+	}
+	var buf [textLen]byte
+	b, err := v.AppendText(buf[:0])
+	if err != nil {
+		return err
+	}
+	return enc.WriteToken(jsontext.String(string(b)))
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom interface for date.Value values.
+//
+// The next JSON value is read whole and decoded by UnmarshalJSON(), so the same tolerant set of forms
+// is accepted: null, date strings, Julian day numbers and MongoDB Extended JSON dates.
+func (v *Value) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalJSON(data)
+}
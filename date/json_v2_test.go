@@ -0,0 +1,80 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package date
+
+import (
+	jsonv2 "encoding/json/v2"
+	"strings"
+	"testing"
+)
+
+func TestJSONv2RoundTrip(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"nil value", Nil, `null`},
+		{"min value", Min, `"1753-01-01"`},
+		{"2019-07-14", Must(FromUnits(2019, 7, 14)), `"2019-07-14"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := jsonv2.Marshal(tc.v)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, data)
+			}
+			got := Max
+			if err := jsonv2.Unmarshal(data, &got); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.v {
+				tt.Errorf("Expected %v, got %v", tc.v, got)
+			}
+		})
+	}
+}
+
+func TestJSONv2Unmarshal(t *testing.T) {
+	d := Must(FromUnits(2019, 7, 14))
+	cases := []struct {
+		name        string
+		data        string
+		expected    Value
+		expectedErr error
+	}{
+		{"null", `null`, Nil, nil},
+		{"empty string", `""`, Nil, nil},
+		{"date string", `"2019-07-14"`, d, nil},
+		{"Julian day number", `2458679`, d, nil},
+		{"MongoDB canonical", `{"$date": {"$numberLong": "1563062400000"}}`, d, nil},
+		{"invalid string", `"2019-13-01"`, Nil, ErrInvalidJSONData},
+		{"boolean", `true`, Nil, ErrInvalidJSONData},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := Max
+			err := jsonv2.Unmarshal([]byte(tc.data), &got)
+			if tc.expectedErr != nil {
+				// encoding/json/v2 wraps the error in a *json.SemanticError, so match on the message
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr.Error()) {
+					tt.Errorf("Expected error %v, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/internal/localized"
+)
+
+// Locale defines the month names, weekday names, AM/PM markers and first day of the week used for
+// localized formatting.  The same locale registry is shared by the date and timeofday packages.
+type Locale = localized.Locale
+
+// RegisterLocale adds the specified locale to the registry used by FormatLocalized(), replacing any
+// existing locale with the same tag.
+func RegisterLocale(l Locale) error {
+	return localized.Register(l)
+}
+
+// LookupLocale returns the registered locale for the specified BCP 47 language tag, falling back to
+// the parent tag (e.g. "fr-CA" to "fr") if there is no exact match.
+func LookupLocale(tag string) (Locale, error) {
+	return localized.Lookup(tag)
+}
+
+// FirstDayOfWeek returns the day on which a calendar week starts in the specified locale, or
+// time.Sunday if no locale is registered for the tag.
+func FirstDayOfWeek(tag string) time.Weekday {
+	l, err := localized.Lookup(tag)
+	if err != nil {
+		return time.Sunday
+	}
+	return l.FirstDayOfWeek
+}
+
+// FormatLocalized returns a textual representation of the date value according to the same rules as
+// Format(), with month and weekday names taken from the locale registered for the specified tag.
+//
+// If no locale is registered for the tag, an error is returned.
+func (v Value) FormatLocalized(layout, tag string) (string, error) {
+	l, err := localized.Lookup(tag)
+	if err != nil {
+		return "", err
+	}
+	return localized.Format(v.ToTime(), layout, l), nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatLocalized(t *testing.T) {
+	d := Must(FromUnits(2019, 7, 14))
+	cases := []struct {
+		name     string
+		tag      string
+		layout   string
+		expected string
+		valid    bool
+	}{
+		{"unknown locale", "xx", "January", "", false},
+		{"english", "en", "Monday, January 2, 2006", "Sunday, July 14, 2019", true},
+		{"french", "fr", "Monday 2 January 2006", "dimanche 14 juillet 2019", true},
+		{"italian", "it-IT", "Mon 2 Jan", "dom 14 lug", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := d.FormatLocalized(tc.layout, tc.tag)
+			if tc.valid != (err == nil) {
+				tt.Errorf("Unexpected error result: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFirstDayOfWeek(t *testing.T) {
+	cases := []struct {
+		tag      string
+		expected time.Weekday
+	}{
+		{"en", time.Sunday},
+		{"en-US", time.Sunday},
+		{"en-GB", time.Monday},
+		{"de", time.Monday},
+		{"xx", time.Sunday},
+	}
+	for _, tc := range cases {
+		t.Run(tc.tag, func(tt *testing.T) {
+			if got := FirstDayOfWeek(tc.tag); got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
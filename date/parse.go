@@ -0,0 +1,174 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// the layouts tried by ParseAny(), in order
+var defaultLayouts = []string{
+	"2006-01-02",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"20060102",
+	"2006/01/02",
+	"01/02/2006",
+	"1/2/2006",
+	"02 Jan 2006",
+	"2 Jan 2006",
+	"02-Jan-2006",
+	"2 January 2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"Mon, 02 Jan 2006",
+	"Monday, January 2, 2006",
+}
+
+// defaultParser is used by ParseAny()
+var defaultParser = NewParser()
+
+// ParseError is returned by ParseISO() when the text is not in the expected format.  It identifies the
+// component that could not be parsed.
+//
+// Both errors.Is(err, ErrInvalidDateFormat) and errors.Cause(err) == ErrInvalidDateFormat are true for
+// a ParseError, and errors.As() can be used to retrieve the details.
+type ParseError struct {
+	// Field is the component that could not be parsed: "year", "month" or "day" when it is not all
+	// digits, or "format" when the length or separators are wrong
+	Field string
+	// Text is the text that was being parsed
+	Text string
+}
+
+// Error implements the error interface for date.ParseError values
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid %s in %q: %v", e.Field, e.Text, ErrInvalidDateFormat)
+}
+
+// Cause returns ErrInvalidDateFormat for compatibility with errors.Cause()
+func (e *ParseError) Cause() error {
+	return ErrInvalidDateFormat
+}
+
+// Unwrap returns ErrInvalidDateFormat for compatibility with errors.Is()
+func (e *ParseError) Unwrap() error {
+	return ErrInvalidDateFormat
+}
+
+// ParseISO parses a date in the ISO 8601 extended format, YYYY-MM-DD, or basic format, YYYYMMDD, and
+// nothing else.  Unlike Parse(), it does not go through time.Parse() and does not allocate unless it
+// fails.
+//
+// If the text is not in either format, a *ParseError is returned that identifies the component that
+// is not all digits, or "format" for the wrong length or separators.  If the text is in the right
+// format but a unit is out of range, such as "2019-02-30", a *RangeError is returned.
+func ParseISO(s string) (Value, error) {
+	var ys, ms, ds string
+	switch {
+	case len(s) == 10 && s[4] == '-' && s[7] == '-':
+		ys, ms, ds = s[:4], s[5:7], s[8:]
+	case len(s) == 8:
+		ys, ms, ds = s[:4], s[4:6], s[6:]
+	default:
+		return Nil, &ParseError{Field: "format", Text: s}
+	}
+	y, ok := parseDigits(ys)
+	if !ok {
+		return Nil, &ParseError{Field: "year", Text: s}
+	}
+	m, ok := parseDigits(ms)
+	if !ok {
+		return Nil, &ParseError{Field: "month", Text: s}
+	}
+	d, ok := parseDigits(ds)
+	if !ok {
+		return Nil, &ParseError{Field: "day", Text: s}
+	}
+	return FromUnits(y, m, d)
+}
+
+// parseDigits converts a string of ASCII digits to an integer.  The second return value is false if
+// the string contains anything else.
+func parseDigits(s string) (int, bool) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, true
+}
+
+// MustParse is like Parse() but panics if the text cannot be parsed.  It simplifies the initialization
+// of package-level variables and test data.
+func MustParse(layout, value string) Value {
+	return Must(Parse(layout, value))
+}
+
+// MustParseISO is like ParseISO() but panics if the text cannot be parsed.  It simplifies the
+// initialization of package-level variables and test data.
+func MustParseISO(s string) Value {
+	return Must(ParseISO(s))
+}
+
+// DefaultLayouts returns a copy of the layouts, in the format used by time.Parse(), that ParseAny()
+// tries in order.  Slash-separated dates are read month first, as in the US, so put "02/01/2006"
+// first to read them day first, such as:
+//
+//	p := date.NewParser(append([]string{"02/01/2006"}, date.DefaultLayouts()...)...)
+func DefaultLayouts() []string {
+	return slices.Clone(defaultLayouts)
+}
+
+// Parser parses dates that may be in any of an ordered list of layouts, such as the data received by
+// an ingest pipeline from several sources.  A Parser is immutable once created, so it is safe for
+// concurrent use.
+type Parser struct {
+	layouts []string
+}
+
+// NewParser returns a Parser that tries the specified layouts, in the format used by time.Parse(), in
+// order.  If no layouts are specified, DefaultLayouts() is used.
+func NewParser(layouts ...string) *Parser {
+	if len(layouts) == 0 {
+		layouts = defaultLayouts
+	}
+	return &Parser{layouts: slices.Clone(layouts)}
+}
+
+// Parse returns the date represented by the text using the first layout that matches it, after
+// removing any leading and trailing white space.  For layouts that include a time and time zone, the
+// date is the one written in the text, not the date in UTC.
+//
+// If none of the layouts match, ErrInvalidDateFormat is returned.
+func (p *Parser) Parse(s string) (Value, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range p.layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			if v, err := FromTime(t); err == nil {
+				return v, nil
+			}
+		}
+	}
+	return Nil, errors.Wrapf(ErrInvalidDateFormat, "%q does not match any of the layouts", s)
+}
+
+// ParseAny parses a date that may be in any of the layouts returned by DefaultLayouts(), such as
+// "2019-07-14", "07/14/2019", "14 Jul 2019" or "2019-07-14T23:30:00-05:00".  Use NewParser() to try a
+// different list of layouts.
+//
+// If none of the layouts match, ErrInvalidDateFormat is returned.
+func ParseAny(s string) (Value, error) {
+	return defaultParser.Parse(s)
+}
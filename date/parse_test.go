@@ -0,0 +1,156 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseISO(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected Value
+		field    string
+		err      error
+	}{
+		{"extended", "2019-07-14", Must(FromUnits(2019, 7, 14)), "", nil},
+		{"basic", "20190714", Must(FromUnits(2019, 7, 14)), "", nil},
+		{"min", "1753-01-01", Min, "", nil},
+		{"max", "99991231", Max, "", nil},
+		{"leap day", "2024-02-29", Must(FromUnits(2024, 2, 29)), "", nil},
+		{"empty", "", Nil, "format", ErrInvalidDateFormat},
+		{"short", "2019-7-14", Nil, "format", ErrInvalidDateFormat},
+		{"wrong separator", "2019/07/14", Nil, "format", ErrInvalidDateFormat},
+		{"mixed formats", "2019-0714", Nil, "format", ErrInvalidDateFormat},
+		{"trailing text", "2019-07-14T00:00:00Z", Nil, "format", ErrInvalidDateFormat},
+		{"invalid year", "2O19-07-14", Nil, "year", ErrInvalidDateFormat},
+		{"signed month", "2019-+7-14", Nil, "month", ErrInvalidDateFormat},
+		{"invalid day", "201907 4", Nil, "day", ErrInvalidDateFormat},
+		{"year out of range", "1752-12-31", Nil, "year", ErrInvalidDateUnit},
+		{"month out of range", "2019-13-01", Nil, "month", ErrInvalidDateUnit},
+		{"day out of range", "2019-02-29", Nil, "day", ErrInvalidDateUnit},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := ParseISO(tc.text)
+			if errors.Cause(err) != tc.err || !stderrors.Is(err, tc.err) {
+				tt.Fatalf("Expected %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			var pe *ParseError
+			var re *RangeError
+			switch {
+			case tc.err == ErrInvalidDateFormat && (!stderrors.As(err, &pe) || pe.Field != tc.field):
+				tt.Errorf("Expected a %q parse error, got %v", tc.field, err)
+			case tc.err == ErrInvalidDateUnit && (!stderrors.As(err, &re) || re.Field != tc.field):
+				tt.Errorf("Expected a %q range error, got %v", tc.field, err)
+			}
+		})
+	}
+}
+
+func TestParseISODoesNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := ParseISO("2019-07-14"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkParseISO(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		v, _ := ParseISO("2019-07-14")
+		benchSink = int(v)
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	expected := Must(FromUnits(2019, 7, 14))
+	if got := MustParse("Jan 2, 2006", "Jul 14, 2019"); got != expected {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := MustParseISO("2019-07-14"); got != expected {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	for name, fn := range map[string]func(){
+		"MustParse":    func() { MustParse("2006-01-02", "2019-02-30") },
+		"MustParseISO": func() { MustParseISO("07/14/2019") },
+	} {
+		t.Run(name, func(tt *testing.T) {
+			defer func() {
+				if recover() == nil {
+					tt.Errorf("Expected a panic")
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	expected := Must(FromUnits(2019, 7, 4))
+	for _, text := range []string{
+		"2019-07-04",
+		"2019-07-04T23:30:00-05:00",
+		"2019-07-04T00:15:00.123Z",
+		"2019-07-04T08:00:00",
+		"2019-07-04 08:00:00",
+		"20190704",
+		"2019/07/04",
+		"07/04/2019",
+		"7/4/2019",
+		"04 Jul 2019",
+		"4 Jul 2019",
+		"04-Jul-2019",
+		"4 July 2019",
+		"Jul 4, 2019",
+		"July 4, 2019",
+		"Thu, 04 Jul 2019",
+		"Thursday, July 4, 2019",
+		"  2019-07-04\n",
+	} {
+		t.Run(text, func(tt *testing.T) {
+			if got, err := ParseAny(text); err != nil || got != expected {
+				tt.Errorf("Expected %v, got %v (err = %v)", expected, got, err)
+			}
+		})
+	}
+	for _, text := range []string{"", "yesterday", "2019-02-30", "13/01/2019", "1752-12-31"} {
+		t.Run(text, func(tt *testing.T) {
+			if _, err := ParseAny(text); errors.Cause(err) != ErrInvalidDateFormat {
+				tt.Errorf("Expected %v, got %v", ErrInvalidDateFormat, err)
+			}
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	p := NewParser(append([]string{"02/01/2006"}, DefaultLayouts()...)...)
+	if got, err := p.Parse("04/07/2019"); err != nil || got != Must(FromUnits(2019, 7, 4)) {
+		t.Errorf("Expected 2019-07-04, got %v (err = %v)", got, err)
+	}
+	// the default parser is unaffected
+	if got, err := ParseAny("04/07/2019"); err != nil || got != Must(FromUnits(2019, 4, 7)) {
+		t.Errorf("Expected 2019-04-07, got %v (err = %v)", got, err)
+	}
+
+	p = NewParser("2006.01.02")
+	if _, err := p.Parse("2019-07-04"); errors.Cause(err) != ErrInvalidDateFormat {
+		t.Errorf("Expected %v, got %v", ErrInvalidDateFormat, err)
+	}
+	if got, err := p.Parse("2019.07.04"); err != nil || got != Must(FromUnits(2019, 7, 4)) {
+		t.Errorf("Expected 2019-07-04, got %v (err = %v)", got, err)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidPeriodFormat is returned from date.ParsePeriod() when the text is not an ISO 8601
+	// period with only year, month, week and day components
+	ErrInvalidPeriodFormat = errors.Errorf("date.Period: text data was not in the correct format")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Period)(nil)
+var _ encoding.TextAppender = (*Period)(nil)
+var _ encoding.TextUnmarshaler = (*Period)(nil)
+
+// Period is an amount of calendar time in years, months and days, such as "1 year, 2 months and 3
+// days".  Unlike time.Duration, the length of a period depends on the date it is added to, so adding
+// one month to January 31 and to February 1 move the dates by different numbers of days.
+//
+// The components are independent and may have different signs.  The zero value is a period of zero
+// days.
+type Period struct {
+	Years, Months, Days int
+}
+
+// ParsePeriod parses an ISO 8601 period, such as "P1Y2M3D", into a Period.  Any of the components can
+// be omitted, but at least one must be present.  Weeks, such as "P2W", are converted to days.  The
+// period and each component can have a sign, so "-P1M" and "P-1M" are equivalent.
+//
+// Time components, such as "PT12H", are not supported and return ErrInvalidPeriodFormat.
+func ParsePeriod(s string) (Period, error) {
+	text := strings.ToUpper(s)
+	neg := false
+	if len(text) > 0 && (text[0] == '-' || text[0] == '+') {
+		neg = text[0] == '-'
+		text = text[1:]
+	}
+	if len(text) < 3 || text[0] != 'P' {
+		return Period{}, errors.Wrapf(ErrInvalidPeriodFormat, "%q", s)
+	}
+	var p Period
+	// the position of the last designator that was parsed, which enforces the Y, M, W, D order
+	last := -1
+	for text = text[1:]; text != ""; {
+		i := strings.IndexAny(text, "YMWD")
+		if i < 1 {
+			return Period{}, errors.Wrapf(ErrInvalidPeriodFormat, "%q", s)
+		}
+		n, err := strconv.Atoi(text[:i])
+		pos := strings.IndexByte("YMWD", text[i])
+		if err != nil || pos <= last {
+			return Period{}, errors.Wrapf(ErrInvalidPeriodFormat, "%q", s)
+		}
+		switch text[i] {
+		case 'Y':
+			p.Years = n
+		case 'M':
+			p.Months = n
+		case 'W':
+			p.Days = n * 7
+		case 'D':
+			p.Days += n
+		}
+		last, text = pos, text[i+1:]
+	}
+	if neg {
+		p = p.Negated()
+	}
+	return p, nil
+}
+
+// MustPeriod panics if the passed-in error is non-nil; otherwise, it returns the passed-in date.Period
+func MustPeriod(p Period, err error) Period {
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the ISO 8601 representation of the period, such as "P1Y2M3D".  Components that are
+// zero are omitted and the zero period is "P0D".  Negative components are written with a minus sign,
+// such as "P-1M".
+func (p Period) String() string {
+	var buf [64]byte
+	b, _ := p.AppendText(buf[:0])
+	return string(b)
+}
+
+// IsZero returns true if all of the components of the period are zero
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negated returns the period with the sign of each component reversed
+func (p Period) Negated() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// Plus returns the component-wise sum of the periods
+func (p Period) Plus(p2 Period) Period {
+	return Period{Years: p.Years + p2.Years, Months: p.Months + p2.Months, Days: p.Days + p2.Days}
+}
+
+// TotalMonths returns the combined number of years and months in the period, in months
+func (p Period) TotalMonths() int {
+	return p.Years*12 + p.Months
+}
+
+// Normalized returns the period with whole years of months moved into the years, so that "P1Y14M" is
+// "P2Y2M" and "P1Y-1M" is "P11M".  The days are not changed, since the number of days in a month
+// varies.
+func (p Period) Normalized() Period {
+	total := p.TotalMonths()
+	return Period{Years: total / 12, Months: total % 12, Days: p.Days}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for date.Period values.  The encoding
+// is the same as String().
+func (p Period) MarshalText() ([]byte, error) {
+	return p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for date.Period values.  It appends the
+// same encoding as MarshalText() to b.
+func (p Period) AppendText(b []byte) ([]byte, error) {
+	if p.IsZero() {
+		return append(b, "P0D"...), nil
+	}
+	b = append(b, 'P')
+	for _, c := range [...]struct {
+		n int
+		d byte
+	}{{p.Years, 'Y'}, {p.Months, 'M'}, {p.Days, 'D'}} {
+		if c.n != 0 {
+			b = append(strconv.AppendInt(b, int64(c.n), 10), c.d)
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for date.Period values.  Any text
+// accepted by ParsePeriod() is accepted.
+func (p *Period) UnmarshalText(text []byte) error {
+	tmp, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = tmp
+	return nil
+}
+
+// AddPeriod adds the specified period to the current date.  The years and months are added together
+// first, as AddMonths() does, and then the days, so 2019-01-31 plus "P1M1D" is 2019-03-01.  By default
+// a day of the month that does not exist in the target month is clamped to the end of that month;
+// pass WithRollover() to move the extra days into the following month instead.
+//
+// If the receiver is date.Nil, this method returns date.Nil and no error
+func (d Value) AddPeriod(p Period, opts ...MonthOption) (Value, error) {
+	if !d.IsValid() {
+		return Nil, nil
+	}
+	if p.Years < -10000 || p.Years > 10000 {
+		return Nil, errors.Errorf("adding %v would generate an out-of-range result", p)
+	}
+	v, err := d.AddMonths(p.TotalMonths(), opts...)
+	if err == nil {
+		v, err = v.AddDays(p.Days)
+	}
+	if err != nil {
+		return Nil, errors.Errorf("adding %v would generate an out-of-range result", p)
+	}
+	return v, nil
+}
+
+// SubtractPeriod subtracts the specified period from the current date.  It is the same as adding the
+// negated period, so the years and months are subtracted first, and then the days.
+//
+// If the receiver is date.Nil, this method returns date.Nil and no error
+func (d Value) SubtractPeriod(p Period, opts ...MonthOption) (Value, error) {
+	return d.AddPeriod(p.Negated(), opts...)
+}
+
+// DiffInYearsMonthsDays returns the difference from a to b in whole years, whole months and the
+// remaining days, which are all negative if b is before a.  The result uses the same end-of-month
+// handling as AddMonths(), so adding Period{years, months, days} to a with AddPeriod() and the same
+// options returns b.
+//
+// By default a day of the month that does not exist in a shorter month is clamped, so 2019-01-31 to
+// 2019-02-28 is one month.  With WithRollover() it is 28 days instead.
+//
+// If either date is date.Nil or is otherwise invalid, ErrInvalidOperand is returned
+func DiffInYearsMonthsDays(a, b Value, opts ...MonthOption) (years, months, days int, err error) {
+	if !a.IsValid() || !b.IsValid() {
+		return 0, 0, 0, errors.Wrapf(ErrInvalidOperand, "%d and %d", int64(a), int64(b))
+	}
+	ay, am, _ := a.Date()
+	by, bm, _ := b.Date()
+	n := (by-ay)*12 + bm - am
+	c, err := a.AddMonths(n, opts...)
+	// step back towards a until adding n months no longer passes b
+	for err != nil || (b >= a && c > b) || (b < a && c < b) {
+		if b >= a {
+			n--
+		} else {
+			n++
+		}
+		c, err = a.AddMonths(n, opts...)
+	}
+	return n / 12, n % 12, int(b - c), nil
+}
+
+// Age returns the number of whole years from dob to asOf, such as a person's age on a given date.
+//
+// By default a February 29 birthday is celebrated on February 28 in common years.  Pass WithRollover()
+// to use March 1 instead, as the laws of some countries do.
+//
+// If either date is date.Nil or is otherwise invalid, or asOf is before dob, ErrInvalidOperand is
+// returned
+func Age(dob, asOf Value, opts ...MonthOption) (int, error) {
+	years, _, _, err := DiffInYearsMonthsDays(dob, asOf, opts...)
+	if err == nil && asOf < dob {
+		return 0, errors.Wrapf(ErrInvalidOperand, "%v is before %v", asOf, dob)
+	}
+	return years, err
+}
@@ -0,0 +1,230 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParsePeriod(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected Period
+		str      string
+	}{
+		{"P1Y2M3D", Period{1, 2, 3}, "P1Y2M3D"},
+		{"P1Y", Period{Years: 1}, "P1Y"},
+		{"P18M", Period{Months: 18}, "P18M"},
+		{"P2W", Period{Days: 14}, "P14D"},
+		{"P1W2D", Period{Days: 9}, "P9D"},
+		{"P0D", Period{}, "P0D"},
+		{"p1y2m3d", Period{1, 2, 3}, "P1Y2M3D"},
+		{"-P1Y2M", Period{Years: -1, Months: -2}, "P-1Y-2M"},
+		{"+P1D", Period{Days: 1}, "P1D"},
+		{"P-1M3D", Period{Months: -1, Days: 3}, "P-1M3D"},
+		{"-P-1M3D", Period{Months: 1, Days: -3}, "P1M-3D"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.text, func(tt *testing.T) {
+			got, err := ParsePeriod(tc.text)
+			if err != nil || got != tc.expected {
+				tt.Errorf("Expected %+v, got %+v (err = %v)", tc.expected, got, err)
+			}
+			if s := got.String(); s != tc.str {
+				tt.Errorf("Expected %q, got %q", tc.str, s)
+			}
+		})
+	}
+}
+
+func TestParsePeriodErrors(t *testing.T) {
+	for _, text := range []string{"", "P", "1Y", "PY", "P1", "P1X", "P1D2Y", "P1M1M", "PT1H", "P1DT1H", "P1.5D", "P--1D", "--P1D"} {
+		t.Run(text, func(tt *testing.T) {
+			if _, err := ParsePeriod(text); errors.Cause(err) != ErrInvalidPeriodFormat {
+				tt.Errorf("Expected %v, got %v", ErrInvalidPeriodFormat, err)
+			}
+		})
+	}
+}
+
+func TestPeriodNormalized(t *testing.T) {
+	cases := []struct {
+		p, expected Period
+	}{
+		{Period{1, 14, 40}, Period{2, 2, 40}},
+		{Period{1, -1, 0}, Period{0, 11, 0}},
+		{Period{-1, -14, 0}, Period{-2, -2, 0}},
+		{Period{0, -1, 0}, Period{0, -1, 0}},
+	}
+	for _, tc := range cases {
+		if got := tc.p.Normalized(); got != tc.expected {
+			t.Errorf("Expected %v for %v, got %v", tc.expected, tc.p, got)
+		}
+	}
+	if got := (Period{1, 2, 3}).Plus(Period{0, -2, 4}); got != (Period{1, 0, 7}) {
+		t.Errorf("Expected P1Y7D, got %v", got)
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	d := func(y, m, dd int) Value { return Must(FromUnits(y, m, dd)) }
+	cases := []struct {
+		name     string
+		start    Value
+		p        string
+		rollover bool
+		expected Value
+	}{
+		{"years months days", d(2019, 7, 14), "P1Y2M3D", false, d(2020, 9, 17)},
+		{"end of month", d(2019, 1, 31), "P1M", false, d(2019, 2, 28)},
+		{"end of month rollover", d(2019, 1, 31), "P1M", true, d(2019, 3, 3)},
+		{"months before days", d(2019, 1, 31), "P1M1D", false, d(2019, 3, 1)},
+		{"leap day", d(2024, 2, 29), "P1Y", false, d(2025, 2, 28)},
+		{"combined months", d(2024, 2, 29), "P1Y1M", false, d(2025, 3, 29)},
+		{"negative", d(2019, 3, 31), "-P1M", false, d(2019, 2, 28)},
+		{"mixed signs", d(2019, 7, 14), "P1M-14D", false, d(2019, 7, 31)},
+		{"nil", Nil, "P1D", false, Nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var opts []MonthOption
+			if tc.rollover {
+				opts = append(opts, WithRollover())
+			}
+			got, err := tc.start.AddPeriod(MustPeriod(ParsePeriod(tc.p)), opts...)
+			if err != nil || got != tc.expected {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.expected, got, err)
+			}
+		})
+	}
+
+	if got, err := d(2020, 9, 17).SubtractPeriod(Period{1, 2, 3}); err != nil || got != d(2019, 7, 14) {
+		t.Errorf("Expected 2019-07-14, got %v (err = %v)", got, err)
+	}
+	for _, p := range []Period{{Years: 9000}, {Years: 100000}, {Days: -1}} {
+		if _, err := Min.AddPeriod(p); err == nil {
+			t.Errorf("Expected an error adding %v to date.Min", p)
+		}
+	}
+}
+
+func TestPeriodAppendText(t *testing.T) {
+	cases := []struct {
+		name     string
+		p        Period
+		expected string
+	}{
+		{"zero", Period{}, "P0D"},
+		{"all components", Period{Years: 1, Months: 2, Days: 3}, "P1Y2M3D"},
+		{"negative", Period{Months: -1}, "P-1M"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.p.AppendText([]byte("for "))
+			if err != nil || string(got) != "for "+tc.expected {
+				tt.Errorf("Expected %q, got %q (err = %v)", "for "+tc.expected, got, err)
+			}
+			if s := tc.p.String(); s != tc.expected {
+				tt.Errorf("Expected String() to return %q, got %q", tc.expected, s)
+			}
+		})
+	}
+}
+
+func TestPeriodJSON(t *testing.T) {
+	type payload struct {
+		P Period `json:"p"`
+	}
+	data, err := json.Marshal(payload{Period{Months: 6}})
+	if err != nil || string(data) != `{"p":"P6M"}` {
+		t.Fatalf("Expected {\"p\":\"P6M\"}, got %s (err = %v)", data, err)
+	}
+	var got payload
+	if err := json.Unmarshal([]byte(`{"p":"P1Y2W"}`), &got); err != nil || got.P != (Period{Years: 1, Days: 14}) {
+		t.Errorf("Expected P1Y14D, got %v (err = %v)", got.P, err)
+	}
+	if err := json.Unmarshal([]byte(`{"p":"1Y"}`), &got); errors.Cause(err) != ErrInvalidPeriodFormat {
+		t.Errorf("Expected %v, got %v", ErrInvalidPeriodFormat, err)
+	}
+}
+
+func TestDiffInYearsMonthsDays(t *testing.T) {
+	d := func(y, m, dd int) Value { return Must(FromUnits(y, m, dd)) }
+	cases := []struct {
+		name     string
+		a, b     Value
+		rollover bool
+		expected Period
+	}{
+		{"same day", d(2019, 7, 14), d(2019, 7, 14), false, Period{}},
+		{"years months days", d(2019, 7, 14), d(2020, 9, 17), false, Period{1, 2, 3}},
+		{"day before anniversary", d(2019, 7, 14), d(2020, 7, 13), false, Period{0, 11, 29}},
+		{"end of month", d(2019, 1, 31), d(2019, 2, 28), false, Period{0, 1, 0}},
+		{"end of month rollover", d(2019, 1, 31), d(2019, 2, 28), true, Period{0, 0, 28}},
+		{"across year end", d(2019, 12, 20), d(2020, 1, 5), false, Period{0, 0, 16}},
+		{"backwards", d(2020, 9, 17), d(2019, 7, 14), false, Period{-1, -2, -3}},
+		{"backwards end of month", d(2019, 3, 31), d(2019, 2, 28), false, Period{0, -1, 0}},
+		{"backwards days", d(2019, 3, 1), d(2019, 2, 28), false, Period{0, 0, -1}},
+		{"full range", Min, Max, false, Period{8246, 11, 30}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var opts []MonthOption
+			if tc.rollover {
+				opts = append(opts, WithRollover())
+			}
+			y, m, dd, err := DiffInYearsMonthsDays(tc.a, tc.b, opts...)
+			if got := (Period{y, m, dd}); err != nil || got != tc.expected {
+				tt.Fatalf("Expected %v, got %v (err = %v)", tc.expected, got, err)
+			}
+			if got, err := tc.a.AddPeriod(tc.expected, opts...); err != nil || got != tc.b {
+				tt.Errorf("Expected %v + %v to be %v, got %v (err = %v)", tc.a, tc.expected, tc.b, got, err)
+			}
+		})
+	}
+	if _, _, _, err := DiffInYearsMonthsDays(Nil, Min); errors.Cause(err) != ErrInvalidOperand {
+		t.Errorf("Expected %v, got %v", ErrInvalidOperand, err)
+	}
+}
+
+func TestAge(t *testing.T) {
+	d := func(y, m, dd int) Value { return Must(FromUnits(y, m, dd)) }
+	cases := []struct {
+		name     string
+		dob      Value
+		asOf     Value
+		rollover bool
+		expected int
+	}{
+		{"birthday", d(1990, 7, 14), d(2019, 7, 14), false, 29},
+		{"day before birthday", d(1990, 7, 14), d(2019, 7, 13), false, 28},
+		{"newborn", d(2019, 7, 14), d(2019, 7, 14), false, 0},
+		{"leap day on Feb 28", d(2000, 2, 29), d(2001, 2, 28), false, 1},
+		{"leap day on Feb 28 with rollover", d(2000, 2, 29), d(2001, 2, 28), true, 0},
+		{"leap day on Mar 1 with rollover", d(2000, 2, 29), d(2001, 3, 1), true, 1},
+		{"leap day in a leap year", d(2000, 2, 29), d(2004, 2, 28), false, 3},
+		{"leap day on its birthday", d(2000, 2, 29), d(2004, 2, 29), false, 4},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var opts []MonthOption
+			if tc.rollover {
+				opts = append(opts, WithRollover())
+			}
+			if got, err := Age(tc.dob, tc.asOf, opts...); err != nil || got != tc.expected {
+				tt.Errorf("Expected %d, got %d (err = %v)", tc.expected, got, err)
+			}
+		})
+	}
+	if _, err := Age(d(2019, 7, 14), d(2019, 7, 13)); errors.Cause(err) != ErrInvalidOperand {
+		t.Errorf("Expected %v, got %v", ErrInvalidOperand, err)
+	}
+	if _, err := Age(Nil, d(2019, 7, 13)); errors.Cause(err) != ErrInvalidOperand {
+		t.Errorf("Expected %v, got %v", ErrInvalidOperand, err)
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidRange is returned when the bounds of a date.Range are invalid dates or the start is
+	// after the end
+	ErrInvalidRange = errors.Errorf("date.Range: the bounds must be valid dates and the start must not be after the end")
+	// ErrDisjointRanges is returned from date.Range.Union() when the ranges neither overlap nor touch,
+	// so their union is not a single range
+	ErrDisjointRanges = errors.Errorf("date.Range: the ranges are neither overlapping nor adjacent")
+	// ErrInvalidRangeFormat is returned from date.ParseRange() when the text is not a range in the
+	// PostgreSQL daterange format
+	ErrInvalidRangeFormat = errors.Errorf("date.Range: text data was not in the correct format")
+	// ErrUnsupportedRangeSourceType is returned by date.Range.Scan() when the provided value cannot
+	// be converted to a date.Range value
+	ErrUnsupportedRangeSourceType = errors.Errorf("date.Range: cannot convert the source data to a date.Range value")
+)
+
+var unsupportedRangeSourceType = typeerr.New(ErrUnsupportedRangeSourceType)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Range)(nil)
+var _ encoding.TextAppender = (*Range)(nil)
+var _ encoding.TextUnmarshaler = (*Range)(nil)
+var _ json.Marshaler = (*Range)(nil)
+var _ json.Unmarshaler = (*Range)(nil)
+var _ driver.Valuer = (*Range)(nil)
+var _ sql.Scanner = (*Range)(nil)
+
+// Bounds specifies whether the start and end dates passed to NewRange() are included in the range
+type Bounds int
+
+// The supported combinations of bounds, named after the notation used by PostgreSQL
+const (
+	// Closed includes both dates, [start,end]
+	Closed Bounds = iota
+	// ClosedOpen includes the start date but not the end date, [start,end)
+	ClosedOpen
+	// OpenClosed includes the end date but not the start date, (start,end]
+	OpenClosed
+	// Open includes neither date, (start,end)
+	Open
+)
+
+// Range is a contiguous span of whole days.  Internally it is stored as a half-open interval, so two
+// ranges that contain the same days compare equal regardless of the bounds they were created with.
+//
+// The zero value is the empty range, which contains no days.
+type Range struct {
+	// lo is the first day in the range and hi is the day after the last one
+	lo, hi Value
+}
+
+// EmptyRange is the range that contains no days
+var EmptyRange Range
+
+// NewRange returns the range between start and end, including or excluding each date as specified by
+// b.  A range with open bounds that contains no days, such as (2019-07-14,2019-07-15), is the empty
+// range.
+//
+// If either date is invalid or start is after end, ErrInvalidRange is returned.
+func NewRange(start, end Value, b Bounds) (Range, error) {
+	if !start.IsValid() || !end.IsValid() || start > end {
+		return EmptyRange, errors.Wrapf(ErrInvalidRange, "%v and %v", start, end)
+	}
+	lo, hi := start, end+1
+	if b == OpenClosed || b == Open {
+		lo++
+	}
+	if b == ClosedOpen || b == Open {
+		hi--
+	}
+	if lo >= hi {
+		return EmptyRange, nil
+	}
+	return Range{lo: lo, hi: hi}, nil
+}
+
+// MustRange panics if the passed-in error is non-nil; otherwise, it returns the passed-in date.Range
+func MustRange(r Range, err error) Range {
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// WeekOf returns the range of days in the week that contains the date represented by d, where weeks
+// start on the specified day.  The weeks that contain date.Min and date.Max are truncated to the
+// supported dates.
+//
+// If the receiver is date.Nil or first is not between time.Sunday and time.Saturday, this method
+// returns the empty range
+func (d Value) WeekOf(first time.Weekday) Range {
+	if !d.IsValid() || first < time.Sunday || first > time.Saturday {
+		return EmptyRange
+	}
+	lo := d - Value((d.Weekday()-first+7)%7)
+	return Range{lo: max(lo, Min), hi: min(lo+7, Max+1)}
+}
+
+// IsEmpty returns true if the range contains no days
+func (r Range) IsEmpty() bool {
+	return r.lo >= r.hi
+}
+
+// Start returns the first day in the range, or date.Nil if the range is empty
+func (r Range) Start() Value {
+	if r.IsEmpty() {
+		return Nil
+	}
+	return r.lo
+}
+
+// End returns the last day in the range, or date.Nil if the range is empty
+func (r Range) End() Value {
+	if r.IsEmpty() {
+		return Nil
+	}
+	return r.hi - 1
+}
+
+// Len returns the number of days in the range
+func (r Range) Len() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return int(r.hi - r.lo)
+}
+
+// Days returns an iterator over every day in the range, in order, for use with range-over-func loops:
+//
+//	for d := range r.Days() {
+//		...
+//	}
+func (r Range) Days() iter.Seq[Value] {
+	return r.Every(1)
+}
+
+// Every returns an iterator over every nth day in the range, in order, starting with the first day.
+// For example, Every(7) yields the same day of the week in each week of the range.  If n is not
+// positive, the iterator yields nothing.
+func (r Range) Every(n int) iter.Seq[Value] {
+	return func(yield func(Value) bool) {
+		if n <= 0 {
+			return
+		}
+		for d := r.lo; d < r.hi; d += Value(n) {
+			// check the remaining days before stepping so that a huge n cannot overflow d
+			if !yield(d) || int64(r.hi-d) <= int64(n) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over every day in the range in reverse order, starting with the last
+// day
+func (r Range) Backward() iter.Seq[Value] {
+	return func(yield func(Value) bool) {
+		for d := r.hi - 1; d >= r.lo; d-- {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// Contains returns true if the specified date is in the range.  It always returns false for date.Nil.
+func (r Range) Contains(d Value) bool {
+	return d.IsValid() && d >= r.lo && d < r.hi
+}
+
+// ContainsRange returns true if every day of r2 is in the range.  The empty range is contained in
+// every range.
+func (r Range) ContainsRange(r2 Range) bool {
+	return r2.IsEmpty() || (!r.IsEmpty() && r2.lo >= r.lo && r2.hi <= r.hi)
+}
+
+// Overlaps returns true if the ranges have at least one day in common
+func (r Range) Overlaps(r2 Range) bool {
+	return !r.IsEmpty() && !r2.IsEmpty() && r.lo < r2.hi && r2.lo < r.hi
+}
+
+// Adjacent returns true if the ranges do not overlap but one starts on the day after the other ends
+func (r Range) Adjacent(r2 Range) bool {
+	return !r.IsEmpty() && !r2.IsEmpty() && (r.hi == r2.lo || r2.hi == r.lo)
+}
+
+// Intersect returns the days that are in both ranges, which is the empty range if they do not overlap
+func (r Range) Intersect(r2 Range) Range {
+	if !r.Overlaps(r2) {
+		return EmptyRange
+	}
+	return Range{lo: max(r.lo, r2.lo), hi: min(r.hi, r2.hi)}
+}
+
+// Union returns the days that are in either range.  The union of a range with the empty range is the
+// range itself.
+//
+// If the ranges neither overlap nor are adjacent, their union is not contiguous and ErrDisjointRanges
+// is returned.
+func (r Range) Union(r2 Range) (Range, error) {
+	switch {
+	case r2.IsEmpty():
+		return r.normalize(), nil
+	case r.IsEmpty():
+		return r2, nil
+	case !r.Overlaps(r2) && !r.Adjacent(r2):
+		return EmptyRange, errors.Wrapf(ErrDisjointRanges, "%v and %v", r, r2)
+	}
+	return Range{lo: min(r.lo, r2.lo), hi: max(r.hi, r2.hi)}, nil
+}
+
+// normalize returns EmptyRange for any empty range, so that empty ranges compare equal
+func (r Range) normalize() Range {
+	if r.IsEmpty() {
+		return EmptyRange
+	}
+	return r
+}
+
+// String implements fmt.Stringer for date.Range values.
+//
+// The returned string uses the PostgreSQL daterange notation with both bounds included, such as
+// "[2019-07-01,2019-07-14]", or "empty" for the empty range.
+func (r Range) String() string {
+	b, _ := r.AppendText(make([]byte, 0, 2*textLen+3))
+	return string(b)
+}
+
+// ParseRange parses a range in the PostgreSQL daterange notation, such as "[2019-07-01,2019-07-15)",
+// with any combination of inclusive and exclusive bounds.  PostgreSQL returns ranges in the [start,end)
+// form, and String() writes the [start,end] form.  The text "empty" is parsed as the empty range.
+//
+// Unbounded ranges, such as "[2019-07-01,)", are not supported and return ErrInvalidRangeFormat.
+func ParseRange(s string) (Range, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "empty") {
+		return EmptyRange, nil
+	}
+	if len(s) < 2 {
+		return EmptyRange, errors.Wrapf(ErrInvalidRangeFormat, "%q", s)
+	}
+	first, last := s[0], s[len(s)-1]
+	startText, endText, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok || (first != '[' && first != '(') || (last != ']' && last != ')') {
+		return EmptyRange, errors.Wrapf(ErrInvalidRangeFormat, "%q", s)
+	}
+	start, err := parseBound(startText)
+	if err != nil {
+		return EmptyRange, errors.Wrapf(ErrInvalidRangeFormat, "%q", s)
+	}
+	end, err := parseBound(endText)
+	if err != nil {
+		return EmptyRange, errors.Wrapf(ErrInvalidRangeFormat, "%q", s)
+	}
+	b := Closed
+	switch {
+	case first == '[' && last == ')':
+		b = ClosedOpen
+	case first == '(' && last == ']':
+		b = OpenClosed
+	case first == '(' && last == ')':
+		b = Open
+	}
+	if b == ClosedOpen && end == Max+1 {
+		// PostgreSQL writes a range that ends on date.Max with the following day as its exclusive end
+		return NewRange(start, Max, Closed)
+	}
+	return NewRange(start, end, b)
+}
+
+// parseBound parses one bound of a range, which may be quoted.  The day after date.Max is accepted so
+// that ranges ending on date.Max can be read in the [start,end) form.
+func parseBound(s string) (Value, error) {
+	s = strings.Trim(strings.TrimSpace(s), `"`)
+	if s == "10000-01-01" {
+		return Max + 1, nil
+	}
+	if s == "" {
+		return Nil, ErrInvalidRangeFormat
+	}
+	return parseText(s)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for date.Range values.  The encoding
+// is the same as String().
+func (r Range) MarshalText() ([]byte, error) {
+	return r.AppendText(make([]byte, 0, 2*textLen+3))
+}
+
+// AppendText implements the encoding.TextAppender interface for date.Range values.  It appends the
+// same encoding as MarshalText() to b.
+func (r Range) AppendText(b []byte) ([]byte, error) {
+	if r.IsEmpty() {
+		return append(b, "empty"...), nil
+	}
+	b = append(b, '[')
+	b = r.lo.appendText(b)
+	b = append(b, ',')
+	b = (r.hi - 1).appendText(b)
+	return append(b, ']'), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for date.Range values.  Any text
+// accepted by ParseRange() is accepted.
+func (r *Range) UnmarshalText(text []byte) error {
+	tmp, err := ParseRange(string(text))
+	if err != nil {
+		return err
+	}
+	*r = tmp
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for date.Range values.  The JSON encoding is a
+// string containing the same text as MarshalText().
+func (r Range) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for date.Range values.  JSON strings are
+// delegated to UnmarshalText(), and null is decoded as the empty range.
+func (r *Range) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*r = EmptyRange
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrapf(ErrInvalidRangeFormat, "%s", data)
+	}
+	return r.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface for date.Range values.  The returned value is the text
+// form, which PostgreSQL accepts for daterange columns.
+func (r Range) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for date.Range values.
+//
+// A string or byte slice is handled by ParseRange(), which accepts the [start,end) text that
+// PostgreSQL returns for daterange columns.  All other values, including NULL, will return an error.
+func (r *Range) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case string:
+		return r.UnmarshalText([]byte(tv))
+	case []byte:
+		return r.UnmarshalText(tv)
+	default:
+		return unsupportedRangeSourceType.Of(src)
+	}
+}
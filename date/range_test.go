@@ -0,0 +1,292 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"iter"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// day returns the date.Value for the specified day of July 2019
+func day(d int) Value {
+	return Must(FromUnits(2019, 7, d))
+}
+
+func TestNewRange(t *testing.T) {
+	cases := []struct {
+		name       string
+		start, end Value
+		b          Bounds
+		expected   string
+		len        int
+		err        error
+	}{
+		{"closed", day(1), day(14), Closed, "[2019-07-01,2019-07-14]", 14, nil},
+		{"closed open", day(1), day(15), ClosedOpen, "[2019-07-01,2019-07-14]", 14, nil},
+		{"open closed", day(1), day(14), OpenClosed, "[2019-07-02,2019-07-14]", 13, nil},
+		{"open", day(1), day(14), Open, "[2019-07-02,2019-07-13]", 12, nil},
+		{"single day", day(14), day(14), Closed, "[2019-07-14,2019-07-14]", 1, nil},
+		{"empty closed open", day(14), day(14), ClosedOpen, "empty", 0, nil},
+		{"empty open", day(14), day(15), Open, "empty", 0, nil},
+		{"full range", Min, Max, Closed, "[1753-01-01,9999-12-31]", int(Max-Min) + 1, nil},
+		{"reversed", day(14), day(1), Closed, "empty", 0, ErrInvalidRange},
+		{"nil start", Nil, day(1), Closed, "empty", 0, ErrInvalidRange},
+		{"invalid end", day(1), Max + 1, ClosedOpen, "empty", 0, ErrInvalidRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			r, err := NewRange(tc.start, tc.end, tc.b)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if r.String() != tc.expected || r.Len() != tc.len {
+				tt.Errorf("Expected %s with %d days, got %s with %d days", tc.expected, tc.len, r, r.Len())
+			}
+		})
+	}
+	if a, b := MustRange(NewRange(day(1), day(15), ClosedOpen)), MustRange(NewRange(day(1), day(14), Closed)); a != b {
+		t.Errorf("Expected ranges with the same days to be equal, got %#v and %#v", a, b)
+	}
+}
+
+func TestRangeAccessors(t *testing.T) {
+	r := MustRange(NewRange(day(1), day(14), Closed))
+	if r.Start() != day(1) || r.End() != day(14) || r.IsEmpty() {
+		t.Errorf("Expected 2019-07-01 to 2019-07-14, got %v to %v", r.Start(), r.End())
+	}
+	if EmptyRange.Start() != Nil || EmptyRange.End() != Nil || !EmptyRange.IsEmpty() || EmptyRange.Len() != 0 {
+		t.Errorf("Expected the empty range to have no days, got %v to %v", EmptyRange.Start(), EmptyRange.End())
+	}
+	for d, expected := range map[Value]bool{day(1): true, day(14): true, day(15): false, day(1) - 1: false, Nil: false} {
+		if got := r.Contains(d); got != expected {
+			t.Errorf("Expected Contains(%v) to return %v, got %v", d, expected, got)
+		}
+	}
+}
+
+func TestRangeAlgebra(t *testing.T) {
+	r := func(start, end int) Range { return MustRange(NewRange(day(start), day(end), Closed)) }
+	cases := []struct {
+		name      string
+		a, b      Range
+		overlaps  bool
+		adjacent  bool
+		contains  bool
+		intersect Range
+		union     Range
+		unionErr  error
+	}{
+		{"overlapping", r(1, 10), r(5, 20), true, false, false, r(5, 10), r(1, 20), nil},
+		{"contained", r(1, 20), r(5, 10), true, false, true, r(5, 10), r(1, 20), nil},
+		{"identical", r(1, 10), r(1, 10), true, false, true, r(1, 10), r(1, 10), nil},
+		{"one day in common", r(1, 10), r(10, 20), true, false, false, r(10, 10), r(1, 20), nil},
+		{"adjacent", r(1, 10), r(11, 20), false, true, false, EmptyRange, r(1, 20), nil},
+		{"adjacent reversed", r(11, 20), r(1, 10), false, true, false, EmptyRange, r(1, 20), nil},
+		{"disjoint", r(1, 10), r(12, 20), false, false, false, EmptyRange, EmptyRange, ErrDisjointRanges},
+		{"empty second", r(1, 10), EmptyRange, false, false, true, EmptyRange, r(1, 10), nil},
+		{"empty first", EmptyRange, r(1, 10), false, false, false, EmptyRange, r(1, 10), nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.a.Overlaps(tc.b); got != tc.overlaps {
+				tt.Errorf("Expected Overlaps() to return %v, got %v", tc.overlaps, got)
+			}
+			if got := tc.a.Adjacent(tc.b); got != tc.adjacent {
+				tt.Errorf("Expected Adjacent() to return %v, got %v", tc.adjacent, got)
+			}
+			if got := tc.a.ContainsRange(tc.b); got != tc.contains {
+				tt.Errorf("Expected ContainsRange() to return %v, got %v", tc.contains, got)
+			}
+			if got := tc.a.Intersect(tc.b); got != tc.intersect {
+				tt.Errorf("Expected the intersection to be %v, got %v", tc.intersect, got)
+			}
+			got, err := tc.a.Union(tc.b)
+			if errors.Cause(err) != tc.unionErr || got != tc.union {
+				tt.Errorf("Expected the union to be (%v, %v), got (%v, %v)", tc.union, tc.unionErr, got, err)
+			}
+		})
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected string
+		err      error
+	}{
+		{"closed", "[2019-07-01,2019-07-14]", "[2019-07-01,2019-07-14]", nil},
+		{"postgres canonical", "[2019-07-01,2019-07-15)", "[2019-07-01,2019-07-14]", nil},
+		{"open", "(2019-06-30,2019-07-15)", "[2019-07-01,2019-07-14]", nil},
+		{"quoted with spaces", ` ["2019-07-01", "2019-07-14"] `, "[2019-07-01,2019-07-14]", nil},
+		{"empty", "empty", "empty", nil},
+		{"postgres range ending on max", "[9999-12-01,10000-01-01)", "[9999-12-01,9999-12-31]", nil},
+		{"unbounded", "[2019-07-01,)", "empty", ErrInvalidRangeFormat},
+		{"missing comma", "[2019-07-01]", "empty", ErrInvalidRangeFormat},
+		{"bad bracket", "{2019-07-01,2019-07-14}", "empty", ErrInvalidRangeFormat},
+		{"bad date", "[2019-07-01,2019-07-32]", "empty", ErrInvalidRangeFormat},
+		{"too short", "[", "empty", ErrInvalidRangeFormat},
+		{"reversed", "[2019-07-14,2019-07-01]", "empty", ErrInvalidRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			r, err := ParseRange(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if r.String() != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, r)
+			}
+		})
+	}
+}
+
+func TestRangeAppendText(t *testing.T) {
+	cases := []struct {
+		name     string
+		r        Range
+		expected string
+	}{
+		{"empty", EmptyRange, "empty"},
+		{"closed", MustRange(NewRange(day(1), day(14), Closed)), "[2019-07-01,2019-07-14]"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.r.AppendText([]byte("stay="))
+			if err != nil || string(got) != "stay="+tc.expected {
+				tt.Errorf("Expected %q, got %q (err = %v)", "stay="+tc.expected, got, err)
+			}
+			if s := tc.r.String(); s != tc.expected {
+				tt.Errorf("Expected String() to return %q, got %q", tc.expected, s)
+			}
+		})
+	}
+}
+
+func TestRangeJSON(t *testing.T) {
+	type booking struct {
+		Stay Range `json:"stay"`
+	}
+	in := booking{MustRange(NewRange(day(1), day(14), Closed))}
+	data, err := json.Marshal(in)
+	if err != nil || string(data) != `{"stay":"[2019-07-01,2019-07-14]"}` {
+		t.Errorf("Expected the range as a string, got %s (err = %v)", data, err)
+	}
+	var out booking
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("Expected %v, got %v (err = %v)", in, out, err)
+	}
+	if err := json.Unmarshal([]byte(`{"stay":null}`), &out); err != nil || !out.Stay.IsEmpty() {
+		t.Errorf("Expected null to decode as the empty range, got %v (err = %v)", out.Stay, err)
+	}
+	if err := out.Stay.UnmarshalJSON([]byte("42")); errors.Cause(err) != ErrInvalidRangeFormat {
+		t.Errorf("Expected %v, got %v", ErrInvalidRangeFormat, err)
+	}
+}
+
+func TestRangeSQL(t *testing.T) {
+	r := MustRange(NewRange(day(1), day(14), Closed))
+	if v, err := r.Value(); err != nil || v != "[2019-07-01,2019-07-14]" {
+		t.Errorf("Expected the text form, got %v (err = %v)", v, err)
+	}
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Range
+		err      error
+	}{
+		{"string", "[2019-07-01,2019-07-15)", r, nil},
+		{"bytes", []byte("[2019-07-01,2019-07-15)"), r, nil},
+		{"empty", "empty", EmptyRange, nil},
+		{"null", nil, EmptyRange, ErrUnsupportedRangeSourceType},
+		{"unsupported type", int64(42), EmptyRange, ErrUnsupportedRangeSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got Range
+			err := got.Scan(tc.src)
+			if errors.Cause(err) != tc.err || got != tc.expected {
+				tt.Errorf("Expected (%v, %v), got (%v, %v)", tc.expected, tc.err, got, err)
+			}
+		})
+	}
+}
+
+func TestRangeIterators(t *testing.T) {
+	r := MustRange(NewRange(day(1), day(14), Closed))
+	cases := []struct {
+		name     string
+		seq      iter.Seq[Value]
+		expected []Value
+	}{
+		{"days", MustRange(NewRange(day(1), day(4), Closed)).Days(), []Value{day(1), day(2), day(3), day(4)}},
+		{"weekly", r.Every(7), []Value{day(1), day(8)}},
+		{"step past end", r.Every(100), []Value{day(1)}},
+		{"huge step", r.Every(math.MaxInt64), []Value{day(1)}},
+		{"zero step", r.Every(0), nil},
+		{"backward", MustRange(NewRange(day(1), day(3), Closed)).Backward(), []Value{day(3), day(2), day(1)}},
+		{"empty", EmptyRange.Days(), nil},
+		{"empty backward", EmptyRange.Backward(), nil},
+		{"at max", MustRange(NewRange(Max-1, Max, Closed)).Days(), []Value{Max - 1, Max}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := slices.Collect(tc.seq); !slices.Equal(got, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+
+	n := 0
+	for d := range r.Days() {
+		if d == day(3) {
+			break
+		}
+		n++
+	}
+	if n != 2 {
+		t.Errorf("Expected to stop after 2 days, got %d", n)
+	}
+	if got := len(slices.Collect(r.Days())); got != r.Len() {
+		t.Errorf("Expected %d days, got %d", r.Len(), got)
+	}
+}
+
+func TestWeekOf(t *testing.T) {
+	// 2019-07-17 is a Wednesday
+	cases := []struct {
+		name     string
+		d        Value
+		first    time.Weekday
+		expected Range
+	}{
+		{"sunday start", day(17), time.Sunday, MustRange(NewRange(day(14), day(20), Closed))},
+		{"monday start", day(17), time.Monday, MustRange(NewRange(day(15), day(21), Closed))},
+		{"saturday start", day(17), time.Saturday, MustRange(NewRange(day(13), day(19), Closed))},
+		{"first day", day(15), time.Monday, MustRange(NewRange(day(15), day(21), Closed))},
+		{"last day", day(21), time.Monday, MustRange(NewRange(day(15), day(21), Closed))},
+		{"truncated at min", Min, time.Sunday, MustRange(NewRange(Min, Min+5, Closed))},
+		{"truncated at max", Max, time.Monday, MustRange(NewRange(Max-4, Max, Closed))},
+		{"nil", Nil, time.Sunday, EmptyRange},
+		{"invalid weekday", day(17), time.Weekday(7), EmptyRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := tc.d.WeekOf(tc.first)
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			if !got.IsEmpty() && (got.Start() != tc.d.StartOfWeek(tc.first) && got.Start() != Min) {
+				tt.Errorf("Expected the range to start on %v, got %v", tc.d.StartOfWeek(tc.first), got.Start())
+			}
+		})
+	}
+}
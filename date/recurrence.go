@@ -0,0 +1,454 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidRecurrence is returned when a recurrence rule is malformed, uses a part that is not
+	// supported or combines parts in a way that RFC 5545 does not allow
+	ErrInvalidRecurrence = errors.Errorf("date.Recurrence: the recurrence rule is not valid")
+)
+
+// Frequency is the unit of time between the periods of a recurrence, the FREQ part of an RFC 5545
+// recurrence rule
+type Frequency int
+
+// The supported recurrence frequencies.  The zero value is not a valid frequency.
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+// the RRULE names of the frequencies, indexed by Frequency
+var frequencyNames = [...]string{"", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+// the RRULE names of the weekdays, indexed by time.Weekday
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// String returns the RRULE name of the frequency, such as "WEEKLY"
+func (f Frequency) String() string {
+	if f < Daily || f > Yearly {
+		return "Frequency(" + strconv.Itoa(int(f)) + ")"
+	}
+	return frequencyNames[f]
+}
+
+// RecurrenceDay is one entry of the BYDAY part of a recurrence rule.  N selects the nth occurrence of
+// the weekday within the month, for monthly rules, or the year, for yearly rules, and negative values
+// count from the end.  Zero selects every occurrence.
+type RecurrenceDay struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// String returns the RRULE form of the day, such as "MO" or "-1FR"
+func (rd RecurrenceDay) String() string {
+	s := ""
+	if rd.N != 0 {
+		s = strconv.Itoa(rd.N)
+	}
+	if rd.Weekday < time.Sunday || rd.Weekday > time.Saturday {
+		return s + "??"
+	}
+	return s + weekdayCodes[rd.Weekday]
+}
+
+// Recurrence is a repeating set of dates described by a subset of an RFC 5545 recurrence rule (RRULE):
+// FREQ, INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL.  Weeks start on Monday, the RRULE default.
+//
+// Occurrences are generated from Start, which takes the place of DTSTART, and only dates on or after
+// Start that match the rule are produced.  Unlike some implementations, Start is not itself an
+// occurrence unless it matches the rule.
+type Recurrence struct {
+	// Start is the first date on which the recurrence can occur.  It also supplies the weekday, day
+	// of the month and month for rules that do not specify them.
+	Start Value
+	// Freq is the unit of time between periods
+	Freq Frequency
+	// Interval is the number of Freq units between periods, so 2 with Weekly is every other week.
+	// Zero is treated as 1.
+	Interval int
+	// ByDay limits or expands the occurrences in each period to the specified weekdays
+	ByDay []RecurrenceDay
+	// ByMonthDay limits or expands the occurrences in each period to the specified days of the month.
+	// Negative values count from the end of the month, so -1 is the last day.
+	ByMonthDay []int
+	// Count, if non-zero, is the total number of occurrences
+	Count int
+	// Until, if it is a valid date, is the last date on which the recurrence can occur.  date.Nil and
+	// the zero value mean there is no end date.
+	Until Value
+}
+
+// ParseRecurrence parses an RFC 5545 recurrence rule, such as "FREQ=MONTHLY;BYDAY=-1FR;COUNT=12", into
+// a Recurrence that starts on the specified date.  The "RRULE:" prefix is optional.  UNTIL can be a
+// date or a date-time, in which case only the date is used.
+//
+// If the rule is malformed or uses any parts other than FREQ, INTERVAL, BYDAY, BYMONTHDAY, COUNT,
+// UNTIL and WKST=MO, ErrInvalidRecurrence is returned.
+func ParseRecurrence(start Value, rule string) (Recurrence, error) {
+	r := Recurrence{Start: start}
+	s := strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	for part := range strings.SplitSeq(s, ";") {
+		name, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return Recurrence{}, errors.Wrapf(ErrInvalidRecurrence, "malformed part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.Freq = Frequency(slices.Index(frequencyNames[1:], strings.ToUpper(val)) + 1)
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(val)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(val)
+		case "UNTIL":
+			r.Until, err = parseUntil(val)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(val)
+		case "WKST":
+			if !strings.EqualFold(val, "MO") {
+				err = errors.Errorf("only WKST=MO is supported")
+			}
+		default:
+			err = errors.Errorf("unsupported part")
+		}
+		if err != nil {
+			return Recurrence{}, errors.Wrapf(ErrInvalidRecurrence, "%q: %v", part, err)
+		}
+	}
+	if err := r.Validate(); err != nil {
+		return Recurrence{}, err
+	}
+	return r, nil
+}
+
+// parseUntil parses the date, or the date part of the date-time, of an UNTIL part
+func parseUntil(s string) (Value, error) {
+	if len(s) > 8 && s[8] == 'T' {
+		s = s[:8]
+	}
+	return Parse("20060102", s)
+}
+
+// parseByDay parses the comma-separated weekdays of a BYDAY part, such as "MO,WE" or "1MO,-1FR"
+func parseByDay(s string) ([]RecurrenceDay, error) {
+	var res []RecurrenceDay
+	for item := range strings.SplitSeq(s, ",") {
+		if len(item) < 2 {
+			return nil, errors.Errorf("invalid weekday %q", item)
+		}
+		wd := slices.Index(weekdayCodes[:], strings.ToUpper(item[len(item)-2:]))
+		if wd < 0 {
+			return nil, errors.Errorf("invalid weekday %q", item)
+		}
+		rd := RecurrenceDay{Weekday: time.Weekday(wd)}
+		if n := item[:len(item)-2]; n != "" {
+			var err error
+			if rd.N, err = strconv.Atoi(n); err != nil || rd.N == 0 {
+				return nil, errors.Errorf("invalid weekday %q", item)
+			}
+		}
+		res = append(res, rd)
+	}
+	return res, nil
+}
+
+// parseInts parses a comma-separated list of integers
+func parseInts(s string) ([]int, error) {
+	var res []int
+	for item := range strings.SplitSeq(s, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, n)
+	}
+	return res, nil
+}
+
+// Validate checks that the recurrence has a valid start date and frequency, that its parts are in
+// range and that it only combines them as RFC 5545 allows: COUNT and UNTIL cannot both be used,
+// BYMONTHDAY cannot be used with weekly rules and BYDAY can only have occurrence numbers in monthly
+// and yearly rules.  If not, ErrInvalidRecurrence is returned.
+func (r Recurrence) Validate() error {
+	fail := func(format string, args ...interface{}) error {
+		return errors.Wrapf(ErrInvalidRecurrence, format, args...)
+	}
+	switch {
+	case !r.Start.IsValid():
+		return fail("invalid start date %v", r.Start)
+	case r.Freq < Daily || r.Freq > Yearly:
+		return fail("invalid frequency %v", r.Freq)
+	case r.Interval < 0:
+		return fail("invalid interval %d", r.Interval)
+	case r.Count < 0:
+		return fail("invalid count %d", r.Count)
+	case r.Count > 0 && r.Until.IsValid():
+		return fail("COUNT and UNTIL cannot both be specified")
+	case r.Freq == Weekly && len(r.ByMonthDay) > 0:
+		return fail("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	maxN := 0
+	switch r.Freq {
+	case Monthly:
+		maxN = 5
+	case Yearly:
+		maxN = 53
+	}
+	for _, rd := range r.ByDay {
+		if rd.Weekday < time.Sunday || rd.Weekday > time.Saturday || rd.N < -maxN || rd.N > maxN {
+			return fail("invalid BYDAY value %v for FREQ=%v", rd, r.Freq)
+		}
+	}
+	for _, md := range r.ByMonthDay {
+		if md == 0 || md < -31 || md > 31 {
+			return fail("invalid BYMONTHDAY value %d", md)
+		}
+	}
+	return nil
+}
+
+// String returns the recurrence rule in the RFC 5545 RRULE format, without the "RRULE:" prefix and the
+// start date, such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10"
+func (r Recurrence) String() string {
+	var sb strings.Builder
+	sb.WriteString("FREQ=")
+	sb.WriteString(r.Freq.String())
+	if r.Interval > 1 {
+		sb.WriteString(";INTERVAL=")
+		sb.WriteString(strconv.Itoa(r.Interval))
+	}
+	for i, rd := range r.ByDay {
+		if i == 0 {
+			sb.WriteString(";BYDAY=")
+		} else {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(rd.String())
+	}
+	for i, md := range r.ByMonthDay {
+		if i == 0 {
+			sb.WriteString(";BYMONTHDAY=")
+		} else {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.Itoa(md))
+	}
+	if r.Count > 0 {
+		sb.WriteString(";COUNT=")
+		sb.WriteString(strconv.Itoa(r.Count))
+	}
+	if r.Until.IsValid() {
+		sb.WriteString(";UNTIL=")
+		sb.WriteString(r.Until.Format("20060102"))
+	}
+	return sb.String()
+}
+
+// Occurrences returns an iterator over the dates on which the recurrence occurs, in order.  A
+// recurrence without COUNT or UNTIL continues until date.Max, so callers should stop iterating when
+// they have seen enough dates.
+//
+// If the recurrence is not valid, the iterator yields nothing.
+func (r Recurrence) Occurrences() iter.Seq[Value] {
+	return func(yield func(Value) bool) {
+		if r.Validate() != nil {
+			return
+		}
+		n := 0
+		for p := 0; ; p++ {
+			candidates, ok := r.period(p)
+			if !ok {
+				return
+			}
+			for _, d := range candidates {
+				if d < r.Start {
+					continue
+				}
+				if r.Until.IsValid() && d > r.Until {
+					return
+				}
+				if !yield(d) {
+					return
+				}
+				if n++; r.Count > 0 && n >= r.Count {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Between returns the occurrences that fall between from and to, inclusive
+func (r Recurrence) Between(from, to Value) []Value {
+	var res []Value
+	for d := range r.Occurrences() {
+		if d > to {
+			break
+		}
+		if d >= from {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+// period returns the sorted candidate dates in the pth period after the one that contains the start
+// date.  The second return value is false once the period is past date.Max.
+func (r Recurrence) period(p int) ([]Value, bool) {
+	interval := max(r.Interval, 1)
+	var res []Value
+	switch r.Freq {
+	case Daily:
+		d := r.Start + Value(p*interval)
+		if d > Max {
+			return nil, false
+		}
+		if r.matchesWeekday(d) && r.matchesMonthDay(d) {
+			res = append(res, d)
+		}
+	case Weekly:
+		// weeks start on Monday
+		monday := r.Start - Value((r.Start.Weekday()+6)%7) + Value(7*p*interval)
+		if monday > Max {
+			return nil, false
+		}
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []RecurrenceDay{{Weekday: r.Start.Weekday()}}
+		}
+		for _, rd := range days {
+			if d := monday + Value((rd.Weekday+6)%7); d <= Max {
+				res = append(res, d)
+			}
+		}
+	case Monthly:
+		y, m, _ := r.Start.Date()
+		months := y*12 + m - 1 + p*interval
+		y, m = months/12, months%12+1
+		if !IsValidYear(y) {
+			return nil, false
+		}
+		res = r.expand(y, m, m)
+	case Yearly:
+		y := r.Start.Year() + p*interval
+		if !IsValidYear(y) {
+			return nil, false
+		}
+		res = r.expand(y, 1, 12)
+	}
+	slices.Sort(res)
+	return slices.Compact(res), true
+}
+
+// expand returns the candidate dates for a monthly or yearly period that covers the months from first
+// to last in the specified year
+func (r Recurrence) expand(y, first, last int) []Value {
+	var res []Value
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for m := first; m <= last; m++ {
+			n := DaysInMonth(y, m)
+			for _, md := range r.ByMonthDay {
+				if md < 0 {
+					md += n + 1
+				}
+				if md >= 1 && md <= n {
+					res = append(res, Must(FromUnits(y, m, md)))
+				}
+			}
+		}
+		// BYDAY limits the days of the month
+		if len(r.ByDay) > 0 {
+			res = slices.DeleteFunc(res, func(d Value) bool {
+				return !r.matchesByDay(d, first, last)
+			})
+		}
+	case len(r.ByDay) > 0:
+		start := Must(FromUnits(y, first, 1))
+		end := Must(FromUnits(y, last, DaysInMonth(y, last)))
+		for _, rd := range r.ByDay {
+			switch {
+			case rd.N > 0:
+				d := start + Value((rd.Weekday-start.Weekday()+7)%7) + Value(7*(rd.N-1))
+				if d <= end {
+					res = append(res, d)
+				}
+			case rd.N < 0:
+				d := end - Value((end.Weekday()-rd.Weekday+7)%7) + Value(7*(rd.N+1))
+				if d >= start {
+					res = append(res, d)
+				}
+			default:
+				for d := start + Value((rd.Weekday-start.Weekday()+7)%7); d <= end; d += 7 {
+					res = append(res, d)
+				}
+			}
+		}
+	default:
+		// the same day as the start date, which is skipped in months or years that do not have it
+		_, sm, sd := r.Start.Date()
+		if first != last {
+			first, last = sm, sm
+		}
+		if d, err := FromUnits(y, first, sd); err == nil {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+// matchesWeekday returns true if BYDAY is empty or contains the weekday of the date, ignoring
+// occurrence numbers
+func (r Recurrence) matchesWeekday(d Value) bool {
+	return len(r.ByDay) == 0 || slices.ContainsFunc(r.ByDay, func(rd RecurrenceDay) bool {
+		return rd.Weekday == d.Weekday()
+	})
+}
+
+// matchesMonthDay returns true if BYMONTHDAY is empty or contains the day of the month of the date
+func (r Recurrence) matchesMonthDay(d Value) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	y, m, dd := d.Date()
+	n := DaysInMonth(y, m)
+	return slices.ContainsFunc(r.ByMonthDay, func(md int) bool {
+		return md == dd || md == dd-n-1
+	})
+}
+
+// matchesByDay returns true if the date matches an entry of BYDAY, where occurrence numbers count
+// within the months from first to last
+func (r Recurrence) matchesByDay(d Value, first, last int) bool {
+	y := d.Year()
+	start := Must(FromUnits(y, first, 1))
+	end := Must(FromUnits(y, last, DaysInMonth(y, last)))
+	return slices.ContainsFunc(r.ByDay, func(rd RecurrenceDay) bool {
+		switch {
+		case rd.Weekday != d.Weekday():
+			return false
+		case rd.N > 0:
+			return int(d-start)/7+1 == rd.N
+		case rd.N < 0:
+			return -(int(end-d)/7 + 1) == rd.N
+		}
+		return true
+	})
+}
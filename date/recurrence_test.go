@@ -0,0 +1,120 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	cases := []struct {
+		name     string
+		start    string
+		rule     string
+		expected []string
+	}{
+		{"daily", "2019-07-01", "FREQ=DAILY;COUNT=3", []string{"2019-07-01", "2019-07-02", "2019-07-03"}},
+		{"daily interval", "2019-07-01", "FREQ=DAILY;INTERVAL=10;COUNT=3", []string{"2019-07-01", "2019-07-11", "2019-07-21"}},
+		{"daily by day", "2019-07-01", "FREQ=DAILY;BYDAY=SA,SU;COUNT=3", []string{"2019-07-06", "2019-07-07", "2019-07-13"}},
+		{"weekly", "2019-07-01", "FREQ=WEEKLY;COUNT=3", []string{"2019-07-01", "2019-07-08", "2019-07-15"}},
+		{"weekly by day", "2019-07-03", "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=4", []string{"2019-07-04", "2019-07-16", "2019-07-18", "2019-07-30"}},
+		{"weekly until", "2019-07-01", "RRULE:FREQ=WEEKLY;UNTIL=20190722T000000Z;WKST=MO", []string{"2019-07-01", "2019-07-08", "2019-07-15", "2019-07-22"}},
+		{"monthly", "2019-01-31", "FREQ=MONTHLY;COUNT=3", []string{"2019-01-31", "2019-03-31", "2019-05-31"}},
+		{"monthly last weekday", "2019-07-01", "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", []string{"2019-07-26", "2019-08-30", "2019-09-27"}},
+		{"monthly by month day", "2019-07-01", "FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=4", []string{"2019-07-01", "2019-07-31", "2019-08-01", "2019-08-31"}},
+		{"friday the 13th", "2019-01-01", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=3", []string{"2019-09-13", "2019-12-13", "2020-03-13"}},
+		{"yearly leap day", "2020-02-29", "FREQ=YEARLY;COUNT=3", []string{"2020-02-29", "2024-02-29", "2028-02-29"}},
+		{"yearly nth weekday", "2019-01-01", "FREQ=YEARLY;BYDAY=20MO;COUNT=2", []string{"2019-05-20", "2020-05-18"}},
+		{"yearly last weekday", "2019-01-01", "FREQ=YEARLY;BYDAY=-1SU;COUNT=1", []string{"2019-12-29"}},
+		{"yearly by month day", "2019-01-01", "FREQ=YEARLY;BYMONTHDAY=-1;UNTIL=20190430", []string{"2019-01-31", "2019-02-28", "2019-03-31", "2019-04-30"}},
+		{"ends at max", "9999-12-30", "FREQ=DAILY", []string{"9999-12-30", "9999-12-31"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			r, err := ParseRecurrence(Must(Parse("2006-01-02", tc.start)), tc.rule)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			var got []string
+			for d := range r.Occurrences() {
+				got = append(got, d.String())
+			}
+			if !slices.Equal(got, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRecurrenceBetween(t *testing.T) {
+	r := Recurrence{Start: day(1), Freq: Weekly, ByDay: []RecurrenceDay{{Weekday: time.Monday}, {Weekday: time.Friday}}}
+	expected := []Value{day(12), day(15), day(19)}
+	if got := r.Between(day(10), day(19)); !slices.Equal(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := slices.Collect((Recurrence{}).Occurrences()); got != nil {
+		t.Errorf("Expected no occurrences for an invalid recurrence, got %v", got)
+	}
+}
+
+func TestRecurrenceString(t *testing.T) {
+	cases := []struct {
+		rule     string
+		expected string
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"RRULE:freq=weekly;interval=2;byday=mo,we;count=10", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10"},
+		{"FREQ=MONTHLY;INTERVAL=1;BYDAY=1MO,-1FR;UNTIL=20191231T235959Z", "FREQ=MONTHLY;BYDAY=1MO,-1FR;UNTIL=20191231"},
+		{"FREQ=YEARLY;BYMONTHDAY=1,-1", "FREQ=YEARLY;BYMONTHDAY=1,-1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.rule, func(tt *testing.T) {
+			r, err := ParseRecurrence(day(1), tc.rule)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got := r.String(); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseRecurrenceErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		start Value
+		rule  string
+	}{
+		{"nil start", Nil, "FREQ=DAILY"},
+		{"empty", day(1), ""},
+		{"missing frequency", day(1), "COUNT=3"},
+		{"invalid frequency", day(1), "FREQ=HOURLY"},
+		{"malformed part", day(1), "FREQ=DAILY;COUNT"},
+		{"invalid interval", day(1), "FREQ=DAILY;INTERVAL=-1"},
+		{"invalid count", day(1), "FREQ=DAILY;COUNT=x"},
+		{"count and until", day(1), "FREQ=DAILY;COUNT=3;UNTIL=20191231"},
+		{"invalid until", day(1), "FREQ=DAILY;UNTIL=2019-12-31"},
+		{"invalid weekday", day(1), "FREQ=WEEKLY;BYDAY=XX"},
+		{"zero occurrence", day(1), "FREQ=MONTHLY;BYDAY=0MO"},
+		{"occurrence with weekly", day(1), "FREQ=WEEKLY;BYDAY=1MO"},
+		{"occurrence out of range", day(1), "FREQ=MONTHLY;BYDAY=6MO"},
+		{"month day with weekly", day(1), "FREQ=WEEKLY;BYMONTHDAY=1"},
+		{"invalid month day", day(1), "FREQ=MONTHLY;BYMONTHDAY=32"},
+		{"unsupported part", day(1), "FREQ=YEARLY;BYMONTH=7"},
+		{"unsupported week start", day(1), "FREQ=WEEKLY;WKST=SU"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if _, err := ParseRecurrence(tc.start, tc.rule); errors.Cause(err) != ErrInvalidRecurrence {
+				tt.Errorf("Expected %v, got %v", ErrInvalidRecurrence, err)
+			}
+		})
+	}
+}
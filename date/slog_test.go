@@ -0,0 +1,53 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected interface{}
+	}{
+		{"date", Must(FromUnits(2024, 7, 14)), "2024-07-14"},
+		{"nil", Nil, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			lv := tc.v.LogValue()
+			if tc.expected == nil {
+				if lv.Kind() != slog.KindAny || lv.Any() != nil {
+					tt.Errorf("Expected null, got %v", lv)
+				}
+				return
+			}
+			if lv.Kind() != slog.KindString || lv.String() != tc.expected {
+				tt.Errorf("Expected %q, got %v", tc.expected, lv)
+			}
+		})
+	}
+}
+
+func TestLogValueJSONHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("shipped", "on", Must(FromUnits(2024, 7, 14)), "delivered", Nil)
+	expected := `{"level":"INFO","msg":"shipped","on":"2024-07-14","delivered":null}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, buf.String())
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// HolidayChecker is implemented by types, such as holiday calendars, that can report whether or not a
+// specific date is a holiday.
+type HolidayChecker interface {
+	IsHoliday(d Value) bool
+}
+
+// Stats contains aggregate counts over a collection of dates
+type Stats struct {
+	// Total is the number of valid dates
+	Total int
+	// Invalid is the number of dates that were Nil or otherwise invalid, which are excluded from all
+	// other counts
+	Invalid int
+	// BusinessDays is the number of dates that fall on Monday through Friday and are not holidays
+	BusinessDays int
+	// Weekends is the number of dates that fall on Saturday or Sunday
+	Weekends int
+	// Holidays is the number of dates that are holidays, regardless of the day of the week
+	Holidays int
+	// ByMonth contains the number of dates in each month.  Index 0 is not used so that month values
+	// can start at 1.
+	ByMonth [13]int
+	// ByWeekday contains the number of dates on each day of the week, indexed by time.Weekday
+	ByWeekday [7]int
+}
+
+// Summarize computes aggregate counts over the specified dates.  Duplicate dates are counted each
+// time they occur.
+//
+// If holidays is nil, no dates are considered to be holidays.
+func Summarize(dates []Value, holidays HolidayChecker) Stats {
+	var s Stats
+	for _, d := range dates {
+		if !d.IsValid() {
+			s.Invalid++
+			continue
+		}
+		_, m, _ := ToUnits(d)
+		s.add(d, m, d.Weekday(), holidays)
+	}
+	return s
+}
+
+// SummarizeBetween computes aggregate counts over every date between from and to, inclusive.
+//
+// If holidays is nil, no dates are considered to be holidays.  If either date is invalid or from is
+// after to, an error is returned.
+func SummarizeBetween(from, to Value, holidays HolidayChecker) (Stats, error) {
+	if !from.IsValid() || !to.IsValid() {
+		return Stats{}, errors.Errorf("cannot summarize between invalid dates: %v and %v", from, to)
+	}
+	if from.After(to) {
+		return Stats{}, errors.Errorf("the start date, %v, is after the end date, %v", from, to)
+	}
+	var s Stats
+	// walk a month at a time so that the year/month decomposition happens once per month
+	for start := from; !start.After(to); {
+		end := start.EndOfMonth()
+		if end.After(to) {
+			end = to
+		}
+		_, m, _ := ToUnits(start)
+		wd := start.Weekday()
+		for d := start; d <= end; d++ {
+			s.add(d, m, wd, holidays)
+			wd = (wd + 1) % 7
+		}
+		start = end + 1
+	}
+	return s, nil
+}
+
+// add includes a single valid date in the counts
+func (s *Stats) add(d Value, m int, wd time.Weekday, holidays HolidayChecker) {
+	s.Total++
+	s.ByMonth[m]++
+	s.ByWeekday[wd]++
+	isHoliday := holidays != nil && holidays.IsHoliday(d)
+	if isHoliday {
+		s.Holidays++
+	}
+	switch {
+	case wd == time.Saturday || wd == time.Sunday:
+		s.Weekends++
+	case !isHoliday:
+		s.BusinessDays++
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+// holidaySet is a trivial HolidayChecker for testing
+type holidaySet map[Value]bool
+
+func (h holidaySet) IsHoliday(d Value) bool {
+	return h[d]
+}
+
+func TestSummarize(t *testing.T) {
+	dates := []Value{
+		Must(FromUnits(2024, 7, 4)), // Thursday, holiday
+		Must(FromUnits(2024, 7, 5)), // Friday
+		Must(FromUnits(2024, 7, 6)), // Saturday
+		Must(FromUnits(2024, 7, 6)), // Saturday, again
+		Must(FromUnits(2024, 8, 1)), // Thursday
+		Nil,
+	}
+	holidays := holidaySet{Must(FromUnits(2024, 7, 4)): true}
+
+	s := Summarize(dates, holidays)
+	if s.Total != 5 || s.Invalid != 1 {
+		t.Errorf("Expected 5 valid and 1 invalid, got %d and %d", s.Total, s.Invalid)
+	}
+	if s.BusinessDays != 2 || s.Weekends != 2 || s.Holidays != 1 {
+		t.Errorf("Unexpected counts: %+v", s)
+	}
+	if s.ByMonth[7] != 4 || s.ByMonth[8] != 1 {
+		t.Errorf("Unexpected month histogram: %v", s.ByMonth)
+	}
+	if s.ByWeekday[time.Thursday] != 2 || s.ByWeekday[time.Saturday] != 2 || s.ByWeekday[time.Friday] != 1 {
+		t.Errorf("Unexpected weekday histogram: %v", s.ByWeekday)
+	}
+
+	if s := Summarize(dates, nil); s.Holidays != 0 || s.BusinessDays != 3 {
+		t.Errorf("Expected no holidays without a checker, got %+v", s)
+	}
+}
+
+func TestSummarizeBetween(t *testing.T) {
+	holidays := holidaySet{
+		Must(FromUnits(2024, 1, 1)):   true, // Monday
+		Must(FromUnits(2024, 12, 25)): true, // Wednesday
+	}
+	s, err := SummarizeBetween(Must(FromUnits(2024, 1, 1)), Must(FromUnits(2024, 12, 31)), holidays)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s.Total != 366 || s.Weekends != 104 || s.Holidays != 2 || s.BusinessDays != 260 {
+		t.Errorf("Unexpected counts: %+v", s)
+	}
+	if s.ByMonth[2] != 29 || s.ByMonth[12] != 31 {
+		t.Errorf("Unexpected month histogram: %v", s.ByMonth)
+	}
+	if s.ByWeekday[time.Monday] != 53 || s.ByWeekday[time.Tuesday] != 53 || s.ByWeekday[time.Wednesday] != 52 {
+		t.Errorf("Unexpected weekday histogram: %v", s.ByWeekday)
+	}
+
+	if _, err := SummarizeBetween(Max, Min, nil); err == nil {
+		t.Errorf("Expected an error for reversed dates")
+	}
+	if _, err := SummarizeBetween(Nil, Max, nil); err == nil {
+		t.Errorf("Expected an error for an invalid date")
+	}
+	if s, err := SummarizeBetween(Max, Max, nil); err != nil || s.Total != 1 {
+		t.Errorf("Expected a single day at the end of the supported range, got %+v (err = %v)", s, err)
+	}
+}
@@ -5,7 +5,9 @@
 package date
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
@@ -31,8 +33,53 @@ var (
 var (
 	// ErrInvalidDateUnit is returned when an out-of-range date unit value is used
 	ErrInvalidDateUnit = errors.Errorf("One or more of the specified date units were invalid")
+	// ErrInvalidOperand is returned when an operation on two dates is passed date.Nil or another invalid
+	// date
+	ErrInvalidOperand = errors.Errorf("One or more of the specified dates were Nil or invalid")
 )
 
+// RangeError is returned by FromUnits() and the functions that call it when a date unit is out of
+// range.  It identifies the invalid unit and its valid range.
+//
+// Both errors.Is(err, ErrInvalidDateUnit) and errors.Cause(err) == ErrInvalidDateUnit are true for a
+// RangeError, and errors.As() can be used to retrieve the details.
+type RangeError struct {
+	// Field is the name of the invalid unit: "year", "month" or "day", or "week", "weekday" or "n"
+	// for the functions that accept those units
+	Field string
+	// Value is the invalid value
+	Value int
+	// Min and Max are the inclusive bounds of the valid range
+	Min, Max int
+}
+
+// Error implements the error interface for date.RangeError values
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("%s %d is outside the valid range [%d, %d]: %v", e.Field, e.Value, e.Min, e.Max, ErrInvalidDateUnit)
+}
+
+// Cause returns ErrInvalidDateUnit for compatibility with errors.Cause()
+func (e *RangeError) Cause() error {
+	return ErrInvalidDateUnit
+}
+
+// Unwrap returns ErrInvalidDateUnit for compatibility with errors.Is()
+func (e *RangeError) Unwrap() error {
+	return ErrInvalidDateUnit
+}
+
+// unitError returns a RangeError for the first invalid unit of the specified date
+func unitError(y, m, d int) error {
+	switch {
+	case !IsValidYear(y):
+		return &RangeError{Field: "year", Value: y, Min: 1753, Max: 9999}
+	case !IsValidMonth(m):
+		return &RangeError{Field: "month", Value: m, Min: 1, Max: 12}
+	default:
+		return &RangeError{Field: "day", Value: d, Min: 1, Max: DaysInMonth(y, m)}
+	}
+}
+
 var (
 	// the number of days in each month in non-leap years
 	// . index 0 is not used so that months values can start at 1
@@ -57,7 +104,7 @@ func FromTime(t time.Time) (Value, error) {
 func FromUnits(y, m, d int) (Value, error) {
 	// validate unit values
 	if !IsValidUnits(y, m, d) {
-		return Nil, ErrInvalidDateUnit
+		return Nil, unitError(y, m, d)
 	}
 
 	return Value(gregorianToJulian(y, m, d)), nil
@@ -85,13 +132,24 @@ func ToUnits(d Value) (year, month, day int) {
 	return julianToGregorian(int64(d))
 }
 
+// Date returns the year, month and day components of the date with a single conversion, so it
+// should be preferred over calling Year(), Month() and Day() separately when more than one
+// component is needed.  The results are the same as ToUnits().
+func (dt Value) Date() (year, month, day int) {
+	return ToUnits(dt)
+}
+
 // Year returns the year (between 1753 and 9999) or 0 if this is a nil date
+//
+// The year is extracted directly, without computing the month and day.
 func (dt Value) Year() int {
 	if dt == Nil {
 		return NilUnit
 	}
-	y, _, _ := ToUnits(dt)
-	return y
+	if !dt.IsValid() {
+		return -1
+	}
+	return julianToYear(int64(dt))
 }
 
 // Month returns the month (between 1 and 12) or 0 if this is a nil date
@@ -164,12 +222,42 @@ func (v Value) After(v2 Value) bool {
 	return int64(v) > int64(v2)
 }
 
+// Compare returns -1 if a is before b, 0 if they are the same date and +1 if a is after b, so dates
+// can be passed to slices.SortFunc(), slices.BinarySearchFunc() and similar functions.
+//
+// Unlike Before(), After() and Equal(), Compare defines a total order that includes date.Nil, which
+// sorts before every valid date and is equal to itself.
+func Compare(a, b Value) int {
+	return cmp.Compare(int64(a), int64(b))
+}
+
+// Compare returns -1 if the receiver is before v2, 0 if they are the same date and +1 if the receiver
+// is after v2.  See the package-level Compare() for the ordering of date.Nil.
+func (v Value) Compare(v2 Value) int {
+	return Compare(v, v2)
+}
+
 // String implements fmt.Stringer for date.Value instances.
 //
 // The returns string is formatted as "YYYY-MM-DD".
 func (v Value) String() string {
-	y, m, d := ToUnits(v)
-	return fmt.Sprintf("%04d-%02d-%02d", y, m, d)
+	return string(v.appendText(make([]byte, 0, textLen)))
+}
+
+// textLen is the length of the "YYYY-MM-DD" text representation of a valid date
+const textLen = 10
+
+// appendText appends the text representation of v, as returned by String(), to b
+func (v Value) appendText(b []byte) []byte {
+	if !v.IsValid() {
+		// keep the historical formatting of the negative sentinel unit values
+		y, m, d := ToUnits(v)
+		return fmt.Appendf(b, "%04d-%02d-%02d", y, m, d)
+	}
+	y, m, d := v.Date()
+	b = append(b, byte('0'+y/1000), byte('0'+y/100%10), byte('0'+y/10%10), byte('0'+y%10), '-')
+	b = append(b, byte('0'+m/10), byte('0'+m%10), '-')
+	return append(b, byte('0'+d/10), byte('0'+d%10))
 }
 
 // Format returns a textual representation of the date value according to the same rules as
@@ -295,6 +383,40 @@ func (d Value) EndOfMonth() Value {
 	return v
 }
 
+// StartOfWeek returns a new date.Value that represents the first day of the week that contains the
+// date represented by d, where weeks start on the specified day.  Pass time.Sunday for the convention
+// used in the US, time.Monday for ISO 8601 weeks or time.Saturday for much of the Middle East.
+//
+// If the receiver is date.Nil, first is not between time.Sunday and time.Saturday or the start of the
+// week is before date.Min, this method returns date.Nil
+func (d Value) StartOfWeek(first time.Weekday) Value {
+	if !d.IsValid() || first < time.Sunday || first > time.Saturday {
+		return Nil
+	}
+	v := d - Value((d.Weekday()-first+7)%7)
+	if !v.IsValid() {
+		return Nil
+	}
+	return v
+}
+
+// EndOfWeek returns a new date.Value that represents the last day of the week that contains the date
+// represented by d, where weeks start on the specified day.  For example, weeks that start on Monday
+// end on Sunday.
+//
+// If the receiver is date.Nil, first is not between time.Sunday and time.Saturday or the end of the
+// week is after date.Max, this method returns date.Nil
+func (d Value) EndOfWeek(first time.Weekday) Value {
+	if !d.IsValid() || first < time.Sunday || first > time.Saturday {
+		return Nil
+	}
+	v := d + 6 - Value((d.Weekday()-first+7)%7)
+	if !v.IsValid() {
+		return Nil
+	}
+	return v
+}
+
 // NextMonth returns a new date.Value that represents the same day on a subsequent
 // month.
 //
@@ -351,7 +473,7 @@ func (d Value) PreviousWeekday(w time.Weekday) (Value, error) {
 	if !d.IsValid() {
 		return Nil, nil
 	}
-	delta := int(w - d.ToTime().Weekday())
+	delta := int(w - d.Weekday())
 	if delta >= 0 {
 		delta -= 7
 	}
@@ -365,7 +487,156 @@ func (d Value) Weekday() time.Weekday {
 	if !d.IsValid() {
 		return -1
 	}
-	return d.ToTime().Weekday()
+	// Julian day 0 was a Monday
+	return time.Weekday((d + 1) % 7)
+}
+
+// IsWeekend returns true if the date falls on one of the specified weekend days, or on Saturday or
+// Sunday if no days are specified.  For example, pass time.Friday and time.Saturday for regions where
+// the weekend is Friday and Saturday.
+//
+// If the receiver is date.Nil, this method returns false
+func (d Value) IsWeekend(weekend ...time.Weekday) bool {
+	if len(weekend) == 0 {
+		return d.IsOneOf(time.Saturday, time.Sunday)
+	}
+	return d.IsOneOf(weekend...)
+}
+
+// IsOneOf returns true if the date falls on any of the specified days of the week, such as to filter
+// the dates of a schedule to Mondays, Wednesdays and Fridays.
+//
+// If the receiver is date.Nil, this method returns false
+func (d Value) IsOneOf(days ...time.Weekday) bool {
+	if !d.IsValid() {
+		return false
+	}
+	return slices.Contains(days, d.Weekday())
+}
+
+// ISOWeek returns the ISO 8601 week-numbering year and week number, 1 to 53, in which the date
+// occurs.  ISO weeks start on Monday and week 1 is the week that contains the first Thursday of the
+// year, so January 1 to 3 can belong to the last week of the previous year and December 29 to 31 to
+// week 1 of the next one.
+//
+// If the receiver is date.Nil, this method returns -1, -1
+func (d Value) ISOWeek() (year, week int) {
+	if !d.IsValid() {
+		return -1, -1
+	}
+	return d.ToTime().ISOWeek()
+}
+
+// ISOWeekYear returns the ISO 8601 week-numbering year in which the date occurs.  Use it instead of
+// Year() alongside the week number returned by ISOWeek(), since the two years differ for dates near
+// January 1.
+//
+// If the receiver is date.Nil, this method returns -1
+func (d Value) ISOWeekYear() int {
+	year, _ := d.ISOWeek()
+	return year
+}
+
+// FromISOWeek returns the date of the specified weekday in an ISO 8601 week, the inverse of
+// ISOWeek().  For example, FromISOWeek(2020, 53, time.Friday) returns 2021-01-01.
+//
+// If the year is not supported, the week is not between 1 and ISOWeeksInYear(year) or the weekday is
+// not between time.Sunday and time.Saturday, a *RangeError is returned.
+func FromISOWeek(year, week int, wd time.Weekday) (Value, error) {
+	if !IsValidYear(year) {
+		return Nil, &RangeError{Field: "year", Value: year, Min: 1753, Max: 9999}
+	}
+	if n := ISOWeeksInYear(year); week < 1 || week > n {
+		return Nil, &RangeError{Field: "week", Value: week, Min: 1, Max: n}
+	}
+	if wd < time.Sunday || wd > time.Saturday {
+		return Nil, &RangeError{Field: "weekday", Value: int(wd), Min: int(time.Sunday), Max: int(time.Saturday)}
+	}
+	// week 1 is the week that contains January 4, and ISO weeks start on Monday
+	jan4 := Must(FromUnits(year, 1, 4))
+	return jan4.AddDays((week-1)*7 - (int(jan4.Weekday())+6)%7 + (int(wd)+6)%7)
+}
+
+// ISOWeeksInYear returns the number of weeks, 52 or 53, in the specified ISO 8601 week-numbering
+// year, or -1 if the year is not supported
+func ISOWeeksInYear(year int) int {
+	if !IsValidYear(year) {
+		return -1
+	}
+	// December 28 is always in the last week of its ISO year
+	_, week := Must(FromUnits(year, 12, 28)).ISOWeek()
+	return week
+}
+
+// WeekdayOccurrence returns which occurrence of its weekday the date is within its month, from 1 to
+// 5.  For example, 2019-07-19 is the 3rd Friday of July 2019, so this method returns 3.
+//
+// If the receiver is date.Nil, this method returns -1
+func (d Value) WeekdayOccurrence() int {
+	if !d.IsValid() {
+		return -1
+	}
+	return (d.Day()-1)/7 + 1
+}
+
+// IsLastWeekdayOfMonth returns true if the date is the last occurrence of its weekday within its
+// month, such as the last Monday in May.  The last occurrence can be either the 4th or 5th, so use
+// this method rather than comparing WeekdayOccurrence() to a constant.
+//
+// If the receiver is date.Nil, this method returns false
+func (d Value) IsLastWeekdayOfMonth() bool {
+	if !d.IsValid() {
+		return false
+	}
+	y, m, dd := d.Date()
+	return dd+7 > DaysInMonth(y, m)
+}
+
+// NthWeekday returns the date of the nth occurrence of the specified weekday in a month, such as the
+// 2nd Tuesday of March 2025.  Negative values of n count from the end of the month, so -1 is the last
+// occurrence.
+//
+// If the year, month or weekday is invalid, or the month does not have an nth occurrence of the
+// weekday, a *RangeError is returned.  For n, the range is the number of occurrences in the month,
+// 4 or 5, in either direction.  There is no zeroth occurrence, so n == 0 returns ErrInvalidDateUnit
+// with its own message rather than a *RangeError.
+func NthWeekday(y, m int, wd time.Weekday, n int) (Value, error) {
+	if n == 0 {
+		return Nil, errors.Wrapf(ErrInvalidDateUnit, "n must not be zero, use 1 for the first occurrence or -1 for the last")
+	}
+	first, err := FromUnits(y, m, 1)
+	if err != nil {
+		return Nil, err
+	}
+	if wd < time.Sunday || wd > time.Saturday {
+		return Nil, &RangeError{Field: "weekday", Value: int(wd), Min: int(time.Sunday), Max: int(time.Saturday)}
+	}
+	first += Value((wd - first.Weekday() + 7) % 7)
+	count := (DaysInMonth(y, m)-first.Day())/7 + 1
+	switch {
+	case n > 0 && n <= count:
+		return first + Value(7*(n-1)), nil
+	case n < 0 && -n <= count:
+		return first + Value(7*(count+n)), nil
+	}
+	return Nil, &RangeError{Field: "n", Value: n, Min: -count, Max: count}
+}
+
+// LastWeekdayOfMonth returns a new date.Value that represents the last occurrence of the specified
+// weekday in the month of the date represented by d, such as the last Friday of the month.
+//
+// If the receiver is date.Nil or the weekday is not between time.Sunday and time.Saturday, this method
+// returns date.Nil
+func (d Value) LastWeekdayOfMonth(wd time.Weekday) Value {
+	if !d.IsValid() {
+		return Nil
+	}
+	y, m, _ := d.Date()
+	v, err := NthWeekday(y, m, wd, -1)
+	if err != nil {
+		return Nil
+	}
+	return v
 }
 
 // AddDays adds the specified number of days to the current date.
@@ -382,6 +653,93 @@ func (d Value) AddDays(n int) (Value, error) {
 	return Value(v), nil
 }
 
+// MonthOption configures how AddMonths() and AddYears() handle a day of the month that does not exist
+// in the target month
+type MonthOption func(*monthOptions)
+
+// monthOptions holds the settings applied by MonthOption values
+type monthOptions struct {
+	rollover bool
+}
+
+// WithRollover returns a MonthOption that moves days past the end of the target month into the
+// following month, so that January 31 plus one month is March 3 (or March 2 in a leap year), matching
+// the behavior of time.Time.AddDate().  By default the day is clamped to the end of the month.
+func WithRollover() MonthOption {
+	return func(o *monthOptions) {
+		o.rollover = true
+	}
+}
+
+// AddMonths adds the specified number of months, which may be negative, to the current date.
+//
+// If the day of the month does not exist in the target month, the result is clamped to the last day
+// of that month, so that January 31 plus one month is February 28 or 29.  Pass WithRollover() to move
+// the extra days into the following month instead.
+//
+// If the receiver is date.Nil, this method returns date.Nil and no error
+func (d Value) AddMonths(n int, opts ...MonthOption) (Value, error) {
+	if !d.IsValid() {
+		return Nil, nil
+	}
+	var o monthOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	y, m, day := d.Date()
+	months := int64(y)*12 + int64(m-1) + int64(n)
+	if months < 1753*12 || months >= 10000*12 {
+		return Nil, errors.Errorf("adding %d months would generate an out-of-range result", n)
+	}
+	ny, nm := int(months/12), int(months%12)+1
+	last := DaysInMonth(ny, nm)
+	if day <= last {
+		return FromUnits(ny, nm, day)
+	}
+	res := Must(FromUnits(ny, nm, last))
+	if !o.rollover {
+		return res, nil
+	}
+	return res.AddDays(day - last)
+}
+
+// AddYears adds the specified number of years, which may be negative, to the current date.  February
+// 29 is clamped to February 28 in non-leap years unless WithRollover() is passed, which moves it to
+// March 1.
+//
+// If the receiver is date.Nil, this method returns date.Nil and no error
+func (d Value) AddYears(n int, opts ...MonthOption) (Value, error) {
+	if !d.IsValid() {
+		return Nil, nil
+	}
+	if n >= -10000 && n <= 10000 {
+		if v, err := d.AddMonths(n*12, opts...); err == nil {
+			return v, nil
+		}
+	}
+	return Nil, errors.Errorf("adding %d years would generate an out-of-range result", n)
+}
+
+// DaysBetween returns the signed number of days from a to b, which is negative if b is before a.  For
+// example, the result is 7 for 2019-07-14 and 2019-07-21, and -7 with the dates reversed.
+//
+// If either date is date.Nil or is otherwise invalid, ErrInvalidOperand is returned
+func DaysBetween(a, b Value) (int, error) {
+	if !a.IsValid() || !b.IsValid() {
+		return 0, errors.Wrapf(ErrInvalidOperand, "%d and %d", int64(a), int64(b))
+	}
+	return int(b - a), nil
+}
+
+// Sub returns the signed number of days from v2 to the receiver, which is negative if the receiver is
+// before v2.  It is the date equivalent of time.Time.Sub(), so d.Sub(v2) == n when v2.AddDays(n)
+// returns d.
+//
+// If either date is date.Nil or is otherwise invalid, ErrInvalidOperand is returned
+func (d Value) Sub(v2 Value) (int, error) {
+	return DaysBetween(v2, d)
+}
+
 // Add adds the specified duration to the current date.
 //
 // Because date.Value has no concept of time, any "partial" day information will be
@@ -459,3 +817,21 @@ func julianToGregorian(v int64) (y, m, d int) {
 	}
 	return y, m, d
 }
+
+// julianToYear returns only the Gregorian year for the specified Julian day number.  It follows the
+// same steps as julianToGregorian() but stops once the day of the March-based year is known, since
+// that is enough to decide whether the date falls in January or February of the following year.
+func julianToYear(v int64) int {
+	jt := uint64(v - 1721119)
+	c := ((jt << 2) - 1) / 146097
+	jt = (jt << 2) - 1 - (146097 * c)
+	ud := jt >> 2
+	yy := ((ud << 2) + 3) / 1461
+	ud = (((ud << 2) + 3 - (1461 * yy)) + 4) >> 2
+	y := int(100*c + yy)
+	// days 307 and later of a year that starts on March 1st are in January and February
+	if ud >= 307 {
+		y++
+	}
+	return y
+}
@@ -5,9 +5,14 @@
 package date
 
 import (
+	stderrors "errors"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 func TestFromUnits(tt *testing.T) {
@@ -344,4 +349,520 @@ func TestEquality(tt *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestDateAndYearMatchToUnits(t *testing.T) {
+	for v := Min; v <= Max; v++ {
+		y, m, d := ToUnits(v)
+		gy, gm, gd := v.Date()
+		if gy != y || gm != m || gd != d {
+			t.Fatalf("%v: Date() returned %d-%d-%d, expected %d-%d-%d", v, gy, gm, gd, y, m, d)
+		}
+		if got := v.Year(); got != y {
+			t.Fatalf("%v: Year() returned %d, expected %d", v, got, y)
+		}
+	}
+	for _, v := range []Value{Nil, Min - 1, Max + 1} {
+		y, m, d := ToUnits(v)
+		gy, gm, gd := v.Date()
+		if gy != y || gm != m || gd != d {
+			t.Errorf("%d: Date() returned %d-%d-%d, expected %d-%d-%d", int64(v), gy, gm, gd, y, m, d)
+		}
+		if got := v.Year(); got != y {
+			t.Errorf("%d: Year() returned %d, expected %d", int64(v), got, y)
+		}
+	}
+}
+
+var (
+	benchSink   int
+	benchString string
+)
+
+func BenchmarkYearMonthDay(b *testing.B) {
+	v := Must(FromUnits(2019, 7, 14))
+	for i := 0; i < b.N; i++ {
+		benchSink = v.Year() + v.Month() + v.Day()
+	}
+}
+
+func BenchmarkDate(b *testing.B) {
+	v := Must(FromUnits(2019, 7, 14))
+	for i := 0; i < b.N; i++ {
+		y, m, d := v.Date()
+		benchSink = y + m + d
+	}
+}
+
+func BenchmarkYear(b *testing.B) {
+	v := Must(FromUnits(2019, 7, 14))
+	for i := 0; i < b.N; i++ {
+		benchSink = v.Year()
+	}
+}
+
+func BenchmarkToUnitsYear(b *testing.B) {
+	v := Must(FromUnits(2019, 7, 14))
+	for i := 0; i < b.N; i++ {
+		y, _, _ := ToUnits(v)
+		benchSink = y
+	}
+}
+
+func TestStringMatchesSprintf(t *testing.T) {
+	for _, v := range []Value{Nil, Min, Max, Min - 1, Max + 1} {
+		y, m, d := ToUnits(v)
+		if expected := fmt.Sprintf("%04d-%02d-%02d", y, m, d); v.String() != expected {
+			t.Errorf("%d: expected %q, got %q", int64(v), expected, v.String())
+		}
+	}
+	for v := Min; v <= Max; v += 13 {
+		y, m, d := ToUnits(v)
+		if expected := fmt.Sprintf("%04d-%02d-%02d", y, m, d); v.String() != expected {
+			t.Fatalf("%d: expected %q, got %q", int64(v), expected, v.String())
+		}
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	v := Must(FromUnits(2019, 7, 14))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchString = v.String()
+	}
+}
+
+func TestRangeError(t *testing.T) {
+	cases := []struct {
+		name     string
+		y, m, d  int
+		expected RangeError
+	}{
+		{"year", 1700, 1, 1, RangeError{Field: "year", Value: 1700, Min: 1753, Max: 9999}},
+		{"month", 2019, 13, 1, RangeError{Field: "month", Value: 13, Min: 1, Max: 12}},
+		{"day", 2019, 2, 29, RangeError{Field: "day", Value: 29, Min: 1, Max: 28}},
+		{"leap day", 2020, 2, 30, RangeError{Field: "day", Value: 30, Min: 1, Max: 29}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := FromUnits(tc.y, tc.m, tc.d)
+			if errors.Cause(err) != ErrInvalidDateUnit || !stderrors.Is(err, ErrInvalidDateUnit) {
+				tt.Errorf("Expected the error to match %v, got %v", ErrInvalidDateUnit, err)
+			}
+			var re *RangeError
+			if !stderrors.As(err, &re) {
+				tt.Fatalf("Expected a *RangeError, got %T", err)
+			}
+			if *re != tc.expected {
+				tt.Errorf("Expected %+v, got %+v", tc.expected, *re)
+			}
+		})
+	}
+	_, err := FromUnits(2019, 13, 1)
+	if expected := "month 13 is outside the valid range [1, 12]: " + ErrInvalidDateUnit.Error(); err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestISOWeek(t *testing.T) {
+	cases := []struct {
+		name          string
+		y, m, d       int
+		isoYear, week int
+	}{
+		{"mid year", 2019, 7, 14, 2019, 28},
+		{"Jan 1 in previous year", 2021, 1, 1, 2020, 53},
+		{"Jan 3 in previous year", 2010, 1, 3, 2009, 53},
+		{"Jan 4 always in week 1", 2010, 1, 4, 2010, 1},
+		{"Dec 31 in next year", 2019, 12, 31, 2020, 1},
+		{"Dec 29 in next year", 2014, 12, 29, 2015, 1},
+		{"Dec 31 in week 53", 2020, 12, 31, 2020, 53},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Must(FromUnits(tc.y, tc.m, tc.d))
+			y, w := v.ISOWeek()
+			if y != tc.isoYear || w != tc.week {
+				tt.Errorf("Expected %d-W%02d, got %d-W%02d", tc.isoYear, tc.week, y, w)
+			}
+			if got := v.ISOWeekYear(); got != tc.isoYear {
+				tt.Errorf("Expected ISO week year %d, got %d", tc.isoYear, got)
+			}
+		})
+	}
+	if y, w := Nil.ISOWeek(); y != -1 || w != -1 || Nil.ISOWeekYear() != -1 {
+		t.Errorf("Expected -1, -1 for date.Nil, got %d, %d", y, w)
+	}
+}
+
+func TestWeekdayOccurrence(t *testing.T) {
+	cases := []struct {
+		name       string
+		y, m, d    int
+		occurrence int
+		last       bool
+	}{
+		{"1st", 2019, 7, 1, 1, false},
+		{"7th is still 1st", 2019, 7, 7, 1, false},
+		{"3rd Friday", 2019, 7, 19, 3, false},
+		{"4th and last", 2019, 7, 25, 4, true},
+		{"4th but not last", 2019, 7, 24, 4, false},
+		{"5th", 2019, 7, 31, 5, true},
+		{"Memorial Day", 2019, 5, 27, 4, true},
+		{"last day of February", 2019, 2, 28, 4, true},
+		{"leap day", 2020, 2, 29, 5, true},
+		{"not last in leap year", 2020, 2, 22, 4, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Must(FromUnits(tc.y, tc.m, tc.d))
+			if got := v.WeekdayOccurrence(); got != tc.occurrence {
+				tt.Errorf("Expected occurrence %d, got %d", tc.occurrence, got)
+			}
+			if got := v.IsLastWeekdayOfMonth(); got != tc.last {
+				tt.Errorf("Expected IsLastWeekdayOfMonth() to return %v, got %v", tc.last, got)
+			}
+		})
+	}
+	if Nil.WeekdayOccurrence() != -1 || Nil.IsLastWeekdayOfMonth() {
+		t.Errorf("Expected -1 and false for date.Nil")
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     Value
+		expected int
+		err      error
+	}{
+		{"same day", Must(FromUnits(2019, 7, 14)), Must(FromUnits(2019, 7, 14)), 0, nil},
+		{"one week", Must(FromUnits(2019, 7, 14)), Must(FromUnits(2019, 7, 21)), 7, nil},
+		{"backwards", Must(FromUnits(2019, 7, 21)), Must(FromUnits(2019, 7, 14)), -7, nil},
+		{"across leap day", Must(FromUnits(2020, 2, 28)), Must(FromUnits(2020, 3, 1)), 2, nil},
+		{"full range", Min, Max, int(Max - Min), nil},
+		{"nil first", Nil, Max, 0, ErrInvalidOperand},
+		{"nil second", Min, Nil, 0, ErrInvalidOperand},
+		{"invalid", Min, Max + 1, 0, ErrInvalidOperand},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := DaysBetween(tc.a, tc.b)
+			if errors.Cause(err) != tc.err || got != tc.expected {
+				tt.Errorf("Expected (%d, %v), got (%d, %v)", tc.expected, tc.err, got, err)
+			}
+			got, err = tc.b.Sub(tc.a)
+			if errors.Cause(err) != tc.err || got != tc.expected {
+				tt.Errorf("Expected Sub() to return (%d, %v), got (%d, %v)", tc.expected, tc.err, got, err)
+			}
+			if err == nil {
+				if back, _ := tc.a.AddDays(got); back != tc.b {
+					tt.Errorf("Expected AddDays(%d) to return %v, got %v", got, tc.b, back)
+				}
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	early, late := Must(FromUnits(1955, 11, 5)), Must(FromUnits(2015, 10, 21))
+	cases := []struct {
+		name     string
+		a, b     Value
+		expected int
+	}{
+		{"before", early, late, -1},
+		{"after", late, early, 1},
+		{"same", early, early, 0},
+		{"nil before min", Nil, Min, -1},
+		{"max after nil", Max, Nil, 1},
+		{"nil equals nil", Nil, Nil, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := Compare(tc.a, tc.b); got != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, got)
+			}
+			if got := tc.a.Compare(tc.b); got != tc.expected {
+				tt.Errorf("Expected the method to return %d, got %d", tc.expected, got)
+			}
+		})
+	}
+
+	dates := []Value{late, Nil, Max, early, Min}
+	slices.SortFunc(dates, Compare)
+	expected := []Value{Nil, Min, early, late, Max}
+	if !slices.Equal(dates, expected) {
+		t.Errorf("Expected %v, got %v", expected, dates)
+	}
+	if i, found := slices.BinarySearchFunc(dates, late, Compare); !found || i != 3 {
+		t.Errorf("Expected to find %v at index 3, got %d (found = %v)", late, i, found)
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        Value
+		n        int
+		clamped  Value
+		rollover Value
+	}{
+		{"same day exists", Must(FromUnits(2019, 7, 14)), 1, Must(FromUnits(2019, 8, 14)), Must(FromUnits(2019, 8, 14))},
+		{"zero", Must(FromUnits(2019, 1, 31)), 0, Must(FromUnits(2019, 1, 31)), Must(FromUnits(2019, 1, 31))},
+		{"end of January", Must(FromUnits(2019, 1, 31)), 1, Must(FromUnits(2019, 2, 28)), Must(FromUnits(2019, 3, 3))},
+		{"end of January in leap year", Must(FromUnits(2020, 1, 31)), 1, Must(FromUnits(2020, 2, 29)), Must(FromUnits(2020, 3, 2))},
+		{"day 31 into 30 day month", Must(FromUnits(2019, 3, 31)), 1, Must(FromUnits(2019, 4, 30)), Must(FromUnits(2019, 5, 1))},
+		{"backwards", Must(FromUnits(2019, 3, 31)), -1, Must(FromUnits(2019, 2, 28)), Must(FromUnits(2019, 3, 3))},
+		{"across years", Must(FromUnits(2019, 11, 30)), 3, Must(FromUnits(2020, 2, 29)), Must(FromUnits(2020, 3, 1))},
+		{"backwards across years", Must(FromUnits(2019, 1, 15)), -13, Must(FromUnits(2017, 12, 15)), Must(FromUnits(2017, 12, 15))},
+		{"to max", Must(FromUnits(9999, 11, 30)), 1, Must(FromUnits(9999, 12, 30)), Must(FromUnits(9999, 12, 30))},
+		{"nil", Nil, 1, Nil, Nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.d.AddMonths(tc.n)
+			if err != nil || got != tc.clamped {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.clamped, got, err)
+			}
+			got, err = tc.d.AddMonths(tc.n, WithRollover())
+			if err != nil || got != tc.rollover {
+				tt.Errorf("Expected %v with rollover, got %v (err = %v)", tc.rollover, got, err)
+			}
+		})
+	}
+	for _, n := range []int{1, 1 << 40} {
+		if _, err := Max.AddMonths(n); err == nil {
+			t.Errorf("Expected an error adding %d months to %v", n, Max)
+		}
+		if _, err := Min.AddMonths(-n); err == nil {
+			t.Errorf("Expected an error adding %d months to %v", -n, Min)
+		}
+	}
+}
+
+func TestAddYears(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        Value
+		n        int
+		clamped  Value
+		rollover Value
+	}{
+		{"ordinary day", Must(FromUnits(2019, 7, 14)), 5, Must(FromUnits(2024, 7, 14)), Must(FromUnits(2024, 7, 14))},
+		{"leap day", Must(FromUnits(2020, 2, 29)), 1, Must(FromUnits(2021, 2, 28)), Must(FromUnits(2021, 3, 1))},
+		{"leap day to leap year", Must(FromUnits(2020, 2, 29)), 4, Must(FromUnits(2024, 2, 29)), Must(FromUnits(2024, 2, 29))},
+		{"backwards", Must(FromUnits(2020, 2, 29)), -1, Must(FromUnits(2019, 2, 28)), Must(FromUnits(2019, 3, 1))},
+		{"nil", Nil, 1, Nil, Nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.d.AddYears(tc.n)
+			if err != nil || got != tc.clamped {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.clamped, got, err)
+			}
+			got, err = tc.d.AddYears(tc.n, WithRollover())
+			if err != nil || got != tc.rollover {
+				tt.Errorf("Expected %v with rollover, got %v (err = %v)", tc.rollover, got, err)
+			}
+		})
+	}
+	for _, n := range []int{1, 10001, 1 << 40} {
+		if _, err := Max.AddYears(n); err == nil {
+			t.Errorf("Expected an error adding %d years to %v", n, Max)
+		}
+	}
+}
+
+func TestFromISOWeek(t *testing.T) {
+	cases := []struct {
+		name     string
+		year     int
+		week     int
+		wd       time.Weekday
+		expected Value
+		field    string
+	}{
+		{"week 1 starts in previous year", 2020, 1, time.Monday, Must(FromUnits(2019, 12, 30)), ""},
+		{"mid year", 2019, 28, time.Sunday, Must(FromUnits(2019, 7, 14)), ""},
+		{"week 53 ends in next year", 2020, 53, time.Friday, Must(FromUnits(2021, 1, 1)), ""},
+		{"min", 1753, 1, time.Monday, Min, ""},
+		{"week 53 in a 52 week year", 2019, 53, time.Monday, Nil, "week"},
+		{"week 0", 2019, 0, time.Monday, Nil, "week"},
+		{"invalid weekday", 2019, 1, 7, Nil, "weekday"},
+		{"invalid year", 1752, 1, time.Monday, Nil, "year"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := FromISOWeek(tc.year, tc.week, tc.wd)
+			var re *RangeError
+			if (tc.field == "" && err != nil) || (tc.field != "" && (!stderrors.As(err, &re) || re.Field != tc.field)) {
+				tt.Fatalf("Expected a %q range error, got %v", tc.field, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			if err == nil {
+				if y, w := got.ISOWeek(); y != tc.year || w != tc.week || got.Weekday() != tc.wd {
+					tt.Errorf("Expected %v to be in week %d-W%02d, got %d-W%02d", got, tc.year, tc.week, y, w)
+				}
+			}
+		})
+	}
+}
+
+func TestISOWeeksInYear(t *testing.T) {
+	for year, expected := range map[int]int{2015: 53, 2019: 52, 2020: 53, 2021: 52, 2026: 53, 1752: -1} {
+		if got := ISOWeeksInYear(year); got != expected {
+			t.Errorf("Expected %d weeks in %d, got %d", expected, year, got)
+		}
+	}
+}
+
+func TestStartAndEndOfWeek(t *testing.T) {
+	// 2019-07-17 is a Wednesday
+	d := Must(FromUnits(2019, 7, 17))
+	cases := []struct {
+		name       string
+		d          Value
+		first      time.Weekday
+		start, end Value
+	}{
+		{"sunday", d, time.Sunday, Must(FromUnits(2019, 7, 14)), Must(FromUnits(2019, 7, 20))},
+		{"monday", d, time.Monday, Must(FromUnits(2019, 7, 15)), Must(FromUnits(2019, 7, 21))},
+		{"saturday", d, time.Saturday, Must(FromUnits(2019, 7, 13)), Must(FromUnits(2019, 7, 19))},
+		{"wednesday", d, time.Wednesday, d, Must(FromUnits(2019, 7, 23))},
+		{"thursday", d, time.Thursday, Must(FromUnits(2019, 7, 11)), d},
+		{"before min", Min, time.Sunday, Nil, Min + 5},
+		{"after max", Max, time.Monday, Max - 4, Nil},
+		{"nil", Nil, time.Sunday, Nil, Nil},
+		{"invalid weekday", d, time.Weekday(-1), Nil, Nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.d.StartOfWeek(tc.first); got != tc.start {
+				tt.Errorf("Expected start %v, got %v", tc.start, got)
+			}
+			if got := tc.d.EndOfWeek(tc.first); got != tc.end {
+				tt.Errorf("Expected end %v, got %v", tc.end, got)
+			}
+		})
+	}
+}
+
+func TestWeekdayMatchesTime(t *testing.T) {
+	for _, d := range []Value{Min, Min + 1, Must(FromUnits(2019, 7, 14)), Max - 1, Max} {
+		if got, expected := d.Weekday(), d.ToTime().Weekday(); got != expected {
+			t.Errorf("Expected %v for %v, got %v", expected, d, got)
+		}
+	}
+	for d := Must(FromUnits(2019, 7, 1)); d < Must(FromUnits(2019, 7, 15)); d++ {
+		if got, expected := d.Weekday(), d.ToTime().Weekday(); got != expected {
+			t.Errorf("Expected %v for %v, got %v", expected, d, got)
+		}
+	}
+}
+
+func TestIsWeekend(t *testing.T) {
+	// 2019-07-12 is a Friday
+	fri, sat, sun, mon := Must(FromUnits(2019, 7, 12)), Must(FromUnits(2019, 7, 13)), Must(FromUnits(2019, 7, 14)), Must(FromUnits(2019, 7, 15))
+	cases := []struct {
+		name     string
+		d        Value
+		weekend  []time.Weekday
+		expected bool
+	}{
+		{"friday", fri, nil, false},
+		{"saturday", sat, nil, true},
+		{"sunday", sun, nil, true},
+		{"monday", mon, nil, false},
+		{"friday in a friday/saturday region", fri, []time.Weekday{time.Friday, time.Saturday}, true},
+		{"sunday in a friday/saturday region", sun, []time.Weekday{time.Friday, time.Saturday}, false},
+		{"nil", Nil, nil, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.d.IsWeekend(tc.weekend...); got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsOneOf(t *testing.T) {
+	// 2019-07-15 is a Monday
+	mon, tue := Must(FromUnits(2019, 7, 15)), Must(FromUnits(2019, 7, 16))
+	if !mon.IsOneOf(time.Monday, time.Wednesday, time.Friday) {
+		t.Errorf("Expected %v to be a Monday", mon)
+	}
+	if tue.IsOneOf(time.Monday, time.Wednesday, time.Friday) {
+		t.Errorf("Expected %v not to match", tue)
+	}
+	if mon.IsOneOf() || Nil.IsOneOf(time.Weekday(-1)) {
+		t.Errorf("Expected no match for an empty list or date.Nil")
+	}
+}
+
+func TestNthWeekday(t *testing.T) {
+	// 2025-03-01 is a Saturday
+	cases := []struct {
+		name     string
+		y, m     int
+		wd       time.Weekday
+		n        int
+		expected Value
+		field    string
+	}{
+		{"2nd tuesday", 2025, 3, time.Tuesday, 2, Must(FromUnits(2025, 3, 11)), ""},
+		{"1st saturday", 2025, 3, time.Saturday, 1, Must(FromUnits(2025, 3, 1)), ""},
+		{"5th monday", 2025, 3, time.Monday, 5, Must(FromUnits(2025, 3, 31)), ""},
+		{"last friday", 2025, 3, time.Friday, -1, Must(FromUnits(2025, 3, 28)), ""},
+		{"5th from last saturday", 2025, 3, time.Saturday, -5, Must(FromUnits(2025, 3, 1)), ""},
+		{"no 5th tuesday", 2025, 3, time.Tuesday, 5, Nil, "n"},
+		{"no 5th from last tuesday", 2025, 3, time.Tuesday, -5, Nil, "n"},
+		{"invalid weekday", 2025, 3, 7, 1, Nil, "weekday"},
+		{"invalid month", 2025, 13, time.Tuesday, 1, Nil, "month"},
+		{"invalid year", 1752, 3, time.Tuesday, 1, Nil, "year"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := NthWeekday(tc.y, tc.m, tc.wd, tc.n)
+			var re *RangeError
+			if (tc.field == "" && err != nil) || (tc.field != "" && (!stderrors.As(err, &re) || re.Field != tc.field)) {
+				tt.Fatalf("Expected a %q range error, got %v", tc.field, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestNthWeekdayZero(t *testing.T) {
+	got, err := NthWeekday(2025, 3, time.Tuesday, 0)
+	if got != Nil || errors.Cause(err) != ErrInvalidDateUnit {
+		t.Fatalf("Expected date.Nil and %v, got %v, %v", ErrInvalidDateUnit, got, err)
+	}
+	var re *RangeError
+	if stderrors.As(err, &re) {
+		t.Errorf("Expected a dedicated error for n == 0, got a range error %v", re)
+	}
+	if !strings.Contains(err.Error(), "n must not be zero") {
+		t.Errorf("Expected the message to reject zero, got %q", err.Error())
+	}
+}
+
+func TestLastWeekdayOfMonth(t *testing.T) {
+	d := Must(FromUnits(2025, 3, 14))
+	if got, expected := d.LastWeekdayOfMonth(time.Friday), Must(FromUnits(2025, 3, 28)); got != expected || !got.IsLastWeekdayOfMonth() {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got, expected := d.LastWeekdayOfMonth(time.Monday), Must(FromUnits(2025, 3, 31)); got != expected {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := Nil.LastWeekdayOfMonth(time.Friday); got != Nil {
+		t.Errorf("Expected date.Nil, got %v", got)
+	}
+	if got := d.LastWeekdayOfMonth(time.Weekday(9)); got != Nil {
+		t.Errorf("Expected date.Nil, got %v", got)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package deque provides Value[T], a generic double-ended queue backed by a growable ring buffer.
+package deque
+
+import (
+	"fmt"
+	"iter"
+)
+
+// minCapacity is the size of the first allocation
+const minCapacity = 8
+
+// Value is a double-ended queue.  Pushing and popping at either end takes amortized O(1) time, and
+// indexing with At() takes O(1) time.
+//
+// The zero value is an empty deque that is ready to use.  A Value must not be copied after first use,
+// so pass it by pointer.  A Value is not safe for concurrent use without external synchronization.
+type Value[T any] struct {
+	buf  []T
+	head int
+	n    int
+}
+
+// Of returns a new deque containing the specified items, in order from front to back
+func Of[T any](items ...T) *Value[T] {
+	d := &Value[T]{}
+	for _, item := range items {
+		d.PushBack(item)
+	}
+	return d
+}
+
+// Len returns the number of items in d
+func (d *Value[T]) Len() int {
+	return d.n
+}
+
+// PushBack adds item to the back of d
+func (d *Value[T]) PushBack(item T) {
+	d.grow()
+	d.buf[d.index(d.n)] = item
+	d.n++
+}
+
+// PushFront adds item to the front of d
+func (d *Value[T]) PushFront(item T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = item
+	d.n++
+}
+
+// PopFront removes and returns the item at the front of d.  It returns false if d is empty.
+func (d *Value[T]) PopFront() (T, bool) {
+	var zero T
+	if d.n == 0 {
+		return zero, false
+	}
+	item := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.n--
+	return item, true
+}
+
+// PopBack removes and returns the item at the back of d.  It returns false if d is empty.
+func (d *Value[T]) PopBack() (T, bool) {
+	var zero T
+	if d.n == 0 {
+		return zero, false
+	}
+	i := d.index(d.n - 1)
+	item := d.buf[i]
+	d.buf[i] = zero
+	d.n--
+	return item, true
+}
+
+// Front returns the item at the front of d without removing it.  It returns false if d is empty.
+func (d *Value[T]) Front() (T, bool) {
+	if d.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// Back returns the item at the back of d without removing it.  It returns false if d is empty.
+func (d *Value[T]) Back() (T, bool) {
+	if d.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(d.n-1)], true
+}
+
+// At returns the item at index i, where 0 is the front of d.  It panics if i is out of range.
+func (d *Value[T]) At(i int) T {
+	if i < 0 || i >= d.n {
+		panic(fmt.Sprintf("deque: index %d out of range [0, %d)", i, d.n))
+	}
+	return d.buf[d.index(i)]
+}
+
+// Clear removes all items from d.  The allocated storage is kept for reuse.
+func (d *Value[T]) Clear() {
+	clear(d.buf)
+	d.head, d.n = 0, 0
+}
+
+// All returns an iterator over the items in d, from front to back.  d must not be modified during
+// the iteration.
+func (d *Value[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.n; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the items in d, from back to front.  d must not be modified
+// during the iteration.
+func (d *Value[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.n - 1; i >= 0; i-- {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns the items in d as a new slice, from front to back
+func (d *Value[T]) Slice() []T {
+	res := make([]T, d.n)
+	d.copyTo(res)
+	return res
+}
+
+// index converts a logical index into an index in buf
+func (d *Value[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// copyTo copies the items in d to dst, which must have room for them, from front to back
+func (d *Value[T]) copyTo(dst []T) {
+	if d.n == 0 {
+		return
+	}
+	if end := d.head + d.n; end <= len(d.buf) {
+		copy(dst, d.buf[d.head:end])
+		return
+	}
+	k := copy(dst, d.buf[d.head:])
+	copy(dst[k:], d.buf[:d.n-k])
+}
+
+// grow doubles the size of buf if it is full
+func (d *Value[T]) grow() {
+	if d.n < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(2*len(d.buf), minCapacity))
+	d.copyTo(buf)
+	d.buf, d.head = buf, 0
+}
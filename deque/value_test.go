@@ -0,0 +1,141 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package deque
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestPushPop(t *testing.T) {
+	var d Value[int]
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("Expected PopFront() to fail on an empty deque")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("Expected PopBack() to fail on an empty deque")
+	}
+	if _, ok := d.Front(); ok {
+		t.Errorf("Expected Front() to fail on an empty deque")
+	}
+	if _, ok := d.Back(); ok {
+		t.Errorf("Expected Back() to fail on an empty deque")
+	}
+
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+	if got := d.Slice(); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("Expected [0 1 2 3], got %v", got)
+	}
+	if v, ok := d.Front(); !ok || v != 0 {
+		t.Errorf("Expected Front() to return 0, got %d", v)
+	}
+	if v, ok := d.Back(); !ok || v != 3 {
+		t.Errorf("Expected Back() to return 3, got %d", v)
+	}
+	if v, ok := d.PopFront(); !ok || v != 0 {
+		t.Errorf("Expected PopFront() to return 0, got %d", v)
+	}
+	if v, ok := d.PopBack(); !ok || v != 3 {
+		t.Errorf("Expected PopBack() to return 3, got %d", v)
+	}
+	if d.Len() != 2 || d.At(0) != 1 || d.At(1) != 2 {
+		t.Errorf("Expected [1 2], got %v", d.Slice())
+	}
+	d.Clear()
+	if d.Len() != 0 {
+		t.Errorf("Expected an empty deque, got %v", d.Slice())
+	}
+}
+
+func TestAgainstSlice(t *testing.T) {
+	// compare a random sequence of operations, which grows and wraps the ring buffer, with a slice
+	rng := rand.New(rand.NewSource(42))
+	var (
+		d     Value[int]
+		model []int
+	)
+	for i := 0; i < 10000; i++ {
+		switch op := rng.Intn(5); {
+		case op == 0:
+			d.PushFront(i)
+			model = append([]int{i}, model...)
+		case op == 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case op == 2 && len(model) > 0:
+			v, _ := d.PopFront()
+			if v != model[0] {
+				t.Fatalf("Step %d: expected PopFront() to return %d, got %d", i, model[0], v)
+			}
+			model = model[1:]
+		case op == 3 && len(model) > 0:
+			v, _ := d.PopBack()
+			if v != model[len(model)-1] {
+				t.Fatalf("Step %d: expected PopBack() to return %d, got %d", i, model[len(model)-1], v)
+			}
+			model = model[:len(model)-1]
+		case op == 4 && len(model) > 0:
+			j := rng.Intn(len(model))
+			if v := d.At(j); v != model[j] {
+				t.Fatalf("Step %d: expected At(%d) to return %d, got %d", i, j, model[j], v)
+			}
+		}
+		if d.Len() != len(model) {
+			t.Fatalf("Step %d: expected %d items, got %d", i, len(model), d.Len())
+		}
+	}
+	if got := d.Slice(); !slices.Equal(got, model) {
+		t.Errorf("Expected %v, got %v", model, got)
+	}
+	if got := slices.Collect(d.All()); !slices.Equal(got, model) {
+		t.Errorf("Expected All() to yield %v, got %v", model, got)
+	}
+	slices.Reverse(model)
+	if got := slices.Collect(d.Backward()); !slices.Equal(got, model) {
+		t.Errorf("Expected Backward() to yield %v, got %v", model, got)
+	}
+}
+
+func TestIterationStops(t *testing.T) {
+	d := Of(1, 2, 3)
+	var got []int
+	for v := range d.All() {
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", got)
+	}
+}
+
+func TestAtPanics(t *testing.T) {
+	for _, i := range []int{-1, 3} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected At(%d) to panic", i)
+				}
+			}()
+			Of(1, 2, 3).At(i)
+		}()
+	}
+}
+
+func TestPopReleasesItems(t *testing.T) {
+	d := Of(new(int), new(int))
+	d.PopFront()
+	d.PopBack()
+	for i, p := range d.buf {
+		if p != nil {
+			t.Errorf("Expected slot %d to be cleared", i)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package digest
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextData is returned from digest.Value.UnmarshalJSON() when the passed-in byte slice
+	// does not contain a string
+	ErrInvalidTextData = errors.Errorf("digest.Value: can only decode JSON strings")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface for digest.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// AppendText implements the encoding.TextAppender interface for digest.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	if v.IsNil() {
+		return b, nil
+	}
+	b = append(b, v.alg.String()...)
+	b = append(b, ':')
+	return hex.AppendEncode(b, v.sum[:v.alg.Size()]), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for digest.Value values.
+//
+// Empty text is decoded as Nil and all other text is decoded by Parse().
+func (v *Value) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = Nil
+		return nil
+	}
+	res, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for digest.Value values.
+//
+// Values are encoded as a JSON string containing the same value as MarshalText().  Nil is encoded as
+// the special JSON null token.
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return []byte("null"), nil
+	}
+	res := append(make([]byte, 0, 2+len(v.alg.String())+1+2*v.alg.Size()), '"')
+	res, _ = v.AppendText(res)
+	return append(res, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for digest.Value values.
+//
+// If the value is the special JSON null token, v is set to Nil.  All other values are delegated to
+// UnmarshalText().
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		*v = Nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return v.UnmarshalText([]byte(s))
+}
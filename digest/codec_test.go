@@ -0,0 +1,75 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package digest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	type doc struct {
+		Ref Value `json:"ref"`
+	}
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"sha256", Must(Sum(SHA256, hello)), `{"ref":"sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"}`},
+		{"md5", Must(Sum(MD5, hello)), `{"ref":"md5:5d41402abc4b2a76b9719d911017c592"}`},
+		{"nil", Nil, `{"ref":null}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := json.Marshal(doc{tc.v})
+			if err != nil || string(data) != tc.expected {
+				tt.Fatalf("Expected %s, got %s (err = %v)", tc.expected, data, err)
+			}
+			got := doc{Must(Sum(SHA1, hello))}
+			if err := json.Unmarshal(data, &got); err != nil || got.Ref != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, got.Ref, err)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	v := Must(Sum(SHA1, hello))
+	text, _ := v.MarshalText()
+	appended, _ := v.AppendText([]byte("prefix:"))
+	if string(appended) != "prefix:"+string(text) {
+		t.Errorf("Expected %q, got %q", "prefix:"+string(text), appended)
+	}
+	var got Value
+	if err := got.UnmarshalText(text); err != nil || got != v {
+		t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+	}
+	if err := got.UnmarshalText(nil); err != nil || !got.IsNil() {
+		t.Errorf("Expected empty text to decode as Nil, got %v (err = %v)", got, err)
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"number", `42`, ErrInvalidTextData},
+		{"missing prefix", `"5d41402abc4b2a76b9719d911017c592"`, ErrInvalidTextFormat},
+		{"wrong length", `"md5:5d41"`, ErrInvalidLength},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			if err := v.UnmarshalJSON([]byte(tc.data)); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package digest
+
+import (
+	"database/sql/driver"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// a digest.Value value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a digest.Value value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for digest.Value values.  The value is stored in its
+// "algorithm:hex" text form so that the algorithm is preserved, and Nil is stored as NULL.
+func (v Value) Value() (driver.Value, error) {
+	if v.IsNil() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for digest.Value values.
+//
+// Strings and byte slices are decoded from the "algorithm:hex" text form and NULL is scanned as Nil.
+// All other values will return an error
+func (v *Value) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case nil:
+		*v = Nil
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(tv))
+	case []byte:
+		return v.UnmarshalText(tv)
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
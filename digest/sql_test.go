@@ -0,0 +1,46 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package digest
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestSQL(t *testing.T) {
+	sum := Must(Sum(MD5, hello))
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"string", "md5:5d41402abc4b2a76b9719d911017c592", sum, nil},
+		{"bytes", []byte("md5:5d41402abc4b2a76b9719d911017c592"), sum, nil},
+		{"null", nil, Nil, nil},
+		{"invalid string", "md5:xyz", Nil, ErrInvalidLength},
+		{"unsupported type", 42, Nil, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, v)
+			}
+			if err != nil {
+				return
+			}
+			dv, err := v.Value()
+			if err != nil || (v.IsNil() && dv != nil) || (!v.IsNil() && dv != v.String()) {
+				tt.Errorf("Expected %q, got %#v (err = %v)", v.String(), dv, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package digest provides a value type for cryptographic hash digests, such as the references used by
+// content-addressed storage.  A digest stores the raw bytes of the hash along with the algorithm that
+// produced it, and its text form is the algorithm name followed by lower case hex, as in
+// "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824".
+package digest
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"hash"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Algorithm identifies the hash function that produced a digest
+type Algorithm int
+
+const (
+	// Unknown is the algorithm of the Nil digest
+	Unknown Algorithm = iota
+	// SHA256 is the SHA-256 hash function from crypto/sha256
+	SHA256
+	// SHA1 is the SHA-1 hash function from crypto/sha1
+	SHA1
+	// MD5 is the MD5 hash function from crypto/md5
+	MD5
+)
+
+// algorithms contains the name, digest size and constructor of each supported algorithm, indexed by
+// Algorithm
+var algorithms = [...]struct {
+	name string
+	size int
+	new  func() hash.Hash
+}{
+	Unknown: {name: "unknown"},
+	SHA256:  {name: "sha256", size: sha256.Size, new: sha256.New},
+	SHA1:    {name: "sha1", size: sha1.Size, new: sha1.New},
+	MD5:     {name: "md5", size: md5.Size, new: md5.New},
+}
+
+// IsValid returns true if a is one of the supported algorithms
+func (a Algorithm) IsValid() bool {
+	return a > Unknown && int(a) < len(algorithms)
+}
+
+// String returns the name of the algorithm that is used in the text form of a digest, such as "sha256"
+func (a Algorithm) String() string {
+	if !a.IsValid() {
+		return algorithms[Unknown].name
+	}
+	return algorithms[a].name
+}
+
+// Size returns the number of bytes in a digest produced by the algorithm, or 0 if a is not valid
+func (a Algorithm) Size() int {
+	if !a.IsValid() {
+		return 0
+	}
+	return algorithms[a].size
+}
+
+// New returns a new hash.Hash that computes digests with the algorithm, or nil if a is not valid
+func (a Algorithm) New() hash.Hash {
+	if !a.IsValid() {
+		return nil
+	}
+	return algorithms[a].new()
+}
+
+// maxSize is the size of the largest supported digest
+const maxSize = sha256.Size
+
+// Value is a hash digest and the algorithm that produced it.  Values are comparable, so they can be
+// used as map keys, but Equal() should be used to compare digests that guard access to a resource.
+//
+// The zero value, Nil, has no algorithm and is encoded as JSON null and SQL NULL.
+type Value struct {
+	alg Algorithm
+	sum [maxSize]byte
+}
+
+// Nil is the zero value, which does not represent any digest
+var Nil = Value{}
+
+var (
+	// ErrUnknownAlgorithm is returned when an algorithm is not one of the supported algorithms
+	ErrUnknownAlgorithm = errors.Errorf("digest: unknown hash algorithm")
+	// ErrInvalidLength is returned when the number of bytes does not match the algorithm's digest size
+	ErrInvalidLength = errors.Errorf("digest: the digest is not the correct length for the algorithm")
+	// ErrInvalidTextFormat is returned when a string is not of the form "algorithm:hex"
+	ErrInvalidTextFormat = errors.Errorf("digest: the specified text is not a valid digest")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in digest.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// New returns a Value containing a copy of sum, which must be exactly alg.Size() bytes long
+func New(alg Algorithm, sum []byte) (Value, error) {
+	if !alg.IsValid() {
+		return Nil, errors.Wrapf(ErrUnknownAlgorithm, "%d", int(alg))
+	}
+	if len(sum) != alg.Size() {
+		return Nil, errors.Wrapf(ErrInvalidLength, "%s: %d bytes", alg, len(sum))
+	}
+	v := Value{alg: alg}
+	copy(v.sum[:], sum)
+	return v, nil
+}
+
+// Sum computes the digest of data with the specified algorithm
+func Sum(alg Algorithm, data []byte) (Value, error) {
+	h := alg.New()
+	if h == nil {
+		return Nil, errors.Wrapf(ErrUnknownAlgorithm, "%d", int(alg))
+	}
+	h.Write(data)
+	return New(alg, h.Sum(nil))
+}
+
+// Parse decodes the "algorithm:hex" text form of a digest.  The algorithm name is case-insensitive and
+// the hex can be upper or lower case.
+func Parse(s string) (Value, error) {
+	name, hx, ok := strings.Cut(s, ":")
+	if !ok {
+		return Nil, errors.Wrapf(ErrInvalidTextFormat, "%q: missing algorithm prefix", s)
+	}
+	alg := Unknown
+	for a := SHA256; a.IsValid(); a++ {
+		if strings.EqualFold(name, a.String()) {
+			alg = a
+			break
+		}
+	}
+	if alg == Unknown {
+		return Nil, errors.Wrapf(ErrUnknownAlgorithm, "%q", name)
+	}
+	if hex.DecodedLen(len(hx)) != alg.Size() {
+		return Nil, errors.Wrapf(ErrInvalidLength, "%q", s)
+	}
+	v := Value{alg: alg}
+	if _, err := hex.Decode(v.sum[:alg.Size()], []byte(hx)); err != nil {
+		return Nil, errors.Wrapf(ErrInvalidTextFormat, "%q: %v", s, err)
+	}
+	return v, nil
+}
+
+// IsNil returns true if v is Nil
+func (v Value) IsNil() bool {
+	return v.alg == Unknown
+}
+
+// Algorithm returns the algorithm that produced the digest, or Unknown if v is Nil
+func (v Value) Algorithm() Algorithm {
+	return v.alg
+}
+
+// Bytes returns a copy of the raw digest bytes, or nil if v is Nil
+func (v Value) Bytes() []byte {
+	if v.IsNil() {
+		return nil
+	}
+	return append([]byte(nil), v.sum[:v.alg.Size()]...)
+}
+
+// Equal returns true if v and other were produced by the same algorithm and contain the same bytes.
+// The bytes are compared in constant time, so Equal is safe to use when verifying content against an
+// expected digest supplied by an untrusted party.
+func (v Value) Equal(other Value) bool {
+	return v.alg == other.alg && subtle.ConstantTimeCompare(v.sum[:], other.sum[:]) == 1
+}
+
+// Verify returns true if data hashes to v with v's algorithm
+func (v Value) Verify(data []byte) bool {
+	sum, err := Sum(v.alg, data)
+	return err == nil && v.Equal(sum)
+}
+
+// String implements fmt.Stringer for digest.Value instances.
+//
+// The returned string is the algorithm name, a colon and the lower case hex encoding of the digest,
+// or an empty string if v is Nil.
+func (v Value) String() string {
+	if v.IsNil() {
+		return ""
+	}
+	b, _ := v.AppendText(make([]byte, 0, len(v.alg.String())+1+2*v.alg.Size()))
+	return string(b)
+}
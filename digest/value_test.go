@@ -0,0 +1,128 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package digest
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+var hello = []byte("hello")
+
+func TestSum(t *testing.T) {
+	sha256Sum, sha1Sum, md5Sum := sha256.Sum256(hello), sha1.Sum(hello), md5.Sum(hello)
+	cases := []struct {
+		name     string
+		alg      Algorithm
+		expected []byte
+		text     string
+	}{
+		{"sha256", SHA256, sha256Sum[:], "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"},
+		{"sha1", SHA1, sha1Sum[:], "sha1:aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"},
+		{"md5", MD5, md5Sum[:], "md5:5d41402abc4b2a76b9719d911017c592"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Sum(tc.alg, hello)
+			if err != nil {
+				tt.Fatalf("Unexpected error %v", err)
+			}
+			if v.Algorithm() != tc.alg || !bytes.Equal(v.Bytes(), tc.expected) {
+				tt.Errorf("Expected %s %x, got %s %x", tc.alg, tc.expected, v.Algorithm(), v.Bytes())
+			}
+			if v.String() != tc.text {
+				tt.Errorf("Expected %q, got %q", tc.text, v.String())
+			}
+			if !v.Verify(hello) || v.Verify([]byte("world")) {
+				tt.Errorf("Expected Verify() to match only the original data")
+			}
+			fromBytes, err := New(tc.alg, tc.expected)
+			if err != nil || fromBytes != v {
+				tt.Errorf("Expected New() to return %v, got %v (err = %v)", v, fromBytes, err)
+			}
+		})
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		alg  Algorithm
+		sum  []byte
+		err  error
+	}{
+		{"unknown algorithm", Unknown, make([]byte, 32), ErrUnknownAlgorithm},
+		{"invalid algorithm", MD5 + 1, make([]byte, 16), ErrUnknownAlgorithm},
+		{"too short", SHA256, make([]byte, 20), ErrInvalidLength},
+		{"too long", MD5, make([]byte, 20), ErrInvalidLength},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if _, err := New(tc.alg, tc.sum); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+	if _, err := Sum(Unknown, hello); errors.Cause(err) != ErrUnknownAlgorithm {
+		t.Errorf("Expected %v, got %v", ErrUnknownAlgorithm, err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	expected := Must(Sum(SHA1, hello))
+	cases := []struct {
+		name string
+		text string
+		err  error
+	}{
+		{"lower case", "sha1:aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", nil},
+		{"upper case", "SHA1:AAF4C61DDCC5E8A2DABEDE0F3B482CD9AEA9434D", nil},
+		{"missing prefix", "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", ErrInvalidTextFormat},
+		{"unknown algorithm", "sha512:aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", ErrUnknownAlgorithm},
+		{"wrong length", "sha256:aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", ErrInvalidLength},
+		{"empty digest", "sha1:", ErrInvalidLength},
+		{"invalid hex", "sha1:zaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.text)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && v != expected {
+				tt.Errorf("Expected %v, got %v", expected, v)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a := Must(Sum(MD5, hello))
+	b := Must(New(MD5, a.Bytes()))
+	other := Must(Sum(MD5, []byte("world")))
+	// an MD5 and a SHA-1 digest that share the same leading bytes must not be equal
+	prefix := Must(New(SHA1, append(a.Bytes(), 0, 0, 0, 0)))
+	if !a.Equal(b) || a.Equal(other) || a.Equal(prefix) || a.Equal(Nil) || !Nil.Equal(Nil) {
+		t.Errorf("Unexpected Equal() results")
+	}
+	b.Bytes()[0] ^= 0xff
+	if !a.Equal(b) {
+		t.Errorf("Expected Bytes() to return a copy")
+	}
+}
+
+func TestNil(t *testing.T) {
+	if !Nil.IsNil() || Nil.String() != "" || Nil.Bytes() != nil || Nil.Algorithm() != Unknown || Nil.Verify(hello) {
+		t.Errorf("Unexpected behavior for Nil")
+	}
+	if Unknown.Size() != 0 || Unknown.New() != nil || Unknown.String() != "unknown" || (MD5+1).String() != "unknown" {
+		t.Errorf("Unexpected behavior for invalid algorithms")
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package emailaddr
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextData is returned from emailaddr.Value.UnmarshalJSON() when the passed-in byte
+	// slice does not contain a string
+	ErrInvalidTextData = errors.Errorf("emailaddr.Value: can only decode JSON strings")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface for emailaddr.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for emailaddr.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	return append(b, v.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for emailaddr.Value values.
+//
+// The text is parsed by Parse().  An empty slice is decoded as Nil.
+func (v *Value) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for emailaddr.Value values.
+//
+// Values are encoded as a JSON string containing the same value as MarshalText().
+func (v Value) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for emailaddr.Value values.
+//
+// If the value is the special JSON null token, v is set to emailaddr.Nil.  All other values are
+// delegated to UnmarshalText().
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		*v = Nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return v.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,53 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package emailaddr
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		err      error
+	}{
+		{"address", `"jane@Example.com"`, Must(Parse("jane@example.com")), nil},
+		{"null", `null`, Nil, nil},
+		{"empty", `""`, Nil, nil},
+		{"number", `42`, Nil, ErrInvalidTextData},
+		{"invalid", `"not an address"`, Nil, ErrInvalidAddress},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Must(Parse("other@example.com"))
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && v != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, v)
+			}
+		})
+	}
+
+	data, err := json.Marshal(Must(Parse("Jane <jane@example.com>")))
+	if err != nil || string(data) != `"\"Jane\" \u003cjane@example.com\u003e"` {
+		t.Errorf("Unexpected encoding %s (err = %v)", data, err)
+	}
+}
+
+func TestText(t *testing.T) {
+	v := Must(Parse("jane@example.com"))
+	text, _ := v.MarshalText()
+	var got Value
+	if err := got.UnmarshalText(text); err != nil || got != v {
+		t.Errorf("Expected %q, got %q (err = %v)", v, got, err)
+	}
+}
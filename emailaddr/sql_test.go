@@ -0,0 +1,75 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package emailaddr
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"string", "jane@example.com", Must(Parse("jane@example.com")), nil},
+		{"bytes", []byte("jane@EXAMPLE.com"), Must(Parse("jane@example.com")), nil},
+		{"invalid", "nope", Nil, ErrInvalidAddress},
+		{"null", nil, Nil, ErrUnsupportedSourceType},
+		{"unsupported type", 42, Nil, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestNullAddress(t *testing.T) {
+	jane := Must(Parse("jane@example.com"))
+	cases := []struct {
+		name   string
+		v      NullAddress
+		sqlVal driver.Value
+		json   string
+	}{
+		{"null", NullAddress{}, nil, "null"},
+		{"valid", NullAddress{V: jane, Valid: true}, "jane@example.com", `"jane@example.com"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got, err := tc.v.Value(); err != nil || got != tc.sqlVal {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.sqlVal, got, err)
+			}
+			var scanned NullAddress
+			if err := scanned.Scan(tc.sqlVal); err != nil || scanned != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, scanned, err)
+			}
+			data, err := json.Marshal(tc.v)
+			if err != nil || string(data) != tc.json {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.json, data, err)
+			}
+			got := NullAddress{V: Must(Parse("other@example.com")), Valid: true}
+			if err := json.Unmarshal(data, &got); err != nil || got != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, got, err)
+			}
+			if tc.v.IsZero() == tc.v.Valid {
+				tt.Errorf("Expected IsZero() to be the inverse of Valid")
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package emailaddr
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Value represents a validated RFC 5322 email address, such as "jane@example.com", with an optional
+// display name, such as "Jane Doe <jane@example.com>".
+//
+// The domain is normalized to lower case, since domain names are case-insensitive, but the local
+// part is kept as is because its case may be significant to the receiving server.  Values with the
+// same normalized form can be compared with ==.
+//
+// The zero value is emailaddr.Nil.
+type Value struct {
+	name, local, domain string
+}
+
+var (
+	// Nil represents a nil/null/undefined email address
+	Nil = Value{}
+)
+
+var (
+	// ErrInvalidAddress is returned when a string is not a valid RFC 5322 address
+	ErrInvalidAddress = errors.Errorf("emailaddr: the specified text is not a valid email address")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in emailaddr.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Parse parses a single RFC 5322 address, with or without a display name, using net/mail.  The domain
+// is converted to lower case.  An empty string is parsed as Nil.
+func Parse(s string) (Value, error) {
+	if s == "" {
+		return Nil, nil
+	}
+	a, err := mail.ParseAddress(s)
+	if err != nil {
+		return Nil, errors.Wrapf(ErrInvalidAddress, "%q: %v", s, err)
+	}
+	// the local part may contain a quoted '@', so split at the last one
+	i := strings.LastIndexByte(a.Address, '@')
+	if i <= 0 || i == len(a.Address)-1 {
+		return Nil, errors.Wrapf(ErrInvalidAddress, "%q", s)
+	}
+	return Value{name: a.Name, local: a.Address[:i], domain: strings.ToLower(a.Address[i+1:])}, nil
+}
+
+// IsNil returns true if v is the Nil address
+func (v Value) IsNil() bool {
+	return v == Nil
+}
+
+// Name returns the display name, or an empty string if there is none
+func (v Value) Name() string {
+	return v.name
+}
+
+// LocalPart returns the part of the address before the '@'
+func (v Value) LocalPart() string {
+	return v.local
+}
+
+// Domain returns the lower case part of the address after the '@'
+func (v Value) Domain() string {
+	return v.domain
+}
+
+// Address returns the "local@domain" address without the display name, or an empty string for Nil.
+// The local part is quoted if it contains characters that require it.
+func (v Value) Address() string {
+	if v.IsNil() {
+		return ""
+	}
+	// net/mail formats a nameless address as "<local@domain>", quoting the local part as needed
+	s := (&mail.Address{Address: v.local + "@" + v.domain}).String()
+	return strings.TrimSuffix(strings.TrimPrefix(s, "<"), ">")
+}
+
+// WithoutName returns a copy of v with the display name removed
+func (v Value) WithoutName() Value {
+	v.name = ""
+	return v
+}
+
+// String implements fmt.Stringer for emailaddr.Value instances.
+//
+// The returned string is the address, with the display name if there is one, formatted by
+// net/mail, or an empty string for Nil.
+func (v Value) String() string {
+	if v.IsNil() {
+		return ""
+	}
+	if v.name == "" {
+		return v.Address()
+	}
+	return (&mail.Address{Name: v.name, Address: v.local + "@" + v.domain}).String()
+}
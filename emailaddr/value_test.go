@@ -0,0 +1,66 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package emailaddr
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name                       string
+		s                          string
+		displayName, local, domain string
+		address, str               string
+		err                        error
+	}{
+		{"empty", "", "", "", "", "", "", nil},
+		{"simple", "jane@example.com", "", "jane", "example.com", "jane@example.com", "jane@example.com", nil},
+		{"domain case", "Jane.Doe@Example.COM", "", "Jane.Doe", "example.com", "Jane.Doe@example.com", "Jane.Doe@example.com", nil},
+		{"display name", "Jane Doe <jane@example.com>", "Jane Doe", "jane", "example.com", "jane@example.com", `"Jane Doe" <jane@example.com>`, nil},
+		{"angle brackets only", "<jane@example.com>", "", "jane", "example.com", "jane@example.com", "jane@example.com", nil},
+		{"quoted local part", `"jane doe"@example.com`, "", "jane doe", "example.com", `"jane doe"@example.com`, `"jane doe"@example.com`, nil},
+		{"plus tag", "jane+news@example.com", "", "jane+news", "example.com", "jane+news@example.com", "jane+news@example.com", nil},
+		{"missing domain", "jane@", "", "", "", "", "", ErrInvalidAddress},
+		{"missing at", "jane.example.com", "", "", "", "", "", ErrInvalidAddress},
+		{"two addresses", "a@example.com, b@example.com", "", "", "", "", "", ErrInvalidAddress},
+		{"spaces", "jane doe@example.com", "", "", "", "", "", ErrInvalidAddress},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v.Name() != tc.displayName || v.LocalPart() != tc.local || v.Domain() != tc.domain {
+				tt.Errorf("Expected (%q, %q, %q), got (%q, %q, %q)", tc.displayName, tc.local, tc.domain, v.Name(), v.LocalPart(), v.Domain())
+			}
+			if v.Address() != tc.address || v.String() != tc.str {
+				tt.Errorf("Expected (%q, %q), got (%q, %q)", tc.address, tc.str, v.Address(), v.String())
+			}
+			if err == nil && Must(Parse(v.String())) != v {
+				tt.Errorf("Expected %q to parse back to the same value", v)
+			}
+		})
+	}
+}
+
+func TestEquality(t *testing.T) {
+	if Must(Parse("jane@EXAMPLE.com")) != Must(Parse("jane@example.com")) {
+		t.Errorf("Expected addresses differing only in domain case to be equal")
+	}
+	if Must(Parse("Jane@example.com")) == Must(Parse("jane@example.com")) {
+		t.Errorf("Expected the case of the local part to be preserved")
+	}
+	v := Must(Parse("Jane Doe <jane@example.com>"))
+	if v.WithoutName() != Must(Parse("jane@example.com")) {
+		t.Errorf("Expected WithoutName() to remove the display name")
+	}
+	if !Nil.IsNil() || v.IsNil() {
+		t.Errorf("Unexpected IsNil() results")
+	}
+}
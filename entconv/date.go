@@ -0,0 +1,75 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package entconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ driver.Valuer = Date(0)
+var _ sql.Scanner = (*Date)(nil)
+
+// Date adapts a date.Value to a DATE column.  date.Nil is written and read as NULL, so Date works for
+// both required and optional fields.
+type Date date.Value
+
+// Value implements the driver.Valuer interface.  Valid dates are written as a time.Time at midnight
+// UTC, which all of the supported drivers bind to DATE columns, and date.Nil is written as NULL.  Any
+// other invalid date returns ErrOutOfRange.
+func (d Date) Value() (driver.Value, error) {
+	v := date.Value(d)
+	if v == date.Nil {
+		return nil, nil
+	}
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "date.Value(%d)", int64(v))
+	}
+	return v.ToTime(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// NULL is read as date.Nil.  Drivers return DATE columns as a time.Time, whose date in its own
+// location is used, or as text formatted as YYYY-MM-DD, optionally followed by a time portion, which
+// is ignored.  All other values will return an error.
+func (d *Date) Scan(src interface{}) error {
+	var (
+		v   date.Value
+		err error
+	)
+	switch tv := src.(type) {
+	case nil:
+		*d = Date(date.Nil)
+		return nil
+	case time.Time:
+		v, err = date.FromTime(tv)
+	case string:
+		v, err = parseDate(tv)
+	case []byte:
+		v, err = parseDate(string(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+	if err != nil {
+		return errors.Wrapf(ErrOutOfRange, "%v", src)
+	}
+	*d = Date(v)
+	return nil
+}
+
+// parseDate parses the YYYY-MM-DD prefix of s, which may be followed by a time portion separated by a
+// 'T' or a space
+func parseDate(s string) (date.Value, error) {
+	if len(s) > 10 && (s[10] == 'T' || s[10] == ' ') {
+		s = s[:10]
+	}
+	return date.Parse("2006-01-02", s)
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package entconv
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+func TestDateValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        date.Value
+		expected driver.Value
+		err      error
+	}{
+		{"valid", date.Must(date.FromUnits(2024, 7, 14)), time.Date(2024, 7, 14, 0, 0, 0, 0, time.UTC), nil},
+		{"nil", date.Nil, nil, nil},
+		{"out of range", date.Max + 1, nil, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := Date(tc.d).Value()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDateScan(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected date.Value
+		err      error
+	}{
+		{"time", time.Date(2024, 7, 14, 0, 0, 0, 0, time.UTC), d, nil},
+		{"time in location", time.Date(2024, 7, 14, 23, 0, 0, 0, loc), d, nil},
+		{"string", "2024-07-14", d, nil},
+		{"bytes", []byte("2024-07-14"), d, nil},
+		{"date time string", "2024-07-14T00:00:00Z", d, nil},
+		{"space separated date time", []byte("2024-07-14 00:00:00"), d, nil},
+		{"null", nil, date.Nil, nil},
+		{"invalid string", "2024-02-30", date.Min, ErrOutOfRange},
+		{"out of range", "1600-01-01", date.Min, ErrOutOfRange},
+		{"unsupported type", int64(42), date.Min, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Date(date.Min)
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if date.Value(v) != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, date.Value(v))
+			}
+		})
+	}
+}
+
+func TestDateSchemaType(t *testing.T) {
+	types := DateSchemaType()
+	for _, dialect := range []string{MySQL, SQLite, Postgres} {
+		if types[dialect] != "date" {
+			t.Errorf("Expected date for %s, got %q", dialect, types[dialect])
+		}
+	}
+	types[MySQL] = "datetime"
+	if DateSchemaType()[MySQL] != "date" {
+		t.Errorf("Expected a new map for each call")
+	}
+}
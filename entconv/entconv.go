@@ -0,0 +1,60 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package entconv provides field types and per-dialect column types for using the date and time of
+// day types in this module in ent (entgo.io/ent) schemas, so fields map to DATE and TIME columns
+// instead of the integers or strings that back the Go values.
+//
+// Date and TimeOfDay implement the driver.Valuer and sql.Scanner interfaces that ent's
+// field.ValueScanner requires, and DateSchemaType() and TimeOfDaySchemaType() return the maps passed
+// to SchemaType():
+//
+//	field.Other("ship_date", entconv.Date(date.Nil)).
+//		SchemaType(entconv.DateSchemaType())
+//
+// Values are validated when they are written, so an invalid date or time of day fails the mutation
+// instead of being stored.  The ent package is not imported.  Its interfaces are satisfied by method
+// signature alone.
+package entconv
+
+import (
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+// Dialect names, matching the constants in entgo.io/ent/dialect
+const (
+	MySQL    = "mysql"
+	SQLite   = "sqlite3"
+	Postgres = "postgres"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by Scan() when the provided value cannot be converted to
+	// the target type
+	ErrUnsupportedSourceType = errors.Errorf("entconv: cannot convert the source data to the target type")
+	// ErrOutOfRange is returned when a value is not valid for the target type
+	ErrOutOfRange = errors.Errorf("entconv: the value is out of range for the target type")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// DateSchemaType returns the column type of Date fields for each dialect
+func DateSchemaType() map[string]string {
+	return map[string]string{
+		MySQL:    "date",
+		SQLite:   "date",
+		Postgres: "date",
+	}
+}
+
+// TimeOfDaySchemaType returns the column type of TimeOfDay fields for each dialect.  MySQL and
+// PostgreSQL store microseconds.  SQLite has no time type, so values are stored as text.
+func TimeOfDaySchemaType() map[string]string {
+	return map[string]string{
+		MySQL:    "time(6)",
+		SQLite:   "text",
+		Postgres: "time",
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package entconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ driver.Valuer = TimeOfDay{}
+var _ sql.Scanner = (*TimeOfDay)(nil)
+
+// TimeOfDay adapts a timeofday.Value to a TIME column.  Optional fields should be declared with
+// Nillable() so that NULL is represented by a nil pointer.
+type TimeOfDay timeofday.Value
+
+// Value implements the driver.Valuer interface.  Values are written as text formatted as
+// hh:mm:ss.ffffff, truncated to microseconds to match the precision of the column types returned by
+// TimeOfDaySchemaType().  An invalid time of day returns ErrOutOfRange.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	v := timeofday.Value(t)
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "%v", v)
+	}
+	h, m, s, ns := v.ToUnits()
+	return fmt.Sprintf("%02d:%02d:%02d.%06d", h, m, s, ns/int64(time.Microsecond)), nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// Drivers return TIME columns as text formatted as hh:mm:ss with optional fractional seconds, or as a
+// time.Time whose clock in its own location is used.  All other values, including NULL, will return
+// an error.
+func (t *TimeOfDay) Scan(src interface{}) error {
+	var (
+		v   timeofday.Value
+		err error
+	)
+	switch tv := src.(type) {
+	case time.Time:
+		h, m, s := tv.Clock()
+		v, err = timeofday.FromUnits(h, m, s, int64(tv.Nanosecond()))
+	case string:
+		v, err = timeofday.ParseTime(tv)
+	case []byte:
+		v, err = timeofday.ParseTime(string(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+	if err != nil {
+		return errors.Wrapf(ErrOutOfRange, "%v", src)
+	}
+	*t = TimeOfDay(v)
+	return nil
+}
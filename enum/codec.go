@@ -0,0 +1,63 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextData is returned from Registry.DecodeJSON() when the passed-in byte slice does
+	// not contain a string
+	ErrInvalidTextData = errors.Errorf("enum: can only decode JSON strings")
+)
+
+// EncodeText returns the name of v, for use in an encoding.TextMarshaler implementation.  If v is not
+// registered, ErrUnknownValue is returned.
+func (r *Registry[T]) EncodeText(v T) ([]byte, error) {
+	name, ok := r.names[v]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownValue, "%s(%v)", r.typeName, underlying(v))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText parses text with Parse() and stores the result in dst, for use in an
+// encoding.TextUnmarshaler implementation.  dst is unchanged if an error is returned.
+func (r *Registry[T]) DecodeText(text []byte, dst *T) error {
+	v, err := r.Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*dst = v
+	return nil
+}
+
+// EncodeJSON returns the name of v as a JSON string, for use in a json.Marshaler implementation.  If
+// v is not registered, ErrUnknownValue is returned.
+func (r *Registry[T]) EncodeJSON(v T) ([]byte, error) {
+	name, ok := r.names[v]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownValue, "%s(%v)", r.typeName, underlying(v))
+	}
+	return json.Marshal(name)
+}
+
+// DecodeJSON decodes a JSON string with DecodeText() and stores the result in dst, for use in a
+// json.Unmarshaler implementation.  If the value is the special JSON null token, dst is unchanged, as
+// for the standard types.
+func (r *Registry[T]) DecodeJSON(data []byte, dst *T) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return r.DecodeText([]byte(s), dst)
+}
@@ -0,0 +1,63 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected level
+		err      error
+	}{
+		{"name", `"info"`, info, nil},
+		{"case insensitive", `"Warn"`, warn, nil},
+		{"null", `null`, debug, nil},
+		{"number", `2`, debug, ErrInvalidTextData},
+		{"unknown", `"trace"`, debug, ErrUnknownName},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := debug
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, v)
+			}
+		})
+	}
+
+	data, err := json.Marshal(map[string]level{"min": warn})
+	if err != nil || string(data) != `{"min":"WARN"}` {
+		t.Errorf("Unexpected encoding %s (err = %v)", data, err)
+	}
+	if _, err := json.Marshal(level(42)); err == nil {
+		t.Errorf("Expected an error encoding an unregistered value")
+	}
+}
+
+func TestText(t *testing.T) {
+	text, err := info.MarshalText()
+	if err != nil || string(text) != "info" {
+		t.Errorf("Expected info, got %s (err = %v)", text, err)
+	}
+	if _, err := level(42).MarshalText(); errors.Cause(err) != ErrUnknownValue {
+		t.Errorf("Expected ErrUnknownValue, got %v", err)
+	}
+
+	// levels are usable as map keys through encoding.TextMarshaler
+	var m map[level]int
+	if err := json.Unmarshal([]byte(`{"debug":1,"WARN":2}`), &m); err != nil || m[debug] != 1 || m[warn] != 2 {
+		t.Errorf("Unexpected map %v (err = %v)", m, err)
+	}
+}
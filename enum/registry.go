@@ -0,0 +1,160 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package enum provides Registry[T], which maps the constants of an enumerated type to their names and
+// implements the string, text, JSON and SQL conversions for the type from that single declaration.
+//
+// Go does not allow a package to add methods to another package's types, so each enumerated type
+// still declares its String(), MarshalText() and similar methods, but each one is a single call to
+// the registry:
+//
+//	type Color uint8
+//
+//	const (
+//		Red Color = iota + 1
+//		Green
+//	)
+//
+//	var colors = enum.New(map[Color]string{Red: "red", Green: "green"})
+//
+//	func (c Color) String() string                  { return colors.String(c) }
+//	func (c Color) MarshalText() ([]byte, error)     { return colors.EncodeText(c) }
+//	func (c *Color) UnmarshalText(text []byte) error { return colors.DecodeText(text, c) }
+//	func ParseColor(s string) (Color, error)         { return colors.Parse(s) }
+package enum
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnknownName is returned when parsing a name that is not registered
+	ErrUnknownName = errors.Errorf("enum: unknown name")
+	// ErrUnknownValue is returned when encoding a value that is not registered
+	ErrUnknownValue = errors.Errorf("enum: unknown value")
+)
+
+// Registry holds the names of the values of the enumerated type T.  Registries are created by New(),
+// are immutable and are safe for concurrent use.
+type Registry[T cmp.Ordered] struct {
+	typeName string
+	names    map[T]string
+	values   map[string]T
+	folded   map[string]T // lower case names that are unambiguous
+	ordered  []T
+}
+
+// New returns a registry for the specified values and names.
+//
+// New panics if a name is empty or is used for more than one value, since that is a programming error
+// in the declaration of the enumerated type.
+func New[T cmp.Ordered](names map[T]string) *Registry[T] {
+	r := &Registry[T]{
+		typeName: reflect.TypeFor[T]().String(),
+		names:    make(map[T]string, len(names)),
+		values:   make(map[string]T, len(names)),
+		folded:   make(map[string]T, len(names)),
+		ordered:  make([]T, 0, len(names)),
+	}
+	ambiguous := make(map[string]bool)
+	for v, name := range names {
+		if name == "" {
+			panic(fmt.Sprintf("enum: empty name for %s value %v", r.typeName, underlying(v)))
+		}
+		if other, ok := r.values[name]; ok {
+			panic(fmt.Sprintf("enum: %s values %v and %v are both named %q", r.typeName, underlying(other), underlying(v), name))
+		}
+		r.names[v], r.values[name] = name, v
+		r.ordered = append(r.ordered, v)
+
+		lower := strings.ToLower(name)
+		if _, ok := r.folded[lower]; ok {
+			ambiguous[lower] = true
+		}
+		r.folded[lower] = v
+	}
+	for lower := range ambiguous {
+		delete(r.folded, lower)
+	}
+	slices.Sort(r.ordered)
+	return r
+}
+
+// Values returns the registered values in ascending order
+func (r *Registry[T]) Values() []T {
+	return slices.Clone(r.ordered)
+}
+
+// Names returns the registered names in the same order as Values()
+func (r *Registry[T]) Names() []string {
+	names := make([]string, len(r.ordered))
+	for i, v := range r.ordered {
+		names[i] = r.names[v]
+	}
+	return names
+}
+
+// IsValid returns true if v is a registered value
+func (r *Registry[T]) IsValid(v T) bool {
+	_, ok := r.names[v]
+	return ok
+}
+
+// Name returns the name of v, or false if v is not registered
+func (r *Registry[T]) Name(v T) (string, bool) {
+	name, ok := r.names[v]
+	return name, ok
+}
+
+// String returns the name of v.  Unregistered values are formatted as the type name followed by the
+// value in parentheses, such as "main.Color(42)", which matches the output of the stringer tool.
+func (r *Registry[T]) String(v T) string {
+	if name, ok := r.names[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%v)", r.typeName, underlying(v))
+}
+
+// Parse returns the value with the specified name.  Names are matched exactly, then without regard to
+// case if no two names differ only by case.  If s does not match a name, ErrUnknownName is returned.
+func (r *Registry[T]) Parse(s string) (T, error) {
+	if v, ok := r.values[s]; ok {
+		return v, nil
+	}
+	if v, ok := r.folded[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	var zero T
+	return zero, errors.Wrapf(ErrUnknownName, "%s: %q", r.typeName, s)
+}
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in value
+func (r *Registry[T]) Must(v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// underlying returns v converted to its underlying type so that formatting it does not call a String()
+// method on T, which would recurse if that method calls Registry.String()
+func underlying[T cmp.Ordered](v T) interface{} {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	default:
+		return rv.String()
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"database/sql/driver"
+	"slices"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// level is an enumerated type that delegates all of its methods to a registry, as described in the
+// package documentation
+type level uint8
+
+const (
+	debug level = iota + 1
+	info
+	warn
+)
+
+var levels = New(map[level]string{debug: "debug", info: "info", warn: "WARN"})
+
+func (l level) String() string                   { return levels.String(l) }
+func (l level) MarshalText() ([]byte, error)     { return levels.EncodeText(l) }
+func (l *level) UnmarshalText(text []byte) error { return levels.DecodeText(text, l) }
+func (l level) MarshalJSON() ([]byte, error)     { return levels.EncodeJSON(l) }
+func (l *level) UnmarshalJSON(data []byte) error { return levels.DecodeJSON(data, l) }
+func (l level) Value() (driver.Value, error)     { return levels.Value(l) }
+func (l *level) Scan(src interface{}) error      { return levels.Scan(src, l) }
+
+func TestRegistry(t *testing.T) {
+	if got := levels.Values(); !slices.Equal(got, []level{debug, info, warn}) {
+		t.Errorf("Expected values in ascending order, got %v", got)
+	}
+	if got := levels.Names(); !slices.Equal(got, []string{"debug", "info", "WARN"}) {
+		t.Errorf("Expected names in value order, got %v", got)
+	}
+	if !levels.IsValid(info) || levels.IsValid(level(42)) {
+		t.Errorf("Unexpected IsValid() results")
+	}
+	if name, ok := levels.Name(warn); !ok || name != "WARN" {
+		t.Errorf("Expected (WARN, true), got (%s, %v)", name, ok)
+	}
+	if _, ok := levels.Name(level(0)); ok {
+		t.Errorf("Expected Name() to fail for an unregistered value")
+	}
+	if got := warn.String(); got != "WARN" {
+		t.Errorf("Expected WARN, got %s", got)
+	}
+	if got := level(42).String(); got != "enum.level(42)" {
+		t.Errorf("Expected enum.level(42), got %s", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	folded := New(map[string]string{"a": "Mixed", "b": "mixed", "c": "Other"})
+	cases := []struct {
+		name     string
+		r        func(string) (string, error)
+		s        string
+		expected string
+		err      error
+	}{
+		{"exact", folded.Parse, "Mixed", "a", nil},
+		{"exact lower", folded.Parse, "mixed", "b", nil},
+		{"ambiguous case", folded.Parse, "MIXED", "", ErrUnknownName},
+		{"case insensitive", folded.Parse, "OTHER", "c", nil},
+		{"unknown", folded.Parse, "nope", "", ErrUnknownName},
+		{"empty", folded.Parse, "", "", ErrUnknownName},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := tc.r(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, v)
+			}
+		})
+	}
+	if v := levels.Must(levels.Parse("warn")); v != warn {
+		t.Errorf("Expected warn, got %v", v)
+	}
+}
+
+func TestNewPanics(t *testing.T) {
+	cases := []struct {
+		name  string
+		names map[int]string
+	}{
+		{"empty name", map[int]string{1: ""}},
+		{"duplicate name", map[int]string{1: "one", 2: "one"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			defer func() {
+				if recover() == nil {
+					tt.Errorf("Expected New() to panic")
+				}
+			}()
+			New(tc.names)
+		})
+	}
+}
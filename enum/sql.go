@@ -0,0 +1,46 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"database/sql/driver"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by Registry.Scan() when the provided value is not a string
+	// or byte slice
+	ErrUnsupportedSourceType = errors.Errorf("enum: cannot convert the source data to an enumerated value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value returns the name of v, for use in a driver.Valuer implementation, so that enumerated values
+// are stored by name rather than by their underlying value.  If v is not registered, ErrUnknownValue
+// is returned.
+func (r *Registry[T]) Value(v T) (driver.Value, error) {
+	name, ok := r.names[v]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownValue, "%s(%v)", r.typeName, underlying(v))
+	}
+	return name, nil
+}
+
+// Scan parses a name read from the database and stores the result in dst, for use in a sql.Scanner
+// implementation.
+//
+// Strings and byte slices are handled by DecodeText().  All other values will return an error
+func (r *Registry[T]) Scan(src interface{}, dst *T) error {
+	switch tv := src.(type) {
+	case []byte:
+		return r.DecodeText(tv, dst)
+	case string:
+		return r.DecodeText([]byte(tv), dst)
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected level
+		err      error
+	}{
+		{"string", "info", info, nil},
+		{"bytes", []byte("WARN"), warn, nil},
+		{"unknown", "trace", debug, ErrUnknownName},
+		{"null", nil, debug, ErrUnsupportedSourceType},
+		{"unsupported type", int64(2), debug, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := debug
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestValue(t *testing.T) {
+	if v, err := warn.Value(); err != nil || v != "WARN" {
+		t.Errorf("Expected WARN, got %v (err = %v)", v, err)
+	}
+	if _, err := level(42).Value(); errors.Cause(err) != ErrUnknownValue {
+		t.Errorf("Expected ErrUnknownValue, got %v", err)
+	}
+}
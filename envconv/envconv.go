@@ -0,0 +1,59 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package envconv provides date and time of day field types for environment variable configuration
+// loaders, so 12-factor configurations can declare typed fields that are validated at startup.
+//
+// Most types in this module, and null.Value wrappers of them, implement encoding.TextUnmarshaler,
+// which both github.com/kelseyhightower/envconfig and github.com/caarlos0/env use for types they
+// don't otherwise support.  Both loaders prefer encoding.TextUnmarshaler to custom parsers.
+//
+// date.Value has no text encoding, and timeofday.Value requires seconds, so this package adds the
+// Date and TimeOfDay field types.  They implement envconfig.Decoder, envconfig.Setter and
+// encoding.TextUnmarshaler, parsing dates from YYYY-MM-DD and times of day from hh:mm or hh:mm:ss with
+// optional fractional seconds.  Optional settings can wrap them in null.Value, where an empty value
+// is NULL:
+//
+//	type config struct {
+//		Start  envconv.Date                  `env:"START"`
+//		Closes null.Value[envconv.TimeOfDay] `env:"CLOSES"`
+//	}
+//
+// Neither configuration package is imported.
+package envconv
+
+import (
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidValue is returned when an environment variable cannot be decoded to the target type
+	ErrInvalidValue = errors.Errorf("envconv: cannot decode the value to the target type")
+)
+
+// ParseDate parses a date formatted as YYYY-MM-DD.  If s is not a valid date, ErrInvalidValue is
+// returned.
+func ParseDate(s string) (date.Value, error) {
+	d, err := date.Parse("2006-01-02", s)
+	if err != nil {
+		return date.Nil, errors.Wrapf(ErrInvalidValue, "date %q", s)
+	}
+	return d, nil
+}
+
+// ParseTimeOfDay parses a time of day formatted as hh:mm or hh:mm:ss with optional fractional
+// seconds.  If s is not a valid time of day, ErrInvalidValue is returned.
+func ParseTimeOfDay(s string) (timeofday.Value, error) {
+	text := []byte(s)
+	if len(text) == 5 {
+		text = append(text, ":00"...)
+	}
+	var t timeofday.Value
+	if err := t.UnmarshalText(text); err != nil {
+		return timeofday.Zero, errors.Wrapf(ErrInvalidValue, "time of day %q", s)
+	}
+	return t, nil
+}
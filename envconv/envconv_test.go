@@ -0,0 +1,108 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package envconv
+
+import (
+	"testing"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestParseDate(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected date.Value
+		err      error
+	}{
+		{"valid", "2024-01-01", date.Must(date.FromUnits(2024, 1, 1)), nil},
+		{"empty", "", date.Nil, ErrInvalidValue},
+		{"invalid", "2024-02-30", date.Nil, ErrInvalidValue},
+		{"out of range", "1600-01-01", date.Nil, ErrInvalidValue},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := ParseDate(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected timeofday.Value
+		err      error
+	}{
+		{"minutes", "09:30", timeofday.Must(timeofday.FromUnits(9, 30, 0, 0)), nil},
+		{"seconds", "09:30:15", timeofday.Must(timeofday.FromUnits(9, 30, 15, 0)), nil},
+		{"fraction", "09:30:15.25", timeofday.Must(timeofday.FromUnits(9, 30, 15, 250000000)), nil},
+		{"empty", "", timeofday.Zero, ErrInvalidValue},
+		{"invalid", "24:00", timeofday.Zero, ErrInvalidValue},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := ParseTimeOfDay(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+type config struct {
+	Start     Date                       `env:"START"`
+	End       null.Value[Date]           `env:"END"`
+	Opens     TimeOfDay                  `env:"OPENS"`
+	Closes    null.Value[TimeOfDay]      `env:"CLOSES"`
+	MaxUpload bytesize.Value             `env:"MAX_UPLOAD"`
+	Cache     null.Value[bytesize.Value] `env:"CACHE"`
+}
+
+func TestCaarlos0Env(t *testing.T) {
+	opts := env.Options{
+		Environment: map[string]string{
+			"START":      "2024-01-01",
+			"END":        "",
+			"OPENS":      "09:30",
+			"CLOSES":     "17:00",
+			"MAX_UPLOAD": "10MiB",
+			"CACHE":      "1GiB",
+		},
+	}
+	got, err := env.ParseAsWithOptions[config](opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := config{
+		Start:     Date(date.Must(date.FromUnits(2024, 1, 1))),
+		Opens:     TimeOfDay(timeofday.Must(timeofday.FromUnits(9, 30, 0, 0))),
+		Closes:    null.From(TimeOfDay(timeofday.Must(timeofday.FromUnits(17, 0, 0, 0)))),
+		MaxUpload: 10 * bytesize.MiB,
+		Cache:     null.From(bytesize.GiB),
+	}
+	if got != expected {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	opts.Environment = map[string]string{"START": "2024-13-01"}
+	if _, err := env.ParseAsWithOptions[config](opts); errors.Cause(err) == nil {
+		t.Errorf("Expected an error for an invalid date")
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/dylan-bourque/go-types/envconv
+
+go 1.25.0
+
+require (
+	github.com/caarlos0/env/v11 v11.4.1
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	github.com/kelseyhightower/envconfig v1.4.0
+	github.com/pkg/errors v0.9.1
+)
+
+replace github.com/dylan-bourque/go-types => ../
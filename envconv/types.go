@@ -0,0 +1,66 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package envconv
+
+import (
+	"encoding"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+)
+
+// interface validations
+var _ encoding.TextUnmarshaler = (*Date)(nil)
+var _ encoding.TextUnmarshaler = (*TimeOfDay)(nil)
+
+// Date is a configuration field holding a date.Value.  An empty value decodes to date.Nil.
+type Date date.Value
+
+// Decode implements the envconfig.Decoder interface by parsing s with ParseDate()
+func (d *Date) Decode(s string) error {
+	if s == "" {
+		*d = Date(date.Nil)
+		return nil
+	}
+	v, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = Date(v)
+	return nil
+}
+
+// Set implements the envconfig.Setter interface.  It is the same as Decode().
+func (d *Date) Set(s string) error {
+	return d.Decode(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.  It is the same as Decode().
+func (d *Date) UnmarshalText(text []byte) error {
+	return d.Decode(string(text))
+}
+
+// TimeOfDay is a configuration field holding a timeofday.Value
+type TimeOfDay timeofday.Value
+
+// Decode implements the envconfig.Decoder interface by parsing s with ParseTimeOfDay()
+func (t *TimeOfDay) Decode(s string) error {
+	v, err := ParseTimeOfDay(s)
+	if err != nil {
+		return err
+	}
+	*t = TimeOfDay(v)
+	return nil
+}
+
+// Set implements the envconfig.Setter interface.  It is the same as Decode().
+func (t *TimeOfDay) Set(s string) error {
+	return t.Decode(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.  It is the same as Decode().
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	return t.Decode(string(text))
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package envconv
+
+import (
+	"testing"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+)
+
+func TestDateDecode(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected date.Value
+		err      error
+	}{
+		{"valid", "2024-07-14", date.Must(date.FromUnits(2024, 7, 14)), nil},
+		{"empty", "", date.Nil, nil},
+		{"invalid", "2024-07-32", date.Min, ErrInvalidValue},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			for _, decode := range []func(*Date) error{
+				func(d *Date) error { return d.Decode(tc.s) },
+				func(d *Date) error { return d.Set(tc.s) },
+				func(d *Date) error { return d.UnmarshalText([]byte(tc.s)) },
+			} {
+				d := Date(date.Min)
+				if err := decode(&d); errors.Cause(err) != tc.err {
+					tt.Fatalf("Expected error %v, got %v", tc.err, err)
+				}
+				if date.Value(d) != tc.expected {
+					tt.Errorf("Expected %v, got %v", tc.expected, date.Value(d))
+				}
+			}
+		})
+	}
+}
+
+func TestTimeOfDayDecode(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected timeofday.Value
+		err      error
+	}{
+		{"minutes", "09:30", timeofday.Must(timeofday.FromUnits(9, 30, 0, 0)), nil},
+		{"seconds", "09:30:15", timeofday.Must(timeofday.FromUnits(9, 30, 15, 0)), nil},
+		{"invalid", "9:30", timeofday.Max, ErrInvalidValue},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			for _, decode := range []func(*TimeOfDay) error{
+				func(v *TimeOfDay) error { return v.Decode(tc.s) },
+				func(v *TimeOfDay) error { return v.Set(tc.s) },
+				func(v *TimeOfDay) error { return v.UnmarshalText([]byte(tc.s)) },
+			} {
+				v := TimeOfDay(timeofday.Max)
+				if err := decode(&v); errors.Cause(err) != tc.err {
+					tt.Fatalf("Expected error %v, got %v", tc.err, err)
+				}
+				if timeofday.Value(v) != tc.expected {
+					tt.Errorf("Expected %v, got %v", tc.expected, timeofday.Value(v))
+				}
+			}
+		})
+	}
+}
+
+func TestEnvconfig(t *testing.T) {
+	type spec struct {
+		Start     Date                       `envconfig:"START"`
+		Opens     TimeOfDay                  `envconfig:"OPENS"`
+		MaxUpload bytesize.Value             `envconfig:"MAX_UPLOAD"`
+		Cache     null.Value[bytesize.Value] `envconfig:"CACHE"`
+	}
+	t.Setenv("APP_START", "2024-01-01")
+	t.Setenv("APP_OPENS", "09:30")
+	t.Setenv("APP_MAX_UPLOAD", "10MiB")
+	t.Setenv("APP_CACHE", "")
+
+	var got spec
+	if err := envconfig.Process("app", &got); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := spec{
+		Start:     Date(date.Must(date.FromUnits(2024, 1, 1))),
+		Opens:     TimeOfDay(timeofday.Must(timeofday.FromUnits(9, 30, 0, 0))),
+		MaxUpload: 10 * bytesize.MiB,
+	}
+	if got != expected {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	t.Setenv("APP_OPENS", "25:00")
+	if err := envconfig.Process("app", &got); errors.Cause(err) == nil {
+		t.Errorf("Expected an error for an invalid time of day")
+	}
+}
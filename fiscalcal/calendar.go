@@ -0,0 +1,323 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package fiscalcal
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+// Pattern identifies how the weeks or months of a fiscal year are grouped into periods
+type Pattern int
+
+const (
+	// Monthly defines a fiscal calendar whose periods are the 12 calendar months, starting at
+	// the configured start month
+	Monthly Pattern = iota
+	// Pattern445 defines a retail calendar with 4-week, 4-week and 5-week periods in each quarter
+	Pattern445
+	// Pattern454 defines a retail calendar with 4-week, 5-week and 4-week periods in each quarter
+	Pattern454
+	// Pattern544 defines a retail calendar with 5-week, 4-week and 4-week periods in each quarter
+	Pattern544
+)
+
+var (
+	// ErrInvalidConfig is returned by New() when the provided configuration is not valid
+	ErrInvalidConfig = errors.Errorf("fiscalcal: the calendar configuration is not valid")
+	// ErrInvalidFiscalUnit is returned when an out-of-range fiscal year, quarter, period or week is used
+	ErrInvalidFiscalUnit = errors.Errorf("fiscalcal: one or more of the specified fiscal units were invalid")
+)
+
+// the number of weeks in each period of a quarter for the retail patterns
+var weeksPerPeriod = map[Pattern][3]int{
+	Pattern445: {4, 4, 5},
+	Pattern454: {4, 5, 4},
+	Pattern544: {5, 4, 4},
+}
+
+// Config defines the options used to construct a fiscal calendar
+type Config struct {
+	// StartMonth is the calendar month, between 1 and 12, in which each fiscal year begins
+	StartMonth int
+	// Pattern is the grouping of the fiscal year into periods
+	Pattern Pattern
+	// WeekEnd is the day of the week on which each fiscal week, and so each retail fiscal year, ends.
+	// It is ignored for Monthly calendars.
+	WeekEnd time.Weekday
+	// Nearest controls how a retail fiscal year end is chosen.  If false, the year ends on the last
+	// WeekEnd day of the month before StartMonth.  If true, it ends on the WeekEnd day nearest to
+	// the last day of that month, which may fall in the first days of StartMonth.
+	Nearest bool
+	// NameByStartYear controls how fiscal years are numbered.  By default, a fiscal year is named
+	// for the calendar year in which it ends.  If true, it is named for the calendar year in which
+	// it starts instead.
+	NameByStartYear bool
+}
+
+// Calendar maps date.Value values to fiscal years, quarters, periods and weeks, and back
+type Calendar struct {
+	cfg Config
+}
+
+// Fiscal contains the fiscal units that identify a single date on a fiscal calendar
+type Fiscal struct {
+	// Year is the fiscal year, named according to Config.NameByStartYear
+	Year int
+	// Quarter is the fiscal quarter, between 1 and 4
+	Quarter int
+	// Period is the fiscal period (month), between 1 and 12
+	Period int
+	// Week is the week of the fiscal year, between 1 and 53
+	Week int
+	// Day is the day of the fiscal year, starting at 1
+	Day int
+}
+
+// New returns a Calendar using the specified configuration or ErrInvalidConfig if the configuration
+// is not valid.
+func New(cfg Config) (Calendar, error) {
+	if !date.IsValidMonth(cfg.StartMonth) {
+		return Calendar{}, errors.Wrapf(ErrInvalidConfig, "invalid start month: %d", cfg.StartMonth)
+	}
+	if _, ok := weeksPerPeriod[cfg.Pattern]; !ok && cfg.Pattern != Monthly {
+		return Calendar{}, errors.Wrapf(ErrInvalidConfig, "invalid pattern: %d", cfg.Pattern)
+	}
+	if cfg.WeekEnd < time.Sunday || cfg.WeekEnd > time.Saturday {
+		return Calendar{}, errors.Wrapf(ErrInvalidConfig, "invalid week end: %d", cfg.WeekEnd)
+	}
+	return Calendar{cfg: cfg}, nil
+}
+
+// Must is a helper that wraps a call to a function that returns (fiscalcal.Calendar, error)
+// and panics if err is non-nil.
+func Must(c Calendar, err error) Calendar {
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Config returns the configuration used to construct the calendar
+func (c Calendar) Config() Config {
+	return c.cfg
+}
+
+// IsRetail returns true if the calendar uses one of the week-based retail patterns
+func (c Calendar) IsRetail() bool {
+	return c.cfg.Pattern != Monthly
+}
+
+// YearStart returns the first day of the specified fiscal year
+func (c Calendar) YearStart(fy int) (date.Value, error) {
+	start, _, err := c.yearBounds(fy)
+	return start, err
+}
+
+// YearEnd returns the last day of the specified fiscal year
+func (c Calendar) YearEnd(fy int) (date.Value, error) {
+	_, end, err := c.yearBounds(fy)
+	return end, err
+}
+
+// WeeksInYear returns the number of weeks in the specified fiscal year.
+//
+// For retail calendars, this is either 52 or 53.  For monthly calendars, the last week of the year
+// may be a partial week so this is always 53.
+func (c Calendar) WeeksInYear(fy int) (int, error) {
+	start, end, err := c.yearBounds(fy)
+	if err != nil {
+		return 0, err
+	}
+	return int(int64(end)-int64(start))/7 + 1, nil
+}
+
+// PeriodStart returns the first day of the specified period, between 1 and 12, of fiscal year fy
+func (c Calendar) PeriodStart(fy, p int) (date.Value, error) {
+	start, _, err := c.periodBounds(fy, p)
+	return start, err
+}
+
+// PeriodEnd returns the last day of the specified period, between 1 and 12, of fiscal year fy
+func (c Calendar) PeriodEnd(fy, p int) (date.Value, error) {
+	_, end, err := c.periodBounds(fy, p)
+	return end, err
+}
+
+// QuarterStart returns the first day of the specified quarter, between 1 and 4, of fiscal year fy
+func (c Calendar) QuarterStart(fy, q int) (date.Value, error) {
+	if q < 1 || q > 4 {
+		return date.Nil, errors.Wrapf(ErrInvalidFiscalUnit, "invalid quarter: %d", q)
+	}
+	return c.PeriodStart(fy, (q-1)*3+1)
+}
+
+// QuarterEnd returns the last day of the specified quarter, between 1 and 4, of fiscal year fy
+func (c Calendar) QuarterEnd(fy, q int) (date.Value, error) {
+	if q < 1 || q > 4 {
+		return date.Nil, errors.Wrapf(ErrInvalidFiscalUnit, "invalid quarter: %d", q)
+	}
+	return c.PeriodEnd(fy, q*3)
+}
+
+// WeekStart returns the first day of the specified week of fiscal year fy
+func (c Calendar) WeekStart(fy, w int) (date.Value, error) {
+	n, err := c.WeeksInYear(fy)
+	if err != nil {
+		return date.Nil, err
+	}
+	if w < 1 || w > n {
+		return date.Nil, errors.Wrapf(ErrInvalidFiscalUnit, "invalid week: %d", w)
+	}
+	start, _ := c.YearStart(fy)
+	return start.AddDays((w - 1) * 7)
+}
+
+// FromDate returns the fiscal units for the specified date.
+//
+// If d is date.Nil or otherwise invalid, or if the containing fiscal year cannot be represented
+// between date.Min and date.Max, an error is returned.
+func (c Calendar) FromDate(d date.Value) (Fiscal, error) {
+	if !d.IsValid() {
+		return Fiscal{}, errors.Errorf("fiscalcal: cannot map an invalid date: %v", d)
+	}
+	// start with the fiscal year of the monthly calendar, which is at most one year away from
+	// the retail fiscal year, then adjust
+	y, m, _ := date.ToUnits(d)
+	fy := y
+	if c.cfg.StartMonth > 1 && m >= c.cfg.StartMonth {
+		fy++
+	}
+	if c.cfg.NameByStartYear && c.cfg.StartMonth > 1 {
+		fy--
+	}
+	for _, delta := range []int{0, -1, 1} {
+		start, end, err := c.yearBounds(fy + delta)
+		if err != nil || d < start || d > end {
+			continue
+		}
+		return c.units(fy+delta, start, d), nil
+	}
+	return Fiscal{}, errors.Errorf("fiscalcal: the fiscal year containing %v is out of range", d)
+}
+
+// units computes the fiscal units for d, which is known to be in fiscal year fy starting at start
+func (c Calendar) units(fy int, start, d date.Value) Fiscal {
+	offset := int(int64(d) - int64(start))
+	f := Fiscal{
+		Year: fy,
+		Week: offset/7 + 1,
+		Day:  offset + 1,
+	}
+	for p := 1; p <= 12; p++ {
+		_, end, _ := c.periodBounds(fy, p)
+		if d <= end {
+			f.Period = p
+			break
+		}
+	}
+	f.Quarter = (f.Period-1)/3 + 1
+	return f
+}
+
+// endYear returns the calendar year in which the specified fiscal year ends
+func (c Calendar) endYear(fy int) int {
+	if c.cfg.NameByStartYear && c.cfg.StartMonth > 1 {
+		return fy + 1
+	}
+	return fy
+}
+
+// monthlyEnd returns the last day of the calendar month before the start month in the calendar
+// year in which the specified fiscal year ends
+func (c Calendar) monthlyEnd(fy int) (date.Value, error) {
+	y, m := c.endYear(fy), c.cfg.StartMonth-1
+	if m == 0 {
+		m = 12
+	}
+	if !date.IsValidYear(y) {
+		return date.Nil, errors.Wrapf(ErrInvalidFiscalUnit, "invalid fiscal year: %d", fy)
+	}
+	return date.FromUnits(y, m, date.DaysInMonth(y, m))
+}
+
+// retailEnd returns the last day of the specified retail fiscal year
+func (c Calendar) retailEnd(fy int) (date.Value, error) {
+	me, err := c.monthlyEnd(fy)
+	if err != nil {
+		return date.Nil, err
+	}
+	// back up to the last week end day on or before the end of the month
+	delta := int(me.Weekday()-c.cfg.WeekEnd+7) % 7
+	if c.cfg.Nearest && delta > 3 {
+		delta -= 7
+	}
+	v, err := me.AddDays(-delta)
+	if err != nil {
+		return date.Nil, errors.Wrapf(ErrInvalidFiscalUnit, "invalid fiscal year: %d", fy)
+	}
+	return v, nil
+}
+
+// yearBounds returns the first and last days of the specified fiscal year
+func (c Calendar) yearBounds(fy int) (start, end date.Value, err error) {
+	if !c.IsRetail() {
+		if end, err = c.monthlyEnd(fy); err != nil {
+			return date.Nil, date.Nil, err
+		}
+		y := c.endYear(fy)
+		if c.cfg.StartMonth > 1 {
+			y--
+		}
+		if start, err = date.FromUnits(y, c.cfg.StartMonth, 1); err != nil {
+			return date.Nil, date.Nil, errors.Wrapf(ErrInvalidFiscalUnit, "invalid fiscal year: %d", fy)
+		}
+		return start, end, nil
+	}
+	if end, err = c.retailEnd(fy); err != nil {
+		return date.Nil, date.Nil, err
+	}
+	if start, err = c.retailEnd(fy - 1); err != nil {
+		return date.Nil, date.Nil, err
+	}
+	if start, err = start.AddDays(1); err != nil {
+		return date.Nil, date.Nil, errors.Wrapf(ErrInvalidFiscalUnit, "invalid fiscal year: %d", fy)
+	}
+	return start, end, nil
+}
+
+// periodBounds returns the first and last days of the specified period of fiscal year fy
+func (c Calendar) periodBounds(fy, p int) (start, end date.Value, err error) {
+	if p < 1 || p > 12 {
+		return date.Nil, date.Nil, errors.Wrapf(ErrInvalidFiscalUnit, "invalid period: %d", p)
+	}
+	ys, ye, err := c.yearBounds(fy)
+	if err != nil {
+		return date.Nil, date.Nil, err
+	}
+	if !c.IsRetail() {
+		y, m, _ := date.ToUnits(ys)
+		m += p - 1
+		if m > 12 {
+			y, m = y+1, m-12
+		}
+		start = date.Must(date.FromUnits(y, m, 1))
+		return start, start.EndOfMonth(), nil
+	}
+	// sum the weeks in the preceding periods
+	weeks := weeksPerPeriod[c.cfg.Pattern]
+	n := 0
+	for i := 1; i < p; i++ {
+		n += weeks[(i-1)%3]
+	}
+	start = date.Must(ys.AddDays(n * 7))
+	if p == 12 {
+		// the last period absorbs the extra week of a 53-week year
+		return start, ye, nil
+	}
+	return start, date.Must(start.AddDays(weeks[(p-1)%3]*7 - 1)), nil
+}
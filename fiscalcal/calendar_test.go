@@ -0,0 +1,206 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package fiscalcal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+// nrf is the National Retail Federation 4-5-4 calendar, which starts in February and ends on the
+// Saturday nearest the end of January
+var nrf = Must(New(Config{
+	StartMonth:      2,
+	Pattern:         Pattern454,
+	WeekEnd:         time.Saturday,
+	Nearest:         true,
+	NameByStartYear: true,
+}))
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name string
+		cfg  Config
+		err  error
+	}{
+		{"invalid start month/underflow", Config{StartMonth: 0}, ErrInvalidConfig},
+		{"invalid start month/overflow", Config{StartMonth: 13}, ErrInvalidConfig},
+		{"invalid pattern", Config{StartMonth: 1, Pattern: Pattern(42)}, ErrInvalidConfig},
+		{"invalid week end", Config{StartMonth: 1, WeekEnd: time.Weekday(7)}, ErrInvalidConfig},
+		{"monthly", Config{StartMonth: 10}, nil},
+		{"retail", Config{StartMonth: 2, Pattern: Pattern445, WeekEnd: time.Saturday}, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := New(tc.cfg)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestYearBounds(t *testing.T) {
+	cases := []struct {
+		name       string
+		cal        Calendar
+		fy         int
+		start, end date.Value
+		weeks      int
+	}{
+		{
+			"monthly/calendar year",
+			Must(New(Config{StartMonth: 1})),
+			2024,
+			date.Must(date.FromUnits(2024, 1, 1)), date.Must(date.FromUnits(2024, 12, 31)),
+			53,
+		},
+		{
+			"monthly/US federal",
+			Must(New(Config{StartMonth: 10})),
+			2025,
+			date.Must(date.FromUnits(2024, 10, 1)), date.Must(date.FromUnits(2025, 9, 30)),
+			53,
+		},
+		{
+			"monthly/named by start year",
+			Must(New(Config{StartMonth: 4, NameByStartYear: true})),
+			2024,
+			date.Must(date.FromUnits(2024, 4, 1)), date.Must(date.FromUnits(2025, 3, 31)),
+			53,
+		},
+		{
+			"retail/NRF 2022",
+			nrf,
+			2022,
+			date.Must(date.FromUnits(2022, 1, 30)), date.Must(date.FromUnits(2023, 1, 28)),
+			52,
+		},
+		{
+			"retail/NRF 2023 (53 weeks)",
+			nrf,
+			2023,
+			date.Must(date.FromUnits(2023, 1, 29)), date.Must(date.FromUnits(2024, 2, 3)),
+			53,
+		},
+		{
+			"retail/NRF 2024",
+			nrf,
+			2024,
+			date.Must(date.FromUnits(2024, 2, 4)), date.Must(date.FromUnits(2025, 2, 1)),
+			52,
+		},
+		{
+			"retail/last Saturday",
+			Must(New(Config{StartMonth: 2, Pattern: Pattern445, WeekEnd: time.Saturday})),
+			2025,
+			date.Must(date.FromUnits(2024, 1, 28)), date.Must(date.FromUnits(2025, 1, 25)),
+			52,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			start, err := tc.cal.YearStart(tc.fy)
+			if err != nil || start != tc.start {
+				tt.Errorf("Expected start %v, got %v (err = %v)", tc.start, start, err)
+			}
+			end, err := tc.cal.YearEnd(tc.fy)
+			if err != nil || end != tc.end {
+				tt.Errorf("Expected end %v, got %v (err = %v)", tc.end, end, err)
+			}
+			weeks, err := tc.cal.WeeksInYear(tc.fy)
+			if err != nil || weeks != tc.weeks {
+				tt.Errorf("Expected %d weeks, got %d (err = %v)", tc.weeks, weeks, err)
+			}
+		})
+	}
+}
+
+func TestPeriodBounds(t *testing.T) {
+	cases := []struct {
+		name       string
+		fy, p      int
+		start, end date.Value
+		err        error
+	}{
+		{"invalid period/underflow", 2024, 0, date.Nil, date.Nil, ErrInvalidFiscalUnit},
+		{"invalid period/overflow", 2024, 13, date.Nil, date.Nil, ErrInvalidFiscalUnit},
+		{"period 1", 2024, 1, date.Must(date.FromUnits(2024, 2, 4)), date.Must(date.FromUnits(2024, 3, 2)), nil},
+		{"period 2", 2024, 2, date.Must(date.FromUnits(2024, 3, 3)), date.Must(date.FromUnits(2024, 4, 6)), nil},
+		{"period 3", 2024, 3, date.Must(date.FromUnits(2024, 4, 7)), date.Must(date.FromUnits(2024, 5, 4)), nil},
+		{"period 12", 2024, 12, date.Must(date.FromUnits(2025, 1, 5)), date.Must(date.FromUnits(2025, 2, 1)), nil},
+		{"period 12 of a 53-week year", 2023, 12, date.Must(date.FromUnits(2023, 12, 31)), date.Must(date.FromUnits(2024, 2, 3)), nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			start, err := nrf.PeriodStart(tc.fy, tc.p)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if start != tc.start {
+				tt.Errorf("Expected start %v, got %v", tc.start, start)
+			}
+			end, _ := nrf.PeriodEnd(tc.fy, tc.p)
+			if end != tc.end {
+				tt.Errorf("Expected end %v, got %v", tc.end, end)
+			}
+		})
+	}
+}
+
+func TestQuarterAndWeekBounds(t *testing.T) {
+	q2, err := nrf.QuarterStart(2024, 2)
+	if err != nil || q2 != date.Must(date.FromUnits(2024, 5, 5)) {
+		t.Errorf("Unexpected Q2 start: %v (err = %v)", q2, err)
+	}
+	q4, err := nrf.QuarterEnd(2024, 4)
+	if err != nil || q4 != date.Must(date.FromUnits(2025, 2, 1)) {
+		t.Errorf("Unexpected Q4 end: %v (err = %v)", q4, err)
+	}
+	if _, err := nrf.QuarterStart(2024, 5); errors.Cause(err) != ErrInvalidFiscalUnit {
+		t.Errorf("Expected ErrInvalidFiscalUnit, got %v", err)
+	}
+	w53, err := nrf.WeekStart(2023, 53)
+	if err != nil || w53 != date.Must(date.FromUnits(2024, 1, 28)) {
+		t.Errorf("Unexpected week 53 start: %v (err = %v)", w53, err)
+	}
+	if _, err := nrf.WeekStart(2024, 53); errors.Cause(err) != ErrInvalidFiscalUnit {
+		t.Errorf("Expected ErrInvalidFiscalUnit, got %v", err)
+	}
+}
+
+func TestFromDate(t *testing.T) {
+	federal := Must(New(Config{StartMonth: 10}))
+	cases := []struct {
+		name     string
+		cal      Calendar
+		d        date.Value
+		expected Fiscal
+		valid    bool
+	}{
+		{"invalid date", nrf, date.Nil, Fiscal{}, false},
+		{"monthly/first day", federal, date.Must(date.FromUnits(2024, 10, 1)), Fiscal{2025, 1, 1, 1, 1}, true},
+		{"monthly/last day", federal, date.Must(date.FromUnits(2025, 9, 30)), Fiscal{2025, 4, 12, 53, 365}, true},
+		{"monthly/mid year", federal, date.Must(date.FromUnits(2025, 2, 14)), Fiscal{2025, 2, 5, 20, 137}, true},
+		{"retail/first day", nrf, date.Must(date.FromUnits(2024, 2, 4)), Fiscal{2024, 1, 1, 1, 1}, true},
+		{"retail/before start month", nrf, date.Must(date.FromUnits(2024, 2, 3)), Fiscal{2023, 4, 12, 53, 371}, true},
+		{"retail/after end month", nrf, date.Must(date.FromUnits(2025, 2, 1)), Fiscal{2024, 4, 12, 52, 364}, true},
+		{"retail/mid year", nrf, date.Must(date.FromUnits(2024, 7, 4)), Fiscal{2024, 2, 5, 22, 152}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.cal.FromDate(tc.d)
+			if tc.valid != (err == nil) {
+				tt.Errorf("Unexpected error result: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
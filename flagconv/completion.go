@@ -0,0 +1,101 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flagconv
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dylan-bourque/go-types/clock"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/spf13/cobra"
+)
+
+// byteSizeUnits are the unit suffixes suggested by CompleteByteSize()
+var byteSizeUnits = []string{"B", "kB", "KiB", "MB", "MiB", "GB", "GiB", "TB", "TiB"}
+
+// CompleteDate is a cobra.CompletionFunc for Date() flags.  It suggests yesterday, today and tomorrow
+// in the local time zone, formatted as YYYY-MM-DD.
+func CompleteDate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return CompleteDateWith(clock.System)(cmd, args, toComplete)
+}
+
+// CompleteDateWith returns a cobra.CompletionFunc for Date() flags that is the same as CompleteDate(),
+// using c to determine the current date
+func CompleteDateWith(c clock.Clock) cobra.CompletionFunc {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		today, err := date.FromTime(c.Now())
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var res []string
+		for i, desc := range []string{"yesterday", "today", "tomorrow"} {
+			d, err := today.AddDays(i - 1)
+			if err != nil {
+				continue
+			}
+			if s := d.String(); strings.HasPrefix(s, toComplete) {
+				res = append(res, s+"\t"+desc)
+			}
+		}
+		return res, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// CompleteTimeOfDay is a cobra.CompletionFunc for TimeOfDay() flags.  It suggests every half hour,
+// formatted as hh:mm.
+func CompleteTimeOfDay(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var res []string
+	for h := 0; h < 24; h++ {
+		for _, m := range []int{0, 30} {
+			if s := fmt.Sprintf("%02d:%02d", h, m); strings.HasPrefix(s, toComplete) {
+				res = append(res, s)
+			}
+		}
+	}
+	return res, cobra.ShellCompDirectiveNoFileComp
+}
+
+// CompleteWeekday is a cobra.CompletionFunc for Weekday() flags.  It suggests the lower case weekday
+// names that start with the text being completed, ignoring case.
+func CompleteWeekday(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var res []string
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if name := strings.ToLower(wd.String()); strings.HasPrefix(name, strings.ToLower(toComplete)) {
+			res = append(res, name)
+		}
+	}
+	return res, cobra.ShellCompDirectiveNoFileComp
+}
+
+// CompleteColor is a cobra.CompletionFunc for color.Value flags.  It suggests the CSS named colors
+// that start with the text being completed, ignoring case.
+func CompleteColor(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var res []string
+	for _, name := range color.Names() {
+		if strings.HasPrefix(name, strings.ToLower(toComplete)) {
+			res = append(res, name)
+		}
+	}
+	return res, cobra.ShellCompDirectiveNoFileComp
+}
+
+// CompleteByteSize is a cobra.CompletionFunc for bytesize.Value flags.  Once a number has been typed,
+// it suggests the number followed by each of the common SI and IEC unit suffixes.
+func CompleteByteSize(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	num := strings.TrimRight(toComplete, "BbIiKkMmGgTtPpEe")
+	if num == "" || strings.Trim(num, "0123456789.") != "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var res []string
+	for _, unit := range byteSizeUnits {
+		if s := num + unit; strings.HasPrefix(strings.ToLower(s), strings.ToLower(toComplete)) {
+			res = append(res, s)
+		}
+	}
+	return res, cobra.ShellCompDirectiveNoFileComp
+}
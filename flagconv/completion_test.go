@@ -0,0 +1,45 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flagconv
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/clock"
+	"github.com/spf13/cobra"
+)
+
+func TestCompletion(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC))
+	cases := []struct {
+		name       string
+		fn         cobra.CompletionFunc
+		toComplete string
+		expected   []string
+	}{
+		{"date", CompleteDateWith(fake), "", []string{"2024-02-28\tyesterday", "2024-02-29\ttoday", "2024-03-01\ttomorrow"}},
+		{"date prefix", CompleteDateWith(fake), "2024-03", []string{"2024-03-01\ttomorrow"}},
+		{"time of day", CompleteTimeOfDay, "09", []string{"09:00", "09:30"}},
+		{"time of day/no match", CompleteTimeOfDay, "25", nil},
+		{"weekday", CompleteWeekday, "T", []string{"tuesday", "thursday"}},
+		{"color", CompleteColor, "Rebecca", []string{"rebeccapurple"}},
+		{"byte size", CompleteByteSize, "10", []string{"10B", "10kB", "10KiB", "10MB", "10MiB", "10GB", "10GiB", "10TB", "10TiB"}},
+		{"byte size/unit prefix", CompleteByteSize, "1.5m", []string{"1.5MB", "1.5MiB"}},
+		{"byte size/no number", CompleteByteSize, "", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, directive := tc.fn(nil, nil, tc.toComplete)
+			if !reflect.DeepEqual(got, tc.expected) {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+			if directive != cobra.ShellCompDirectiveNoFileComp {
+				tt.Errorf("Expected file completion to be disabled, got %v", directive)
+			}
+		})
+	}
+}
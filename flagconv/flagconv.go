@@ -0,0 +1,25 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package flagconv provides pflag.Value implementations and cobra shell completion functions for the
+// types in this module, so command line tools built on github.com/spf13/cobra get typed flags:
+//
+//	var from date.Value
+//	cmd.Flags().Var(flagconv.Date(&from), "from", "first day of the report (YYYY-MM-DD)")
+//	_ = cmd.RegisterFlagCompletionFunc("from", flagconv.CompleteDate)
+//
+// Date() parses YYYY-MM-DD, TimeOfDay() parses hh:mm or hh:mm:ss with optional fractional seconds
+// and Weekday() parses English weekday names and their three letter abbreviations.  Text() adapts any
+// other type that implements encoding.TextUnmarshaler, which includes every other value type in this
+// module.
+package flagconv
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidFlagValue is returned by Set() when the flag value cannot be decoded to the target type
+	ErrInvalidFlagValue = errors.Errorf("flagconv: cannot decode the flag value to the target type")
+)
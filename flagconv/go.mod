@@ -0,0 +1,14 @@
+module github.com/dylan-bourque/go-types/flagconv
+
+go 1.25.0
+
+require (
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	github.com/pkg/errors v0.9.1
+	github.com/spf13/cobra v1.10.2
+	github.com/spf13/pflag v1.0.9
+)
+
+require github.com/inconshreveable/mousetrap v1.1.0 // indirect
+
+replace github.com/dylan-bourque/go-types => ../
@@ -0,0 +1,168 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flagconv
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// interface validations
+var _ pflag.Value = (*dateValue)(nil)
+var _ pflag.Value = (*timeOfDayValue)(nil)
+var _ pflag.Value = (*weekdayValue)(nil)
+var _ pflag.Value = (*textValue[bytesize.Value, *bytesize.Value])(nil)
+
+// dateValue is the pflag.Value for date.Value flags
+type dateValue date.Value
+
+// Date returns a pflag.Value that stores flag values in *p.  Values are formatted as YYYY-MM-DD and
+// date.Nil is the empty string.
+func Date(p *date.Value) pflag.Value {
+	return (*dateValue)(p)
+}
+
+// String implements the pflag.Value interface
+func (v *dateValue) String() string {
+	if d := date.Value(*v); d.IsValid() {
+		return d.String()
+	}
+	return ""
+}
+
+// Set implements the pflag.Value interface
+func (v *dateValue) Set(s string) error {
+	d, err := date.Parse("2006-01-02", s)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidFlagValue, "date %q", s)
+	}
+	*v = dateValue(d)
+	return nil
+}
+
+// Type implements the pflag.Value interface
+func (v *dateValue) Type() string {
+	return "date"
+}
+
+// timeOfDayValue is the pflag.Value for timeofday.Value flags
+type timeOfDayValue timeofday.Value
+
+// TimeOfDay returns a pflag.Value that stores flag values in *p.  Values are formatted as hh:mm or
+// hh:mm:ss with optional fractional seconds.
+func TimeOfDay(p *timeofday.Value) pflag.Value {
+	return (*timeOfDayValue)(p)
+}
+
+// String implements the pflag.Value interface
+func (v *timeOfDayValue) String() string {
+	return timeofday.Value(*v).String()
+}
+
+// Set implements the pflag.Value interface
+func (v *timeOfDayValue) Set(s string) error {
+	text := []byte(s)
+	if len(text) == 5 {
+		text = append(text, ":00"...)
+	}
+	var t timeofday.Value
+	if err := t.UnmarshalText(text); err != nil {
+		return errors.Wrapf(ErrInvalidFlagValue, "time of day %q", s)
+	}
+	*v = timeOfDayValue(t)
+	return nil
+}
+
+// Type implements the pflag.Value interface
+func (v *timeOfDayValue) Type() string {
+	return "timeofday"
+}
+
+// weekdayValue is the pflag.Value for time.Weekday flags
+type weekdayValue time.Weekday
+
+// Weekday returns a pflag.Value that stores flag values in *p.  Values are English weekday names or
+// their three letter abbreviations, in any case.
+func Weekday(p *time.Weekday) pflag.Value {
+	return (*weekdayValue)(p)
+}
+
+// String implements the pflag.Value interface
+func (v *weekdayValue) String() string {
+	return strings.ToLower(time.Weekday(*v).String())
+}
+
+// Set implements the pflag.Value interface
+func (v *weekdayValue) Set(s string) error {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if name := wd.String(); strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			*v = weekdayValue(wd)
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidFlagValue, "weekday %q", s)
+}
+
+// Type implements the pflag.Value interface
+func (v *weekdayValue) Type() string {
+	return "weekday"
+}
+
+// textValue is the pflag.Value for types that implement encoding.TextUnmarshaler
+type textValue[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}] struct {
+	p *T
+}
+
+// Text returns a pflag.Value that stores flag values in *p, decoded by the type's UnmarshalText()
+// method and formatted by its String() or MarshalText() method.  The type name shown in help text is
+// the name of the type's package, such as "bytesize" for bytesize.Value.
+func Text[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](p *T) pflag.Value {
+	return &textValue[T, PT]{p: p}
+}
+
+// String implements the pflag.Value interface
+func (v *textValue[T, PT]) String() string {
+	switch tv := any(*v.p).(type) {
+	case fmt.Stringer:
+		return tv.String()
+	case encoding.TextMarshaler:
+		text, _ := tv.MarshalText()
+		return string(text)
+	default:
+		return fmt.Sprint(*v.p)
+	}
+}
+
+// Set implements the pflag.Value interface
+func (v *textValue[T, PT]) Set(s string) error {
+	if err := PT(v.p).UnmarshalText([]byte(s)); err != nil {
+		return errors.Wrapf(ErrInvalidFlagValue, "%v", err)
+	}
+	return nil
+}
+
+// Type implements the pflag.Value interface
+func (v *textValue[T, PT]) Type() string {
+	t := reflect.TypeOf(v.p).Elem()
+	if pkg := t.PkgPath(); pkg != "" {
+		return pkg[strings.LastIndexByte(pkg, '/')+1:]
+	}
+	return t.String()
+}
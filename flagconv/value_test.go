@@ -0,0 +1,86 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flagconv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+func TestSet(t *testing.T) {
+	var (
+		d    date.Value
+		tod  timeofday.Value
+		wd   time.Weekday
+		size bytesize.Value
+	)
+	cases := []struct {
+		name     string
+		v        pflag.Value
+		s        string
+		expected string
+		typ      string
+		err      error
+	}{
+		{"date", Date(&d), "2024-07-14", "2024-07-14", "date", nil},
+		{"invalid date", Date(&d), "2024-07-32", "2024-07-14", "date", ErrInvalidFlagValue},
+		{"time of day/minutes", TimeOfDay(&tod), "09:30", "09:30:00", "timeofday", nil},
+		{"time of day/seconds", TimeOfDay(&tod), "09:30:15.5", "09:30:15.5", "timeofday", nil},
+		{"invalid time of day", TimeOfDay(&tod), "9:30", "09:30:15.5", "timeofday", ErrInvalidFlagValue},
+		{"weekday", Weekday(&wd), "Tuesday", "tuesday", "weekday", nil},
+		{"weekday abbreviation", Weekday(&wd), "FRI", "friday", "weekday", nil},
+		{"invalid weekday", Weekday(&wd), "fr", "friday", "weekday", ErrInvalidFlagValue},
+		{"text", Text(&size), "10MiB", (10 * bytesize.MiB).String(), "bytesize", nil},
+		{"invalid text", Text(&size), "ten", (10 * bytesize.MiB).String(), "bytesize", ErrInvalidFlagValue},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.v.Set(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got := tc.v.String(); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+			if got := tc.v.Type(); got != tc.typ {
+				tt.Errorf("Expected type %q, got %q", tc.typ, got)
+			}
+		})
+	}
+}
+
+func TestNilDateString(t *testing.T) {
+	d := date.Nil
+	if s := Date(&d).String(); s != "" {
+		t.Errorf("Expected an empty string for date.Nil, got %q", s)
+	}
+}
+
+func TestFlagSet(t *testing.T) {
+	var (
+		from  date.Value
+		at    timeofday.Value
+		limit = bytesize.MiB
+	)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(Date(&from), "from", "first day")
+	fs.Var(TimeOfDay(&at), "at", "time of day")
+	fs.Var(Text(&limit), "limit", "size limit")
+	if err := fs.Parse([]string{"--from", "2024-01-01", "--at=09:30", "--limit", "2GiB"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if from != date.Must(date.FromUnits(2024, 1, 1)) || at != timeofday.Must(timeofday.FromUnits(9, 30, 0, 0)) || limit != 2*bytesize.GiB {
+		t.Errorf("Unexpected flag values: %v, %v, %v", from, at, limit)
+	}
+	if err := fs.Parse([]string{"--from", "yesterday"}); err == nil {
+		t.Errorf("Expected an error for an invalid date")
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flexnum
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextData is returned from flexnum.Value.UnmarshalJSON() when the passed-in byte slice
+	// does not contain a number or a string
+	ErrInvalidTextData = errors.Errorf("flexnum.Value: can only decode JSON numbers and strings")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface for flexnum.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for flexnum.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	return append(b, v.text...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for flexnum.Value values.
+//
+// The text is parsed by Parse().  The encoding of v is not changed.
+func (v *Value) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	v.text = res.text
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for flexnum.Value values.
+//
+// Values are encoded as a JSON number or a JSON string, depending on their Encoding.  flexnum.Nil is
+// encoded as the special JSON null token.
+func (v Value) MarshalJSON() ([]byte, error) {
+	switch {
+	case v.IsNil():
+		return []byte("null"), nil
+	case v.enc == StringEncoding:
+		return []byte(`"` + v.text + `"`), nil
+	default:
+		return []byte(v.text), nil
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for flexnum.Value values.
+//
+// Both JSON numbers and JSON strings containing a number are accepted, and the encoding of v is set
+// to match, so the value is marshaled back in the same form.  If the value is the special JSON null
+// token or an empty string, v is set to flexnum.Nil.
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		*v = Nil
+		return nil
+	}
+	if len(p) > 0 && p[0] == '"' {
+		var s string
+		if err := json.Unmarshal(p, &s); err != nil {
+			return errors.Wrapf(ErrInvalidTextData, "%v", err)
+		}
+		res, err := Parse(s)
+		if err != nil {
+			return err
+		}
+		if res.IsNil() {
+			*v = Nil
+			return nil
+		}
+		*v = res.WithEncoding(StringEncoding)
+		return nil
+	}
+	if !isValidNumber(string(p)) {
+		return errors.Wrapf(ErrInvalidTextData, "%s", p)
+	}
+	*v = Value{text: string(p)}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flexnum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	type doc struct {
+		Amount Value `json:"amount"`
+	}
+	cases := []struct {
+		name     string
+		data     string
+		text     string
+		encoding Encoding
+		err      error
+	}{
+		{"number", `{"amount":12.50}`, "12.50", NumberEncoding, nil},
+		{"string", `{"amount":"12.50"}`, "12.50", StringEncoding, nil},
+		{"large integer", `{"amount":12345678901234567890123}`, "12345678901234567890123", NumberEncoding, nil},
+		{"large string", `{"amount":"0.12345678901234567890123"}`, "0.12345678901234567890123", StringEncoding, nil},
+		{"null", `{"amount":null}`, "", NumberEncoding, nil},
+		{"boolean", `{"amount":true}`, "", NumberEncoding, ErrInvalidTextData},
+		{"object", `{"amount":{}}`, "", NumberEncoding, ErrInvalidTextData},
+		{"non-numeric string", `{"amount":"12 USD"}`, "", NumberEncoding, ErrInvalidNumber},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := doc{Amount: FromInt64(1)}
+			err := json.Unmarshal([]byte(tc.data), &d)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if d.Amount.String() != tc.text || d.Amount.Encoding() != tc.encoding {
+				tt.Errorf("Expected (%q, %v), got (%q, %v)", tc.text, tc.encoding, d.Amount, d.Amount.Encoding())
+			}
+			if data, err := json.Marshal(d); err != nil || string(data) != tc.data {
+				tt.Errorf("Expected %s to round-trip, got %s (err = %v)", tc.data, data, err)
+			}
+		})
+	}
+}
+
+func TestJSONEmptyString(t *testing.T) {
+	v := FromInt64(1)
+	if err := json.Unmarshal([]byte(`""`), &v); err != nil || v != Nil {
+		t.Errorf("Expected an empty string to decode as Nil, got %q (err = %v)", v, err)
+	}
+}
+
+func TestWithEncoding(t *testing.T) {
+	v := Must(Parse("99.95"))
+	cases := []struct {
+		name     string
+		enc      Encoding
+		expected string
+	}{
+		{"number", NumberEncoding, `99.95`},
+		{"string", StringEncoding, `"99.95"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := json.Marshal(v.WithEncoding(tc.enc))
+			if err != nil || string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.expected, data, err)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	v := Must(Parse("1.5e3")).WithEncoding(StringEncoding)
+	text, _ := v.MarshalText()
+	if string(text) != "1.5e3" {
+		t.Errorf("Expected 1.5e3, got %s", text)
+	}
+	var got Value
+	if err := got.UnmarshalText([]byte("-7")); err != nil || got.String() != "-7" {
+		t.Errorf("Expected -7, got %q (err = %v)", got, err)
+	}
+	if err := got.UnmarshalText([]byte("seven")); errors.Cause(err) != ErrInvalidNumber {
+		t.Errorf("Expected ErrInvalidNumber, got %v", err)
+	}
+}
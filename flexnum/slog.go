@@ -0,0 +1,21 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flexnum
+
+import (
+	"log/slog"
+)
+
+// interface validations
+var _ slog.LogValuer = (*Value)(nil)
+
+// LogValue implements the slog.LogValuer interface for flexnum.Value values, so structured logs
+// show the exact decimal string returned by String().  flexnum.Nil is logged as null.
+func (v Value) LogValue() slog.Value {
+	if v.IsNil() {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(v.String())
+}
@@ -0,0 +1,243 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flexnum
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Value is a number that can be decoded from either a JSON number, such as 12.50, or a JSON string
+// containing a number, such as "12.50", which many third-party APIs use to avoid precision loss.  The
+// number is kept as its original decimal text, so no precision is lost, and it is converted on demand
+// by Int64(), Float64() and Rat().
+//
+// Each Value also has an Encoding that controls whether it is marshaled as a JSON number or a JSON
+// string.  Decoding keeps the form of the input so that values round-trip unchanged, and WithEncoding()
+// can be used to choose a different form.
+//
+// The zero value is flexnum.Nil, which is encoded as JSON null.
+type Value struct {
+	text string
+	enc  Encoding
+}
+
+// Encoding specifies how a Value is marshaled to JSON
+type Encoding uint8
+
+const (
+	// NumberEncoding encodes values as JSON numbers, such as 12.50
+	NumberEncoding Encoding = iota
+	// StringEncoding encodes values as JSON strings, such as "12.50"
+	StringEncoding
+)
+
+var (
+	// Nil represents a nil/null/undefined number
+	Nil = Value{}
+)
+
+var (
+	// ErrInvalidNumber is returned when a string does not contain a number in JSON syntax
+	ErrInvalidNumber = errors.Errorf("flexnum: the specified text is not a valid number")
+	// ErrNotAnInteger is returned by Int64() and Uint64() when the number has a fractional part
+	ErrNotAnInteger = errors.Errorf("flexnum: the number is not an integer")
+	// ErrOutOfRange is returned when the number cannot be represented by the requested type
+	ErrOutOfRange = errors.Errorf("flexnum: the number is out of range for the requested type")
+	// ErrNilValue is returned when converting flexnum.Nil to a number
+	ErrNilValue = errors.Errorf("flexnum: cannot convert a nil value to a number")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in flexnum.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Parse returns a Value for the specified text, which must be a number in JSON syntax, such as "-12",
+// "0.5" or "6.02e23".  The text is kept exactly as provided.  An empty string is parsed as Nil.
+func Parse(s string) (Value, error) {
+	if s == "" {
+		return Nil, nil
+	}
+	if !isValidNumber(s) {
+		return Nil, errors.Wrapf(ErrInvalidNumber, "%q", s)
+	}
+	return Value{text: s}, nil
+}
+
+// FromInt64 returns a Value for the specified integer
+func FromInt64(n int64) Value {
+	return Value{text: strconv.FormatInt(n, 10)}
+}
+
+// FromFloat64 returns a Value for the specified float, using the shortest text that represents it
+// exactly.  NaN and infinite values cannot be represented in JSON and return ErrInvalidNumber.
+func FromFloat64(f float64) (Value, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Nil, errors.Wrapf(ErrInvalidNumber, "%v", f)
+	}
+	return Value{text: strconv.FormatFloat(f, 'g', -1, 64)}, nil
+}
+
+// isValidNumber returns true if s matches the JSON number grammar:
+//
+//	-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?
+func isValidNumber(s string) bool {
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(s) && s[i] == '0':
+		i++
+	case i < len(s) && '1' <= s[i] && s[i] <= '9':
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	default:
+		return false
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		if i == len(s) || !isDigit(s[i]) {
+			return false
+		}
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		if i == len(s) || !isDigit(s[i]) {
+			return false
+		}
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	}
+	return i == len(s)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// IsNil returns true if v is flexnum.Nil
+func (v Value) IsNil() bool {
+	return v.text == ""
+}
+
+// Encoding returns the form v is marshaled to in JSON
+func (v Value) Encoding() Encoding {
+	return v.enc
+}
+
+// WithEncoding returns a copy of v that is marshaled to JSON using the specified form
+func (v Value) WithEncoding(e Encoding) Value {
+	v.enc = e
+	return v
+}
+
+// Equal returns true if v and other represent the same number, regardless of their text or encoding,
+// such as "1.50" and "1.5e0"
+func (v Value) Equal(other Value) bool {
+	if v.IsNil() || other.IsNil() {
+		return v.IsNil() == other.IsNil()
+	}
+	a, b := v.Rat(), other.Rat()
+	if a == nil || b == nil {
+		return v.text == other.text
+	}
+	return a.Cmp(b) == 0
+}
+
+// Rat returns the exact value of v as a big.Rat.  It returns nil for flexnum.Nil and for numbers with
+// exponents too large for math/big to expand, such as 1e100000000.
+func (v Value) Rat() *big.Rat {
+	if v.IsNil() {
+		return nil
+	}
+	r, _ := new(big.Rat).SetString(v.text)
+	return r
+}
+
+// Int64 returns v as an int64.  Values with a fractional part return ErrNotAnInteger and values
+// outside of the int64 range return ErrOutOfRange.  Exponents are allowed, so "1.5e3" is 1500.
+func (v Value) Int64() (int64, error) {
+	if v.IsNil() {
+		return 0, ErrNilValue
+	}
+	if n, err := strconv.ParseInt(v.text, 10, 64); err == nil {
+		return n, nil
+	}
+	r := v.Rat()
+	switch {
+	case r == nil:
+		return 0, errors.Wrapf(ErrOutOfRange, "%s", v.text)
+	case !r.IsInt():
+		return 0, errors.Wrapf(ErrNotAnInteger, "%s", v.text)
+	case !r.Num().IsInt64():
+		return 0, errors.Wrapf(ErrOutOfRange, "%s", v.text)
+	default:
+		return r.Num().Int64(), nil
+	}
+}
+
+// Uint64 returns v as a uint64.  Values with a fractional part return ErrNotAnInteger and values
+// outside of the uint64 range, including negative values, return ErrOutOfRange.
+func (v Value) Uint64() (uint64, error) {
+	if v.IsNil() {
+		return 0, ErrNilValue
+	}
+	if n, err := strconv.ParseUint(v.text, 10, 64); err == nil {
+		return n, nil
+	}
+	r := v.Rat()
+	switch {
+	case r == nil:
+		return 0, errors.Wrapf(ErrOutOfRange, "%s", v.text)
+	case !r.IsInt():
+		return 0, errors.Wrapf(ErrNotAnInteger, "%s", v.text)
+	case !r.Num().IsUint64():
+		return 0, errors.Wrapf(ErrOutOfRange, "%s", v.text)
+	default:
+		return r.Num().Uint64(), nil
+	}
+}
+
+// Float64 returns v as the nearest float64.  Values whose magnitude is too large for a float64 return
+// ErrOutOfRange.
+func (v Value) Float64() (float64, error) {
+	if v.IsNil() {
+		return 0, ErrNilValue
+	}
+	f, err := strconv.ParseFloat(v.text, 64)
+	if err != nil {
+		return 0, errors.Wrapf(ErrOutOfRange, "%s", v.text)
+	}
+	return f, nil
+}
+
+// Number returns v as a json.Number
+func (v Value) Number() json.Number {
+	return json.Number(v.text)
+}
+
+// String implements fmt.Stringer for flexnum.Value instances.
+//
+// The returned string is the original text of the number, or an empty string for flexnum.Nil.
+func (v Value) String() string {
+	return v.text
+}
@@ -0,0 +1,169 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package flexnum
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name string
+		s    string
+		err  error
+	}{
+		{"empty", "", nil},
+		{"zero", "0", nil},
+		{"negative", "-12", nil},
+		{"fraction", "12.50", nil},
+		{"exponent", "6.02e23", nil},
+		{"signed exponent", "1E-7", nil},
+		{"beyond float64 precision", "12345678901234567890.123456789", nil},
+		{"leading plus", "+1", ErrInvalidNumber},
+		{"leading zero", "007", ErrInvalidNumber},
+		{"trailing dot", "1.", ErrInvalidNumber},
+		{"leading dot", ".5", ErrInvalidNumber},
+		{"missing exponent", "1e", ErrInvalidNumber},
+		{"whitespace", " 1", ErrInvalidNumber},
+		{"minus only", "-", ErrInvalidNumber},
+		{"hex", "0x10", ErrInvalidNumber},
+		{"nan", "NaN", ErrInvalidNumber},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && v.String() != tc.s {
+				tt.Errorf("Expected the text to be preserved as %q, got %q", tc.s, v)
+			}
+		})
+	}
+}
+
+func TestInt64(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected int64
+		err      error
+	}{
+		{"integer", "-42", -42, nil},
+		{"min", "-9223372036854775808", math.MinInt64, nil},
+		{"trailing zero fraction", "12.000", 12, nil},
+		{"exponent", "1.5e3", 1500, nil},
+		{"fraction", "12.5", 0, ErrNotAnInteger},
+		{"overflow", "9223372036854775808", 0, ErrOutOfRange},
+		{"huge exponent", "1e999999999", 0, ErrOutOfRange},
+		{"nil", "", 0, ErrNilValue},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			n, err := Must(Parse(tc.s)).Int64()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if n != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, n)
+			}
+		})
+	}
+}
+
+func TestUint64(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected uint64
+		err      error
+	}{
+		{"integer", "42", 42, nil},
+		{"max", "18446744073709551615", math.MaxUint64, nil},
+		{"exponent", "2E2", 200, nil},
+		{"negative", "-1", 0, ErrOutOfRange},
+		{"fraction", "0.5", 0, ErrNotAnInteger},
+		{"nil", "", 0, ErrNilValue},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			n, err := Must(Parse(tc.s)).Uint64()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if n != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, n)
+			}
+		})
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected float64
+		err      error
+	}{
+		{"integer", "42", 42, nil},
+		{"fraction", "-0.25", -0.25, nil},
+		{"exponent", "6.02e23", 6.02e23, nil},
+		{"overflow", "1e400", 0, ErrOutOfRange},
+		{"nil", "", 0, ErrNilValue},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			f, err := Must(Parse(tc.s)).Float64()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if f != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, f)
+			}
+		})
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	if v := FromInt64(math.MinInt64); v.String() != "-9223372036854775808" {
+		t.Errorf("Unexpected FromInt64() result %q", v)
+	}
+	if v := Must(FromFloat64(0.1)); v.String() != "0.1" {
+		t.Errorf("Unexpected FromFloat64() result %q", v)
+	}
+	if v := Must(FromFloat64(1e21)); v.String() != "1e+21" || !isValidNumber(v.String()) {
+		t.Errorf("Unexpected FromFloat64() result %q", v)
+	}
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := FromFloat64(f); errors.Cause(err) != ErrInvalidNumber {
+			t.Errorf("Expected ErrInvalidNumber for %v, got %v", f, err)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     Value
+		expected bool
+	}{
+		{"same text", Must(Parse("1.5")), Must(Parse("1.5")), true},
+		{"different text", Must(Parse("1.50")), Must(Parse("15e-1")), true},
+		{"different encoding", Must(Parse("1")), Must(Parse("1")).WithEncoding(StringEncoding), true},
+		{"different numbers", Must(Parse("1")), Must(Parse("1.0001")), false},
+		{"nil", Nil, Nil, true},
+		{"nil and zero", Nil, Must(Parse("0")), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.a.Equal(tc.b); got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package formconv provides the decode functions that HTTP form and query string decoders need to
+// populate fields of the types in this module, so handlers can decode parameters such as
+// ?from=2024-01-01&at=09:30 directly into struct fields.
+//
+// Decoders() returns one Decoder per type, with methods matching the custom type hooks of
+// github.com/gorilla/schema and github.com/go-playground/form:
+//
+//	dec := schema.NewDecoder()
+//	for _, d := range formconv.Decoders() {
+//		dec.RegisterConverter(d.Value, d.Convert)
+//	}
+//
+//	dec := form.NewDecoder()
+//	for _, d := range formconv.Decoders() {
+//		dec.RegisterCustomTypeFunc(d.DecodeForm, d.Value)
+//	}
+//
+// Most types are decoded by their encoding.TextUnmarshaler implementation.  date.Value, which has no
+// text encoding, is decoded from YYYY-MM-DD, and timeofday.Value also accepts hh:mm, the format sent
+// by HTML time inputs.  An empty parameter decodes to the zero value of the type, or to date.Nil for
+// dates.  Neither decoder package is imported.
+package formconv
+
+import (
+	"encoding"
+	"reflect"
+
+	"github.com/dylan-bourque/go-types/b64bytes"
+	"github.com/dylan-bourque/go-types/b64urlbytes"
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/emailaddr"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/hexbytes"
+	"github.com/dylan-bourque/go-types/hostport"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/dylan-bourque/go-types/ulid"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidFormValue is returned when a form value cannot be decoded to the target type
+	ErrInvalidFormValue = errors.Errorf("formconv: cannot decode the form value to the target type")
+)
+
+// Decoder decodes form values to a single type
+type Decoder struct {
+	// Value is the zero value of the decoded type, which identifies the type when registering the
+	// decode functions
+	Value interface{}
+	parse func(string) (interface{}, error)
+}
+
+// Decode decodes s to a value of the target type.  If s cannot be decoded, ErrInvalidFormValue is
+// returned.
+func (d Decoder) Decode(s string) (interface{}, error) {
+	v, err := d.parse(s)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidFormValue, "%T from %q: %v", d.Value, s, err)
+	}
+	return v, nil
+}
+
+// Convert decodes s to a value of the target type, returning an invalid reflect.Value if s cannot be
+// decoded.  Its signature matches the schema.Converter type of github.com/gorilla/schema.
+func (d Decoder) Convert(s string) reflect.Value {
+	v, err := d.parse(s)
+	if err != nil {
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(v)
+}
+
+// DecodeForm decodes the first of vals to a value of the target type.  Its signature matches the
+// form.DecodeCustomTypeFunc type of github.com/go-playground/form.
+func (d Decoder) DecodeForm(vals []string) (interface{}, error) {
+	var s string
+	if len(vals) > 0 {
+		s = vals[0]
+	}
+	return d.Decode(s)
+}
+
+// Decoders returns a Decoder for each of the types in this module that can be represented as a single
+// form value
+func Decoders() []Decoder {
+	return []Decoder{
+		{Value: date.Nil, parse: parseDate},
+		{Value: timeofday.Zero, parse: parseTimeOfDay},
+		textDecoder[partialdate.Value](),
+		textDecoder[ulid.Value](),
+		textDecoder[snowflake.Value](),
+		textDecoder[int128.Value](),
+		textDecoder[uint128.Value](),
+		textDecoder[ratio.Value](),
+		textDecoder[bytesize.Value](),
+		textDecoder[flexnum.Value](),
+		textDecoder[color.Value](),
+		textDecoder[emailaddr.Value](),
+		textDecoder[hostport.Value](),
+		textDecoder[langtag.Value](),
+		textDecoder[inet.Addr](),
+		textDecoder[inet.Prefix](),
+		textDecoder[hexbytes.Value](),
+		textDecoder[b64bytes.Value](),
+		textDecoder[b64urlbytes.Value](),
+	}
+}
+
+// textDecoder returns a Decoder that decodes values with the encoding.TextUnmarshaler implementation
+// of *T
+func textDecoder[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}]() Decoder {
+	var zero T
+	return Decoder{
+		Value: zero,
+		parse: func(s string) (interface{}, error) {
+			var v T
+			if s == "" {
+				return v, nil
+			}
+			if err := PT(&v).UnmarshalText([]byte(s)); err != nil {
+				return nil, err
+			}
+			return v, nil
+		},
+	}
+}
+
+// parseDate decodes a date formatted as YYYY-MM-DD, or date.Nil from an empty string
+func parseDate(s string) (interface{}, error) {
+	if s == "" {
+		return date.Nil, nil
+	}
+	return date.Parse("2006-01-02", s)
+}
+
+// parseTimeOfDay decodes a time of day formatted as hh:mm or hh:mm:ss with optional fractional
+// seconds, or timeofday.Zero from an empty string
+func parseTimeOfDay(s string) (interface{}, error) {
+	if s == "" {
+		return timeofday.Zero, nil
+	}
+	if len(s) == 5 {
+		s += ":00"
+	}
+	var v timeofday.Value
+	if err := v.UnmarshalText([]byte(s)); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
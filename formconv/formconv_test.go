@@ -0,0 +1,149 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package formconv
+
+import (
+	"net/netip"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/hexbytes"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/ulid"
+	"github.com/go-playground/form/v4"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+)
+
+func TestDecode(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    interface{}
+		s        string
+		expected interface{}
+		err      error
+	}{
+		{"date", date.Nil, "2024-01-01", date.Must(date.FromUnits(2024, 1, 1)), nil},
+		{"empty date", date.Nil, "", date.Nil, nil},
+		{"invalid date", date.Nil, "2024-02-30", nil, ErrInvalidFormValue},
+		{"out of range date", date.Nil, "1600-01-01", nil, ErrInvalidFormValue},
+		{"time of day/minutes", timeofday.Zero, "09:30", timeofday.Must(timeofday.FromUnits(9, 30, 0, 0)), nil},
+		{"time of day/seconds", timeofday.Zero, "09:30:15.5", timeofday.Must(timeofday.FromUnits(9, 30, 15, 500000000)), nil},
+		{"invalid time of day", timeofday.Zero, "9:30", nil, ErrInvalidFormValue},
+		{"bytesize", bytesize.Value(0), "10MiB", 10 * bytesize.MiB, nil},
+		{"empty bytesize", bytesize.Value(0), "", bytesize.Value(0), nil},
+		{"ulid", ulid.Nil, ulid.Max.String(), ulid.Max, nil},
+		{"invalid ulid", ulid.Nil, "nope", nil, ErrInvalidFormValue},
+		{"empty hex bytes", hexbytes.Value{}, "", hexbytes.Value(nil), nil},
+	}
+	decoders := make(map[reflect.Type]Decoder)
+	for _, d := range Decoders() {
+		decoders[reflect.TypeOf(d.Value)] = d
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d, ok := decoders[reflect.TypeOf(tc.value)]
+			if !ok {
+				tt.Fatalf("No decoder for %T", tc.value)
+			}
+			got, err := d.Decode(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			rv := d.Convert(tc.s)
+			if rv.IsValid() != (tc.err == nil) {
+				tt.Errorf("Expected Convert() to return a valid value only on success")
+			}
+		})
+	}
+}
+
+func TestDecodersRoundTrip(t *testing.T) {
+	samples := map[reflect.Type]string{
+		reflect.TypeOf(date.Nil):       "2024-07-14",
+		reflect.TypeOf(timeofday.Zero): "13:45:30.5",
+		reflect.TypeOf(inet.Addr{}):    "192.168.1.1",
+		reflect.TypeOf(inet.Prefix{}):  "10.0.0.0/8",
+	}
+	for _, d := range Decoders() {
+		typ := reflect.TypeOf(d.Value)
+		t.Run(typ.String(), func(tt *testing.T) {
+			got, err := d.Decode("")
+			if err != nil || reflect.TypeOf(got) != typ {
+				tt.Fatalf("Expected the zero %v for an empty value, got %#v (err = %v)", typ, got, err)
+			}
+			s, ok := samples[typ]
+			if !ok {
+				return
+			}
+			if got, err = d.Decode(s); err != nil || reflect.TypeOf(got) != typ {
+				tt.Fatalf("Expected a %v from %q, got %#v (err = %v)", typ, s, got, err)
+			}
+		})
+	}
+}
+
+type query struct {
+	From date.Value      `schema:"from" form:"from"`
+	At   timeofday.Value `schema:"at" form:"at"`
+	Max  bytesize.Value  `schema:"max" form:"max"`
+	Peer inet.Addr       `schema:"peer" form:"peer"`
+}
+
+var (
+	testValues = url.Values{
+		"from": {"2024-01-01"},
+		"at":   {"09:30"},
+		"max":  {"10MiB"},
+		"peer": {"::1"},
+	}
+	expectedQuery = query{
+		From: date.Must(date.FromUnits(2024, 1, 1)),
+		At:   timeofday.Must(timeofday.FromUnits(9, 30, 0, 0)),
+		Max:  10 * bytesize.MiB,
+		Peer: inet.Addr{Addr: netip.MustParseAddr("::1")},
+	}
+)
+
+func TestGorillaSchema(t *testing.T) {
+	dec := schema.NewDecoder()
+	for _, d := range Decoders() {
+		dec.RegisterConverter(d.Value, d.Convert)
+	}
+	var got query
+	if err := dec.Decode(&got, testValues); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != expectedQuery {
+		t.Errorf("Expected %+v, got %+v", expectedQuery, got)
+	}
+	if err := dec.Decode(&got, url.Values{"from": {"2024-13-01"}}); err == nil {
+		t.Errorf("Expected an error for an invalid date")
+	}
+}
+
+func TestPlaygroundForm(t *testing.T) {
+	dec := form.NewDecoder()
+	for _, d := range Decoders() {
+		dec.RegisterCustomTypeFunc(d.DecodeForm, d.Value)
+	}
+	var got query
+	if err := dec.Decode(&got, testValues); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != expectedQuery {
+		t.Errorf("Expected %+v, got %+v", expectedQuery, got)
+	}
+	if err := dec.Decode(&got, url.Values{"at": {"25:00"}}); err == nil {
+		t.Errorf("Expected an error for an invalid time of day")
+	}
+}
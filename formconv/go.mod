@@ -0,0 +1,14 @@
+module github.com/dylan-bourque/go-types/formconv
+
+go 1.25.0
+
+require (
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	github.com/go-playground/form/v4 v4.2.1
+	github.com/gorilla/schema v1.4.1
+	github.com/pkg/errors v0.9.1
+)
+
+require golang.org/x/text v0.40.0 // indirect
+
+replace github.com/dylan-bourque/go-types => ../
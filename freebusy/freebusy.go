@@ -0,0 +1,180 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package freebusy computes the free time remaining within a set of bounds after removing a list of
+// busy, or booked, spans.  It is the core of availability search and supports both whole-day spans
+// of date.Value values and time.Time intervals.
+package freebusy
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+)
+
+// DateSpan defines a span of whole days between Start and End, inclusive.
+//
+// A DateSpan is not validated when it is created, so busy lists can be built from any source.  It
+// contains the same days as date.NewRange(Start, End, date.Closed), which is the validated range type
+// with set operations; Range.Start() and Range.End() convert back.
+type DateSpan struct {
+	Start, End date.Value
+}
+
+// IsValid returns true if both ends of the span are valid dates and Start is not after End
+func (s DateSpan) IsValid() bool {
+	return s.Start.IsValid() && s.End.IsValid() && !s.Start.After(s.End)
+}
+
+// Days returns the number of days in the span, or 0 if the span is not valid
+func (s DateSpan) Days() int {
+	if !s.IsValid() {
+		return 0
+	}
+	return int(int64(s.End)-int64(s.Start)) + 1
+}
+
+// Interval defines a span of time from Start, inclusive, to End, exclusive
+type Interval struct {
+	Start, End time.Time
+}
+
+// IsValid returns true if Start is before End
+func (i Interval) IsValid() bool {
+	return i.Start.Before(i.End)
+}
+
+// Duration returns the length of the interval, or 0 if the interval is not valid
+func (i Interval) Duration() time.Duration {
+	if !i.IsValid() {
+		return 0
+	}
+	return i.End.Sub(i.Start)
+}
+
+// FreeDates returns the spans of days within bounds that are not covered by any of the busy spans,
+// in order.  Busy spans may overlap each other and may extend outside of bounds, and invalid spans
+// are ignored.
+func FreeDates(bounds DateSpan, busy []DateSpan) []DateSpan {
+	if !bounds.IsValid() {
+		return nil
+	}
+	sorted := make([]DateSpan, 0, len(busy))
+	for _, b := range busy {
+		if b.IsValid() {
+			sorted = append(sorted, b)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	var (
+		res  []DateSpan
+		next = bounds.Start
+	)
+	for _, b := range sorted {
+		if b.End < next {
+			continue
+		}
+		if b.Start > bounds.End {
+			break
+		}
+		if b.Start > next {
+			res = append(res, DateSpan{Start: next, End: b.Start - 1})
+		}
+		if b.End >= bounds.End {
+			return res
+		}
+		next = b.End + 1
+	}
+	return append(res, DateSpan{Start: next, End: bounds.End})
+}
+
+// LargestFreeDates returns the longest span of days within bounds that is not covered by any of the
+// busy spans.  If there are several of the same length, the earliest is returned.  The second return
+// value is false if there are no free days.
+func LargestFreeDates(bounds DateSpan, busy []DateSpan) (DateSpan, bool) {
+	var (
+		best  DateSpan
+		found bool
+	)
+	for _, s := range FreeDates(bounds, busy) {
+		if !found || s.Days() > best.Days() {
+			best, found = s, true
+		}
+	}
+	return best, found
+}
+
+// TotalFreeDays returns the number of days within bounds that are not covered by any of the busy spans
+func TotalFreeDays(bounds DateSpan, busy []DateSpan) int {
+	n := 0
+	for _, s := range FreeDates(bounds, busy) {
+		n += s.Days()
+	}
+	return n
+}
+
+// Free returns the intervals within bounds that are not covered by any of the busy intervals, in
+// order.  Busy intervals may overlap each other and may extend outside of bounds, and invalid
+// intervals are ignored.
+func Free(bounds Interval, busy []Interval) []Interval {
+	if !bounds.IsValid() {
+		return nil
+	}
+	sorted := make([]Interval, 0, len(busy))
+	for _, b := range busy {
+		if b.IsValid() {
+			sorted = append(sorted, b)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	var (
+		res  []Interval
+		next = bounds.Start
+	)
+	for _, b := range sorted {
+		if !b.End.After(next) {
+			continue
+		}
+		if !b.Start.Before(bounds.End) {
+			break
+		}
+		if b.Start.After(next) {
+			res = append(res, Interval{Start: next, End: b.Start})
+		}
+		if !b.End.Before(bounds.End) {
+			return res
+		}
+		next = b.End
+	}
+	return append(res, Interval{Start: next, End: bounds.End})
+}
+
+// LargestFree returns the longest interval within bounds that is not covered by any of the busy
+// intervals.  If there are several of the same length, the earliest is returned.  The second return
+// value is false if there is no free time.
+func LargestFree(bounds Interval, busy []Interval) (Interval, bool) {
+	var (
+		best  Interval
+		found bool
+	)
+	for _, i := range Free(bounds, busy) {
+		if !found || i.Duration() > best.Duration() {
+			best, found = i, true
+		}
+	}
+	return best, found
+}
+
+// TotalFree returns the total length of time within bounds that is not covered by any of the busy
+// intervals
+func TotalFree(bounds Interval, busy []Interval) time.Duration {
+	var d time.Duration
+	for _, i := range Free(bounds, busy) {
+		d += i.Duration()
+	}
+	return d
+}
@@ -0,0 +1,88 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package freebusy
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+)
+
+func day(d int) date.Value {
+	return date.Must(date.FromUnits(2024, 7, d))
+}
+
+func span(from, to int) DateSpan {
+	return DateSpan{Start: day(from), End: day(to)}
+}
+
+func TestFreeDates(t *testing.T) {
+	bounds := span(1, 31)
+	cases := []struct {
+		name     string
+		busy     []DateSpan
+		expected []DateSpan
+	}{
+		{"no bookings", nil, []DateSpan{span(1, 31)}},
+		{"fully booked", []DateSpan{span(1, 31)}, nil},
+		{"booked beyond bounds", []DateSpan{{date.Must(date.FromUnits(2024, 6, 1)), date.Must(date.FromUnits(2024, 8, 31))}}, nil},
+		{"single booking", []DateSpan{span(10, 12)}, []DateSpan{span(1, 9), span(13, 31)}},
+		{"unsorted and overlapping", []DateSpan{span(20, 25), span(10, 12), span(11, 15)}, []DateSpan{span(1, 9), span(16, 19), span(26, 31)}},
+		{"adjacent bookings", []DateSpan{span(1, 5), span(6, 10)}, []DateSpan{span(11, 31)}},
+		{"invalid bookings ignored", []DateSpan{span(12, 10), {date.Nil, day(5)}}, []DateSpan{span(1, 31)}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := FreeDates(bounds, tc.busy)
+			if !reflect.DeepEqual(got, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDateAggregates(t *testing.T) {
+	busy := []DateSpan{span(5, 9), span(20, 21)}
+	if got := TotalFreeDays(span(1, 31), busy); got != 24 {
+		t.Errorf("Expected 24 free days, got %d", got)
+	}
+	largest, ok := LargestFreeDates(span(1, 31), busy)
+	if !ok || largest != span(10, 19) {
+		t.Errorf("Expected %v, got %v (ok = %v)", span(10, 19), largest, ok)
+	}
+	if _, ok := LargestFreeDates(span(5, 9), busy); ok {
+		t.Errorf("Expected no free days")
+	}
+}
+
+func TestFree(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2024, 7, 1, h, 0, 0, 0, time.UTC)
+	}
+	bounds := Interval{at(9), at(17)}
+	busy := []Interval{
+		{at(13), at(14)},
+		{at(8), at(10)},
+		{at(11), at(12)},
+		{at(11), at(13)},
+		{at(16), at(18)},
+	}
+	expected := []Interval{{at(10), at(11)}, {at(14), at(16)}}
+	if got := Free(bounds, busy); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := TotalFree(bounds, busy); got != 3*time.Hour {
+		t.Errorf("Expected 3h, got %v", got)
+	}
+	largest, ok := LargestFree(bounds, busy)
+	if !ok || largest != expected[1] {
+		t.Errorf("Expected %v, got %v (ok = %v)", expected[1], largest, ok)
+	}
+	if got := Free(Interval{at(17), at(9)}, busy); got != nil {
+		t.Errorf("Expected no intervals for invalid bounds, got %v", got)
+	}
+}
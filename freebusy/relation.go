@@ -0,0 +1,104 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package freebusy
+
+// IntervalRelation is one of the 13 relations of Allen's interval algebra.  Exactly one relation
+// holds between any two valid intervals, so temporal reasoning code can switch on the result of
+// Relation() instead of chaining comparisons of the start and end times.
+type IntervalRelation int
+
+const (
+	// Invalid is returned by Relation() when either interval is not valid
+	Invalid IntervalRelation = iota
+	// Precedes means that a ends before b starts, with a gap between them
+	Precedes
+	// Meets means that a ends exactly when b starts
+	Meets
+	// Overlaps means that a starts first and ends while b is in progress
+	Overlaps
+	// Starts means that a and b start together and a ends first
+	Starts
+	// During means that a starts after and ends before b
+	During
+	// Finishes means that a and b end together and a starts last
+	Finishes
+	// Equals means that a and b start and end together
+	Equals
+	// FinishedBy is the inverse of Finishes: a and b end together and a starts first
+	FinishedBy
+	// Contains is the inverse of During: a starts before and ends after b
+	Contains
+	// StartedBy is the inverse of Starts: a and b start together and b ends first
+	StartedBy
+	// OverlappedBy is the inverse of Overlaps: b starts first and ends while a is in progress
+	OverlappedBy
+	// MetBy is the inverse of Meets: a starts exactly when b ends
+	MetBy
+	// PrecededBy is the inverse of Precedes: a starts after b ends, with a gap between them
+	PrecededBy
+)
+
+// relationNames contains the String() values, indexed by IntervalRelation
+var relationNames = [...]string{
+	"invalid", "precedes", "meets", "overlaps", "starts", "during", "finishes", "equals",
+	"finished by", "contains", "started by", "overlapped by", "met by", "preceded by",
+}
+
+// String returns the name of the relation, such as "overlaps" or "met by"
+func (r IntervalRelation) String() string {
+	if r < Invalid || int(r) >= len(relationNames) {
+		return relationNames[Invalid]
+	}
+	return relationNames[r]
+}
+
+// Inverse returns the relation of b to a when r is the relation of a to b.  Equals and Invalid are
+// their own inverses.
+func (r IntervalRelation) Inverse() IntervalRelation {
+	if r <= Invalid || r > PrecededBy {
+		return Invalid
+	}
+	return PrecededBy + Precedes - r
+}
+
+// Relation returns the relation of interval a to interval b, or Invalid if either interval is not
+// valid.  Intervals are half-open, so a meets b when a.End is equal to b.Start.
+func Relation(a, b Interval) IntervalRelation {
+	if !a.IsValid() || !b.IsValid() {
+		return Invalid
+	}
+	ss := a.Start.Compare(b.Start)
+	ee := a.End.Compare(b.End)
+	switch {
+	case a.End.Before(b.Start):
+		return Precedes
+	case a.End.Equal(b.Start):
+		return Meets
+	case a.Start.After(b.End):
+		return PrecededBy
+	case a.Start.Equal(b.End):
+		return MetBy
+	case ss == 0 && ee == 0:
+		return Equals
+	case ss == 0:
+		if ee < 0 {
+			return Starts
+		}
+		return StartedBy
+	case ee == 0:
+		if ss > 0 {
+			return Finishes
+		}
+		return FinishedBy
+	case ss < 0 && ee > 0:
+		return Contains
+	case ss > 0 && ee < 0:
+		return During
+	case ss < 0:
+		return Overlaps
+	default:
+		return OverlappedBy
+	}
+}
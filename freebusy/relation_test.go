@@ -0,0 +1,73 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package freebusy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelation(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2024, 7, 1, h, 0, 0, 0, time.UTC)
+	}
+	b := Interval{at(10), at(14)}
+	cases := []struct {
+		a        Interval
+		expected IntervalRelation
+	}{
+		{Interval{at(7), at(9)}, Precedes},
+		{Interval{at(8), at(10)}, Meets},
+		{Interval{at(9), at(12)}, Overlaps},
+		{Interval{at(10), at(12)}, Starts},
+		{Interval{at(11), at(13)}, During},
+		{Interval{at(12), at(14)}, Finishes},
+		{Interval{at(10), at(14)}, Equals},
+		{Interval{at(9), at(14)}, FinishedBy},
+		{Interval{at(9), at(15)}, Contains},
+		{Interval{at(10), at(15)}, StartedBy},
+		{Interval{at(12), at(16)}, OverlappedBy},
+		{Interval{at(14), at(16)}, MetBy},
+		{Interval{at(15), at(16)}, PrecededBy},
+		{Interval{at(12), at(12)}, Invalid},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expected.String(), func(tt *testing.T) {
+			if got := Relation(tc.a, b); got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			if got := Relation(b, tc.a); got != tc.expected.Inverse() {
+				tt.Errorf("Expected the inverse relation %v, got %v", tc.expected.Inverse(), got)
+			}
+		})
+	}
+}
+
+func TestRelationIgnoresLocation(t *testing.T) {
+	a := Interval{time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)}
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	b := Interval{a.Start.In(loc), a.End.In(loc)}
+	if got := Relation(a, b); got != Equals {
+		t.Errorf("Expected %v, got %v", Equals, got)
+	}
+}
+
+func TestRelationString(t *testing.T) {
+	cases := []struct {
+		r        IntervalRelation
+		expected string
+	}{
+		{Overlaps, "overlaps"},
+		{MetBy, "met by"},
+		{Invalid, "invalid"},
+		{PrecededBy + 1, "invalid"},
+		{-1, "invalid"},
+	}
+	for _, tc := range cases {
+		if got := tc.r.String(); got != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+	}
+}
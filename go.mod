@@ -1,3 +1,8 @@
 module github.com/dylan-bourque/go-types
 
-require github.com/pkg/errors v0.8.1
+go 1.25.0
+
+require (
+	github.com/pkg/errors v0.9.1
+	golang.org/x/text v0.40.0
+)
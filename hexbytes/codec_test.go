@@ -0,0 +1,63 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hexbytes
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	type doc struct {
+		Hash Value `json:"hash"`
+	}
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		encoded  string
+		err      error
+	}{
+		{"hex", `{"hash":"0a0B0c"}`, Value{0x0a, 0x0b, 0x0c}, `{"hash":"0a0b0c"}`, nil},
+		{"prefix", `{"hash":"0xff"}`, Value{0xff}, `{"hash":"ff"}`, nil},
+		{"empty", `{"hash":""}`, Value{}, `{"hash":""}`, nil},
+		{"null", `{"hash":null}`, nil, `{"hash":null}`, nil},
+		{"number", `{"hash":12}`, nil, "", ErrInvalidTextData},
+		{"base64", `{"hash":"3q2+7w=="}`, nil, "", ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := doc{Hash: Value{1}}
+			err := json.Unmarshal([]byte(tc.data), &d)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if !bytes.Equal(d.Hash, tc.expected) || (d.Hash == nil) != (tc.expected == nil) {
+				tt.Errorf("Expected %#v, got %#v", tc.expected, d.Hash)
+			}
+			if data, err := json.Marshal(d); err != nil || string(data) != tc.encoded {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.encoded, data, err)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	v := Value{0xca, 0xfe}
+	text, _ := v.MarshalText()
+	if string(text) != "cafe" {
+		t.Errorf("Expected cafe, got %s", text)
+	}
+	var got Value
+	if err := got.UnmarshalText(text); err != nil || !got.Equal(v) {
+		t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+	}
+}
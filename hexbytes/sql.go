@@ -0,0 +1,51 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hexbytes
+
+import (
+	"database/sql/driver"
+	"strings"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// a hexbytes.Value value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a hexbytes.Value value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for hexbytes.Value values.  The raw bytes are
+// returned so that they are stored in binary columns, such as Postgres bytea, rather than as text.
+// A nil Value is returned as NULL.
+func (v Value) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return []byte(v), nil
+}
+
+// Scan implements the sql.Scanner interface for hexbytes.Value values.
+//
+// Byte slices are copied as is, since the driver may reuse the memory.  Strings are decoded as hex,
+// including the Postgres bytea hex format, such as "\xdeadbeef".  NULL is scanned as nil.  All other
+// values will return an error
+func (v *Value) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case []byte:
+		*v = append(Value{}, tv...)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(strings.TrimPrefix(tv, `\x`)))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hexbytes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestSQL(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"bytes", []byte{0xde, 0xad}, Value{0xde, 0xad}, nil},
+		{"empty bytes", []byte{}, Value{}, nil},
+		{"bytea hex", `\xdead`, Value{0xde, 0xad}, nil},
+		{"hex string", "DEAD", Value{0xde, 0xad}, nil},
+		{"null", nil, nil, nil},
+		{"invalid string", `\xzz`, nil, ErrInvalidTextFormat},
+		{"unsupported type", 42, nil, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if !bytes.Equal(v, tc.expected) || (v == nil) != (tc.expected == nil) {
+				tt.Errorf("Expected %#v, got %#v", tc.expected, v)
+			}
+			if err != nil {
+				return
+			}
+			dv, err := v.Value()
+			if err != nil || (dv == nil) != (v == nil) || (dv != nil && !bytes.Equal(dv.([]byte), v)) {
+				tt.Errorf("Expected %#v, got %#v (err = %v)", v, dv, err)
+			}
+		})
+	}
+
+	src := []byte{1, 2, 3}
+	var v Value
+	_ = v.Scan(src)
+	src[0] = 9
+	if v[0] != 1 {
+		t.Errorf("Expected Scan() to copy the source bytes")
+	}
+}
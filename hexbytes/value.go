@@ -0,0 +1,64 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hexbytes
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Value is a byte slice whose text and JSON form is lower case hex, such as "deadbeef", rather than
+// the base64 that encoding/json uses for []byte.  It is intended for hashes, keys and tokens that
+// are easier to read, log and compare as hex.
+//
+// A nil Value is encoded as JSON null and SQL NULL.  An empty, non-nil Value is encoded as "".
+type Value []byte
+
+var (
+	// ErrInvalidTextFormat is returned when a string is not a valid hex encoding
+	ErrInvalidTextFormat = errors.Errorf("hexbytes: the specified text is not valid hex")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in hexbytes.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Parse decodes a hex string, in upper or lower case and with an optional "0x" prefix.  An empty
+// string decodes as an empty, non-nil Value.
+func Parse(s string) (Value, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	res := make(Value, hex.DecodedLen(len(s)))
+	if _, err := hex.Decode(res, []byte(s)); err != nil {
+		return nil, errors.Wrapf(ErrInvalidTextFormat, "%v", err)
+	}
+	return res, nil
+}
+
+// Equal returns true if v and other contain the same bytes.  The comparison takes a constant amount
+// of time for inputs of the same length, so it is safe to use with secrets such as tokens and MACs.
+func (v Value) Equal(other Value) bool {
+	return subtle.ConstantTimeCompare(v, other) == 1
+}
+
+// String implements fmt.Stringer for hexbytes.Value instances.
+//
+// The returned string is the lower case hex encoding of v with no prefix.
+func (v Value) String() string {
+	return hex.EncodeToString(v)
+}
+
+// PrefixedString returns the lower case hex encoding of v with a "0x" prefix
+func (v Value) PrefixedString() string {
+	return "0x" + v.String()
+}
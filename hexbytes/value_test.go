@@ -0,0 +1,75 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hexbytes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected Value
+		err      error
+	}{
+		{"empty", "", Value{}, nil},
+		{"lower case", "deadbeef", Value{0xde, 0xad, 0xbe, 0xef}, nil},
+		{"upper case", "DEADBEEF", Value{0xde, 0xad, 0xbe, 0xef}, nil},
+		{"prefix", "0x00ff", Value{0x00, 0xff}, nil},
+		{"upper case prefix", "0X00FF", Value{0x00, 0xff}, nil},
+		{"prefix only", "0x", Value{}, nil},
+		{"odd length", "abc", nil, ErrInvalidTextFormat},
+		{"invalid character", "zz", nil, ErrInvalidTextFormat},
+		{"double prefix", "0x0x00", nil, ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if !bytes.Equal(v, tc.expected) || (v == nil) != (tc.expected == nil) {
+				tt.Errorf("Expected %#v, got %#v", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	v := Value{0xDE, 0xAD, 0x00, 0x01}
+	if s := v.String(); s != "dead0001" {
+		t.Errorf("Expected dead0001, got %s", s)
+	}
+	if s := v.PrefixedString(); s != "0xdead0001" {
+		t.Errorf("Expected 0xdead0001, got %s", s)
+	}
+	if s := Value(nil).String(); s != "" {
+		t.Errorf("Expected an empty string, got %s", s)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     Value
+		expected bool
+	}{
+		{"equal", Value{1, 2, 3}, Value{1, 2, 3}, true},
+		{"different", Value{1, 2, 3}, Value{1, 2, 4}, false},
+		{"different length", Value{1, 2, 3}, Value{1, 2}, false},
+		{"nil and empty", nil, Value{}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.a.Equal(tc.b); got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package holiday provides holiday calendars that are defined by rules, such as "the fourth Thursday
+// in November" or "2 days before Easter", rather than by lists of dates, and a registry of named
+// calendars that can be combined.
+package holiday
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidName is returned by New() when the calendar name is empty
+	ErrInvalidName = errors.Errorf("holiday: the calendar name must not be empty")
+)
+
+// interface validations
+var _ date.HolidayChecker = (*Calendar)(nil)
+
+// Holiday is a single occurrence of a holiday
+type Holiday struct {
+	// Name is the name of the rule that produced the holiday
+	Name string
+	// Date is the day on which the holiday is observed
+	Date date.Value
+	// Actual is the day on which the holiday falls, which differs from Date when the holiday falls on
+	// a weekend and is observed on a weekday
+	Actual date.Value
+}
+
+// Calendar is a named set of holiday rules.  A Calendar is immutable once created, so it is safe for
+// concurrent use.
+//
+// The observed holidays for each year are computed once and cached, so checking every day of a long
+// span, as date.SummarizeBetween() does, only applies the rules once per year.
+type Calendar struct {
+	name  string
+	rules []Rule
+	years sync.Map // int -> []Holiday, the results of observed()
+}
+
+// New returns a calendar with the specified name and rules.  If the name is empty, ErrInvalidName is
+// returned, and if any rule is not valid, ErrInvalidRule is returned.
+func New(name string, rules ...Rule) (*Calendar, error) {
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	for _, r := range rules {
+		if err := r.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &Calendar{name: name, rules: slices.Clone(rules)}, nil
+}
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in calendar
+func Must(c *Calendar, err error) *Calendar {
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Compose returns a new calendar with the specified name that contains the rules of all of the
+// specified calendars, in order.  Rules that appear in more than one of the calendars are only
+// included once.
+func Compose(name string, cals ...*Calendar) (*Calendar, error) {
+	var rules []Rule
+	for _, c := range cals {
+		for _, r := range c.rules {
+			if !slices.Contains(rules, r) {
+				rules = append(rules, r)
+			}
+		}
+	}
+	return New(name, rules...)
+}
+
+// Name returns the name of the calendar
+func (c *Calendar) Name() string {
+	return c.name
+}
+
+// Rules returns a copy of the rules in the calendar
+func (c *Calendar) Rules() []Rule {
+	return slices.Clone(c.rules)
+}
+
+// With returns a new calendar with the specified name that contains the rules of this calendar
+// followed by the specified rules
+func (c *Calendar) With(name string, rules ...Rule) (*Calendar, error) {
+	return New(name, append(slices.Clone(c.rules), rules...)...)
+}
+
+// Holidays returns the holidays that are observed in the specified year, ordered by date.  Holidays
+// that are moved by their observance rules are included in the year in which they are observed, so a
+// New Year's Day that falls on a Saturday can be observed on December 31 of the previous year.
+func (c *Calendar) Holidays(year int) []Holiday {
+	var res []Holiday
+	for y := year - 1; y <= year+1; y++ {
+		for _, h := range c.observed(y) {
+			if h.Date.IsValid() && h.Date.Year() == year {
+				res = append(res, h)
+			}
+		}
+	}
+	slices.SortStableFunc(res, func(a, b Holiday) int {
+		return cmp.Compare(a.Date, b.Date)
+	})
+	return res
+}
+
+// HolidaysOn returns the holidays that are observed on the specified date, which is usually zero or one
+// but may be more when the rules of several calendars have been composed
+func (c *Calendar) HolidaysOn(d date.Value) []Holiday {
+	if !d.IsValid() {
+		return nil
+	}
+	var res []Holiday
+	for y := d.Year() - 1; y <= d.Year()+1; y++ {
+		for _, h := range c.observed(y) {
+			if h.Date == d {
+				res = append(res, h)
+			}
+		}
+	}
+	return res
+}
+
+// IsHoliday returns true if a holiday is observed on the specified date.  A holiday that falls on a
+// weekend and is observed on a weekday is only reported for the weekday.
+//
+// IsHoliday implements date.HolidayChecker, so a Calendar can be passed to date.Summarize().
+func (c *Calendar) IsHoliday(d date.Value) bool {
+	if !d.IsValid() {
+		return false
+	}
+	for y := d.Year() - 1; y <= d.Year()+1; y++ {
+		for _, h := range c.observed(y) {
+			if h.Date == d {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// observed returns the holidays for a single year, computing them with observeYear() the first time
+// the year is requested.  The returned slice is shared and must not be modified.
+func (c *Calendar) observed(year int) []Holiday {
+	if hs, ok := c.years.Load(year); ok {
+		return hs.([]Holiday)
+	}
+	hs, _ := c.years.LoadOrStore(year, c.observeYear(year))
+	return hs.([]Holiday)
+}
+
+// observeYear applies the rules for a single year and then moves weekend holidays according to their
+// observance
+func (c *Calendar) observeYear(year int) []Holiday {
+	hs := make([]Holiday, 0, len(c.rules))
+	obs := make([]Observance, 0, len(c.rules))
+	// the weekdays that are already holidays, so that NextWeekday substitutes skip them
+	taken := make(map[date.Value]bool)
+	for _, r := range c.rules {
+		d, ok := r.Date(year)
+		if !ok {
+			continue
+		}
+		hs = append(hs, Holiday{Name: r.Name, Date: d, Actual: d})
+		obs = append(obs, r.Observance)
+		if !d.IsWeekend() {
+			taken[d] = true
+		}
+	}
+	for i := range hs {
+		d := hs[i].Date
+		if !d.IsWeekend() {
+			continue
+		}
+		switch obs[i] {
+		case NearestWeekday:
+			if d.Weekday() == time.Saturday {
+				d--
+			} else {
+				d++
+			}
+		case NextWeekday:
+			for d.IsWeekend() || taken[d] {
+				d++
+			}
+			taken[d] = true
+		}
+		hs[i].Date = d
+	}
+	return hs
+}
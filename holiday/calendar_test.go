@@ -0,0 +1,145 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package holiday
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+// dates returns the observed dates of the holidays
+func dates(hs []Holiday) []string {
+	res := make([]string, len(hs))
+	for i, h := range hs {
+		res[i] = h.Date.String()
+	}
+	return res
+}
+
+func TestHolidays(t *testing.T) {
+	cases := []struct {
+		name     string
+		c        *Calendar
+		year     int
+		expected []string
+	}{
+		{"US", US, 2021, []string{
+			"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31", "2021-06-18", "2021-07-05",
+			"2021-09-06", "2021-10-11", "2021-11-11", "2021-11-25", "2021-12-24", "2021-12-31",
+		}},
+		{"US before Juneteenth", US, 2020, []string{
+			"2020-01-01", "2020-01-20", "2020-02-17", "2020-05-25", "2020-07-03", "2020-09-07",
+			"2020-10-12", "2020-11-11", "2020-11-26", "2020-12-25",
+		}},
+		{"US 1975", US, 1975, []string{
+			"1975-01-01", "1975-02-17", "1975-05-26", "1975-07-04", "1975-09-01", "1975-10-13",
+			"1975-10-27", "1975-11-27", "1975-12-25",
+		}},
+		{"UK weekend Christmas", UK, 2021, []string{
+			"2021-01-01", "2021-04-02", "2021-04-05", "2021-05-03", "2021-05-31", "2021-08-30",
+			"2021-12-27", "2021-12-28",
+		}},
+		{"UK Sunday Christmas", UK, 2022, []string{
+			"2022-01-03", "2022-04-15", "2022-04-18", "2022-05-02", "2022-05-30", "2022-08-29",
+			"2022-12-26", "2022-12-27",
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := dates(tc.c.Holidays(tc.year)); !slices.Equal(got, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestHolidaysOn(t *testing.T) {
+	// Christmas 2022 is a Sunday, so the substitute day is Tuesday since Boxing Day is Monday
+	hs := UK.HolidaysOn(date.Must(date.FromUnits(2022, 12, 27)))
+	if len(hs) != 1 || hs[0].Name != "Christmas Day" || hs[0].Actual != date.Must(date.FromUnits(2022, 12, 25)) {
+		t.Errorf("Unexpected holidays: %+v", hs)
+	}
+	// New Year's Day 2022 is a Saturday, so it is observed in 2021
+	if !US.IsHoliday(date.Must(date.FromUnits(2021, 12, 31))) {
+		t.Errorf("Expected 2021-12-31 to be a holiday")
+	}
+	if US.IsHoliday(date.Must(date.FromUnits(2022, 1, 1))) {
+		t.Errorf("Expected 2022-01-01 not to be a holiday")
+	}
+	if US.IsHoliday(date.Nil) || US.HolidaysOn(date.Nil) != nil {
+		t.Errorf("Expected no holidays for date.Nil")
+	}
+}
+
+func TestNewAndCompose(t *testing.T) {
+	if _, err := New(""); errors.Cause(err) != ErrInvalidName {
+		t.Errorf("Expected %v, got %v", ErrInvalidName, err)
+	}
+	if _, err := New("bad", OnDate("bad", 2, 30)); errors.Cause(err) != ErrInvalidRule {
+		t.Errorf("Expected %v, got %v", ErrInvalidRule, err)
+	}
+
+	company := Must(New("company",
+		OnDate("Christmas Day", 12, 25).Observed(NearestWeekday),
+		OnNthWeekday("Company Thanksgiving", 11, time.Thursday, 4),
+	))
+	// the shared Christmas rule is only included once
+	c, err := Compose("US+company", US, company)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := len(c.Rules()); got != len(US.Rules())+1 {
+		t.Errorf("Expected %d rules, got %d", len(US.Rules())+1, got)
+	}
+	hs := c.HolidaysOn(date.Must(date.FromUnits(2021, 11, 25)))
+	if len(hs) != 2 || hs[0].Name != "Thanksgiving Day" || hs[1].Name != "Company Thanksgiving" {
+		t.Errorf("Unexpected holidays: %+v", hs)
+	}
+
+	extended, err := US.With("US+Easter", OnEaster("Easter Sunday", 0))
+	if err != nil || extended.Name() != "US+Easter" || !extended.IsHoliday(date.Must(date.FromUnits(2024, 3, 31))) {
+		t.Errorf("Expected Easter to be a holiday (err = %v)", err)
+	}
+	if US.IsHoliday(date.Must(date.FromUnits(2024, 3, 31))) {
+		t.Errorf("Expected With() not to modify the original calendar")
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	from, to := date.Must(date.FromUnits(2021, 7, 1)), date.Must(date.FromUnits(2021, 7, 31))
+	s, err := date.SummarizeBetween(from, to, US)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s.Holidays != 1 || s.BusinessDays != 21 {
+		t.Errorf("Expected 1 holiday and 21 business days, got %d and %d", s.Holidays, s.BusinessDays)
+	}
+}
+
+func TestObservedIsCached(t *testing.T) {
+	c := Must(New("test", OnDate("New Year's Day", 1, 1).Observed(NextWeekday)))
+	first, second := c.observed(2022), c.observed(2022)
+	if len(first) != 1 || &first[0] != &second[0] {
+		t.Errorf("Expected the second call to return the cached holidays, got %v and %v", first, second)
+	}
+	// callers of Holidays() get their own copy, so they cannot modify the cache
+	hs := c.Holidays(2022)
+	hs[0].Name = "changed"
+	if c.observed(2022)[0].Name != "New Year's Day" {
+		t.Errorf("Expected the cached holidays to be unchanged, got %v", c.observed(2022))
+	}
+}
+
+func BenchmarkSummarizeBetween(b *testing.B) {
+	from, to := date.Must(date.FromUnits(2000, 1, 1)), date.Must(date.FromUnits(2049, 12, 31))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = date.SummarizeBetween(from, to, US)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package holiday
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnknownCalendar is returned by ComposeNamed() when no calendar is registered with one of the
+	// specified names
+	ErrUnknownCalendar = errors.Errorf("holiday: no calendar is registered with the specified name")
+)
+
+// US is the calendar of US federal holidays, which are observed on the nearest weekday.  Holidays that
+// were created or moved by the Uniform Monday Holiday Act are only included from 1971.
+var US = Must(New("US",
+	OnDate("New Year's Day", 1, 1).Observed(NearestWeekday),
+	OnNthWeekday("Birthday of Martin Luther King, Jr.", 1, time.Monday, 3).Years(1986, 0),
+	OnNthWeekday("Washington's Birthday", 2, time.Monday, 3).Years(1971, 0),
+	OnNthWeekday("Memorial Day", 5, time.Monday, -1).Years(1971, 0),
+	OnDate("Juneteenth National Independence Day", 6, 19).Observed(NearestWeekday).Years(2021, 0),
+	OnDate("Independence Day", 7, 4).Observed(NearestWeekday),
+	OnNthWeekday("Labor Day", 9, time.Monday, 1),
+	OnNthWeekday("Columbus Day", 10, time.Monday, 2).Years(1971, 0),
+	OnNthWeekday("Veterans Day", 10, time.Monday, 4).Years(1971, 1977),
+	OnDate("Veterans Day", 11, 11).Observed(NearestWeekday).Years(1978, 0),
+	OnNthWeekday("Thanksgiving Day", 11, time.Thursday, 4),
+	OnDate("Christmas Day", 12, 25).Observed(NearestWeekday),
+))
+
+// UK is the calendar of the regular bank holidays in England and Wales, which are substituted with the
+// next available weekday.  One-off bank holidays, such as those for coronations and jubilees, are not
+// included.
+var UK = Must(New("UK",
+	OnDate("New Year's Day", 1, 1).Observed(NextWeekday).Years(1974, 0),
+	OnEaster("Good Friday", -2),
+	OnEaster("Easter Monday", 1),
+	OnNthWeekday("Early May bank holiday", 5, time.Monday, 1).Years(1978, 0),
+	OnNthWeekday("Spring bank holiday", 5, time.Monday, -1).Years(1971, 0),
+	OnNthWeekday("Summer bank holiday", 8, time.Monday, -1).Years(1971, 0),
+	OnDate("Christmas Day", 12, 25).Observed(NextWeekday),
+	OnDate("Boxing Day", 12, 26).Observed(NextWeekday),
+))
+
+var (
+	mu        sync.RWMutex
+	calendars = map[string]*Calendar{
+		US.Name(): US,
+		UK.Name(): UK,
+	}
+)
+
+// Register adds the calendar to the registry under its name, replacing any calendar that was
+// previously registered with the same name.  The US and UK calendars are registered by default.
+func Register(c *Calendar) {
+	mu.Lock()
+	defer mu.Unlock()
+	calendars[c.Name()] = c
+}
+
+// Unregister removes the calendar with the specified name from the registry
+func Unregister(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(calendars, name)
+}
+
+// Lookup returns the calendar registered with the specified name.  The second return value is false
+// if there is no such calendar.
+func Lookup(name string) (*Calendar, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	c, ok := calendars[name]
+	return c, ok
+}
+
+// Names returns the names of the registered calendars in sorted order
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return slices.Sorted(maps.Keys(calendars))
+}
+
+// ComposeNamed returns a new calendar with the specified name that contains the rules of the
+// registered calendars with the specified names, as Compose() does.  The new calendar is not
+// registered.
+//
+// If any of the names is not registered, ErrUnknownCalendar is returned.
+func ComposeNamed(name string, names ...string) (*Calendar, error) {
+	cals := make([]*Calendar, 0, len(names))
+	for _, n := range names {
+		c, ok := Lookup(n)
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownCalendar, "%q", n)
+		}
+		cals = append(cals, c)
+	}
+	return Compose(name, cals...)
+}
@@ -0,0 +1,48 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package holiday
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+func TestRegistry(t *testing.T) {
+	if c, ok := Lookup("US"); !ok || c != US {
+		t.Errorf("Expected the US calendar to be registered")
+	}
+	if _, ok := Lookup("acme"); ok {
+		t.Errorf("Expected no calendar named acme")
+	}
+
+	acme := Must(New("acme", OnDate("Founders' Day", 3, 14)))
+	Register(acme)
+	defer Unregister("acme")
+	if got := Names(); !slices.Equal(got, []string{"UK", "US", "acme"}) {
+		t.Errorf("Unexpected names: %v", got)
+	}
+
+	c, err := ComposeNamed("US+acme", "US", "acme")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !c.IsHoliday(date.Must(date.FromUnits(2024, 3, 14))) || !c.IsHoliday(date.Must(date.FromUnits(2024, 7, 4))) {
+		t.Errorf("Expected the composed calendar to contain the holidays of both calendars")
+	}
+	if _, ok := Lookup("US+acme"); ok {
+		t.Errorf("Expected the composed calendar not to be registered")
+	}
+	if _, err := ComposeNamed("x", "US", "nope"); errors.Cause(err) != ErrUnknownCalendar {
+		t.Errorf("Expected %v, got %v", ErrUnknownCalendar, err)
+	}
+
+	Unregister("acme")
+	if _, ok := Lookup("acme"); ok {
+		t.Errorf("Expected acme to be unregistered")
+	}
+}
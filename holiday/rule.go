@@ -0,0 +1,170 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package holiday
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidRule is returned by New() when one or more of the provided rules is not valid
+	ErrInvalidRule = errors.Errorf("holiday: the holiday rule is not valid")
+)
+
+// Kind identifies how a Rule computes the date of a holiday
+type Kind int
+
+const (
+	// FixedDate holidays fall on the same month and day every year, such as July 4
+	FixedDate Kind = iota
+	// NthWeekday holidays fall on the nth occurrence of a weekday in a month, such as the fourth
+	// Thursday in November
+	NthWeekday
+	// EasterOffset holidays fall a number of days before or after Easter Sunday, such as Good Friday
+	EasterOffset
+)
+
+// Observance specifies whether, and how, a holiday that falls on a weekend is moved to a weekday
+type Observance int
+
+const (
+	// Actual leaves holidays on the day they fall
+	Actual Observance = iota
+	// NearestWeekday moves a holiday on a Saturday to the preceding Friday and a holiday on a Sunday
+	// to the following Monday, which is how US federal holidays are observed
+	NearestWeekday
+	// NextWeekday moves a holiday on a weekend to the next weekday that is not already a holiday in
+	// the same calendar, which is how UK bank holidays are substituted
+	NextWeekday
+)
+
+// Rule describes a holiday as data rather than as a list of dates.  Rules are usually created with
+// OnDate(), OnNthWeekday() or OnEaster() and then adjusted with Observed() and Years().
+type Rule struct {
+	// Name is the name of the holiday, such as "Independence Day"
+	Name string
+	// Kind selects which of the remaining fields are used to compute the date
+	Kind Kind
+	// Month is the month, between 1 and 12, of FixedDate and NthWeekday holidays
+	Month int
+	// Day is the day of the month of FixedDate holidays.  A February 29 holiday only occurs in leap
+	// years.
+	Day int
+	// Weekday is the day of the week of NthWeekday holidays
+	Weekday time.Weekday
+	// N is the occurrence of Weekday in the month for NthWeekday holidays, between 1 and 5.  Negative
+	// values count from the end of the month, so -1 is the last occurrence.
+	N int
+	// Offset is the number of days after Easter Sunday of EasterOffset holidays, which is negative
+	// for days before Easter
+	Offset int
+	// Observance specifies how the holiday is moved when it falls on a weekend
+	Observance Observance
+	// FirstYear and LastYear limit the years in which the holiday occurs.  Zero means there is no
+	// limit.
+	FirstYear, LastYear int
+}
+
+// OnDate returns a rule for a holiday that falls on the same month and day every year
+func OnDate(name string, month, day int) Rule {
+	return Rule{Name: name, Kind: FixedDate, Month: month, Day: day}
+}
+
+// OnNthWeekday returns a rule for a holiday that falls on the nth occurrence of the specified weekday
+// in a month.  Negative values of n count from the end of the month, so OnNthWeekday("Memorial Day",
+// 5, time.Monday, -1) is the last Monday in May.
+func OnNthWeekday(name string, month int, wd time.Weekday, n int) Rule {
+	return Rule{Name: name, Kind: NthWeekday, Month: month, Weekday: wd, N: n}
+}
+
+// OnEaster returns a rule for a holiday that falls the specified number of days after Easter Sunday.
+// Use a negative offset for days before Easter.
+func OnEaster(name string, offset int) Rule {
+	return Rule{Name: name, Kind: EasterOffset, Offset: offset}
+}
+
+// Observed returns a copy of the rule with the specified weekend observance
+func (r Rule) Observed(o Observance) Rule {
+	r.Observance = o
+	return r
+}
+
+// Years returns a copy of the rule that only applies between the first and last years, inclusive.
+// Pass 0 for either year to leave that end unlimited.
+func (r Rule) Years(first, last int) Rule {
+	r.FirstYear, r.LastYear = first, last
+	return r
+}
+
+// Date returns the date on which the holiday falls in the specified year, before any weekend
+// observance is applied.  The second return value is false if the holiday does not occur in that
+// year.
+func (r Rule) Date(year int) (date.Value, bool) {
+	if (r.FirstYear != 0 && year < r.FirstYear) || (r.LastYear != 0 && year > r.LastYear) {
+		return date.Nil, false
+	}
+	var (
+		d   date.Value
+		err error
+	)
+	switch r.Kind {
+	case FixedDate:
+		d, err = date.FromUnits(year, r.Month, r.Day)
+	case NthWeekday:
+		d, err = date.NthWeekday(year, r.Month, r.Weekday, r.N)
+	case EasterOffset:
+		if d, err = Easter(year); err == nil {
+			d, err = d.AddDays(r.Offset)
+		}
+	default:
+		return date.Nil, false
+	}
+	if err != nil {
+		return date.Nil, false
+	}
+	return d, true
+}
+
+// validate checks the fields that are used by the rule's kind
+func (r Rule) validate() error {
+	var ok bool
+	switch r.Kind {
+	case FixedDate:
+		// February 29 is allowed, so check against a leap year
+		ok = date.IsValidMonth(r.Month) && r.Day >= 1 && r.Day <= date.DaysInMonth(2000, r.Month)
+	case NthWeekday:
+		ok = date.IsValidMonth(r.Month) && r.Weekday >= time.Sunday && r.Weekday <= time.Saturday &&
+			r.N != 0 && r.N >= -5 && r.N <= 5
+	case EasterOffset:
+		ok = r.Offset >= -366 && r.Offset <= 366
+	}
+	ok = ok && r.Observance >= Actual && r.Observance <= NextWeekday &&
+		(r.FirstYear == 0 || r.LastYear == 0 || r.FirstYear <= r.LastYear)
+	if !ok {
+		return errors.Wrapf(ErrInvalidRule, "%+v", r)
+	}
+	return nil
+}
+
+// Easter returns the date of Easter Sunday in the specified year, as observed by the Western churches
+// using the Gregorian calendar
+func Easter(year int) (date.Value, error) {
+	// the anonymous Gregorian algorithm, also known as the Meeus/Jones/Butcher algorithm
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date.FromUnits(year, month, day)
+}
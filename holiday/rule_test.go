@@ -0,0 +1,102 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+func TestEaster(t *testing.T) {
+	cases := []struct {
+		year, month, day int
+	}{
+		{1818, 3, 22},
+		{1943, 4, 25},
+		{2000, 4, 23},
+		{2019, 4, 21},
+		{2024, 3, 31},
+		{2025, 4, 20},
+		{2038, 4, 25},
+	}
+	for _, tc := range cases {
+		expected := date.Must(date.FromUnits(tc.year, tc.month, tc.day))
+		if got, err := Easter(tc.year); err != nil || got != expected {
+			t.Errorf("Expected %v for %d, got %v (err = %v)", expected, tc.year, got, err)
+		}
+	}
+	if _, err := Easter(1700); err == nil {
+		t.Errorf("Expected an error for an invalid year")
+	}
+}
+
+func TestRuleDate(t *testing.T) {
+	cases := []struct {
+		name     string
+		r        Rule
+		year     int
+		expected date.Value
+	}{
+		{"fixed", OnDate("", 7, 4), 2021, date.Must(date.FromUnits(2021, 7, 4))},
+		{"leap day", OnDate("", 2, 29), 2024, date.Must(date.FromUnits(2024, 2, 29))},
+		{"leap day in a common year", OnDate("", 2, 29), 2023, date.Nil},
+		{"first weekday", OnNthWeekday("", 9, time.Monday, 1), 2021, date.Must(date.FromUnits(2021, 9, 6))},
+		{"fourth weekday", OnNthWeekday("", 11, time.Thursday, 4), 2021, date.Must(date.FromUnits(2021, 11, 25))},
+		{"fifth weekday", OnNthWeekday("", 7, time.Saturday, 5), 2021, date.Must(date.FromUnits(2021, 7, 31))},
+		{"no fifth weekday", OnNthWeekday("", 7, time.Monday, 5), 2021, date.Nil},
+		{"last weekday", OnNthWeekday("", 5, time.Monday, -1), 2021, date.Must(date.FromUnits(2021, 5, 31))},
+		{"second to last weekday", OnNthWeekday("", 5, time.Monday, -2), 2021, date.Must(date.FromUnits(2021, 5, 24))},
+		{"before easter", OnEaster("", -2), 2021, date.Must(date.FromUnits(2021, 4, 2))},
+		{"after easter", OnEaster("", 49), 2021, date.Must(date.FromUnits(2021, 5, 23))},
+		{"before first year", OnDate("", 6, 19).Years(2021, 0), 2020, date.Nil},
+		{"first year", OnDate("", 6, 19).Years(2021, 0), 2021, date.Must(date.FromUnits(2021, 6, 19))},
+		{"after last year", OnDate("", 6, 19).Years(0, 2020), 2021, date.Nil},
+		{"invalid year", OnDate("", 1, 1), 10000, date.Nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, ok := tc.r.Date(tc.year)
+			if got != tc.expected || ok != (tc.expected != date.Nil) {
+				tt.Errorf("Expected %v, got %v (ok = %v)", tc.expected, got, ok)
+			}
+		})
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	cases := []struct {
+		name  string
+		r     Rule
+		valid bool
+	}{
+		{"fixed", OnDate("", 12, 25), true},
+		{"leap day", OnDate("", 2, 29), true},
+		{"invalid day", OnDate("", 2, 30), false},
+		{"invalid month", OnDate("", 13, 1), false},
+		{"nth weekday", OnNthWeekday("", 5, time.Monday, -1), true},
+		{"zero occurrence", OnNthWeekday("", 5, time.Monday, 0), false},
+		{"invalid occurrence", OnNthWeekday("", 5, time.Monday, 6), false},
+		{"invalid weekday", OnNthWeekday("", 5, time.Weekday(7), 1), false},
+		{"easter", OnEaster("", 60), true},
+		{"invalid offset", OnEaster("", 400), false},
+		{"invalid kind", Rule{Kind: Kind(42)}, false},
+		{"invalid observance", OnDate("", 1, 1).Observed(Observance(42)), false},
+		{"invalid years", OnDate("", 1, 1).Years(2021, 2020), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.r.validate()
+			if tc.valid && err != nil {
+				tt.Errorf("Unexpected error: %v", err)
+			}
+			if !tc.valid && errors.Cause(err) != ErrInvalidRule {
+				tt.Errorf("Expected %v, got %v", ErrInvalidRule, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hostport
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		err      error
+	}{
+		{"host and port", `"[::1]:8080"`, Must(New("::1", 8080)), nil},
+		{"host only", `"example.com"`, Must(New("example.com", 0)), nil},
+		{"null", `null`, Nil, nil},
+		{"empty", `""`, Nil, nil},
+		{"number", `8080`, Nil, ErrInvalidTextData},
+		{"invalid port", `"example.com:99999"`, Nil, ErrInvalidPort},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Must(Parse("other.example.com:1"))
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, v)
+			}
+			if tc.data == "null" {
+				return
+			}
+			if data, err := json.Marshal(v); err != nil || string(data) != tc.data {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.data, data, err)
+			}
+		})
+	}
+}
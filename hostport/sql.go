@@ -0,0 +1,40 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hostport
+
+import (
+	"database/sql/driver"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// a hostport.Value value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a hostport.Value value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for hostport.Value values.  The returned value is
+// the same string as is returned by String().
+func (v Value) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for hostport.Value values.
+//
+// Strings and byte slices are handled by UnmarshalText().  All other values will return an error
+func (v *Value) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case []byte:
+		return v.UnmarshalText(tv)
+	case string:
+		return v.UnmarshalText([]byte(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hostport
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestSQL(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"string", "db.example.com:5432", Must(New("db.example.com", 5432)), nil},
+		{"bytes", []byte("[::1]:5432"), Must(New("::1", 5432)), nil},
+		{"no port", "db.example.com", Must(New("db.example.com", 0)), nil},
+		{"invalid", "db.example.com:0", Nil, ErrInvalidPort},
+		{"unsupported type", 5432, Nil, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, v)
+			}
+			if err != nil {
+				return
+			}
+			if dv, err := v.Value(); err != nil || dv != v.String() {
+				tt.Errorf("Expected %q, got %v (err = %v)", v, dv, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hostport
+
+import (
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Value represents a network endpoint as a host, which can be a DNS name or an IP address, and an
+// optional TCP/UDP port, such as "db.example.com:5432" or "[::1]:8080".
+//
+// Host names are normalized to lower case and IPv6 addresses are stored without brackets.  A port of
+// 0 means that no port was specified.  Values can be compared with ==, but Compare() and Equivalent()
+// also treat fully-qualified names with a trailing dot, as used in SRV records, the same as names
+// without one.
+//
+// The zero value is hostport.Nil.
+type Value struct {
+	host string
+	port uint16
+}
+
+var (
+	// Nil represents a nil/null/undefined host/port value
+	Nil = Value{}
+)
+
+var (
+	// ErrInvalidHost is returned when the host is not a valid DNS name or IP address
+	ErrInvalidHost = errors.Errorf("hostport: the host must be a valid DNS name or IP address")
+	// ErrInvalidPort is returned when the port is not a number in the range [1, 65535]
+	ErrInvalidPort = errors.Errorf("hostport: the port must be a number between 1 and 65535")
+	// ErrMissingPort is returned by Parse() when the string does not contain a port
+	ErrMissingPort = errors.Errorf("hostport: the specified text does not contain a port")
+	// ErrInvalidTextFormat is returned when a string cannot be split into a host and a port
+	ErrInvalidTextFormat = errors.Errorf("hostport: the specified text is not a valid host:port value")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in hostport.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// New returns a hostport.Value for the specified host and port, or an error if the host is not a valid
+// DNS name or IP address.  IPv6 addresses can be specified with or without brackets.  A port of 0 means
+// that there is no port.
+func New(host string, port uint16) (Value, error) {
+	h, err := normalizeHost(host)
+	if err != nil {
+		return Nil, err
+	}
+	return Value{host: h, port: port}, nil
+}
+
+// Parse parses a "host:port" string, such as "example.com:443", "10.0.0.1:53" or "[::1]:8080", and
+// returns an error if there is no port.  An empty string is parsed as Nil.
+//
+// Unlike splitting at the first ':', IPv6 addresses are handled correctly.  The port must be a
+// decimal number between 1 and 65535.
+func Parse(s string) (Value, error) {
+	v, hasPort, err := parse(s)
+	if err != nil {
+		return Nil, err
+	}
+	if !hasPort && v != Nil {
+		return Nil, errors.Wrapf(ErrMissingPort, "%q", s)
+	}
+	return v, nil
+}
+
+// ParseDefault parses a string like Parse() but allows the port to be omitted, in which case the
+// specified default port is used.  A default port of 0 leaves the port unspecified.
+//
+// Bare IPv6 addresses, such as "::1", are accepted as a host with no port.
+func ParseDefault(s string, defaultPort uint16) (Value, error) {
+	v, _, err := parse(s)
+	if err != nil {
+		return Nil, err
+	}
+	return v.WithDefaultPort(defaultPort), nil
+}
+
+// parse splits s into a host and an optional port and validates both.  The returned flag reports
+// whether s contained a port.
+func parse(s string) (Value, bool, error) {
+	if s == "" {
+		return Nil, false, nil
+	}
+
+	var (
+		host, port string
+		hasPort    bool
+	)
+	switch {
+	case strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]"):
+		host = s
+	case strings.HasPrefix(s, "["), strings.Count(s, ":") == 1:
+		var err error
+		if host, port, err = net.SplitHostPort(s); err != nil {
+			return Nil, false, errors.Wrapf(ErrInvalidTextFormat, "%q: %v", s, err)
+		}
+		if strings.HasPrefix(s, "[") {
+			// keep the brackets so that normalizeHost() only accepts an IPv6 address
+			host = "[" + host + "]"
+		}
+		hasPort = true
+	default:
+		// no colons is a host with no port and more than one is an unbracketed IPv6 address
+		host = s
+	}
+
+	h, err := normalizeHost(host)
+	if err != nil {
+		return Nil, false, err
+	}
+	if !hasPort {
+		if h == "" {
+			return Nil, false, errors.Wrapf(ErrInvalidTextFormat, "%q", s)
+		}
+		return Value{host: h}, false, nil
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || p == 0 {
+		return Nil, false, errors.Wrapf(ErrInvalidPort, "%q", port)
+	}
+	return Value{host: h, port: uint16(p)}, true, nil
+}
+
+// normalizeHost validates the specified host and converts it to its canonical form.  An empty host
+// is allowed, as in ":8080", to support listen addresses.
+func normalizeHost(host string) (string, error) {
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		host = host[1 : len(host)-1]
+		if !strings.Contains(host, ":") {
+			return "", errors.Wrapf(ErrInvalidHost, "%q is not an IPv6 address", host)
+		}
+	}
+	if strings.Contains(host, ":") {
+		a, err := netip.ParseAddr(host)
+		if err != nil || !a.Is6() {
+			return "", errors.Wrapf(ErrInvalidHost, "%q is not an IPv6 address", host)
+		}
+		return a.String(), nil
+	}
+	if !isValidName(host) {
+		return "", errors.Wrapf(ErrInvalidHost, "%q", host)
+	}
+	return strings.ToLower(host), nil
+}
+
+// isValidName returns true if s is empty or is a sequence of non-empty labels containing letters,
+// digits, '-' and '_', separated by dots and with an optional trailing dot.  IPv4 addresses are
+// valid names.
+func isValidName(s string) bool {
+	if s == "" {
+		return true
+	}
+	if len(s) > 254 || s == "." {
+		return false
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(s, "."), ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '_') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsNil returns true if v is the Nil value
+func (v Value) IsNil() bool {
+	return v == Nil
+}
+
+// Host returns the host name or IP address, without brackets, or an empty string if there is none
+func (v Value) Host() string {
+	return v.host
+}
+
+// Port returns the port, or 0 if there is none
+func (v Value) Port() uint16 {
+	return v.port
+}
+
+// HasPort returns true if v has a port
+func (v Value) HasPort() bool {
+	return v.port != 0
+}
+
+// Addr returns the host as an IP address, if it is one
+func (v Value) Addr() (netip.Addr, bool) {
+	a, err := netip.ParseAddr(v.host)
+	return a, err == nil
+}
+
+// WithPort returns a copy of v with the specified port.  A port of 0 removes the port.
+func (v Value) WithPort(port uint16) Value {
+	v.port = port
+	return v
+}
+
+// WithDefaultPort returns a copy of v with the specified port if v does not already have one
+func (v Value) WithDefaultPort(port uint16) Value {
+	if v.port == 0 && v != Nil {
+		v.port = port
+	}
+	return v
+}
+
+// canonicalHost returns the host without a trailing dot, so that "example.com." and "example.com"
+// are treated as the same name
+func (v Value) canonicalHost() string {
+	return strings.TrimSuffix(v.host, ".")
+}
+
+// Compare returns an integer comparing v and other, ordering by host and then by port.  The result
+// is 0 if v == other, -1 if v < other, and +1 if v > other.  A trailing dot on a host name is ignored.
+func (v Value) Compare(other Value) int {
+	if c := strings.Compare(v.canonicalHost(), other.canonicalHost()); c != 0 {
+		return c
+	}
+	switch {
+	case v.port < other.port:
+		return -1
+	case v.port > other.port:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Equivalent returns true if v and other refer to the same endpoint when a missing port is replaced
+// with the specified default port, such as 443 for "example.com" and "example.com:443".  A trailing
+// dot on a host name is ignored.
+func (v Value) Equivalent(other Value, defaultPort uint16) bool {
+	return v.WithDefaultPort(defaultPort).Compare(other.WithDefaultPort(defaultPort)) == 0
+}
+
+// String implements fmt.Stringer for hostport.Value instances.
+//
+// The returned string is "host:port", with brackets around IPv6 addresses, or just the host if there
+// is no port.  Nil is returned as an empty string.
+func (v Value) String() string {
+	if v.port == 0 {
+		if strings.Contains(v.host, ":") {
+			return "[" + v.host + "]"
+		}
+		return v.host
+	}
+	return net.JoinHostPort(v.host, strconv.Itoa(int(v.port)))
+}
@@ -0,0 +1,173 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package hostport
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name string
+		s    string
+		host string
+		port uint16
+		str  string
+		err  error
+	}{
+		{"empty", "", "", 0, "", nil},
+		{"name", "db.example.com:5432", "db.example.com", 5432, "db.example.com:5432", nil},
+		{"name case", "DB.Example.COM:5432", "db.example.com", 5432, "db.example.com:5432", nil},
+		{"ipv4", "10.0.0.1:53", "10.0.0.1", 53, "10.0.0.1:53", nil},
+		{"ipv6", "[::1]:8080", "::1", 8080, "[::1]:8080", nil},
+		{"ipv6 normalized", "[2001:DB8:0:0::1]:443", "2001:db8::1", 443, "[2001:db8::1]:443", nil},
+		{"ipv6 zone", "[fe80::1%eth0]:22", "fe80::1%eth0", 22, "[fe80::1%eth0]:22", nil},
+		{"listen address", ":8080", "", 8080, ":8080", nil},
+		{"max port", "localhost:65535", "localhost", 65535, "localhost:65535", nil},
+		{"trailing dot", "example.com.:443", "example.com.", 443, "example.com.:443", nil},
+		{"missing port", "example.com", "", 0, "", ErrMissingPort},
+		{"missing ipv6 port", "[::1]", "", 0, "", ErrMissingPort},
+		{"empty port", "example.com:", "", 0, "", ErrInvalidPort},
+		{"zero port", "example.com:0", "", 0, "", ErrInvalidPort},
+		{"port too large", "example.com:65536", "", 0, "", ErrInvalidPort},
+		{"named port", "example.com:https", "", 0, "", ErrInvalidPort},
+		{"signed port", "example.com:+80", "", 0, "", ErrInvalidPort},
+		{"unbracketed ipv6 with port", "::1:8080", "", 0, "", ErrMissingPort},
+		{"unclosed bracket", "[::1:8080", "", 0, "", ErrInvalidTextFormat},
+		{"bracketed name", "[example.com]:80", "", 0, "", ErrInvalidHost},
+		{"invalid character", "exa mple.com:80", "", 0, "", ErrInvalidHost},
+		{"empty label", "example..com:80", "", 0, "", ErrInvalidHost},
+		{"leading hyphen", "-example.com:80", "", 0, "", ErrInvalidHost},
+		{"path", "example.com/x:80", "", 0, "", ErrInvalidHost},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v.Host() != tc.host || v.Port() != tc.port || v.String() != tc.str {
+				tt.Errorf("Expected (%q, %d, %q), got (%q, %d, %q)", tc.host, tc.port, tc.str, v.Host(), v.Port(), v.String())
+			}
+			if err == nil && Must(Parse(v.String())) != v {
+				tt.Errorf("Expected %q to parse back to the same value", v)
+			}
+		})
+	}
+}
+
+func TestParseDefault(t *testing.T) {
+	cases := []struct {
+		name        string
+		s           string
+		defaultPort uint16
+		str         string
+		err         error
+	}{
+		{"empty", "", 443, "", nil},
+		{"default used", "example.com", 443, "example.com:443", nil},
+		{"port kept", "example.com:8443", 443, "example.com:8443", nil},
+		{"bracketed ipv6", "[::1]", 443, "[::1]:443", nil},
+		{"bare ipv6", "2001:db8::1", 443, "[2001:db8::1]:443", nil},
+		{"no default", "example.com", 0, "example.com", nil},
+		{"no default ipv6", "::1", 0, "[::1]", nil},
+		{"invalid port", "example.com:0", 443, "", ErrInvalidPort},
+		{"invalid host", "exa_mple com", 443, "", ErrInvalidHost},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := ParseDefault(tc.s, tc.defaultPort)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v.String() != tc.str {
+				tt.Errorf("Expected %q, got %q", tc.str, v)
+			}
+			if err == nil && Must(ParseDefault(v.String(), 0)) != v {
+				tt.Errorf("Expected %q to parse back to the same value", v)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name string
+		host string
+		port uint16
+		str  string
+		err  error
+	}{
+		{"name", "Example.com", 80, "example.com:80", nil},
+		{"ipv6", "::1", 80, "[::1]:80", nil},
+		{"bracketed ipv6", "[::1]", 80, "[::1]:80", nil},
+		{"no port", "example.com", 0, "example.com", nil},
+		{"invalid host", "a:b", 80, "", ErrInvalidHost},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := New(tc.host, tc.port)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v.String() != tc.str {
+				tt.Errorf("Expected %q, got %q", tc.str, v)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name        string
+		a, b        string
+		cmp         int
+		defaultPort uint16
+		equivalent  bool
+	}{
+		{"equal", "example.com:443", "example.com:443", 0, 443, true},
+		{"trailing dot", "example.com.:443", "example.com:443", 0, 443, true},
+		{"case", "EXAMPLE.com:443", "example.com:443", 0, 443, true},
+		{"default port", "example.com", "example.com:443", -1, 443, true},
+		{"different default port", "example.com", "example.com:443", -1, 80, false},
+		{"port order", "example.com:80", "example.com:443", -1, 443, false},
+		{"host order", "b.example.com:80", "a.example.com:443", 1, 443, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			a, b := Must(ParseDefault(tc.a, 0)), Must(ParseDefault(tc.b, 0))
+			if c := a.Compare(b); c != tc.cmp {
+				tt.Errorf("Expected Compare() to return %d, got %d", tc.cmp, c)
+			}
+			if c := b.Compare(a); c != -tc.cmp {
+				tt.Errorf("Expected reversed Compare() to return %d, got %d", -tc.cmp, c)
+			}
+			if eq := a.Equivalent(b, tc.defaultPort); eq != tc.equivalent {
+				tt.Errorf("Expected Equivalent() to return %v, got %v", tc.equivalent, eq)
+			}
+		})
+	}
+}
+
+func TestAccessors(t *testing.T) {
+	v := Must(Parse("10.0.0.1:53"))
+	if a, ok := v.Addr(); !ok || a.String() != "10.0.0.1" {
+		t.Errorf("Expected Addr() to return 10.0.0.1, got %v, %v", a, ok)
+	}
+	if _, ok := Must(Parse("example.com:53")).Addr(); ok {
+		t.Errorf("Expected Addr() to fail for a host name")
+	}
+	if w := v.WithPort(0); w.HasPort() || w.String() != "10.0.0.1" {
+		t.Errorf("Expected WithPort(0) to remove the port, got %q", w)
+	}
+	if w := v.WithDefaultPort(80); w != v {
+		t.Errorf("Expected WithDefaultPort() to keep the existing port, got %q", w)
+	}
+	if w := Nil.WithDefaultPort(80); !w.IsNil() {
+		t.Errorf("Expected WithDefaultPort() to leave Nil unchanged, got %q", w)
+	}
+}
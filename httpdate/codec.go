@@ -0,0 +1,73 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package httpdate
+
+import (
+	"encoding"
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidTextData is returned from httpdate.Value.UnmarshalJSON() when the passed-in byte slice
+// does not contain a JSON string
+var ErrInvalidTextData = errors.Errorf("httpdate.Value: can only decode JSON strings")
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// MarshalText implements the encoding.TextMarshaler interface for httpdate.Value values
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for httpdate.Value values.  The
+// IMF-fixdate form is always used, and httpdate.Nil is encoded as an empty string.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	if v.IsNil() {
+		return b, nil
+	}
+	return v.t.AppendFormat(b, Format), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for httpdate.Value values.  Any of
+// the HTTP-date formats is accepted.
+func (v *Value) UnmarshalText(text []byte) error {
+	tmp, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = tmp
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for httpdate.Value values.  httpdate.Nil is
+// encoded as null.
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return []byte("null"), nil
+	}
+	b, _ := v.AppendText([]byte{'"'})
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for httpdate.Value values.  null is
+// decoded as httpdate.Nil.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*v = Nil
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil || len(data) == 0 || data[0] != '"' {
+		return ErrInvalidTextData
+	}
+	return v.UnmarshalText([]byte(s))
+}
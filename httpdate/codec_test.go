@@ -0,0 +1,48 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package httpdate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSON(t *testing.T) {
+	v := FromTime(sample)
+	data, err := json.Marshal(v)
+	if err != nil || string(data) != `"Sun, 14 Jul 2019 13:45:30 GMT"` {
+		t.Errorf("Expected the quoted IMF-fixdate form, got %s (err = %v)", data, err)
+	}
+	var got Value
+	if err := json.Unmarshal([]byte(`"Sun Jul 14 13:45:30 2019"`), &got); err != nil || got != v {
+		t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+	}
+	if data, _ = json.Marshal(Nil); string(data) != "null" {
+		t.Errorf("Expected null, got %s", data)
+	}
+	if err := json.Unmarshal([]byte("null"), &got); err != nil || !got.IsNil() {
+		t.Errorf("Expected null to decode as Nil, got %v (err = %v)", got, err)
+	}
+	if err := got.UnmarshalJSON([]byte("1563111930")); errors.Cause(err) != ErrInvalidTextData {
+		t.Errorf("Expected %v, got %v", ErrInvalidTextData, err)
+	}
+}
+
+func TestText(t *testing.T) {
+	v := FromTime(sample)
+	b, _ := v.AppendText([]byte("Expires: "))
+	if string(b) != "Expires: Sun, 14 Jul 2019 13:45:30 GMT" {
+		t.Errorf("Expected %q, got %q", "Expires: Sun, 14 Jul 2019 13:45:30 GMT", b)
+	}
+	var got Value
+	if err := got.UnmarshalText([]byte("Sunday, 14-Jul-19 13:45:30 GMT")); err != nil || got != v {
+		t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+	}
+	if err := got.UnmarshalText([]byte("bogus")); errors.Cause(err) != ErrInvalidFormat {
+		t.Errorf("Expected %v, got %v", ErrInvalidFormat, err)
+	}
+}
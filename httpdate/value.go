@@ -0,0 +1,121 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package httpdate provides a type for the HTTP-date values defined by RFC 7231, such as those in the
+// Date, Last-Modified, Expires and Retry-After headers.
+package httpdate
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidFormat is returned when a string is not in any of the HTTP-date formats
+	ErrInvalidFormat = errors.Errorf("httpdate: the specified text is not a valid HTTP-date")
+	// ErrMissingHeader is returned by FromHeader() and RetryAfter() when the header is not present
+	ErrMissingHeader = errors.Errorf("httpdate: the specified header is not present")
+)
+
+// Format is the IMF-fixdate layout that is always used when formatting values, such as
+// "Sun, 14 Jul 2019 13:45:30 GMT"
+const Format = http.TimeFormat
+
+// parseFormats contains the layouts that RFC 7231 requires recipients to accept, the preferred
+// IMF-fixdate followed by the obsolete RFC 850 and ANSI C asctime() forms
+var parseFormats = []string{
+	Format,
+	"Monday, 02-Jan-06 15:04:05 GMT",
+	"Mon Jan _2 15:04:05 2006",
+}
+
+// Value is an HTTP-date, an instant in UTC with a resolution of one second.  The zero value,
+// httpdate.Nil, represents a missing date.
+type Value struct {
+	t time.Time
+}
+
+// Nil is the zero value, which represents a missing date
+var Nil Value
+
+// FromTime converts t to an HTTP-date, truncating any fractional seconds.  The zero time.Time is
+// converted to httpdate.Nil.
+func FromTime(t time.Time) Value {
+	if t.IsZero() {
+		return Nil
+	}
+	return Value{t: t.UTC().Truncate(time.Second)}
+}
+
+// Parse parses s in any of the three HTTP-date formats permitted by RFC 7231: IMF-fixdate
+// ("Sun, 14 Jul 2019 13:45:30 GMT"), RFC 850 ("Sunday, 14-Jul-19 13:45:30 GMT") or ANSI C asctime()
+// ("Sun Jul 14 13:45:30 2019").  An empty string is parsed as httpdate.Nil.
+func Parse(s string) (Value, error) {
+	if s == "" {
+		return Nil, nil
+	}
+	for _, layout := range parseFormats {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Value{t: t.UTC()}, nil
+		}
+	}
+	return Nil, errors.Wrapf(ErrInvalidFormat, "%q", s)
+}
+
+// IsNil returns true if v is httpdate.Nil
+func (v Value) IsNil() bool {
+	return v.t.IsZero()
+}
+
+// Time returns v as a UTC time.Time, or the zero time.Time if v is httpdate.Nil
+func (v Value) Time() time.Time {
+	return v.t
+}
+
+// String returns the IMF-fixdate form of v, or an empty string if v is httpdate.Nil
+func (v Value) String() string {
+	if v.IsNil() {
+		return ""
+	}
+	return v.t.Format(Format)
+}
+
+// FromHeader parses the first value of the named header in h
+func FromHeader(h http.Header, key string) (Value, error) {
+	s := h.Get(key)
+	if s == "" {
+		return Nil, errors.Wrapf(ErrMissingHeader, "%s", key)
+	}
+	return Parse(s)
+}
+
+// SetHeader sets the named header in h to the IMF-fixdate form of v.  The header is deleted if v is
+// httpdate.Nil.
+func SetHeader(h http.Header, key string, v Value) {
+	if v.IsNil() {
+		h.Del(key)
+		return
+	}
+	h.Set(key, v.String())
+}
+
+// RetryAfter returns the instant indicated by the Retry-After header in h, which may be either an
+// HTTP-date or a number of seconds to wait after now
+func RetryAfter(h http.Header, now time.Time) (Value, error) {
+	s := h.Get("Retry-After")
+	if s == "" {
+		return Nil, errors.Wrapf(ErrMissingHeader, "Retry-After")
+	}
+	if s[0] >= '0' && s[0] <= '9' {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return Nil, errors.Wrapf(ErrInvalidFormat, "%q", s)
+		}
+		return FromTime(now.Add(time.Duration(n) * time.Second)), nil
+	}
+	return Parse(s)
+}
@@ -0,0 +1,113 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package httpdate
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var sample = time.Date(2019, 7, 14, 13, 45, 30, 0, time.UTC)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected Value
+		err      error
+	}{
+		{"empty", "", Nil, nil},
+		{"IMF-fixdate", "Sun, 14 Jul 2019 13:45:30 GMT", FromTime(sample), nil},
+		{"RFC 850", "Sunday, 14-Jul-19 13:45:30 GMT", FromTime(sample), nil},
+		{"asctime", "Sun Jul 14 13:45:30 2019", FromTime(sample), nil},
+		{"asctime single digit day", "Thu Jul  4 13:45:30 2019", FromTime(time.Date(2019, 7, 4, 13, 45, 30, 0, time.UTC)), nil},
+		{"other zone", "Sun, 14 Jul 2019 13:45:30 PST", Nil, ErrInvalidFormat},
+		{"RFC 3339", "2019-07-14T13:45:30Z", Nil, ErrInvalidFormat},
+		{"garbage", "yesterday", Nil, ErrInvalidFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFromTime(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	cases := []struct {
+		name     string
+		t        time.Time
+		expected string
+	}{
+		{"zero", time.Time{}, ""},
+		{"UTC", sample, "Sun, 14 Jul 2019 13:45:30 GMT"},
+		{"other zone", sample.In(loc), "Sun, 14 Jul 2019 13:45:30 GMT"},
+		{"truncated", sample.Add(999 * time.Millisecond), "Sun, 14 Jul 2019 13:45:30 GMT"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := FromTime(tc.t).String(); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	h := http.Header{}
+	SetHeader(h, "Last-Modified", FromTime(sample))
+	if got := h.Get("Last-Modified"); got != "Sun, 14 Jul 2019 13:45:30 GMT" {
+		t.Errorf("Expected the IMF-fixdate form, got %q", got)
+	}
+	if got, err := FromHeader(h, "Last-Modified"); err != nil || !got.Time().Equal(sample) {
+		t.Errorf("Expected %v, got %v (err = %v)", sample, got, err)
+	}
+	SetHeader(h, "Last-Modified", Nil)
+	if _, ok := h["Last-Modified"]; ok {
+		t.Errorf("Expected setting Nil to remove the header")
+	}
+	if _, err := FromHeader(h, "Expires"); errors.Cause(err) != ErrMissingHeader {
+		t.Errorf("Expected %v, got %v", ErrMissingHeader, err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := []struct {
+		name     string
+		header   string
+		expected Value
+		err      error
+	}{
+		{"seconds", "120", FromTime(sample.Add(2 * time.Minute)), nil},
+		{"date", "Sunday, 14-Jul-19 14:00:00 GMT", FromTime(time.Date(2019, 7, 14, 14, 0, 0, 0, time.UTC)), nil},
+		{"missing", "", Nil, ErrMissingHeader},
+		{"bad seconds", "12s", Nil, ErrInvalidFormat},
+		{"bad date", "soon", Nil, ErrInvalidFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			h := http.Header{}
+			if tc.header != "" {
+				h.Set("Retry-After", tc.header)
+			}
+			got, err := RetryAfter(h, sample)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Addr wraps a netip.Addr to add database and JSON support.  All of the netip.Addr methods are
+// available through the embedded field, and a netip.Addr can be converted with inet.Addr{a}.
+//
+// The zero value wraps the invalid netip.Addr, which is encoded as an empty string in text and JSON
+// and as NULL in the database.
+type Addr struct {
+	netip.Addr
+}
+
+// interface validations
+var _ encoding.TextMarshaler = (*Addr)(nil)
+var _ encoding.TextUnmarshaler = (*Addr)(nil)
+var _ json.Marshaler = (*Addr)(nil)
+var _ json.Unmarshaler = (*Addr)(nil)
+var _ driver.Valuer = (*Addr)(nil)
+
+// ParseAddr parses an IPv4 or IPv6 address, such as "10.0.0.1" or "2001:db8::1".  A Postgres inet
+// value with a full-length mask, such as "10.0.0.1/32", is also accepted.  An empty string is parsed as
+// the zero value.
+func ParseAddr(s string) (Addr, error) {
+	if s == "" {
+		return Addr{}, nil
+	}
+	if strings.IndexByte(s, '/') == -1 {
+		a, err := netip.ParseAddr(s)
+		if err != nil {
+			return Addr{}, errors.Wrapf(ErrInvalidTextFormat, "%v", err)
+		}
+		return Addr{a}, nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return Addr{}, errors.Wrapf(ErrInvalidTextFormat, "%v", err)
+	}
+	return addrFromPrefix(p)
+}
+
+// MustParseAddr calls ParseAddr(s) and panics on error.  It is intended for use in tests with
+// hard-coded strings.
+func MustParseAddr(s string) Addr {
+	a, err := ParseAddr(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// addrFromPrefix returns the address of a prefix that covers a single address
+func addrFromPrefix(p netip.Prefix) (Addr, error) {
+	if p.Bits() != p.Addr().BitLen() {
+		return Addr{}, errors.Wrapf(ErrNotSingleAddress, "%s", p)
+	}
+	return Addr{p.Addr()}, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for inet.Addr values.
+//
+// The text is parsed by ParseAddr().
+func (a *Addr) UnmarshalText(text []byte) error {
+	res, err := ParseAddr(string(text))
+	if err != nil {
+		return err
+	}
+	*a = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for inet.Addr values.
+//
+// Values are encoded as a JSON string containing the same value as MarshalText().
+func (a Addr) MarshalJSON() ([]byte, error) {
+	text, _ := a.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for inet.Addr values.
+//
+// If the value is the special JSON null token, a is set to the zero value.  All other values are
+// delegated to UnmarshalText().
+func (a *Addr) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		*a = Addr{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface for inet.Addr values.  The returned value is the
+// same text as is returned by MarshalText(), which Postgres accepts for both inet and cidr columns, or
+// nil for the zero value.
+func (a Addr) Value() (driver.Value, error) {
+	if !a.IsValid() {
+		return nil, nil
+	}
+	return a.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for inet.Addr values.
+//
+// Strings and byte slices containing text are handled by UnmarshalText().  Byte slices containing the
+// Postgres binary form of an inet or cidr value are also accepted.  Values with a mask shorter than
+// the address, such as "10.0.0.0/8", return ErrNotSingleAddress.  All other values will return an
+// error.  Use NullAddr for columns that can be NULL.
+func (a *Addr) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case []byte:
+		if isPostgresBinary(tv) {
+			p, err := decodePostgresBinary(tv)
+			if err != nil {
+				return err
+			}
+			res, err := addrFromPrefix(p)
+			if err != nil {
+				return err
+			}
+			*a = res
+			return nil
+		}
+		return a.UnmarshalText(tv)
+	case string:
+		return a.UnmarshalText([]byte(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
+
+// PostgresBinary returns the Postgres binary wire format of a as an inet value, for use with drivers
+// that support custom binary encoders.  The zero value returns nil.
+func (a Addr) PostgresBinary() []byte {
+	if !a.IsValid() {
+		return nil
+	}
+	return encodePostgresBinary(netip.PrefixFrom(a.Addr, a.BitLen()), false)
+}
@@ -0,0 +1,140 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseAddr(t *testing.T) {
+	cases := []struct {
+		name string
+		s    string
+		str  string
+		err  error
+	}{
+		{"empty", "", "", nil},
+		{"ipv4", "10.0.0.1", "10.0.0.1", nil},
+		{"ipv6", "2001:DB8::1", "2001:db8::1", nil},
+		{"zone", "fe80::1%eth0", "fe80::1%eth0", nil},
+		{"ipv4 host mask", "10.0.0.1/32", "10.0.0.1", nil},
+		{"ipv6 host mask", "2001:db8::1/128", "2001:db8::1", nil},
+		{"network", "10.0.0.0/8", "", ErrNotSingleAddress},
+		{"invalid", "10.0.0.256", "", ErrInvalidTextFormat},
+		{"invalid prefix", "10.0.0.1/33", "", ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			a, err := ParseAddr(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if text, _ := a.MarshalText(); string(text) != tc.str {
+				tt.Errorf("Expected %q, got %q", tc.str, text)
+			}
+		})
+	}
+}
+
+func TestAddrJSON(t *testing.T) {
+	type doc struct {
+		A Addr `json:"a"`
+	}
+	cases := []struct {
+		name     string
+		data     string
+		expected Addr
+		err      error
+	}{
+		{"ipv4", `{"a":"192.168.1.1"}`, MustParseAddr("192.168.1.1"), nil},
+		{"ipv6", `{"a":"::1"}`, MustParseAddr("::1"), nil},
+		{"zero", `{"a":""}`, Addr{}, nil},
+		{"number", `{"a":42}`, Addr{}, ErrInvalidTextData},
+		{"invalid", `{"a":"localhost"}`, Addr{}, ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var d doc
+			err := json.Unmarshal([]byte(tc.data), &d)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if d.A != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, d.A)
+			}
+			if data, err := json.Marshal(d); err != nil || string(data) != tc.data {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.data, data, err)
+			}
+		})
+	}
+
+	a := MustParseAddr("10.0.0.1")
+	if err := json.Unmarshal([]byte("null"), &a); err != nil || a.IsValid() {
+		t.Errorf("Expected null to decode as the zero value, got %v (err = %v)", a, err)
+	}
+}
+
+func TestAddrSQL(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Addr
+		err      error
+	}{
+		{"string", "10.0.0.1", MustParseAddr("10.0.0.1"), nil},
+		{"inet text", []byte("10.0.0.1/32"), MustParseAddr("10.0.0.1"), nil},
+		{"ipv4 binary", []byte{2, 32, 0, 4, 10, 0, 0, 1}, MustParseAddr("10.0.0.1"), nil},
+		{"ipv6 binary", []byte{3, 128, 0, 16, 0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}, MustParseAddr("2001:db8::1"), nil},
+		{"network text", "10.0.0.0/8", Addr{}, ErrNotSingleAddress},
+		{"network binary", []byte{2, 8, 1, 4, 10, 0, 0, 0}, Addr{}, ErrNotSingleAddress},
+		{"short binary", []byte{2, 32, 0}, Addr{}, ErrInvalidBinaryData},
+		{"wrong length", []byte{2, 32, 0, 16, 10, 0, 0, 1}, Addr{}, ErrInvalidBinaryData},
+		{"truncated", []byte{3, 128, 0, 16, 10, 0, 0, 1}, Addr{}, ErrInvalidBinaryData},
+		{"invalid mask", []byte{2, 33, 0, 4, 10, 0, 0, 1}, Addr{}, ErrInvalidBinaryData},
+		{"null", nil, Addr{}, ErrUnsupportedSourceType},
+		{"unsupported type", 42, Addr{}, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var a Addr
+			err := a.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if a != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, a)
+			}
+		})
+	}
+}
+
+func TestAddrValue(t *testing.T) {
+	if v, err := (Addr{}).Value(); err != nil || v != nil {
+		t.Errorf("Expected the zero value to be NULL, got %v (err = %v)", v, err)
+	}
+	for _, s := range []string{"10.0.0.1", "2001:db8::1", "::ffff:10.0.0.1"} {
+		a := MustParseAddr(s)
+		if v, err := a.Value(); err != nil || v != s {
+			t.Errorf("Expected %q, got %v (err = %v)", s, v, err)
+		}
+		var got Addr
+		if err := got.Scan(a.PostgresBinary()); err != nil || got != a {
+			t.Errorf("Expected %v to round-trip through the binary form, got %v (err = %v)", a, got, err)
+		}
+	}
+	if b := (Addr{}).PostgresBinary(); b != nil {
+		t.Errorf("Expected no binary form for the zero value, got %v", b)
+	}
+	if a := (Addr{netip.MustParseAddr("10.0.0.1")}); !a.Is4() || a.Next().String() != "10.0.0.2" {
+		t.Errorf("Expected the netip.Addr methods to be available")
+	}
+}
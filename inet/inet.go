@@ -0,0 +1,31 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package inet provides thin wrappers around netip.Addr and netip.Prefix that add the database/sql
+// and JSON support the standard library types lack, including the text and binary forms of the
+// Postgres inet and cidr types, plus NullAddr and NullPrefix for nullable columns.
+package inet
+
+import (
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextFormat is returned when a string is not a valid IP address or prefix
+	ErrInvalidTextFormat = errors.Errorf("inet: the specified text is not a valid IP address or prefix")
+	// ErrNotSingleAddress is returned when an inet.Addr is decoded from a prefix that covers more
+	// than one address, such as "10.0.0.0/8"
+	ErrNotSingleAddress = errors.Errorf("inet: the specified prefix covers more than one address")
+	// ErrInvalidBinaryData is returned when a byte slice is not a valid Postgres inet or cidr value
+	ErrInvalidBinaryData = errors.Errorf("inet: the specified data is not a valid Postgres inet/cidr value")
+	// ErrInvalidTextData is returned from UnmarshalJSON() when the passed-in byte slice does not
+	// contain a string
+	ErrInvalidTextData = errors.Errorf("inet: can only decode JSON strings")
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// an inet.Addr or inet.Prefix value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to an inet.Addr or inet.Prefix value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
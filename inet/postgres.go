@@ -0,0 +1,64 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"net/netip"
+
+	"github.com/pkg/errors"
+)
+
+// The Postgres binary form of inet and cidr values is a 4-byte header followed by the address bytes:
+//
+//	family  1 byte  - 2 for IPv4 and 3 for IPv6 (PGSQL_AF_INET and PGSQL_AF_INET6)
+//	bits    1 byte  - the mask length
+//	is_cidr 1 byte  - 1 for cidr values and 0 for inet values
+//	nb      1 byte  - the number of address bytes, 4 or 16
+const (
+	pgFamilyIPv4 = 2
+	pgFamilyIPv6 = 3
+	pgHeaderLen  = 4
+)
+
+// isPostgresBinary returns true if b starts with a Postgres inet/cidr family byte.  Since the text
+// forms only contain printable characters, there is no ambiguity between the two forms.
+func isPostgresBinary(b []byte) bool {
+	return len(b) > 0 && (b[0] == pgFamilyIPv4 || b[0] == pgFamilyIPv6)
+}
+
+// decodePostgresBinary decodes the Postgres binary form of an inet or cidr value
+func decodePostgresBinary(b []byte) (netip.Prefix, error) {
+	if len(b) < pgHeaderLen {
+		return netip.Prefix{}, errors.Wrapf(ErrInvalidBinaryData, "expected at least %d bytes, got %d", pgHeaderLen, len(b))
+	}
+	family, bits, nb := b[0], int(b[1]), int(b[3])
+	if (family == pgFamilyIPv4 && nb != 4) || (family == pgFamilyIPv6 && nb != 16) {
+		return netip.Prefix{}, errors.Wrapf(ErrInvalidBinaryData, "invalid address length %d for family %d", nb, family)
+	}
+	if len(b) != pgHeaderLen+nb {
+		return netip.Prefix{}, errors.Wrapf(ErrInvalidBinaryData, "expected %d bytes, got %d", pgHeaderLen+nb, len(b))
+	}
+	if bits > nb*8 {
+		return netip.Prefix{}, errors.Wrapf(ErrInvalidBinaryData, "invalid mask length %d", bits)
+	}
+	a, _ := netip.AddrFromSlice(b[pgHeaderLen:])
+	return netip.PrefixFrom(a, bits), nil
+}
+
+// encodePostgresBinary returns the Postgres binary form of p
+func encodePostgresBinary(p netip.Prefix, cidr bool) []byte {
+	a := p.Addr().WithZone("")
+	family := byte(pgFamilyIPv6)
+	if a.Is4() {
+		family = pgFamilyIPv4
+	}
+	addr := a.AsSlice()
+	res := make([]byte, pgHeaderLen, pgHeaderLen+len(addr))
+	res[0], res[1], res[3] = family, byte(p.Bits()), byte(len(addr))
+	if cidr {
+		res[2] = 1
+	}
+	return append(res, addr...)
+}
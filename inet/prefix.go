@@ -0,0 +1,155 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Prefix wraps a netip.Prefix to add database and JSON support.  All of the netip.Prefix methods are
+// available through the embedded field, and a netip.Prefix can be converted with inet.Prefix{p}.
+//
+// A Prefix can hold both Postgres cidr values, which have no bits set after the mask, and inet values
+// such as "192.168.0.1/24", which do.  Use Masked() to discard the host bits.
+//
+// The zero value wraps the invalid netip.Prefix, which is encoded as an empty string in text and JSON
+// and as NULL in the database.
+type Prefix struct {
+	netip.Prefix
+}
+
+// interface validations
+var _ encoding.TextMarshaler = (*Prefix)(nil)
+var _ encoding.TextUnmarshaler = (*Prefix)(nil)
+var _ json.Marshaler = (*Prefix)(nil)
+var _ json.Unmarshaler = (*Prefix)(nil)
+var _ driver.Valuer = (*Prefix)(nil)
+
+// ParsePrefix parses an IP prefix in CIDR notation, such as "10.0.0.0/8" or "2001:db8::/32".  As in
+// Postgres, an address without a mask, such as "10.0.0.1", is parsed as a prefix covering only that
+// address.  An empty string is parsed as the zero value.
+func ParsePrefix(s string) (Prefix, error) {
+	if s == "" {
+		return Prefix{}, nil
+	}
+	if strings.IndexByte(s, '/') == -1 {
+		a, err := netip.ParseAddr(s)
+		if err != nil {
+			return Prefix{}, errors.Wrapf(ErrInvalidTextFormat, "%v", err)
+		}
+		if a.Zone() != "" {
+			return Prefix{}, errors.Wrapf(ErrInvalidTextFormat, "%q: prefixes cannot have a zone", s)
+		}
+		return Prefix{netip.PrefixFrom(a, a.BitLen())}, nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return Prefix{}, errors.Wrapf(ErrInvalidTextFormat, "%v", err)
+	}
+	return Prefix{p}, nil
+}
+
+// MustParsePrefix calls ParsePrefix(s) and panics on error.  It is intended for use in tests with
+// hard-coded strings.
+func MustParsePrefix(s string) Prefix {
+	p, err := ParsePrefix(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Masked returns p with all of the bits after the mask set to zero, which is the form Postgres
+// requires for cidr values
+func (p Prefix) Masked() Prefix {
+	return Prefix{p.Prefix.Masked()}
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for inet.Prefix values.
+//
+// The text is parsed by ParsePrefix().
+func (p *Prefix) UnmarshalText(text []byte) error {
+	res, err := ParsePrefix(string(text))
+	if err != nil {
+		return err
+	}
+	*p = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for inet.Prefix values.
+//
+// Values are encoded as a JSON string containing the same value as MarshalText().
+func (p Prefix) MarshalJSON() ([]byte, error) {
+	text, _ := p.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for inet.Prefix values.
+//
+// If the value is the special JSON null token, p is set to the zero value.  All other values are
+// delegated to UnmarshalText().
+func (p *Prefix) UnmarshalJSON(d []byte) error {
+	if bytes.Equal(d, []byte("null")) {
+		*p = Prefix{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(d, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return p.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface for inet.Prefix values.  The returned value is the
+// same text as is returned by MarshalText(), or nil for the zero value.  Postgres rejects cidr values
+// with bits set after the mask, so use Masked() before writing to a cidr column.
+func (p Prefix) Value() (driver.Value, error) {
+	if !p.IsValid() {
+		return nil, nil
+	}
+	return p.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for inet.Prefix values.
+//
+// Strings and byte slices containing text are handled by UnmarshalText().  Byte slices containing the
+// Postgres binary form of an inet or cidr value are also accepted.  All other values will return an
+// error.  Use NullPrefix for columns that can be NULL.
+func (p *Prefix) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case []byte:
+		if isPostgresBinary(tv) {
+			res, err := decodePostgresBinary(tv)
+			if err != nil {
+				return err
+			}
+			*p = Prefix{res}
+			return nil
+		}
+		return p.UnmarshalText(tv)
+	case string:
+		return p.UnmarshalText([]byte(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
+
+// PostgresBinary returns the Postgres binary wire format of p, for use with drivers that support
+// custom binary encoders.  The value is flagged as a cidr if there are no bits set after the mask.
+// The zero value returns nil.
+func (p Prefix) PostgresBinary() []byte {
+	if !p.IsValid() {
+		return nil
+	}
+	return encodePostgresBinary(p.Prefix, p.Prefix == p.Prefix.Masked())
+}
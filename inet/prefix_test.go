@@ -0,0 +1,140 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParsePrefix(t *testing.T) {
+	cases := []struct {
+		name   string
+		s      string
+		str    string
+		masked string
+		err    error
+	}{
+		{"empty", "", "", "", nil},
+		{"cidr", "10.0.0.0/8", "10.0.0.0/8", "10.0.0.0/8", nil},
+		{"inet", "192.168.0.1/24", "192.168.0.1/24", "192.168.0.0/24", nil},
+		{"ipv6", "2001:DB8::/32", "2001:db8::/32", "2001:db8::/32", nil},
+		{"ipv4 host", "10.0.0.1", "10.0.0.1/32", "10.0.0.1/32", nil},
+		{"ipv6 host", "::1", "::1/128", "::1/128", nil},
+		{"zone", "fe80::1%eth0", "", "", ErrInvalidTextFormat},
+		{"invalid mask", "10.0.0.0/33", "", "", ErrInvalidTextFormat},
+		{"invalid address", "10.0.0/8", "", "", ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			p, err := ParsePrefix(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if text, _ := p.MarshalText(); string(text) != tc.str {
+				tt.Errorf("Expected %q, got %q", tc.str, text)
+			}
+			if text, _ := p.Masked().MarshalText(); string(text) != tc.masked {
+				tt.Errorf("Expected masked %q, got %q", tc.masked, text)
+			}
+		})
+	}
+}
+
+func TestPrefixJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Prefix
+		err      error
+	}{
+		{"cidr", `"10.0.0.0/8"`, MustParsePrefix("10.0.0.0/8"), nil},
+		{"zero", `""`, Prefix{}, nil},
+		{"number", `8`, Prefix{}, ErrInvalidTextData},
+		{"invalid", `"10.0.0.0/99"`, Prefix{}, ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var p Prefix
+			err := json.Unmarshal([]byte(tc.data), &p)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if p != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, p)
+			}
+			if data, err := json.Marshal(p); err != nil || string(data) != tc.data {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.data, data, err)
+			}
+		})
+	}
+}
+
+func TestPrefixSQL(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Prefix
+		err      error
+	}{
+		{"cidr text", "10.0.0.0/8", MustParsePrefix("10.0.0.0/8"), nil},
+		{"inet text", []byte("192.168.0.1/24"), MustParsePrefix("192.168.0.1/24"), nil},
+		{"host text", "10.0.0.1", MustParsePrefix("10.0.0.1/32"), nil},
+		{"cidr binary", []byte{2, 8, 1, 4, 10, 0, 0, 0}, MustParsePrefix("10.0.0.0/8"), nil},
+		{"ipv6 binary", []byte{3, 32, 1, 16, 0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, MustParsePrefix("2001:db8::/32"), nil},
+		{"invalid binary", []byte{3, 32, 1, 4, 10, 0, 0, 0}, Prefix{}, ErrInvalidBinaryData},
+		{"invalid text", "10.0.0.0/", Prefix{}, ErrInvalidTextFormat},
+		{"unsupported type", 8, Prefix{}, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var p Prefix
+			err := p.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if p != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, p)
+			}
+		})
+	}
+}
+
+func TestPrefixBinary(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected []byte
+	}{
+		{"cidr", "10.0.0.0/8", []byte{2, 8, 1, 4, 10, 0, 0, 0}},
+		{"inet", "192.168.0.1/24", []byte{2, 24, 0, 4, 192, 168, 0, 1}},
+		{"ipv6", "2001:db8::/32", []byte{3, 32, 1, 16, 0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			p := MustParsePrefix(tc.s)
+			b := p.PostgresBinary()
+			if !bytes.Equal(b, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, b)
+			}
+			var got Prefix
+			if err := got.Scan(b); err != nil || got != p {
+				tt.Errorf("Expected %v, got %v (err = %v)", p, got, err)
+			}
+			if v, err := p.Value(); err != nil || v != tc.s {
+				tt.Errorf("Expected %q, got %v (err = %v)", tc.s, v, err)
+			}
+		})
+	}
+	if v, err := (Prefix{}).Value(); err != nil || v != nil {
+		t.Errorf("Expected the zero value to be NULL, got %v (err = %v)", v, err)
+	}
+}
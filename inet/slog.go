@@ -0,0 +1,31 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"log/slog"
+)
+
+// interface validations
+var _ slog.LogValuer = (*Addr)(nil)
+var _ slog.LogValuer = (*Prefix)(nil)
+
+// LogValue implements the slog.LogValuer interface for inet.Addr values.  The zero value is logged as
+// null and all other values as the string returned by String().
+func (a Addr) LogValue() slog.Value {
+	if !a.IsValid() {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(a.String())
+}
+
+// LogValue implements the slog.LogValuer interface for inet.Prefix values.  The zero value is logged
+// as null and all other values in CIDR notation.
+func (p Prefix) LogValue() slog.Value {
+	if !p.IsValid() {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(p.String())
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"log/slog"
+	"net/netip"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/null"
+)
+
+func TestLogValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        slog.LogValuer
+		expected interface{}
+	}{
+		{"addr", MustParseAddr("192.168.1.1"), "192.168.1.1"},
+		{"zero addr", Addr{}, nil},
+		{"prefix", Prefix{netip.MustParsePrefix("10.0.0.0/8")}, "10.0.0.0/8"},
+		{"zero prefix", Prefix{}, nil},
+		{"null addr", NullAddr{}, nil},
+		{"valid null addr", null.From(MustParseAddr("::1")), "::1"},
+		{"null prefix", NullPrefix{}, nil},
+		{"valid null prefix", null.From(Prefix{netip.MustParsePrefix("2001:db8::/32")}), "2001:db8::/32"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			lv := tc.v.LogValue().Resolve()
+			if tc.expected == nil {
+				if lv.Kind() != slog.KindAny || lv.Any() != nil {
+					tt.Errorf("Expected null, got %v", lv)
+				}
+				return
+			}
+			if lv.Kind() != slog.KindString || lv.String() != tc.expected {
+				tt.Errorf("Expected %q, got %v", tc.expected, lv)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"github.com/dylan-bourque/go-types/null"
+)
+
+// NullAddr can be used with the standard sql package to represent an inet.Addr value that can be
+// NULL in the database.  The wrapped value is in the V field.
+//
+// It wraps inet.Addr rather than netip.Addr because netip.Addr has no Scan() or Value() methods, so
+// null.Value would have nothing to delegate to for the text and Postgres binary forms.
+type NullAddr = null.Value[Addr]
+
+// NullPrefix can be used with the standard sql package to represent an inet.Prefix value that can be
+// NULL in the database.  The wrapped value is in the V field.
+//
+// It wraps inet.Prefix rather than netip.Prefix for the same reason as NullAddr.
+type NullPrefix = null.Value[Prefix]
@@ -0,0 +1,87 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package inet
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/null"
+)
+
+func TestNullAddr(t *testing.T) {
+	cases := []struct {
+		name   string
+		v      NullAddr
+		sqlVal driver.Value
+		json   string
+	}{
+		{"null", NullAddr{}, nil, "null"},
+		{"valid", null.From(MustParseAddr("10.0.0.1")), "10.0.0.1", `"10.0.0.1"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got, err := tc.v.Value(); err != nil || got != tc.sqlVal {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.sqlVal, got, err)
+			}
+			var scanned NullAddr
+			if err := scanned.Scan(tc.sqlVal); err != nil || scanned != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, scanned, err)
+			}
+			data, err := json.Marshal(tc.v)
+			if err != nil || string(data) != tc.json {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.json, data, err)
+			}
+			got := null.From(MustParseAddr("::1"))
+			if err := json.Unmarshal(data, &got); err != nil || got != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, got, err)
+			}
+			if tc.v.IsZero() == tc.v.Valid {
+				tt.Errorf("Expected IsZero() to be the inverse of Valid")
+			}
+		})
+	}
+
+	var a NullAddr
+	if err := a.Scan([]byte{2, 32, 0, 4, 10, 0, 0, 1}); err != nil || !a.Valid || a.V.String() != "10.0.0.1" {
+		t.Errorf("Expected the binary form to be scanned, got %v (err = %v)", a, err)
+	}
+}
+
+func TestNullPrefix(t *testing.T) {
+	cases := []struct {
+		name   string
+		v      NullPrefix
+		sqlVal driver.Value
+		json   string
+	}{
+		{"null", NullPrefix{}, nil, "null"},
+		{"valid", null.From(Prefix{netip.MustParsePrefix("10.0.0.0/8")}), "10.0.0.0/8", `"10.0.0.0/8"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got, err := tc.v.Value(); err != nil || got != tc.sqlVal {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.sqlVal, got, err)
+			}
+			var scanned NullPrefix
+			if err := scanned.Scan(tc.sqlVal); err != nil || scanned != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, scanned, err)
+			}
+			data, err := json.Marshal(tc.v)
+			if err != nil || string(data) != tc.json {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.json, data, err)
+			}
+			got := null.From(Prefix{netip.MustParsePrefix("::/0")})
+			if err := json.Unmarshal(data, &got); err != nil || got != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, got, err)
+			}
+			if tc.v.IsZero() == tc.v.Valid {
+				tt.Errorf("Expected IsZero() to be the inverse of Valid")
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package int128
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidBinaryDataLen is returned from int128.Value.UnmarshalBinary() when the passed-in byte
+	// slice is not 16 bytes long
+	ErrInvalidBinaryDataLen = errors.Errorf("int128.Value: binary data must be 16 bytes")
+	// ErrInvalidTextData is returned from int128.Value.UnmarshalJSON() when the passed-in byte slice
+	// does not contain a string or an integer
+	ErrInvalidTextData = errors.Errorf("int128.Value: can only decode JSON strings and integers")
+)
+
+// interface validations
+var _ encoding.BinaryMarshaler = (*Value)(nil)
+var _ encoding.BinaryAppender = (*Value)(nil)
+var _ encoding.BinaryUnmarshaler = (*Value)(nil)
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for int128.Value values.  The
+// encoded value is the 16 bytes of the two's complement form in big-endian order.
+func (v Value) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, 16))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for int128.Value values.  It appends the
+// same encoding as MarshalBinary() to b.
+func (v Value) AppendBinary(b []byte) ([]byte, error) {
+	return v.u.AppendBinary(b)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for int128.Value values.
+func (v *Value) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return ErrInvalidBinaryDataLen
+	}
+	return v.u.UnmarshalBinary(data)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for int128.Value values.
+//
+// The encoded value is the decimal representation, the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for int128.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	return v.AppendFormat(b, 10), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for int128.Value values.
+//
+// The text is parsed by Parse() with base 0, so "0x", "0o" and "0b" prefixes are accepted.
+func (v *Value) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for int128.Value values.
+//
+// Values are encoded as a JSON string containing the decimal representation, since most JSON
+// consumers cannot represent integers outside of ±2^53 exactly.
+func (v Value) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for int128.Value values.
+//
+// Both JSON strings, which are delegated to UnmarshalText(), and JSON integers are accepted.  As with
+// the built-in integer types, the JSON null token leaves v unchanged.
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		return nil
+	}
+	if len(p) > 0 && (p[0] == '-' || (p[0] >= '0' && p[0] <= '9')) {
+		res, err := Parse(string(p), 10)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidTextData, "%v", err)
+		}
+		*v = res
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return v.UnmarshalText([]byte(s))
+}
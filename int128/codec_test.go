@@ -0,0 +1,88 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package int128
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, v := range sampleValues() {
+		data, _ := v.MarshalBinary()
+		var got Value
+		if err := got.UnmarshalBinary(data); err != nil || got != v {
+			t.Fatalf("Expected %s, got %s (err = %v)", v, got, err)
+		}
+	}
+	if data, _ := MinusOne.MarshalBinary(); data[0] != 0xff || data[15] != 0xff {
+		t.Errorf("Expected the two's complement encoding of -1, got %x", data)
+	}
+	var v Value
+	if err := v.UnmarshalBinary(make([]byte, 17)); err != ErrInvalidBinaryDataLen {
+		t.Errorf("Expected %v, got %v", ErrInvalidBinaryDataLen, err)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		err      error
+	}{
+		{"string", `"-170141183460469231731687303715884105728"`, Min, nil},
+		{"number", `-42`, From64(-42), nil},
+		{"hex string", `"-0x10"`, From64(-16), nil},
+		{"fractional number", `-1.5`, Zero, ErrInvalidTextData},
+		{"null", `null`, Zero, nil},
+		{"overflow", `"170141183460469231731687303715884105728"`, Zero, ErrOverflow},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+
+	data, err := json.Marshal(From64(-42))
+	if err != nil || string(data) != `"-42"` {
+		t.Errorf(`Expected "-42", got %s (err = %v)`, data, err)
+	}
+}
+
+func TestAppendTextDoesNotAllocate(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"zero", Zero, "0"},
+		{"positive", Max, "170141183460469231731687303715884105727"},
+		{"negative", Min, "-170141183460469231731687303715884105728"},
+	}
+	buf := make([]byte, 0, 64)
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				buf, _ = tc.v.AppendText(buf[:0])
+			})
+			if allocs != 0 {
+				tt.Errorf("Expected no allocations, got %v", allocs)
+			}
+			if string(buf) != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, buf)
+			}
+		})
+	}
+}
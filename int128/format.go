@@ -0,0 +1,110 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package int128
+
+import (
+	"math/big"
+
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidBase is returned by Parse() when the base is not 0 or between 2 and 36
+	ErrInvalidBase = errors.Errorf("int128: the base must be 0 or between 2 and 36")
+	// ErrInvalidTextFormat is returned by Parse() and UnmarshalText() when the text is not a valid
+	// integer in the requested base
+	ErrInvalidTextFormat = errors.Errorf("int128.Value: text data was not in the correct format")
+)
+
+// Parse parses a signed integer in the specified base, which must be between 2 and 36.  The text may
+// start with a '+' or '-' sign.
+//
+// If base is 0, the base is taken from the prefix that follows the sign: "0x" or "0X" for base 16,
+// "0o" or "0O" for base 8, "0b" or "0B" for base 2, and base 10 otherwise.  Values outside of the
+// range [Min, Max] return ErrOverflow.
+func Parse(s string, base int) (Value, error) {
+	neg := false
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		neg, s = s[0] == '-', s[1:]
+	}
+	m, err := uint128.Parse(s, base)
+	switch errors.Cause(err) {
+	case nil:
+	case uint128.ErrOverflow:
+		return Zero, ErrOverflow
+	case uint128.ErrInvalidBase:
+		return Zero, ErrInvalidBase
+	default:
+		return Zero, errors.Wrapf(ErrInvalidTextFormat, "%q", s)
+	}
+	if neg {
+		if uint128.Compare(m, Min.u) > 0 {
+			return Zero, ErrOverflow
+		}
+		return Value{u: m}.Neg(), nil
+	}
+	if uint128.Compare(m, Max.u) > 0 {
+		return Zero, ErrOverflow
+	}
+	return Value{u: m}, nil
+}
+
+// Format returns v in the specified base, which must be between 2 and 36, using lower case letters
+// for digits above 9, a leading '-' for negative values and no prefix.  It panics if the base is out
+// of range.
+func (v Value) Format(base int) string {
+	if !v.isNeg() {
+		return v.u.Format(base)
+	}
+	var buf [129]byte
+	return string(v.AppendFormat(buf[:0], base))
+}
+
+// AppendFormat appends the same text as Format(base) to b and returns the extended buffer.  It panics
+// if the base is out of range.
+func (v Value) AppendFormat(b []byte, base int) []byte {
+	if base < 2 || base > 36 {
+		panic(ErrInvalidBase)
+	}
+	if v.isNeg() {
+		b = append(b, '-')
+	}
+	return v.Abs().AppendFormat(b, base)
+}
+
+// String implements fmt.Stringer for int128.Value instances.  The returned string is the decimal
+// representation of v.
+func (v Value) String() string {
+	return v.Format(10)
+}
+
+// Big returns v as a *big.Int
+func (v Value) Big() *big.Int {
+	b := v.Abs().Big()
+	if v.isNeg() {
+		b.Neg(b)
+	}
+	return b
+}
+
+// FromBig returns a Value equal to the specified *big.Int, or ErrOverflow if it is outside of the
+// range [Min, Max]
+func FromBig(b *big.Int) (Value, error) {
+	m, err := uint128.FromBig(new(big.Int).Abs(b))
+	if err != nil {
+		return Zero, ErrOverflow
+	}
+	if b.Sign() < 0 {
+		if uint128.Compare(m, Min.u) > 0 {
+			return Zero, ErrOverflow
+		}
+		return Value{u: m}.Neg(), nil
+	}
+	if uint128.Compare(m, Max.u) > 0 {
+		return Zero, ErrOverflow
+	}
+	return Value{u: m}, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package int128
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		base     int
+		expected Value
+		err      error
+	}{
+		{"zero", "0", 10, Zero, nil},
+		{"negative", "-42", 10, From64(-42), nil},
+		{"explicit positive", "+42", 10, From64(42), nil},
+		{"min", "-170141183460469231731687303715884105728", 10, Min, nil},
+		{"max", "170141183460469231731687303715884105727", 10, Max, nil},
+		{"below min", "-170141183460469231731687303715884105729", 10, Zero, ErrOverflow},
+		{"above max", "170141183460469231731687303715884105728", 10, Zero, ErrOverflow},
+		{"beyond 128 bits", "-999999999999999999999999999999999999999999", 10, Zero, ErrOverflow},
+		{"negative hex", "-0xff", 0, From64(-255), nil},
+		{"empty", "", 10, Zero, ErrInvalidTextFormat},
+		{"sign only", "-", 10, Zero, ErrInvalidTextFormat},
+		{"double sign", "--1", 10, Zero, ErrInvalidTextFormat},
+		{"invalid base", "1", 1, Zero, ErrInvalidBase},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s, tc.base)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for _, v := range sampleValues() {
+		for _, base := range []int{2, 10, 16, 36} {
+			s := v.Format(base)
+			if expected := v.Big().Text(base); s != expected {
+				t.Fatalf("Format(%d): expected %s, got %s", base, expected, s)
+			}
+			if got := Must(Parse(s, base)); got != v {
+				t.Fatalf("Parse(%q, %d): expected %s, got %s", s, base, v, got)
+			}
+		}
+	}
+}
+
+func TestFromBig(t *testing.T) {
+	cases := []struct {
+		name string
+		b    *big.Int
+		err  error
+	}{
+		{"zero", big.NewInt(0), nil},
+		{"min", minBig, nil},
+		{"max", maxBig, nil},
+		{"below min", new(big.Int).Sub(minBig, big.NewInt(1)), ErrOverflow},
+		{"above max", new(big.Int).Add(maxBig, big.NewInt(1)), ErrOverflow},
+		{"beyond 128 bits", new(big.Int).Neg(two128), ErrOverflow},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := FromBig(tc.b)
+			if err != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && v.Big().Cmp(tc.b) != 0 {
+				tt.Errorf("Expected %s, got %s", tc.b, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package int128
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestValue(t *testing.T) {
+	got, err := Min.Value()
+	if err != nil || got != "-170141183460469231731687303715884105728" {
+		t.Errorf("Unexpected value %v (err = %v)", got, err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"int64", int64(-42), From64(-42), nil},
+		{"string", "-18446744073709551616", FromParts(^uint64(0), 0), nil},
+		{"bytes", []byte("12345"), From64(12345), nil},
+		{"invalid text", "12.5", Zero, ErrInvalidTextFormat},
+		{"unsupported type", 1.5, Zero, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package int128
+
+import (
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/pkg/errors"
+)
+
+// Value represents a signed 128-bit integer, stored in two's complement form.
+//
+// Arithmetic wraps around on overflow, as with Go's built-in signed integer types.  The *Checked
+// variants report overflow as an error instead.  Values can be compared with == and used as map keys.
+//
+// The zero value is int128.Zero.
+type Value struct {
+	u uint128.Value
+}
+
+var (
+	// Zero is the int128.Value with value 0
+	Zero = Value{}
+	// One is the int128.Value with value 1
+	One = Value{u: uint128.One}
+	// MinusOne is the int128.Value with value -1
+	MinusOne = Value{u: uint128.Max}
+	// Min is the smallest int128.Value, -2^127
+	Min = Value{u: uint128.FromParts(1<<63, 0)}
+	// Max is the largest int128.Value, 2^127 - 1
+	Max = Value{u: uint128.FromParts(1<<63-1, ^uint64(0))}
+)
+
+var (
+	// ErrOverflow is returned when the result of an operation does not fit in 128 bits
+	ErrOverflow = errors.Errorf("int128: the result overflows 128 bits")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in int128.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// From64 returns a Value equal to the specified 64-bit integer
+func From64(v int64) Value {
+	hi := uint64(0)
+	if v < 0 {
+		hi = ^uint64(0)
+	}
+	return Value{u: uint128.FromParts(hi, uint64(v))}
+}
+
+// FromParts returns a Value from its upper and lower 64 bits, in two's complement form
+func FromParts(hi, lo uint64) Value {
+	return Value{u: uint128.FromParts(hi, lo)}
+}
+
+// FromUint128 returns the Value with the same bits as u, which is negative if the top bit of u is set
+func FromUint128(u uint128.Value) Value {
+	return Value{u: u}
+}
+
+// Parts returns the upper and lower 64 bits of v, in two's complement form
+func (v Value) Parts() (hi, lo uint64) {
+	return v.u.Parts()
+}
+
+// Uint128 returns the two's complement bits of v as a uint128.Value
+func (v Value) Uint128() uint128.Value {
+	return v.u
+}
+
+// IsInt64 returns true if v can be represented as an int64
+func (v Value) IsInt64() bool {
+	hi, lo := v.u.Parts()
+	return (hi == 0 && int64(lo) >= 0) || (hi == ^uint64(0) && int64(lo) < 0)
+}
+
+// Int64 returns the lower 64 bits of v as an int64, which is v itself if IsInt64() returns true
+func (v Value) Int64() int64 {
+	_, lo := v.u.Parts()
+	return int64(lo)
+}
+
+// IsZero returns true if v is 0
+func (v Value) IsZero() bool {
+	return v == Zero
+}
+
+// Sign returns -1, 0 or +1 depending on whether v is negative, zero or positive
+func (v Value) Sign() int {
+	switch {
+	case v.isNeg():
+		return -1
+	case v.IsZero():
+		return 0
+	default:
+		return 1
+	}
+}
+
+// isNeg returns true if the sign bit of v is set
+func (v Value) isNeg() bool {
+	hi, _ := v.u.Parts()
+	return int64(hi) < 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether v1 is less than, equal to, or greater than v2
+func Compare(v1, v2 Value) int {
+	// flipping the sign bit maps the signed range onto the unsigned range in the same order
+	return uint128.Compare(v1.u.Xor(Min.u), v2.u.Xor(Min.u))
+}
+
+// Neg returns -v.  As with Go's built-in types, the negation of Min is Min.
+func (v Value) Neg() Value {
+	return Value{u: uint128.Zero.Sub(v.u)}
+}
+
+// Abs returns the absolute value of v as a uint128.Value, which can represent the magnitude of Min
+func (v Value) Abs() uint128.Value {
+	if v.isNeg() {
+		return v.Neg().u
+	}
+	return v.u
+}
+
+// Add returns v + v2, wrapping around on overflow
+func (v Value) Add(v2 Value) Value {
+	return Value{u: v.u.Add(v2.u)}
+}
+
+// AddChecked returns v + v2, or ErrOverflow if the result does not fit in 128 bits
+func (v Value) AddChecked(v2 Value) (Value, error) {
+	r := v.Add(v2)
+	// overflow only occurs when both operands have the same sign and the result does not
+	if v.isNeg() == v2.isNeg() && r.isNeg() != v.isNeg() {
+		return Zero, ErrOverflow
+	}
+	return r, nil
+}
+
+// Sub returns v - v2, wrapping around on overflow
+func (v Value) Sub(v2 Value) Value {
+	return Value{u: v.u.Sub(v2.u)}
+}
+
+// SubChecked returns v - v2, or ErrOverflow if the result does not fit in 128 bits
+func (v Value) SubChecked(v2 Value) (Value, error) {
+	r := v.Sub(v2)
+	// overflow only occurs when the operands have different signs and the result's sign differs from v
+	if v.isNeg() != v2.isNeg() && r.isNeg() != v.isNeg() {
+		return Zero, ErrOverflow
+	}
+	return r, nil
+}
+
+// Mul returns v * v2, wrapping around on overflow
+func (v Value) Mul(v2 Value) Value {
+	return Value{u: v.u.Mul(v2.u)}
+}
+
+// MulChecked returns v * v2, or ErrOverflow if the result does not fit in 128 bits
+func (v Value) MulChecked(v2 Value) (Value, error) {
+	m, err := v.Abs().MulChecked(v2.Abs())
+	if err != nil {
+		return Zero, ErrOverflow
+	}
+	if v.isNeg() != v2.isNeg() {
+		// the magnitude of a negative result can be at most 2^127
+		if uint128.Compare(m, Min.u) > 0 {
+			return Zero, ErrOverflow
+		}
+		return Value{u: m}.Neg(), nil
+	}
+	if uint128.Compare(m, Max.u) > 0 {
+		return Zero, ErrOverflow
+	}
+	return Value{u: m}, nil
+}
+
+// QuoRem returns the quotient of v / v2, truncated toward zero, and the remainder, which has the
+// same sign as v.  This matches Go's built-in / and % operators, including Min / -1 wrapping to Min.
+// It panics if v2 is 0.
+func (v Value) QuoRem(v2 Value) (q, r Value) {
+	uq, ur := v.Abs().QuoRem(v2.Abs())
+	q, r = Value{u: uq}, Value{u: ur}
+	if v.isNeg() != v2.isNeg() {
+		q = q.Neg()
+	}
+	if v.isNeg() {
+		r = r.Neg()
+	}
+	return q, r
+}
+
+// Quo returns v / v2, truncated toward zero.  It panics if v2 is 0.
+func (v Value) Quo(v2 Value) Value {
+	q, _ := v.QuoRem(v2)
+	return q
+}
+
+// Rem returns the remainder of v / v2, which has the same sign as v.  It panics if v2 is 0.
+func (v Value) Rem(v2 Value) Value {
+	_, r := v.QuoRem(v2)
+	return r
+}
+
+// And returns the bitwise AND of v and v2
+func (v Value) And(v2 Value) Value {
+	return Value{u: v.u.And(v2.u)}
+}
+
+// Or returns the bitwise OR of v and v2
+func (v Value) Or(v2 Value) Value {
+	return Value{u: v.u.Or(v2.u)}
+}
+
+// Xor returns the bitwise XOR of v and v2
+func (v Value) Xor(v2 Value) Value {
+	return Value{u: v.u.Xor(v2.u)}
+}
+
+// Not returns the bitwise complement of v, which is -v - 1
+func (v Value) Not() Value {
+	return Value{u: v.u.Not()}
+}
+
+// Lsh returns v << n.  Bits shifted past the most significant bit are discarded.
+func (v Value) Lsh(n uint) Value {
+	return Value{u: v.u.Lsh(n)}
+}
+
+// Rsh returns v >> n, using an arithmetic shift that preserves the sign, as with Go's built-in types
+func (v Value) Rsh(n uint) Value {
+	if !v.isNeg() {
+		return Value{u: v.u.Rsh(n)}
+	}
+	// shifting the complement of a negative number and complementing again fills with ones
+	return Value{u: v.u.Not().Rsh(n).Not()}
+}
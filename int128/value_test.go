@@ -0,0 +1,157 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package int128
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+var (
+	minBig = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	maxBig = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	two128 = new(big.Int).Lsh(big.NewInt(1), 128)
+)
+
+// wrap reduces b into the int128 range using two's complement wrapping
+func wrap(b *big.Int) *big.Int {
+	r := new(big.Int).Mod(b, two128)
+	if r.Cmp(maxBig) > 0 {
+		r.Sub(r, two128)
+	}
+	return r
+}
+
+// inRange returns true if b fits in an int128
+func inRange(b *big.Int) bool {
+	return b.Cmp(minBig) >= 0 && b.Cmp(maxBig) <= 0
+}
+
+// sampleValues returns edge cases plus pseudo-random values of various bit lengths and both signs
+func sampleValues() []Value {
+	vals := []Value{Zero, One, MinusOne, Min, Max, Min.Add(One), Max.Sub(One), From64(2), From64(-2), From64(-1 << 63), FromParts(0, 1<<63)}
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 40; i++ {
+		v := FromParts(r.Uint64(), r.Uint64()).Rsh(uint(r.Intn(128)))
+		if i%2 == 0 {
+			v = v.Neg()
+		}
+		vals = append(vals, v)
+	}
+	return vals
+}
+
+func TestArithmeticMatchesBig(t *testing.T) {
+	vals := sampleValues()
+	for _, a := range vals {
+		for _, b := range vals {
+			ba, bb := a.Big(), b.Big()
+
+			sum := new(big.Int).Add(ba, bb)
+			if got := a.Add(b).Big(); got.Cmp(wrap(sum)) != 0 {
+				t.Fatalf("%s + %s: expected %s, got %s", a, b, wrap(sum), got)
+			}
+			if _, err := a.AddChecked(b); (err != nil) != !inRange(sum) {
+				t.Fatalf("%s + %s: unexpected overflow result %v", a, b, err)
+			}
+
+			diff := new(big.Int).Sub(ba, bb)
+			if got := a.Sub(b).Big(); got.Cmp(wrap(diff)) != 0 {
+				t.Fatalf("%s - %s: expected %s, got %s", a, b, wrap(diff), got)
+			}
+			if _, err := a.SubChecked(b); (err != nil) != !inRange(diff) {
+				t.Fatalf("%s - %s: unexpected overflow result %v", a, b, err)
+			}
+
+			prod := new(big.Int).Mul(ba, bb)
+			if got := a.Mul(b).Big(); got.Cmp(wrap(prod)) != 0 {
+				t.Fatalf("%s * %s: expected %s, got %s", a, b, wrap(prod), got)
+			}
+			if _, err := a.MulChecked(b); (err != nil) != !inRange(prod) {
+				t.Fatalf("%s * %s: unexpected overflow result %v", a, b, err)
+			}
+
+			if !b.IsZero() {
+				q, r := a.QuoRem(b)
+				bq, br := new(big.Int).QuoRem(ba, bb, new(big.Int))
+				if q.Big().Cmp(wrap(bq)) != 0 || r.Big().Cmp(br) != 0 {
+					t.Fatalf("%s / %s: expected (%s, %s), got (%s, %s)", a, b, wrap(bq), br, q, r)
+				}
+			}
+
+			if got, expected := Compare(a, b), ba.Cmp(bb); got != expected {
+				t.Fatalf("Compare(%s, %s): expected %d, got %d", a, b, expected, got)
+			}
+		}
+	}
+}
+
+func TestShifts(t *testing.T) {
+	for _, v := range sampleValues() {
+		bv := v.Big()
+		for _, n := range []uint{0, 1, 63, 64, 65, 127, 128, 200} {
+			if got, expected := v.Lsh(n).Big(), wrap(new(big.Int).Lsh(bv, n)); got.Cmp(expected) != 0 {
+				t.Fatalf("%s << %d: expected %s, got %s", v, n, expected, got)
+			}
+			// big.Int.Rsh is an arithmetic shift for negative values
+			if got, expected := v.Rsh(n).Big(), new(big.Int).Rsh(bv, n); got.Cmp(expected) != 0 {
+				t.Fatalf("%s >> %d: expected %s, got %s", v, n, expected, got)
+			}
+		}
+		if v.Not() != v.Neg().Sub(One) || v.And(v.Not()) != Zero || v.Or(v.Not()) != MinusOne || v.Xor(v) != Zero {
+			t.Fatalf("Bitwise identities do not hold for %s", v)
+		}
+	}
+}
+
+func TestSignAndAbs(t *testing.T) {
+	cases := []struct {
+		name string
+		v    Value
+		sign int
+		abs  string
+	}{
+		{"zero", Zero, 0, "0"},
+		{"positive", From64(42), 1, "42"},
+		{"negative", From64(-42), -1, "42"},
+		{"min", Min, -1, "170141183460469231731687303715884105728"},
+		{"max", Max, 1, "170141183460469231731687303715884105727"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if s, a := tc.v.Sign(), tc.v.Abs().String(); s != tc.sign || a != tc.abs {
+				tt.Errorf("Expected (%d, %s), got (%d, %s)", tc.sign, tc.abs, s, a)
+			}
+		})
+	}
+	if Min.Neg() != Min {
+		t.Errorf("Expected -Min to wrap to Min")
+	}
+}
+
+func TestInt64(t *testing.T) {
+	cases := []struct {
+		name  string
+		v     Value
+		isInt bool
+	}{
+		{"zero", Zero, true},
+		{"min int64", From64(-1 << 63), true},
+		{"max int64", From64(1<<63 - 1), true},
+		{"below min int64", From64(-1 << 63).Sub(One), false},
+		{"above max int64", From64(1<<63 - 1).Add(One), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.v.IsInt64(); got != tc.isInt {
+				tt.Errorf("Expected %v, got %v", tc.isInt, got)
+			}
+			if tc.isInt && big.NewInt(tc.v.Int64()).Cmp(tc.v.Big()) != 0 {
+				tt.Errorf("Expected %s, got %d", tc.v, tc.v.Int64())
+			}
+		})
+	}
+}
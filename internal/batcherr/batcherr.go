@@ -0,0 +1,63 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package batcherr provides the per-element errors returned by the batch conversion functions of the
+// other packages, such as date.FromTimes() and timeofday.ParseAll().  Those packages alias the types
+// here so that callers never need to import this package.
+package batcherr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndexError records the error for a single element of a batch conversion
+type IndexError struct {
+	// Index is the position of the failed element in the input slice
+	Index int
+	// Err is the error returned when converting that element
+	Err error
+}
+
+// Error implements the error interface for batcherr.IndexError values
+func (e IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Cause returns the underlying error so that errors.Cause() can be used to check for a specific
+// error, such as date.ErrInvalidDateUnit
+func (e IndexError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the underlying error for compatibility with errors.Is() and errors.As()
+func (e IndexError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError is returned by the batch conversion functions when one or more elements could not be
+// converted.  It contains one IndexError for each failed element, in input order.
+type BatchError []IndexError
+
+// Error implements the error interface for batcherr.BatchError values
+func (e BatchError) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msgs := make([]string, len(e))
+	for i, ie := range e {
+		msgs[i] = ie.Error()
+	}
+	return fmt.Sprintf("%d errors: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual IndexError values so that errors.Is() and errors.As() match an error
+// for any element
+func (e BatchError) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, ie := range e {
+		errs[i] = ie
+	}
+	return errs
+}
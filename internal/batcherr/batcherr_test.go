@@ -0,0 +1,50 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package batcherr
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+var errSentinel = errors.Errorf("test: conversion failed")
+
+func TestBatchErrorMessage(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      BatchError
+		expected string
+	}{
+		{"single error", BatchError{{Index: 2, Err: errSentinel}}, "index 2: " + errSentinel.Error()},
+		{
+			"multiple errors",
+			BatchError{{Index: 0, Err: errSentinel}, {Index: 3, Err: errSentinel}},
+			"2 errors: index 0: " + errSentinel.Error() + "; index 3: " + errSentinel.Error(),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.err.Error(); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	var err error = BatchError{{Index: 0, Err: errSentinel}, {Index: 3, Err: errSentinel}}
+	if !stderrors.Is(err, errSentinel) {
+		t.Errorf("Expected errors.Is() to match %v through the BatchError", errSentinel)
+	}
+	var ie IndexError
+	if !stderrors.As(err, &ie) || ie.Index != 0 {
+		t.Errorf("Expected errors.As() to find the first IndexError, got %v", ie)
+	}
+	if errors.Cause(IndexError{Index: 1, Err: errSentinel}) != errSentinel {
+		t.Errorf("Expected errors.Cause() to return %v", errSentinel)
+	}
+}
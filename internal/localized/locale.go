@@ -0,0 +1,175 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package localized provides the locale data - month and weekday names, AM/PM markers and the
+// first day of the week - that is shared by the localized formatting of the date and timeofday
+// packages.
+//
+// The built-in data is a subset of the Unicode CLDR data for a handful of common locales.  Additional
+// locales can be registered at runtime via Register().
+package localized
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Locale defines the localized names and settings for a single locale
+type Locale struct {
+	// Tag is the BCP 47 language tag that identifies the locale, such as "en" or "fr-CA"
+	Tag string
+	// Months contains the full month names, starting with January
+	Months [12]string
+	// ShortMonths contains the abbreviated month names, starting with January
+	ShortMonths [12]string
+	// Weekdays contains the full weekday names, starting with Sunday
+	Weekdays [7]string
+	// ShortWeekdays contains the abbreviated weekday names, starting with Sunday
+	ShortWeekdays [7]string
+	// AM and PM are the markers for times before and after noon
+	AM, PM string
+	// FirstDayOfWeek is the day on which a calendar week starts in this locale
+	FirstDayOfWeek time.Weekday
+}
+
+var (
+	// ErrInvalidLocale is returned by Register() when the locale is missing a tag or any of the names
+	ErrInvalidLocale = errors.Errorf("localized: the locale definition is incomplete")
+	// ErrUnknownLocale is returned when no locale is registered for a tag or any of its parents
+	ErrUnknownLocale = errors.Errorf("localized: no locale is registered for the specified tag")
+)
+
+var (
+	mu      sync.RWMutex
+	locales = make(map[string]Locale)
+)
+
+func init() {
+	for _, l := range builtin {
+		locales[normalize(l.Tag)] = l
+	}
+}
+
+// Register adds the specified locale to the registry, replacing any existing locale with the same tag
+func Register(l Locale) error {
+	if err := validate(l); err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	locales[normalize(l.Tag)] = l
+	return nil
+}
+
+// Lookup returns the locale registered for the specified tag.
+//
+// If there is no exact match, the tag is truncated at the last '-' and the lookup is retried so that,
+// for example, "fr-CA" falls back to "fr".
+func Lookup(tag string) (Locale, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for key := normalize(tag); key != ""; {
+		if l, ok := locales[key]; ok {
+			return l, nil
+		}
+		i := strings.LastIndexByte(key, '-')
+		if i < 0 {
+			break
+		}
+		key = key[:i]
+	}
+	return Locale{}, errors.Wrapf(ErrUnknownLocale, "tag: %q", tag)
+}
+
+// Format returns a textual representation of t according to the same rules as time.Time.Format(),
+// with the month names, weekday names and AM/PM markers replaced by the values from l.
+func Format(t time.Time, layout string, l Locale) string {
+	var (
+		buf   strings.Builder
+		start int
+	)
+	for i := 0; i < len(layout); {
+		name, n := localName(t, layout[i:], l)
+		if n == 0 {
+			i++
+			continue
+		}
+		// format everything before the name token using the standard rules
+		if start < i {
+			buf.WriteString(t.Format(layout[start:i]))
+		}
+		buf.WriteString(name)
+		i += n
+		start = i
+	}
+	if start < len(layout) {
+		buf.WriteString(t.Format(layout[start:]))
+	}
+	return buf.String()
+}
+
+// localName checks for a localizable token at the start of s and returns the localized value and
+// the length of the token, or a length of 0 if there isn't one.
+//
+// The tokens are checked in the same order, and with the same rules, as the time package so that, for
+// example, "Monday" is matched before "Mon" and words such as "Month" are left as literal text.
+func localName(t time.Time, s string, l Locale) (string, int) {
+	switch {
+	case strings.HasPrefix(s, "January"):
+		return l.Months[t.Month()-1], 7
+	case strings.HasPrefix(s, "Jan") && !startsWithLowerCase(s[3:]):
+		return l.ShortMonths[t.Month()-1], 3
+	case strings.HasPrefix(s, "Monday"):
+		return l.Weekdays[t.Weekday()], 6
+	case strings.HasPrefix(s, "Mon") && !startsWithLowerCase(s[3:]):
+		return l.ShortWeekdays[t.Weekday()], 3
+	case strings.HasPrefix(s, "PM"):
+		if t.Hour() >= 12 {
+			return l.PM, 2
+		}
+		return l.AM, 2
+	case strings.HasPrefix(s, "pm"):
+		if t.Hour() >= 12 {
+			return strings.ToLower(l.PM), 2
+		}
+		return strings.ToLower(l.AM), 2
+	}
+	return "", 0
+}
+
+// startsWithLowerCase reports whether s begins with a lower case ASCII letter, in which case the time
+// package treats a preceding "Jan" or "Mon" as part of a word rather than a token
+func startsWithLowerCase(s string) bool {
+	return s != "" && 'a' <= s[0] && s[0] <= 'z'
+}
+
+// validate ensures that all of the fields of l are populated
+func validate(l Locale) error {
+	if normalize(l.Tag) == "" || l.AM == "" || l.PM == "" {
+		return ErrInvalidLocale
+	}
+	if l.FirstDayOfWeek < time.Sunday || l.FirstDayOfWeek > time.Saturday {
+		return ErrInvalidLocale
+	}
+	for i := range l.Months {
+		if l.Months[i] == "" || l.ShortMonths[i] == "" {
+			return ErrInvalidLocale
+		}
+	}
+	for i := range l.Weekdays {
+		if l.Weekdays[i] == "" || l.ShortWeekdays[i] == "" {
+			return ErrInvalidLocale
+		}
+	}
+	return nil
+}
+
+// normalize converts a language tag to the form used as the registry key: lower case with '-'
+// separators
+func normalize(tag string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(tag), "_", "-", -1))
+}
@@ -0,0 +1,87 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package localized
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestLookup(t *testing.T) {
+	cases := []struct {
+		name     string
+		tag      string
+		expected string
+		err      error
+	}{
+		{"empty tag", "", "", ErrUnknownLocale},
+		{"unknown tag", "xx", "", ErrUnknownLocale},
+		{"exact match", "fr", "fr", nil},
+		{"exact match/region", "fr-CA", "fr-CA", nil},
+		{"case insensitive", "EN-gb", "en-GB", nil},
+		{"underscore separator", "en_GB", "en-GB", nil},
+		{"parent fallback", "de-AT", "de", nil},
+		{"parent fallback/multiple subtags", "pt-Latn-BR", "pt", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			l, err := Lookup(tc.tag)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if l.Tag != tc.expected {
+				tt.Errorf("Expected locale %q, got %q", tc.expected, l.Tag)
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	en, _ := Lookup("en")
+	if err := Register(Locale{Tag: "xx-incomplete"}); err != ErrInvalidLocale {
+		t.Errorf("Expected ErrInvalidLocale, got %v", err)
+	}
+	custom := en
+	custom.Tag = "en-x-test"
+	custom.AM, custom.PM = "in the morning", "in the afternoon"
+	if err := Register(custom); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, err := Lookup("en-x-test")
+	if err != nil || got.PM != "in the afternoon" {
+		t.Errorf("Expected the registered locale, got %+v (err = %v)", got, err)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tm := time.Date(2024, time.February, 5, 14, 30, 0, 0, time.UTC)
+	cases := []struct {
+		name     string
+		tag      string
+		layout   string
+		expected string
+	}{
+		{"english/long", "en", "Monday, January 2, 2006", "Monday, February 5, 2024"},
+		{"french/long", "fr", "Monday 2 January 2006", "lundi 5 février 2024"},
+		{"french/short", "fr", "Mon 2 Jan 2006", "lun. 5 févr. 2024"},
+		{"german/short", "de", "Mon, 02. Jan 2006", "Mo., 05. Feb. 2024"},
+		{"spanish/PM", "es", "3:04 PM", "2:30 p. m."},
+		{"english/lower pm", "en", "3:04pm", "2:30pm"},
+		{"no names", "fr", "2006-01-02T15:04:05", "2024-02-05T14:30:00"},
+		{"literal Month", "en", "Month: January 2", "Month: February 5"},
+		{"literal Monitor", "fr", "Monitor Mon", "Monitor lun."},
+		{"literal Janet", "de", "Janet Jan", "Janet Feb."},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			l, _ := Lookup(tc.tag)
+			if got := Format(tm, tc.layout, l); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package typeerr provides the prebuilt "unsupported type" errors returned by the Scan() methods and
+// conversion functions of the other packages.
+//
+// Wrapping a sentinel error with github.com/pkg/errors.Wrapf() captures a stack trace and formats a
+// message on every call, which is wasted work on hot paths where callers only compare the cause.  A
+// Cache instead returns one error per source type, created the first time that type is seen, and only
+// formats the message when Error() is called.
+package typeerr
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Error reports a value of an unsupported type.  Cause() and Unwrap() return the sentinel error that
+// was passed to New(), so both github.com/pkg/errors.Cause() and the standard errors.Is() work.
+type Error struct {
+	cause error
+	typ   reflect.Type
+	once  sync.Once
+	msg   string
+}
+
+// Error implements the error interface for typeerr.Error values.  The message matches the one
+// produced by errors.Wrapf(cause, "Unsupported type: %T", src).
+func (e *Error) Error() string {
+	e.once.Do(func() {
+		name := "<nil>"
+		if e.typ != nil {
+			name = e.typ.String()
+		}
+		e.msg = fmt.Sprintf("Unsupported type: %s: %v", name, e.cause)
+	})
+	return e.msg
+}
+
+// SourceType returns the unsupported type, or nil if the source value was nil.  Callers outside this
+// module can retrieve it with errors.As() and an interface{ SourceType() reflect.Type } target.
+func (e *Error) SourceType() reflect.Type {
+	return e.typ
+}
+
+// Cause returns the sentinel error
+func (e *Error) Cause() error {
+	return e.cause
+}
+
+// Unwrap returns the sentinel error
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Cache holds the errors for a single sentinel, one per unsupported source type.  The zero value is
+// not usable; create instances with New().
+type Cache struct {
+	cause  error
+	nilErr *Error
+	errs   sync.Map // reflect.Type -> *Error
+}
+
+// New returns a Cache that creates errors with the specified sentinel as their cause
+func New(cause error) *Cache {
+	return &Cache{cause: cause, nilErr: &Error{cause: cause}}
+}
+
+// Of returns the error for a value with the same type as src.  Repeated calls for the same type
+// return the same error without allocating.
+func (c *Cache) Of(src interface{}) error {
+	t := reflect.TypeOf(src)
+	if t == nil {
+		return c.nilErr
+	}
+	if e, ok := c.errs.Load(t); ok {
+		return e.(*Error)
+	}
+	e, _ := c.errs.LoadOrStore(t, &Error{cause: c.cause, typ: t})
+	return e.(*Error)
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typeerr
+
+import (
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+var errSentinel = errors.Errorf("test: unsupported source type")
+
+func TestOf(t *testing.T) {
+	c := New(errSentinel)
+	cases := []struct {
+		name string
+		src  interface{}
+	}{
+		{"nil", nil},
+		{"int", 42},
+		{"string", "text"},
+		{"byte slice", []byte("text")},
+		{"pointer", new(float64)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := c.Of(tc.src)
+			if errors.Cause(err) != errSentinel {
+				tt.Errorf("Expected errors.Cause() to return %v, got %v", errSentinel, errors.Cause(err))
+			}
+			if !stderrors.Is(err, errSentinel) {
+				tt.Errorf("Expected errors.Is() to match %v", errSentinel)
+			}
+			expected := errors.Wrapf(errSentinel, "Unsupported type: %T", tc.src).Error()
+			if err.Error() != expected {
+				tt.Errorf("Expected %q, got %q", expected, err.Error())
+			}
+			var te interface{ SourceType() reflect.Type }
+			if !stderrors.As(err, &te) || te.SourceType() != reflect.TypeOf(tc.src) {
+				tt.Errorf("Expected errors.As() to return the source type %T", tc.src)
+			}
+			if again := c.Of(tc.src); again != err {
+				tt.Errorf("Expected the same error for the same source type")
+			}
+		})
+	}
+}
+
+func TestOfAllocations(t *testing.T) {
+	c := New(errSentinel)
+	src := interface{}(42)
+	_ = c.Of(src)
+	if n := testing.AllocsPerRun(100, func() { _ = c.Of(src) }); n != 0 {
+		t.Errorf("Expected no allocations for a cached type, got %v", n)
+	}
+}
+
+func BenchmarkOf(b *testing.B) {
+	c := New(errSentinel)
+	src := interface{}(42)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = c.Of(src)
+	}
+}
+
+func BenchmarkWrapf(b *testing.B) {
+	src := interface{}(42)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = errors.Wrapf(errSentinel, "Unsupported type: %T", src)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package langtag
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestTextRoundTrip(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"empty", "", ""},
+		{"canonicalized", "zh-hans-cn", "zh-Hans-CN"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			if err := v.UnmarshalText([]byte(tc.text)); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			got, _ := v.MarshalText()
+			if string(got) != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"nil", Nil, "null"},
+		{"tag", Must(Parse("en-gb")), `"en-GB"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := json.Marshal(tc.v)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if string(got) != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected string
+		err      error
+	}{
+		{"null", "null", "", nil},
+		{"tag", `"de-at"`, "de-AT", nil},
+		{"not a string", "42", "", ErrInvalidTextData},
+		{"invalid tag", `"not a tag"`, "", ErrInvalidTag},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Must(Parse("fr"))
+			err := v.UnmarshalJSON([]byte(tc.data))
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if tc.err == nil && v.String() != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package langtag
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+)
+
+var (
+	// ErrNoSupportedTags is returned by NewMatcher() when the list of supported tags is empty or only
+	// contains Nil values
+	ErrNoSupportedTags = errors.Errorf("langtag: at least one supported tag must be specified")
+	// ErrInvalidAcceptLanguage is returned by Matcher.MatchAcceptLanguage() when the header value
+	// cannot be parsed
+	ErrInvalidAcceptLanguage = errors.Errorf("langtag: the Accept-Language value is not valid")
+)
+
+// Matcher selects the best supported tag for a list of user preferences, using the CLDR matching
+// algorithm so that, for example, a preference for "en-AU" selects a supported "en-GB" over "en-US".
+//
+// A Matcher is safe for concurrent use.
+type Matcher struct {
+	supported []Value
+	m         language.Matcher
+}
+
+// NewMatcher returns a Matcher for the specified supported tags.  Nil values are ignored.  The first
+// remaining tag is the default that is returned when none of the preferences match.
+func NewMatcher(supported ...Value) (*Matcher, error) {
+	var (
+		vals []Value
+		tags []language.Tag
+	)
+	for _, v := range supported {
+		if v.IsNil() {
+			continue
+		}
+		vals = append(vals, v)
+		tags = append(tags, v.tag)
+	}
+	if len(vals) == 0 {
+		return nil, ErrNoSupportedTags
+	}
+	return &Matcher{supported: vals, m: language.NewMatcher(tags)}, nil
+}
+
+// Supported returns a copy of the supported tags, in the order they were specified
+func (m *Matcher) Supported() []Value {
+	return append([]Value(nil), m.supported...)
+}
+
+// Match returns the supported tag that best matches the preferred tags, which are in decreasing
+// order of preference, and true.  If no supported tag is an acceptable match, the default tag and
+// false are returned.
+func (m *Matcher) Match(preferred ...Value) (Value, bool) {
+	tags := make([]language.Tag, 0, len(preferred))
+	for _, v := range preferred {
+		if !v.IsNil() {
+			tags = append(tags, v.tag)
+		}
+	}
+	_, idx, conf := m.m.Match(tags...)
+	return m.supported[idx], conf != language.No
+}
+
+// MatchAcceptLanguage is the same as Match() but takes the preferences from the value of an HTTP
+// Accept-Language header, such as "fr-CH, fr;q=0.9, en;q=0.8".  An empty header selects the default.
+func (m *Matcher) MatchAcceptLanguage(header string) (Value, bool, error) {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return Nil, false, errors.Wrapf(ErrInvalidAcceptLanguage, "%v", err)
+	}
+	prefs := make([]Value, len(tags))
+	for i, t := range tags {
+		prefs[i] = Value{tag: t}
+	}
+	v, ok := m.Match(prefs...)
+	return v, ok, nil
+}
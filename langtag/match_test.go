@@ -0,0 +1,92 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package langtag
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestNewMatcher(t *testing.T) {
+	if _, err := NewMatcher(); err != ErrNoSupportedTags {
+		t.Errorf("Expected %v, got %v", ErrNoSupportedTags, err)
+	}
+	if _, err := NewMatcher(Nil); err != ErrNoSupportedTags {
+		t.Errorf("Expected %v, got %v", ErrNoSupportedTags, err)
+	}
+	m, err := NewMatcher(Nil, Must(Parse("en")), Must(Parse("fr")))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s := m.Supported(); len(s) != 2 || s[0].String() != "en" {
+		t.Errorf("Expected [en fr], got %v", s)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	m, err := NewMatcher(Must(Parse("en-US")), Must(Parse("en-GB")), Must(Parse("fr")), Must(Parse("pt-BR")))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	cases := []struct {
+		name      string
+		preferred []string
+		expected  string
+		ok        bool
+	}{
+		{"no preferences", nil, "en-US", false},
+		{"exact", []string{"fr"}, "fr", true},
+		{"closest region", []string{"en-AU"}, "en-GB", true},
+		{"region variant", []string{"fr-CA"}, "fr", true},
+		{"first acceptable preference", []string{"ja", "pt"}, "pt-BR", true},
+		{"no match", []string{"ja"}, "en-US", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var prefs []Value
+			for _, s := range tc.preferred {
+				prefs = append(prefs, Must(Parse(s)))
+			}
+			got, ok := m.Match(prefs...)
+			if got.String() != tc.expected || ok != tc.ok {
+				tt.Errorf("Expected (%s, %v), got (%s, %v)", tc.expected, tc.ok, got, ok)
+			}
+		})
+	}
+}
+
+func TestMatchAcceptLanguage(t *testing.T) {
+	m := func() *Matcher {
+		m, err := NewMatcher(Must(Parse("en")), Must(Parse("de")), Must(Parse("fr")))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return m
+	}()
+	cases := []struct {
+		name     string
+		header   string
+		expected string
+		ok       bool
+		err      error
+	}{
+		{"empty", "", "en", false, nil},
+		{"weighted", "ja, fr-CH;q=0.9, en;q=0.8", "fr", true, nil},
+		{"wildcard only", "*", "en", false, nil},
+		{"invalid", "en;q=x", "", false, ErrInvalidAcceptLanguage},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, ok, err := m.MatchAcceptLanguage(tc.header)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got.String() != tc.expected || ok != tc.ok {
+				tt.Errorf("Expected (%s, %v), got (%s, %v)", tc.expected, tc.ok, got, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package langtag
+
+import (
+	"database/sql/driver"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// a langtag.Value value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a langtag.Value value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for langtag.Value values.  Nil is stored as NULL and
+// all other values are stored as the canonical string.
+func (v Value) Value() (driver.Value, error) {
+	if v.IsNil() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for langtag.Value values.
+//
+// NULL is decoded as Nil and a string or byte slice is handled by UnmarshalText().  All other values
+// will return an error
+func (v *Value) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case nil:
+		*v = Nil
+		return nil
+	case []byte:
+		return v.UnmarshalText(tv)
+	case string:
+		return v.UnmarshalText([]byte(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
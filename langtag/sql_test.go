@@ -0,0 +1,61 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package langtag
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected driver.Value
+	}{
+		{"nil", Nil, nil},
+		{"tag", Must(Parse("nl-be")), "nl-BE"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.v.Value()
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected string
+		err      error
+	}{
+		{"null", nil, "", nil},
+		{"string", "it-ch", "it-CH", nil},
+		{"bytes", []byte("es-MX"), "es-MX", nil},
+		{"invalid tag", "not a tag", "", ErrInvalidTag},
+		{"unsupported type", 42, "", ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Must(Parse("fr"))
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if tc.err == nil && v.String() != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package langtag
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+)
+
+// Value represents a validated, canonicalized BCP 47 language tag, such as "en", "en-US" or "zh-Hant-TW".
+//
+// The zero value is langtag.Nil, which represents a missing tag.  The BCP 47 "und" (undetermined)
+// tag is treated as Nil.
+type Value struct {
+	tag language.Tag
+}
+
+var (
+	// Nil represents a nil/null/undefined language tag
+	Nil = Value{}
+)
+
+var (
+	// ErrInvalidTag is returned when a string is not a well-formed BCP 47 language tag
+	ErrInvalidTag = errors.Errorf("langtag: the specified text is not a valid BCP 47 language tag")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in langtag.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Parse parses and canonicalizes a BCP 47 language tag.
+//
+// Canonicalization normalizes the case of each subtag ("EN-us" becomes "en-US") and replaces
+// deprecated and legacy subtags with their preferred values ("iw" becomes "he").  An empty string
+// is parsed as Nil.
+func Parse(s string) (Value, error) {
+	if s == "" {
+		return Nil, nil
+	}
+	t, err := language.Parse(s)
+	if err != nil {
+		return Nil, errors.Wrapf(ErrInvalidTag, "%q: %v", s, err)
+	}
+	return Value{tag: t}, nil
+}
+
+// FromTag returns a Value that wraps the specified golang.org/x/text/language.Tag
+func FromTag(t language.Tag) Value {
+	return Value{tag: t}
+}
+
+// Tag returns the wrapped golang.org/x/text/language.Tag, which is language.Und for Nil
+func (v Value) Tag() language.Tag {
+	return v.tag
+}
+
+// IsNil returns true if v does not identify a language
+func (v Value) IsNil() bool {
+	return v.tag == language.Und
+}
+
+// IsZero returns true if v is Nil.  It is used by the "omitzero" JSON struct tag option to omit
+// Nil values.
+func (v Value) IsZero() bool {
+	return v.IsNil()
+}
+
+// Language returns the ISO 639 language subtag, such as "en", or an empty string if it was not specified
+func (v Value) Language() string {
+	b, c := v.tag.Base()
+	if c != language.Exact {
+		return ""
+	}
+	return b.String()
+}
+
+// Script returns the ISO 15924 script subtag, such as "Hant", or an empty string if it was not specified
+func (v Value) Script() string {
+	s, c := v.tag.Script()
+	if c != language.Exact {
+		return ""
+	}
+	return s.String()
+}
+
+// Region returns the ISO 3166-1 or UN M.49 region subtag, such as "US" or "419", or an empty string
+// if it was not specified
+func (v Value) Region() string {
+	r, c := v.tag.Region()
+	if c != language.Exact {
+		return ""
+	}
+	return r.String()
+}
+
+// Parent returns the tag with the last subtag removed, following the CLDR inheritance chain, so
+// the parent of "en-US" is "en".  The parent of a language-only tag is Nil.
+func (v Value) Parent() Value {
+	return Value{tag: v.tag.Parent()}
+}
+
+// Equal returns true if v1 and v2 are the same canonical tag.
+//
+// *NOTE*
+// The Nil value is treated specially and is not equal to any value, so this function returns false
+// if either value is Nil.
+func Equal(v1, v2 Value) bool {
+	if v1.IsNil() || v2.IsNil() {
+		return false
+	}
+	return v1.tag == v2.tag
+}
+
+// String implements fmt.Stringer for langtag.Value instances.
+//
+// The returned string is the canonical form of the tag, or an empty string for Nil.
+func (v Value) String() string {
+	if v.IsNil() {
+		return ""
+	}
+	return v.tag.String()
+}
@@ -0,0 +1,113 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package langtag
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected string
+		err      error
+	}{
+		{"empty", "", "", nil},
+		{"undetermined", "und", "", nil},
+		{"language", "en", "en", nil},
+		{"language and region", "en-US", "en-US", nil},
+		{"mixed case", "EN-us", "en-US", nil},
+		{"script", "zh-hant-tw", "zh-Hant-TW", nil},
+		{"numeric region", "es-419", "es-419", nil},
+		{"deprecated language", "iw", "he", nil},
+		{"private use", "en-x-custom", "en-x-custom", nil},
+		{"invalid characters", "en US", "", ErrInvalidTag},
+		{"subtag too long", "english", "", ErrInvalidTag},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got := v.String(); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSubtags(t *testing.T) {
+	cases := []struct {
+		name                     string
+		v                        Value
+		language, script, region string
+	}{
+		{"nil", Nil, "", "", ""},
+		{"language", Must(Parse("fr")), "fr", "", ""},
+		{"language and region", Must(Parse("fr-CA")), "fr", "", "CA"},
+		{"all", Must(Parse("sr-Latn-RS")), "sr", "Latn", "RS"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if l, s, r := tc.v.Language(), tc.v.Script(), tc.v.Region(); l != tc.language || s != tc.script || r != tc.region {
+				tt.Errorf("Expected (%q, %q, %q), got (%q, %q, %q)", tc.language, tc.script, tc.region, l, s, r)
+			}
+		})
+	}
+}
+
+func TestParent(t *testing.T) {
+	if p := Must(Parse("en-US")).Parent(); p.String() != "en" {
+		t.Errorf("Expected en, got %q", p)
+	}
+	if p := Must(Parse("en")).Parent(); !p.IsNil() {
+		t.Errorf("Expected Nil, got %q", p)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		name     string
+		v1, v2   Value
+		expected bool
+	}{
+		{"nil", Nil, Nil, false},
+		{"nil and non-nil", Nil, Must(Parse("en")), false},
+		{"same", Must(Parse("en-us")), Must(Parse("EN-US")), true},
+		{"canonical alias", Must(Parse("iw")), Must(Parse("he")), true},
+		{"different", Must(Parse("en-US")), Must(Parse("en-GB")), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := Equal(tc.v1, tc.v2); got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFromTag(t *testing.T) {
+	v := FromTag(language.BrazilianPortuguese)
+	if v.String() != "pt-BR" || v.Tag() != language.BrazilianPortuguese {
+		t.Errorf("Expected pt-BR, got %q", v)
+	}
+	if !FromTag(language.Und).IsNil() {
+		t.Errorf("Expected Nil for language.Und")
+	}
+}
+
+func TestMust(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	Must(Parse("not a tag"))
+}
@@ -0,0 +1,178 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+)
+
+// Format returns the textual representation of the specified date and time of day according to the
+// layout.  The result is the same as formatting the equivalent time.Time with time.Time.Format().
+//
+// If d is date.Nil or invalid, any date tokens are formatted as the date of the zero time.Time,
+// 0001-01-01, which is in week 1 of ISO week-numbering year 1.
+func (l *Layout) Format(d date.Value, t timeofday.Value) string {
+	var buf [64]byte
+	return string(l.Append(buf[:0], d, t))
+}
+
+// FormatDate is a shortcut for Format(d, timeofday.Zero)
+func (l *Layout) FormatDate(d date.Value) string {
+	return l.Format(d, timeofday.Zero)
+}
+
+// FormatTimeOfDay is a shortcut for Format(date.Nil, t)
+func (l *Layout) FormatTimeOfDay(t timeofday.Value) string {
+	return l.Format(date.Nil, t)
+}
+
+// Append is like Format() but appends the textual representation to b and returns the extended
+// buffer.
+func (l *Layout) Append(b []byte, d date.Value, t timeofday.Value) []byte {
+	var (
+		year, month, day = 1, 1, 1
+		weekday          = time.Monday
+		yday             = 1
+		isoYear, isoWeek = 1, 1
+	)
+	if l.hasDate && d.IsValid() {
+		year, month, day = d.Date()
+		weekday = d.Weekday()
+		yday = int(int64(d)-int64(d.StartOfYear())) + 1
+		isoYear, isoWeek = d.ISOWeek()
+	}
+	var (
+		hour, min, sec int
+		ns             int64
+	)
+	if l.hasTime {
+		hour, min, sec, ns = t.ToUnits()
+	}
+
+	for _, c := range l.chunks {
+		switch c.tok {
+		case tokLiteral:
+			b = append(b, c.lit...)
+		case tokLongMonth:
+			b = append(b, time.Month(month).String()...)
+		case tokMonth:
+			b = append(b, time.Month(month).String()[:3]...)
+		case tokNumMonth:
+			b = appendInt(b, month, 0)
+		case tokZeroMonth:
+			b = appendInt(b, month, 2)
+		case tokLongWeekday:
+			b = append(b, weekday.String()...)
+		case tokWeekday:
+			b = append(b, weekday.String()[:3]...)
+		case tokDay:
+			b = appendInt(b, day, 0)
+		case tokUnderDay:
+			if day < 10 {
+				b = append(b, ' ')
+			}
+			b = appendInt(b, day, 0)
+		case tokZeroDay:
+			b = appendInt(b, day, 2)
+		case tokUnderYearDay:
+			if yday < 100 {
+				b = append(b, ' ')
+				if yday < 10 {
+					b = append(b, ' ')
+				}
+			}
+			b = appendInt(b, yday, 0)
+		case tokZeroYearDay:
+			b = appendInt(b, yday, 3)
+		case tokYear:
+			b = appendInt(b, year%100, 2)
+		case tokLongYear:
+			b = appendInt(b, year, 4)
+		case tokISOYear:
+			b = appendInt(b, isoYear%100, 2)
+		case tokLongISOYear:
+			b = appendInt(b, isoYear, 4)
+		case tokISOWeek:
+			b = appendInt(b, isoWeek, 2)
+		case tokHour:
+			b = appendInt(b, hour, 2)
+		case tokHour12, tokZeroHour12:
+			hr := hour % 12
+			if hr == 0 {
+				hr = 12
+			}
+			if c.tok == tokZeroHour12 {
+				b = appendInt(b, hr, 2)
+			} else {
+				b = appendInt(b, hr, 0)
+			}
+		case tokMinute:
+			b = appendInt(b, min, 0)
+		case tokZeroMinute:
+			b = appendInt(b, min, 2)
+		case tokSecond:
+			b = appendInt(b, sec, 0)
+		case tokZeroSecond:
+			b = appendInt(b, sec, 2)
+		case tokPM, tokpm:
+			am, pm := "AM", "PM"
+			if c.tok == tokpm {
+				am, pm = "am", "pm"
+			}
+			if hour >= 12 {
+				b = append(b, pm...)
+			} else {
+				b = append(b, am...)
+			}
+		case tokFracZeros, tokFracNines:
+			b = appendFrac(b, ns, c)
+		}
+	}
+	return b
+}
+
+// appendInt appends the decimal representation of v, which must not be negative, to b, zero-padded
+// to at least width digits
+func appendInt(b []byte, v, width int) []byte {
+	var buf [20]byte
+	i := len(buf)
+	for v >= 10 || width > 1 {
+		i--
+		buf[i] = byte('0' + v%10)
+		v /= 10
+		width--
+	}
+	i--
+	buf[i] = byte('0' + v)
+	return append(b, buf[i:]...)
+}
+
+// appendFrac appends the fractional seconds in ns to b as specified by c.  Fixed-width fractions are
+// truncated, not rounded, to the specified number of digits.  Trailing zeros are removed from
+// variable-width fractions, and the separator is omitted as well if the fraction is zero.
+func appendFrac(b []byte, ns int64, c chunk) []byte {
+	var digits [9]byte
+	for i := len(digits) - 1; i >= 0; i-- {
+		digits[i] = byte('0' + ns%10)
+		ns /= 10
+	}
+	n := c.digits
+	if n > len(digits) {
+		n = len(digits)
+	}
+	if c.tok == tokFracNines {
+		for n > 0 && digits[n-1] == '0' {
+			n--
+		}
+		if n == 0 {
+			return b
+		}
+	}
+	b = append(b, c.sep)
+	return append(b, digits[:n]...)
+}
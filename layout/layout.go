@@ -0,0 +1,281 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package layout provides precompiled layouts for formatting and parsing date.Value and
+// timeofday.Value values.
+//
+// Layouts use the same reference-time notation as the standard time package ("2006-01-02",
+// "15:04:05.000", "Mon, 02 Jan 2006", etc.) but are interpreted once by Compile() instead of on every
+// call, in the same way that a compiled regexp.Regexp avoids re-parsing its pattern.  A Layout is
+// immutable and safe for concurrent use, so it can be stored in a package-level variable and shared by
+// all of the goroutines that generate logs or reports.
+//
+// Three tokens that the time package does not have support ISO 8601 week numbering: "GGGG" and "GG"
+// are the 4 and 2 digit week-numbering year and "WW" is the zero-padded week number, with "WWW"
+// producing a literal "W" before the number.  Use them together, as in "GGGG-WWW", since the
+// week-numbering year differs from the calendar year for dates near January 1.
+//
+// Time zone tokens, such as "MST" and "-07:00", are not supported because neither date.Value nor
+// timeofday.Value has a location.
+package layout
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedToken is returned by Compile() when the layout contains a time zone token
+	ErrUnsupportedToken = errors.Errorf("layout: time zone tokens are not supported")
+	// ErrNoTokens is returned by Compile() when the layout does not contain any date or time tokens
+	ErrNoTokens = errors.Errorf("layout: the layout does not contain any date or time tokens")
+	// ErrMismatch is returned by the Parse methods when the text does not match the layout
+	ErrMismatch = errors.Errorf("layout: the text does not match the layout")
+	// ErrOutOfRange is returned by the Parse methods when a parsed field is outside of its valid range
+	ErrOutOfRange = errors.Errorf("layout: a parsed value is out of range")
+	// ErrAmbiguousYear is returned by the Parse methods for a 2-digit year that the WithAmbiguousYears()
+	// option marks as ambiguous
+	ErrAmbiguousYear = errors.Errorf("layout: the century of a 2-digit year is ambiguous")
+)
+
+// token identifies a single element of a compiled layout
+type token int
+
+const (
+	tokLiteral      token = iota
+	tokLongMonth          // January
+	tokMonth              // Jan
+	tokNumMonth           // 1
+	tokZeroMonth          // 01
+	tokLongWeekday        // Monday
+	tokWeekday            // Mon
+	tokDay                // 2
+	tokUnderDay           // _2
+	tokZeroDay            // 02
+	tokUnderYearDay       // __2
+	tokZeroYearDay        // 002
+	tokYear               // 06
+	tokLongYear           // 2006
+	tokISOYear            // GG
+	tokLongISOYear        // GGGG
+	tokISOWeek            // WW
+	tokHour               // 15
+	tokHour12             // 3
+	tokZeroHour12         // 03
+	tokMinute             // 4
+	tokZeroMinute         // 04
+	tokSecond             // 5
+	tokZeroSecond         // 05
+	tokPM                 // PM
+	tokpm                 // pm
+	tokFracZeros          // .0, .00, ... or ,0, ,00, ...
+	tokFracNines          // .9, .99, ... or ,9, ,99, ...
+)
+
+// chunk is a single element of a compiled layout: either a literal string or a token
+type chunk struct {
+	tok token
+	// lit is the literal text for tokLiteral chunks
+	lit string
+	// digits is the number of fractional second digits for tokFracZeros and tokFracNines chunks
+	digits int
+	// sep is the fractional second separator, '.' or ',', for tokFracZeros and tokFracNines chunks
+	sep byte
+}
+
+// isDate returns true if the chunk formats or parses part of a date
+func (c chunk) isDate() bool {
+	return tokLongMonth <= c.tok && c.tok <= tokISOWeek
+}
+
+// isTime returns true if the chunk formats or parses part of a time of day
+func (c chunk) isTime() bool {
+	return c.tok >= tokHour
+}
+
+// Layout is a compiled layout that can format and parse date and time of day values without
+// re-interpreting the layout string.  Use Compile() or MustCompile() to create instances.
+type Layout struct {
+	src     string
+	chunks  []chunk
+	hasDate bool
+	hasTime bool
+}
+
+// MustCompile is like Compile() but panics if the layout cannot be compiled.  It simplifies the
+// initialization of package-level variables.
+func MustCompile(layout string) *Layout {
+	l, err := Compile(layout)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Compile parses a layout written with the reference time of the standard time package, Mon Jan 2
+// 15:04:05 2006, and returns a Layout that can be used to format and parse values.
+//
+// An error is returned if the layout contains a time zone token or does not contain any date or time
+// tokens at all.
+func Compile(layout string) (*Layout, error) {
+	l := &Layout{src: layout}
+	rest := layout
+	for rest != "" {
+		prefix, c, suffix, err := nextChunk(rest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%q", layout)
+		}
+		if prefix != "" {
+			l.chunks = append(l.chunks, chunk{tok: tokLiteral, lit: prefix})
+		}
+		if c.tok == tokLiteral {
+			break
+		}
+		l.chunks = append(l.chunks, c)
+		l.hasDate = l.hasDate || c.isDate()
+		l.hasTime = l.hasTime || c.isTime()
+		rest = suffix
+	}
+	if !l.hasDate && !l.hasTime {
+		return nil, errors.Wrapf(ErrNoTokens, "%q", layout)
+	}
+	return l, nil
+}
+
+// String returns the layout string that l was compiled from
+func (l *Layout) String() string {
+	return l.src
+}
+
+// HasDate returns true if the layout contains any date tokens
+func (l *Layout) HasDate() bool {
+	return l.hasDate
+}
+
+// HasTime returns true if the layout contains any time of day tokens
+func (l *Layout) HasTime() bool {
+	return l.hasTime
+}
+
+// nextChunk returns the literal text before the first token in s, the token and the text after it.
+// If there are no more tokens, the returned chunk is a literal and prefix contains all of s.
+//
+// The tokens are recognized by the same rules, and in the same order, as the standard time package,
+// plus the ISO week tokens.
+func nextChunk(s string) (prefix string, c chunk, suffix string, err error) {
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; ch {
+		case 'J': // January, Jan
+			if strings.HasPrefix(s[i:], "Jan") {
+				if strings.HasPrefix(s[i:], "January") {
+					return s[:i], chunk{tok: tokLongMonth}, s[i+7:], nil
+				}
+				if !startsWithLowerCase(s[i+3:]) {
+					return s[:i], chunk{tok: tokMonth}, s[i+3:], nil
+				}
+			}
+		case 'M': // Monday, Mon, MST
+			if strings.HasPrefix(s[i:], "Mon") {
+				if strings.HasPrefix(s[i:], "Monday") {
+					return s[:i], chunk{tok: tokLongWeekday}, s[i+6:], nil
+				}
+				if !startsWithLowerCase(s[i+3:]) {
+					return s[:i], chunk{tok: tokWeekday}, s[i+3:], nil
+				}
+			}
+			if strings.HasPrefix(s[i:], "MST") {
+				return "", chunk{}, "", errors.Wrapf(ErrUnsupportedToken, "MST")
+			}
+		case '0': // 01, 02, 03, 04, 05, 06, 002
+			if i+1 < len(s) && '1' <= s[i+1] && s[i+1] <= '6' {
+				toks := [...]token{tokZeroMonth, tokZeroDay, tokZeroHour12, tokZeroMinute, tokZeroSecond, tokYear}
+				return s[:i], chunk{tok: toks[s[i+1]-'1']}, s[i+2:], nil
+			}
+			if strings.HasPrefix(s[i:], "002") {
+				return s[:i], chunk{tok: tokZeroYearDay}, s[i+3:], nil
+			}
+		case '1': // 15, 1
+			if strings.HasPrefix(s[i:], "15") {
+				return s[:i], chunk{tok: tokHour}, s[i+2:], nil
+			}
+			return s[:i], chunk{tok: tokNumMonth}, s[i+1:], nil
+		case '2': // 2006, 2
+			if strings.HasPrefix(s[i:], "2006") {
+				return s[:i], chunk{tok: tokLongYear}, s[i+4:], nil
+			}
+			return s[:i], chunk{tok: tokDay}, s[i+1:], nil
+		case '_': // _2, _2006, __2
+			if strings.HasPrefix(s[i:], "_2") {
+				// _2006 is really a literal _, followed by 2006
+				if strings.HasPrefix(s[i+1:], "2006") {
+					return s[:i+1], chunk{tok: tokLongYear}, s[i+5:], nil
+				}
+				return s[:i], chunk{tok: tokUnderDay}, s[i+2:], nil
+			}
+			if strings.HasPrefix(s[i:], "__2") {
+				return s[:i], chunk{tok: tokUnderYearDay}, s[i+3:], nil
+			}
+		case '3':
+			return s[:i], chunk{tok: tokHour12}, s[i+1:], nil
+		case '4':
+			return s[:i], chunk{tok: tokMinute}, s[i+1:], nil
+		case '5':
+			return s[:i], chunk{tok: tokSecond}, s[i+1:], nil
+		case 'G': // GGGG, GG
+			if strings.HasPrefix(s[i:], "GGGG") {
+				return s[:i], chunk{tok: tokLongISOYear}, s[i+4:], nil
+			}
+			if strings.HasPrefix(s[i:], "GG") {
+				return s[:i], chunk{tok: tokISOYear}, s[i+2:], nil
+			}
+		case 'W': // WW, WWW
+			// WWW is really a literal W, followed by WW, for the ISO 8601 "2006-W01" form
+			if strings.HasPrefix(s[i:], "WWW") {
+				return s[:i+1], chunk{tok: tokISOWeek}, s[i+3:], nil
+			}
+			if strings.HasPrefix(s[i:], "WW") {
+				return s[:i], chunk{tok: tokISOWeek}, s[i+2:], nil
+			}
+		case 'P': // PM
+			if strings.HasPrefix(s[i:], "PM") {
+				return s[:i], chunk{tok: tokPM}, s[i+2:], nil
+			}
+		case 'p': // pm
+			if strings.HasPrefix(s[i:], "pm") {
+				return s[:i], chunk{tok: tokpm}, s[i+2:], nil
+			}
+		case '-': // -070000, -07:00:00, -0700, -07:00, -07
+			if strings.HasPrefix(s[i:], "-07") {
+				return "", chunk{}, "", errors.Wrapf(ErrUnsupportedToken, "%s", s[i:])
+			}
+		case 'Z': // Z070000, Z07:00:00, Z0700, Z07:00, Z07
+			if strings.HasPrefix(s[i:], "Z07") {
+				return "", chunk{}, "", errors.Wrapf(ErrUnsupportedToken, "%s", s[i:])
+			}
+		case '.', ',': // ,000, or .000, or ,999, or .999 - repeated digits for fractional seconds
+			if i+1 < len(s) && (s[i+1] == '0' || s[i+1] == '9') {
+				digit := s[i+1]
+				j := i + 1
+				for j < len(s) && s[j] == digit {
+					j++
+				}
+				// the fraction must not be followed by another digit
+				if j == len(s) || s[j] < '0' || s[j] > '9' {
+					tok := tokFracZeros
+					if digit == '9' {
+						tok = tokFracNines
+					}
+					return s[:i], chunk{tok: tok, digits: j - (i + 1), sep: ch}, s[j:], nil
+				}
+			}
+		}
+	}
+	return s, chunk{tok: tokLiteral}, "", nil
+}
+
+// startsWithLowerCase returns true if s starts with a lower case ASCII letter
+func startsWithLowerCase(s string) bool {
+	return s != "" && 'a' <= s[0] && s[0] <= 'z'
+}
@@ -0,0 +1,363 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// layouts is the set of layouts that are checked against the standard time package
+var layouts = []string{
+	"2006-01-02",
+	"15:04:05",
+	"15:04:05.000",
+	"15:04:05.999999999",
+	"15:04:05,000000",
+	"2006-01-02T15:04:05.999",
+	"Mon, 02 Jan 2006",
+	"Monday, January 2, 2006 at 3:04:05 PM",
+	"Jan _2 06 03:04pm",
+	"2006.002",
+	"2006 __2",
+	"1/2/06 3:4:5",
+	"20060102150405",
+	"Month: Jan",
+	"_2006",
+}
+
+func TestCompile(t *testing.T) {
+	cases := []struct {
+		name    string
+		layout  string
+		hasDate bool
+		hasTime bool
+		err     error
+	}{
+		{"date", "2006-01-02", true, false, nil},
+		{"time", "15:04:05", false, true, nil},
+		{"date and time", time.DateTime, true, true, nil},
+		{"literal text only", "hello world", false, false, ErrNoTokens},
+		{"empty", "", false, false, ErrNoTokens},
+		{"zone name", "15:04 MST", false, false, ErrUnsupportedToken},
+		{"zone offset", "15:04 -07:00", false, false, ErrUnsupportedToken},
+		{"ISO 8601 zone", time.RFC3339, false, false, ErrUnsupportedToken},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			l, err := Compile(tc.layout)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if l.HasDate() != tc.hasDate || l.HasTime() != tc.hasTime {
+				tt.Errorf("Expected (%v, %v), got (%v, %v)", tc.hasDate, tc.hasTime, l.HasDate(), l.HasTime())
+			}
+			if l.String() != tc.layout {
+				tt.Errorf("Expected %q, got %q", tc.layout, l.String())
+			}
+		})
+	}
+}
+
+func TestMustCompilePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	MustCompile("MST")
+}
+
+// randomValues returns n random date and time of day values
+func randomValues(n int) ([]date.Value, []timeofday.Value) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ds, ts := make([]date.Value, n), make([]timeofday.Value, n)
+	for i := range ds {
+		ds[i] = date.Min + date.Value(r.Int63n(int64(date.Max-date.Min+1)))
+		ts[i] = timeofday.Must(timeofday.FromDuration(time.Duration(r.Int63n(int64(24 * time.Hour)))))
+	}
+	ds = append(ds, date.Min, date.Max, date.Must(date.FromUnits(2024, 2, 29)))
+	ts = append(ts, timeofday.Min, timeofday.Max, timeofday.Must(timeofday.FromUnits(12, 0, 0, 0)))
+	return ds, ts
+}
+
+func TestFormatMatchesTimePackage(t *testing.T) {
+	ds, ts := randomValues(500)
+	for _, layout := range layouts {
+		l := MustCompile(layout)
+		for i := range ds {
+			y, m, d := ds[i].Date()
+			tm := ts[i].ToDateTimeUTC(y, time.Month(m), d)
+			if got, expected := l.Format(ds[i], ts[i]), tm.Format(layout); got != expected {
+				t.Fatalf("%q: expected %q, got %q", layout, expected, got)
+			}
+		}
+	}
+}
+
+func TestFormatShortcuts(t *testing.T) {
+	l := MustCompile("2006-01-02 15:04:05.999")
+	d := date.Must(date.FromUnits(2019, 7, 14))
+	tv := timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000))
+	if got := l.FormatDate(d); got != "2019-07-14 00:00:00" {
+		t.Errorf("FormatDate: expected %q, got %q", "2019-07-14 00:00:00", got)
+	}
+	if got := l.FormatTimeOfDay(tv); got != "0001-01-01 13:45:30.5" {
+		t.Errorf("FormatTimeOfDay: expected %q, got %q", "0001-01-01 13:45:30.5", got)
+	}
+	if got := string(l.Append([]byte("at "), d, tv)); got != "at 2019-07-14 13:45:30.5" {
+		t.Errorf("Append: expected %q, got %q", "at 2019-07-14 13:45:30.5", got)
+	}
+}
+
+func TestParseMatchesTimePackage(t *testing.T) {
+	ds, ts := randomValues(500)
+	for _, layout := range layouts {
+		l := MustCompile(layout)
+		for i := range ds {
+			text := l.Format(ds[i], ts[i])
+			expected, err := time.Parse(layout, text)
+			if err != nil {
+				// time.Parse() can't round trip some layouts, such as 2-digit years
+				continue
+			}
+			gd, gt, err := l.Parse(text)
+			if _, derr := date.FromTime(expected); l.HasDate() && derr != nil {
+				// the parsed date, such as year 0 when there is no year token, is not a valid date.Value
+				if errors.Cause(err) != ErrOutOfRange {
+					t.Fatalf("%q: expected %v parsing %q, got %v", layout, ErrOutOfRange, text, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%q: unexpected error parsing %q: %v", layout, text, err)
+			}
+			if l.HasDate() {
+				if ed := date.Must(date.FromTime(expected)); gd != ed {
+					t.Fatalf("%q: expected date %v from %q, got %v", layout, ed, text, gd)
+				}
+			} else if gd != date.Nil {
+				t.Fatalf("%q: expected date.Nil, got %v", layout, gd)
+			}
+			h, m, s := expected.Clock()
+			if et := timeofday.Must(timeofday.FromUnits(h, m, s, int64(expected.Nanosecond()))); gt != et {
+				t.Fatalf("%q: expected time %v from %q, got %v", layout, et, text, gt)
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name   string
+		layout string
+		text   string
+		d      date.Value
+		t      timeofday.Value
+		err    error
+	}{
+		{"date", "2006-01-02", "2019-07-14", date.Must(date.FromUnits(2019, 7, 14)), timeofday.Zero, nil},
+		{"time", "15:04:05", "13:45:30", date.Nil, timeofday.Must(timeofday.FromUnits(13, 45, 30, 0)), nil},
+		{"unspecified fraction", "15:04:05", "13:45:30.25", date.Nil, timeofday.Must(timeofday.FromUnits(13, 45, 30, 250000000)), nil},
+		{"optional fraction", "15:04:05.999", "13:45:30", date.Nil, timeofday.Must(timeofday.FromUnits(13, 45, 30, 0)), nil},
+		{"12 AM", "3:04 PM", "12:30 AM", date.Nil, timeofday.Must(timeofday.FromUnits(0, 30, 0, 0)), nil},
+		{"12 PM", "3:04 PM", "12:30 PM", date.Nil, timeofday.Must(timeofday.FromUnits(12, 30, 0, 0)), nil},
+		{"month name ignores case", "02 Jan 2006", "14 JUL 2019", date.Must(date.FromUnits(2019, 7, 14)), timeofday.Zero, nil},
+		{"day of year", "2006-002", "2024-060", date.Must(date.FromUnits(2024, 2, 29)), timeofday.Zero, nil},
+		{"2-digit year 19xx", "06-01-02", "69-01-01", date.Must(date.FromUnits(1969, 1, 1)), timeofday.Zero, nil},
+		{"2-digit year 20xx", "06-01-02", "68-01-01", date.Must(date.FromUnits(2068, 1, 1)), timeofday.Zero, nil},
+		{"literal mismatch", "2006-01-02", "2019/07/14", date.Nil, timeofday.Zero, ErrMismatch},
+		{"extra text", "2006-01-02", "2019-07-14T00:00", date.Nil, timeofday.Zero, ErrMismatch},
+		{"missing fixed fraction", "15:04:05.000", "13:45:30", date.Nil, timeofday.Zero, ErrMismatch},
+		{"bad weekday", "Mon 2006-01-02", "Xyz 2019-07-14", date.Nil, timeofday.Zero, ErrMismatch},
+		{"invalid month", "2006-01-02", "2019-13-14", date.Nil, timeofday.Zero, ErrOutOfRange},
+		{"invalid day", "2006-01-02", "2019-02-30", date.Nil, timeofday.Zero, ErrOutOfRange},
+		{"before date.Min", "2006-01-02", "1700-01-01", date.Nil, timeofday.Zero, ErrOutOfRange},
+		{"missing year", "01-02", "07-14", date.Nil, timeofday.Zero, ErrOutOfRange},
+		{"invalid hour", "15:04", "24:00", date.Nil, timeofday.Zero, ErrOutOfRange},
+		{"invalid day of year", "2006-002", "2023-366", date.Nil, timeofday.Zero, ErrOutOfRange},
+		{"conflicting day of year", "2006-01-02 002", "2024-03-01 060", date.Nil, timeofday.Zero, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d, tv, err := MustCompile(tc.layout).Parse(tc.text)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if d != tc.d || tv != tc.t {
+				tt.Errorf("Expected (%v, %v), got (%v, %v)", tc.d, tc.t, d, tv)
+			}
+		})
+	}
+}
+
+func TestParseTwoDigitYears(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		opts     []ParseOption
+		expected date.Value
+		err      error
+	}{
+		{"default 19xx", "03/04/69", nil, date.Must(date.FromUnits(1969, 3, 4)), nil},
+		{"default 20xx", "03/04/68", nil, date.Must(date.FromUnits(2068, 3, 4)), nil},
+		{"1970 window start", "03/04/70", []ParseOption{WithCenturyStart(1970)}, date.Must(date.FromUnits(1970, 3, 4)), nil},
+		{"1970 window end", "03/04/69", []ParseOption{WithCenturyStart(1970)}, date.Must(date.FromUnits(2069, 3, 4)), nil},
+		{"1950 window", "03/04/99", []ParseOption{WithCenturyStart(1950)}, date.Must(date.FromUnits(1999, 3, 4)), nil},
+		{"2000 window", "03/04/99", []ParseOption{WithCenturyStart(2000)}, date.Must(date.FromUnits(2099, 3, 4)), nil},
+		{"outside ambiguous range", "03/04/99", []ParseOption{WithAmbiguousYears(60, 79)}, date.Must(date.FromUnits(1999, 3, 4)), nil},
+		{"ambiguous", "03/04/70", []ParseOption{WithCenturyStart(1970), WithAmbiguousYears(60, 79)}, date.Nil, ErrAmbiguousYear},
+		{"ambiguous edge", "03/04/60", []ParseOption{WithAmbiguousYears(60, 79)}, date.Nil, ErrAmbiguousYear},
+		{"window before date.Min", "03/04/00", []ParseOption{WithCenturyStart(1700)}, date.Nil, ErrOutOfRange},
+	}
+	l := MustCompile("01/02/06")
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d, err := l.ParseDate(tc.text, tc.opts...)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if d != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, d)
+			}
+		})
+	}
+	d, err := MustCompile("GG-WW").ParseDate("70-01", WithCenturyStart(2000))
+	if expected := date.Must(date.FromUnits(2069, 12, 30)); err != nil || d != expected {
+		t.Errorf("Expected the window to apply to ISO week years: expected %v, got %v (err = %v)", expected, d, err)
+	}
+}
+
+func TestISOWeekTokens(t *testing.T) {
+	cases := []struct {
+		name     string
+		layout   string
+		d        date.Value
+		expected string
+	}{
+		{"mid year", "GGGG-WWW", date.Must(date.FromUnits(2019, 7, 14)), "2019-W28"},
+		{"Jan 1 in previous year", "GGGG-WWW 2006-01-02", date.Must(date.FromUnits(2021, 1, 1)), "2020-W53 2021-01-01"},
+		{"Dec 31 in next year", "GG/WW", date.Must(date.FromUnits(2019, 12, 31)), "20/01"},
+		{"nil", "GGGG-WWW", date.Nil, "0001-W01"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			l := MustCompile(tc.layout)
+			if !l.HasDate() || l.HasTime() {
+				tt.Errorf("Expected a date-only layout")
+			}
+			if got := l.FormatDate(tc.d); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseISOWeek(t *testing.T) {
+	cases := []struct {
+		name   string
+		layout string
+		text   string
+		d      date.Value
+		err    error
+	}{
+		{"Monday by default", "GGGG-WWW", "2020-W53", date.Must(date.FromUnits(2020, 12, 28)), nil},
+		{"weekday", "GGGG-WWW Mon", "2020-W53 Fri", date.Must(date.FromUnits(2021, 1, 1)), nil},
+		{"week 1 in previous calendar year", "GGGG-WWW Monday", "2020-W01 Tuesday", date.Must(date.FromUnits(2019, 12, 31)), nil},
+		{"2-digit year", "GG-WW", "15-01", date.Must(date.FromUnits(2014, 12, 29)), nil},
+		{"calendar year as week year", "2006 WW", "2019 28", date.Must(date.FromUnits(2019, 7, 8)), nil},
+		{"matching calendar date", "GGGG-WWW 2006-01-02", "2020-W53 2020-12-28", date.Must(date.FromUnits(2020, 12, 28)), nil},
+		{"week year only", "GGGG 2006-01-02", "2020 2021-01-01", date.Must(date.FromUnits(2021, 1, 1)), nil},
+		{"week 53 in 52-week year", "GGGG-WWW", "2019-W53", date.Nil, ErrOutOfRange},
+		{"week 0", "GGGG-WWW", "2019-W00", date.Nil, ErrOutOfRange},
+		{"conflicting calendar date", "GGGG-WWW 2006-01-02", "2020-W53 2020-01-01", date.Nil, ErrOutOfRange},
+		{"conflicting week year", "GGGG 2006-01-02", "2021 2021-01-01", date.Nil, ErrOutOfRange},
+		{"missing week digits", "GGGG-WWW", "2019-W7", date.Nil, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d, err := MustCompile(tc.layout).ParseDate(tc.text)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if d != tc.d {
+				tt.Errorf("Expected %v, got %v", tc.d, d)
+			}
+		})
+	}
+}
+
+func TestISOWeekRoundTrip(t *testing.T) {
+	l := MustCompile("GGGG-WWW-Mon")
+	ds, _ := randomValues(1000)
+	for _, d := range ds {
+		got, err := l.ParseDate(l.FormatDate(d))
+		if err != nil || got != d {
+			t.Errorf("Expected %v, got %v (err = %v)", d, got, err)
+		}
+	}
+}
+
+func TestParseShortcuts(t *testing.T) {
+	l := MustCompile(time.DateTime)
+	d, err := l.ParseDate("2019-07-14 13:45:30")
+	if err != nil || d != date.Must(date.FromUnits(2019, 7, 14)) {
+		t.Errorf("ParseDate: expected 2019-07-14, got %v (err = %v)", d, err)
+	}
+	tv, err := l.ParseTimeOfDay("2019-07-14 13:45:30")
+	if err != nil || tv != timeofday.Must(timeofday.FromUnits(13, 45, 30, 0)) {
+		t.Errorf("ParseTimeOfDay: expected 13:45:30, got %v (err = %v)", tv, err)
+	}
+}
+
+var (
+	benchLayout = "Mon, 02 Jan 2006 15:04:05.000"
+	benchDate   = date.Must(date.FromUnits(2019, 7, 14))
+	benchTime   = timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000))
+	benchBytes  []byte
+	benchString string
+)
+
+func BenchmarkAppend(b *testing.B) {
+	l := MustCompile(benchLayout)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchBytes = l.Append(buf[:0], benchDate, benchTime)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	l := MustCompile(benchLayout)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchString = l.Format(benchDate, benchTime)
+	}
+}
+
+func BenchmarkTimeFormat(b *testing.B) {
+	tm := benchTime.ToDateTimeUTC(2019, time.July, 14)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchString = tm.Format(benchLayout)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	l := MustCompile(benchLayout)
+	text := l.Format(benchDate, benchTime)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = l.Parse(text)
+	}
+}
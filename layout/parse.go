@@ -0,0 +1,410 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// ParseOption changes how Parse() and its shortcuts interpret the text for a single call
+type ParseOption func(*parseOptions)
+
+// parseOptions contains the settings that can be changed with ParseOption values
+type parseOptions struct {
+	// centuryStart is the first year of the 100 year window that 2-digit years are mapped into
+	centuryStart int
+	// ambiguousLo and ambiguousHi are the inclusive range of 2-digit years that are rejected, or -1
+	// if none are
+	ambiguousLo, ambiguousHi int
+}
+
+// defaultParseOptions match time.Parse(), which maps 2-digit years to 1969-2068
+var defaultParseOptions = parseOptions{centuryStart: 1969, ambiguousLo: -1, ambiguousHi: -1}
+
+// WithCenturyStart sets the 100 year window that 2-digit years ("06") are mapped into.  For example,
+// WithCenturyStart(1970) maps "70" to "99" to 1970-1999 and "00" to "69" to 2000-2069.  The default
+// window is 1969-2068, the same as time.Parse().
+func WithCenturyStart(year int) ParseOption {
+	return func(o *parseOptions) { o.centuryStart = year }
+}
+
+// WithAmbiguousYears makes Parse() return ErrAmbiguousYear, instead of guessing the century, for
+// 2-digit years from lo to hi, inclusive.  Legacy data feeds often contain years near the edges of
+// the window, such as "68" or "70", that cannot be assigned to a century reliably; this option lets
+// the caller route those records for review.
+func WithAmbiguousYears(lo, hi int) ParseOption {
+	return func(o *parseOptions) { o.ambiguousLo, o.ambiguousHi = lo, hi }
+}
+
+// year2 maps a 2-digit year into the configured window, or returns ErrAmbiguousYear
+func (o parseOptions) year2(yy int) (int, error) {
+	if o.ambiguousLo <= yy && yy <= o.ambiguousHi {
+		return 0, ErrAmbiguousYear
+	}
+	year := o.centuryStart - o.centuryStart%100 + yy
+	if year < o.centuryStart {
+		year += 100
+	}
+	return year, nil
+}
+
+// Parse parses the specified text according to the layout and returns the date and time of day that
+// it represents, following the same rules as time.Parse() unless changed by the specified options.
+//
+// If the layout does not contain any date tokens, the returned date is date.Nil.  If it does not
+// contain any time tokens, the returned time of day is timeofday.Zero.  Weekday names are checked for
+// validity but are otherwise ignored, except that a layout with an ISO week number ("WW") uses the
+// weekday to select the day within the week, or Monday if the layout has no weekday.  An ISO
+// week-numbering year must agree with the parsed date.
+func (l *Layout) Parse(s string, opts ...ParseOption) (date.Value, timeofday.Value, error) {
+	o := defaultParseOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var (
+		year, month, day = 0, 1, 1
+		yday             = -1
+		isoYear, isoWeek = -1, -1
+		weekday          = time.Monday
+		hour, min, sec   int
+		ns               int64
+		pmSet, amSet     bool
+		value            = s
+		err              error
+	)
+	for i, c := range l.chunks {
+		switch c.tok {
+		case tokLiteral:
+			if !strings.HasPrefix(value, c.lit) {
+				return l.mismatch(s, c.lit)
+			}
+			value = value[len(c.lit):]
+		case tokLongMonth, tokMonth:
+			names := longMonthNames
+			if c.tok == tokMonth {
+				names = shortMonthNames
+			}
+			var idx int
+			if idx, value, err = lookup(names, value); err != nil {
+				return l.mismatch(s, "month name")
+			}
+			month = idx + 1
+		case tokLongWeekday, tokWeekday:
+			names := longWeekdayNames
+			if c.tok == tokWeekday {
+				names = shortWeekdayNames
+			}
+			var idx int
+			if idx, value, err = lookup(names, value); err != nil {
+				return l.mismatch(s, "weekday name")
+			}
+			weekday = time.Weekday(idx)
+		case tokNumMonth, tokZeroMonth:
+			if month, value, err = getnum(value, c.tok == tokZeroMonth); err != nil || month < 1 || month > 12 {
+				return l.outOfRange(s, "month")
+			}
+		case tokDay, tokUnderDay, tokZeroDay:
+			if c.tok == tokUnderDay && value != "" && value[0] == ' ' {
+				value = value[1:]
+			}
+			if day, value, err = getnum(value, c.tok == tokZeroDay); err != nil || day < 1 || day > 31 {
+				return l.outOfRange(s, "day")
+			}
+		case tokUnderYearDay, tokZeroYearDay:
+			for i := 0; i < 2 && c.tok == tokUnderYearDay && value != "" && value[0] == ' '; i++ {
+				value = value[1:]
+			}
+			if yday, value, err = getnum3(value, c.tok == tokZeroYearDay); err != nil || yday < 1 || yday > 366 {
+				return l.outOfRange(s, "day of year")
+			}
+		case tokYear, tokISOYear:
+			var y int
+			if y, value, err = getnum(value, true); err != nil {
+				return l.mismatch(s, "2-digit year")
+			}
+			if y, err = o.year2(y); err != nil {
+				return date.Nil, timeofday.Zero, errors.Wrapf(err, "parsing %q as %q", s, l.src)
+			}
+			if c.tok == tokYear {
+				year = y
+			} else {
+				isoYear = y
+			}
+		case tokLongYear, tokLongISOYear:
+			var y int
+			if y, value, err = getYear4(value); err != nil {
+				return l.mismatch(s, "4-digit year")
+			}
+			if c.tok == tokLongYear {
+				year = y
+			} else {
+				isoYear = y
+			}
+		case tokISOWeek:
+			if isoWeek, value, err = getnum(value, true); err != nil || isoWeek < 1 || isoWeek > 53 {
+				return l.outOfRange(s, "ISO week")
+			}
+		case tokHour:
+			if hour, value, err = getnum(value, false); err != nil || hour > 23 {
+				return l.outOfRange(s, "hour")
+			}
+		case tokHour12, tokZeroHour12:
+			if hour, value, err = getnum(value, c.tok == tokZeroHour12); err != nil || hour > 12 {
+				return l.outOfRange(s, "hour")
+			}
+		case tokMinute, tokZeroMinute:
+			if min, value, err = getnum(value, c.tok == tokZeroMinute); err != nil || min > 59 {
+				return l.outOfRange(s, "minute")
+			}
+		case tokSecond, tokZeroSecond:
+			if sec, value, err = getnum(value, c.tok == tokZeroSecond); err != nil || sec > 59 {
+				return l.outOfRange(s, "second")
+			}
+			// as with time.Parse(), accept fractional seconds after the seconds even if the layout
+			// doesn't specify them
+			if len(value) >= 2 && (value[0] == '.' || value[0] == ',') && isDigits(value[1:2]) {
+				next := i + 1
+				if next < len(l.chunks) && (l.chunks[next].tok == tokFracZeros || l.chunks[next].tok == tokFracNines) {
+					break
+				}
+				n := 1
+				for n < len(value) && isDigits(value[n:n+1]) {
+					n++
+				}
+				if ns, err = parseNanos(value[1:n]); err != nil {
+					return l.outOfRange(s, "fractional second")
+				}
+				value = value[n:]
+			}
+		case tokPM, tokpm:
+			if len(value) < 2 {
+				return l.mismatch(s, "AM/PM")
+			}
+			switch p := value[:2]; {
+			case c.tok == tokPM && p == "PM", c.tok == tokpm && p == "pm":
+				pmSet = true
+			case c.tok == tokPM && p == "AM", c.tok == tokpm && p == "am":
+				amSet = true
+			default:
+				return l.mismatch(s, "AM/PM")
+			}
+			value = value[2:]
+		case tokFracZeros:
+			n := 1 + c.digits
+			if len(value) < n || value[0] != c.sep || !isDigits(value[1:n]) {
+				return l.mismatch(s, "fractional second")
+			}
+			if ns, err = parseNanos(value[1:n]); err != nil {
+				return l.outOfRange(s, "fractional second")
+			}
+			value = value[n:]
+		case tokFracNines:
+			if len(value) < 2 || value[0] != c.sep || !isDigits(value[1:2]) {
+				// the fraction is optional
+				break
+			}
+			n := 1
+			for n < len(value) && isDigits(value[n:n+1]) {
+				n++
+			}
+			if ns, err = parseNanos(value[1:n]); err != nil {
+				return l.outOfRange(s, "fractional second")
+			}
+			value = value[n:]
+		}
+	}
+	if value != "" {
+		return date.Nil, timeofday.Zero, errors.Wrapf(ErrMismatch, "extra text %q after parsing %q as %q", value, s, l.src)
+	}
+	if pmSet && hour < 12 {
+		hour += 12
+	} else if amSet && hour == 12 {
+		hour = 0
+	}
+
+	d := date.Nil
+	if l.hasDate {
+		if yday >= 0 {
+			if !date.IsValidYear(year) || yday > date.DaysInYear(year) {
+				return l.outOfRange(s, "day of year")
+			}
+			start, _ := date.FromUnits(year, 1, 1)
+			yd, _ := start.AddDays(yday - 1)
+			// a day of year must agree with the month and day, if they were also specified
+			y, m, dd := yd.Date()
+			if l.has(tokNumMonth, tokZeroMonth, tokMonth, tokLongMonth) && m != month ||
+				l.has(tokDay, tokUnderDay, tokZeroDay) && dd != day {
+				return l.outOfRange(s, "day of year")
+			}
+			year, month, day = y, m, dd
+		}
+		if isoWeek >= 0 {
+			if isoYear < 0 {
+				isoYear = year
+			}
+			wd, ok := fromISOWeek(isoYear, isoWeek, weekday)
+			if !ok {
+				return l.outOfRange(s, "ISO week")
+			}
+			// the calendar year, month and day must agree with the week, if they were also specified
+			y, m, dd := wd.Date()
+			if l.has(tokYear, tokLongYear) && y != year ||
+				l.has(tokNumMonth, tokZeroMonth, tokMonth, tokLongMonth) && m != month ||
+				l.has(tokDay, tokUnderDay, tokZeroDay) && dd != day {
+				return l.outOfRange(s, "ISO week")
+			}
+			year, month, day = y, m, dd
+		}
+		if d, err = date.FromUnits(year, month, day); err != nil {
+			return l.outOfRange(s, "date")
+		}
+		if isoYear >= 0 && d.ISOWeekYear() != isoYear {
+			return l.outOfRange(s, "ISO week-numbering year")
+		}
+	}
+	t, err := timeofday.FromUnits(hour, min, sec, ns)
+	if err != nil {
+		return l.outOfRange(s, "time of day")
+	}
+	return d, t, nil
+}
+
+// ParseDate parses the specified text like Parse() and returns only the date
+func (l *Layout) ParseDate(s string, opts ...ParseOption) (date.Value, error) {
+	d, _, err := l.Parse(s, opts...)
+	return d, err
+}
+
+// ParseTimeOfDay parses the specified text like Parse() and returns only the time of day
+func (l *Layout) ParseTimeOfDay(s string, opts ...ParseOption) (timeofday.Value, error) {
+	_, t, err := l.Parse(s, opts...)
+	return t, err
+}
+
+// has returns true if the layout contains any of the specified tokens
+func (l *Layout) has(toks ...token) bool {
+	for _, c := range l.chunks {
+		for _, t := range toks {
+			if c.tok == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fromISOWeek returns the date of the specified weekday in an ISO 8601 week, or false if the week
+// does not exist or the date is outside of the range supported by date.Value
+func fromISOWeek(isoYear, week int, wd time.Weekday) (date.Value, bool) {
+	d, err := date.FromISOWeek(isoYear, week, wd)
+	return d, err == nil
+}
+
+// mismatch returns the error for text that does not match the expected element of the layout
+func (l *Layout) mismatch(s, expected string) (date.Value, timeofday.Value, error) {
+	return date.Nil, timeofday.Zero, errors.Wrapf(ErrMismatch, "parsing %q as %q: expected %s", s, l.src, expected)
+}
+
+// outOfRange returns the error for a field that is missing or outside of its valid range
+func (l *Layout) outOfRange(s, field string) (date.Value, timeofday.Value, error) {
+	return date.Nil, timeofday.Zero, errors.Wrapf(ErrOutOfRange, "parsing %q as %q: invalid %s", s, l.src, field)
+}
+
+var (
+	longMonthNames    = names(12, func(i int) string { return time.Month(i + 1).String() })
+	shortMonthNames   = names(12, func(i int) string { return time.Month(i + 1).String()[:3] })
+	longWeekdayNames  = names(7, func(i int) string { return time.Weekday(i).String() })
+	shortWeekdayNames = names(7, func(i int) string { return time.Weekday(i).String()[:3] })
+)
+
+// names returns a slice of n names generated by f
+func names(n int, f func(int) string) []string {
+	res := make([]string, n)
+	for i := range res {
+		res[i] = f(i)
+	}
+	return res
+}
+
+// lookup returns the index of the name in tab that s starts with, ignoring ASCII case, and the rest
+// of s
+func lookup(tab []string, s string) (int, string, error) {
+	for i, name := range tab {
+		if len(s) >= len(name) && strings.EqualFold(s[:len(name)], name) {
+			return i, s[len(name):], nil
+		}
+	}
+	return -1, s, ErrMismatch
+}
+
+// getnum parses a 1 or 2 digit number at the start of s, or exactly 2 digits if fixed is true, and
+// returns the number and the rest of s
+func getnum(s string, fixed bool) (int, string, error) {
+	if s == "" || !isDigits(s[:1]) {
+		return 0, s, ErrMismatch
+	}
+	if len(s) < 2 || !isDigits(s[1:2]) {
+		if fixed {
+			return 0, s, ErrMismatch
+		}
+		return int(s[0] - '0'), s[1:], nil
+	}
+	return int(s[0]-'0')*10 + int(s[1]-'0'), s[2:], nil
+}
+
+// getYear4 parses a 4-digit year at the start of s and returns the year and the rest of s
+func getYear4(s string) (int, string, error) {
+	if len(s) < 4 || !isDigits(s[:4]) {
+		return 0, s, ErrMismatch
+	}
+	return int(s[0]-'0')*1000 + int(s[1]-'0')*100 + int(s[2]-'0')*10 + int(s[3]-'0'), s[4:], nil
+}
+
+// getnum3 parses a 1 to 3 digit number at the start of s, or exactly 3 digits if fixed is true, and
+// returns the number and the rest of s
+func getnum3(s string, fixed bool) (int, string, error) {
+	var n, i int
+	for i = 0; i < 3 && i < len(s) && isDigits(s[i:i+1]); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	if i == 0 || fixed && i != 3 {
+		return 0, s, ErrMismatch
+	}
+	return n, s[i:], nil
+}
+
+// parseNanos converts a string of fractional second digits to nanoseconds, ignoring digits beyond
+// nanosecond precision
+func parseNanos(digits string) (int64, error) {
+	if digits == "" || !isDigits(digits) {
+		return 0, ErrMismatch
+	}
+	var ns int64
+	for i := 0; i < 9; i++ {
+		ns *= 10
+		if i < len(digits) {
+			ns += int64(digits[i] - '0')
+		}
+	}
+	return ns, nil
+}
+
+// isDigits returns true if s is non-empty and contains only ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
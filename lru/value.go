@@ -0,0 +1,204 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package lru provides Value[K, V], a generic least-recently-used cache with a maximum size and
+// optional per-entry expiration.
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/dylan-bourque/go-types/clock"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidCapacity is returned by New() when the capacity is not positive
+	ErrInvalidCapacity = errors.Errorf("lru: the capacity must be greater than zero")
+)
+
+// Value is a cache that holds at most a fixed number of entries.  When a new entry is added to a full
+// cache, the least recently used entry is evicted.  Entries may also have a time-to-live, after which
+// they are treated as missing.
+//
+// Expiration is checked against a clock.Clock, so tests can use a clock.Fake to control exactly when
+// entries expire.  Expired entries are removed lazily, when they are looked up or evicted, or eagerly
+// by RemoveExpired().
+//
+// Values are created by New() and are safe for concurrent use.
+type Value[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	clock    clock.Clock
+	items    map[K]*list.Element
+	order    list.List // front is the most recently used entry
+}
+
+// entry is the payload of each element in Value.order
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time // zero if the entry does not expire
+}
+
+// New returns an empty cache that holds at most capacity entries and uses c to check expiration.  If c
+// is nil, clock.System is used.
+func New[K comparable, V any](capacity int, c clock.Clock) (*Value[K, V], error) {
+	if capacity <= 0 {
+		return nil, errors.Wrapf(ErrInvalidCapacity, "%d", capacity)
+	}
+	if c == nil {
+		c = clock.System
+	}
+	return &Value[K, V]{capacity: capacity, clock: c, items: make(map[K]*list.Element)}, nil
+}
+
+// Set adds or replaces the entry for key, without an expiration, and marks it as the most recently
+// used.  If the cache is full, the least recently used entry is evicted.
+func (c *Value[K, V]) Set(key K, value V) {
+	c.set(key, value, time.Time{})
+}
+
+// SetWithTTL adds or replaces the entry for key, which expires after ttl, and marks it as the most
+// recently used.  If ttl is not positive, the entry does not expire.  If the cache is full, the least
+// recently used entry is evicted.
+func (c *Value[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.clock.Now().Add(ttl)
+	}
+	c.set(key, value, expires)
+}
+
+// Get returns the value for key and marks it as the most recently used.  If there is no entry for key
+// or it has expired, the zero value and false are returned.
+func (c *Value[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(c.items[key])
+	return e.value, true
+}
+
+// Peek returns the value for key without marking it as recently used.  If there is no entry for key or
+// it has expired, the zero value and false are returned.
+func (c *Value[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Delete removes the entry for key, returning true if there was one
+func (c *Value[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if ok {
+		c.remove(el)
+	}
+	return ok
+}
+
+// RemoveExpired removes all expired entries and returns the number that were removed
+func (c *Value[K, V]) RemoveExpired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now, n := c.clock.Now(), 0
+	for el := c.order.Front(); el != nil; {
+		next := el.Next()
+		if el.Value.(*entry[K, V]).expired(now) {
+			c.remove(el)
+			n++
+		}
+		el = next
+	}
+	return n
+}
+
+// Len returns the number of entries in the cache, including expired entries that have not been removed
+// yet
+func (c *Value[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Cap returns the maximum number of entries the cache can hold
+func (c *Value[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Keys returns the keys of the unexpired entries, from the most to the least recently used
+func (c *Value[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	keys := make([]K, 0, c.order.Len())
+	for el := c.order.Front(); el != nil; el = el.Next() {
+		if e := el.Value.(*entry[K, V]); !e.expired(now) {
+			keys = append(keys, e.key)
+		}
+	}
+	return keys
+}
+
+// Clear removes all entries from the cache
+func (c *Value[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.items)
+	c.order.Init()
+}
+
+// set adds or replaces the entry for key with the specified expiration time
+func (c *Value[K, V]) set(key K, value V, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		e.value, e.expires = value, expires
+		c.order.MoveToFront(el)
+		return
+	}
+	if c.order.Len() >= c.capacity {
+		c.remove(c.order.Back())
+	}
+	c.items[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, expires: expires})
+}
+
+// lookup returns the unexpired entry for key, removing it if it has expired.  c.mu must be held.
+func (c *Value[K, V]) lookup(key K) (*entry[K, V], bool) {
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry[K, V])
+	if e.expired(c.clock.Now()) {
+		c.remove(el)
+		return nil, false
+	}
+	return e, true
+}
+
+// remove removes el from the cache.  c.mu must be held.
+func (c *Value[K, V]) remove(el *list.Element) {
+	delete(c.items, el.Value.(*entry[K, V]).key)
+	c.order.Remove(el)
+}
+
+// expired returns true if e has an expiration time that is not after now
+func (e *entry[K, V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
@@ -0,0 +1,125 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package lru
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/clock"
+	"github.com/pkg/errors"
+)
+
+func TestNew(t *testing.T) {
+	for _, capacity := range []int{0, -1} {
+		if _, err := New[string, int](capacity, nil); errors.Cause(err) != ErrInvalidCapacity {
+			t.Errorf("Expected ErrInvalidCapacity for %d, got %v", capacity, err)
+		}
+	}
+	c, err := New[string, int](2, nil)
+	if err != nil || c.Cap() != 2 || c.Len() != 0 || c.clock != clock.System {
+		t.Errorf("Unexpected cache state (err = %v)", err)
+	}
+}
+
+func TestEviction(t *testing.T) {
+	c, _ := New[string, int](2, nil)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected (1, true), got (%d, %v)", v, ok)
+	}
+	// "b" is now the least recently used entry
+	c.Set("c", 3)
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+	if got := c.Keys(); !slices.Equal(got, []string{"c", "a"}) {
+		t.Errorf("Expected [c a], got %v", got)
+	}
+
+	// Peek() does not change the order, so "a" is evicted next
+	c.Peek("a")
+	c.Set("d", 4)
+	if got := c.Keys(); !slices.Equal(got, []string{"d", "c"}) {
+		t.Errorf("Expected [d c], got %v", got)
+	}
+
+	// replacing an entry marks it as recently used without evicting anything
+	c.Set("c", 30)
+	if v, _ := c.Get("c"); v != 30 || c.Len() != 2 {
+		t.Errorf("Expected c to be replaced, got %d", v)
+	}
+	if got := c.Keys(); !slices.Equal(got, []string{"c", "d"}) {
+		t.Errorf("Expected [c d], got %v", got)
+	}
+
+	if !c.Delete("c") || c.Delete("c") || c.Len() != 1 {
+		t.Errorf("Unexpected Delete() results")
+	}
+	c.Clear()
+	if c.Len() != 0 || len(c.Keys()) != 0 {
+		t.Errorf("Expected an empty cache after Clear()")
+	}
+}
+
+func TestTTL(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, time.July, 14, 12, 0, 0, 0, time.UTC))
+	c, _ := New[string, int](10, fake)
+	c.SetWithTTL("short", 1, time.Minute)
+	c.SetWithTTL("long", 2, time.Hour)
+	c.SetWithTTL("forever", 3, 0)
+	c.Set("plain", 4)
+
+	fake.Advance(time.Minute - time.Nanosecond)
+	if _, ok := c.Get("short"); !ok {
+		t.Errorf("Expected short to be present just before it expires")
+	}
+
+	fake.Advance(time.Nanosecond)
+	if _, ok := c.Get("short"); ok {
+		t.Errorf("Expected short to expire after one minute")
+	}
+	if c.Len() != 3 {
+		t.Errorf("Expected Get() to remove the expired entry, got %d entries", c.Len())
+	}
+
+	fake.Advance(time.Hour)
+	if _, ok := c.Peek("long"); ok {
+		t.Errorf("Expected long to expire after one hour")
+	}
+	if got := c.Keys(); !slices.Equal(got, []string{"plain", "forever"}) {
+		t.Errorf("Expected [plain forever], got %v", got)
+	}
+
+	c.SetWithTTL("a", 5, time.Second)
+	c.SetWithTTL("b", 6, time.Second)
+	c.SetWithTTL("plain", 7, time.Second)
+	fake.Advance(time.Second)
+	if n := c.RemoveExpired(); n != 3 || c.Len() != 1 {
+		t.Errorf("Expected 3 expired entries to be removed, got %d (%d left)", n, c.Len())
+	}
+}
+
+func TestConcurrentUse(t *testing.T) {
+	c, _ := New[int, int](16, nil)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Set(i%32, g)
+				c.Get((i + g) % 32)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if c.Len() != 16 {
+		t.Errorf("Expected a full cache, got %d entries", c.Len())
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package mssqlconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ driver.Valuer = Date(0)
+var _ sql.Scanner = (*Date)(nil)
+
+// Date adapts a date.Value to a date column.  date.Nil is written and read as NULL.
+type Date date.Value
+
+// Value implements the driver.Valuer interface.  Valid dates are written as text formatted as
+// YYYY-MM-DD and date.Nil is written as NULL.  Any other invalid date returns ErrOutOfRange.
+func (d Date) Value() (driver.Value, error) {
+	v := date.Value(d)
+	if v == date.Nil {
+		return nil, nil
+	}
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "date.Value(%d)", int64(v))
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// NULL is read as date.Nil.  go-mssqldb returns date columns as a time.Time, whose date in its own
+// location is used, and ODBC drivers return text formatted as YYYY-MM-DD.  All other values will
+// return an error.
+func (d *Date) Scan(src interface{}) error {
+	var (
+		v   date.Value
+		err error
+	)
+	switch tv := src.(type) {
+	case nil:
+		*d = Date(date.Nil)
+		return nil
+	case time.Time:
+		v, err = date.FromTime(tv)
+	case string:
+		v, err = date.Parse("2006-01-02", tv)
+	case []byte:
+		v, err = date.Parse("2006-01-02", string(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+	if err != nil {
+		return errors.Wrapf(ErrOutOfRange, "%v", src)
+	}
+	*d = Date(v)
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package mssqlconv
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+func TestDateValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        date.Value
+		expected driver.Value
+		err      error
+	}{
+		{"valid", date.Must(date.FromUnits(2019, 7, 14)), "2019-07-14", nil},
+		{"nil", date.Nil, nil, nil},
+		{"invalid", date.Max + 1, nil, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := Date(tc.d).Value()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDateScan(t *testing.T) {
+	d := date.Must(date.FromUnits(2019, 7, 14))
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected date.Value
+		err      error
+	}{
+		{"time", time.Date(2019, 7, 14, 0, 0, 0, 0, time.UTC), d, nil},
+		{"string", "2019-07-14", d, nil},
+		{"bytes", []byte("2019-07-14"), d, nil},
+		{"null", nil, date.Nil, nil},
+		{"invalid string", "2019-02-30", date.Max, ErrOutOfRange},
+		{"unsupported type", int64(42), date.Max, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Date(date.Max)
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if date.Value(v) != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, date.Value(v))
+			}
+		})
+	}
+}
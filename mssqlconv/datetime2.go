@@ -0,0 +1,95 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package mssqlconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ driver.Valuer = DateTime2{}
+var _ sql.Scanner = (*DateTime2)(nil)
+
+// DateTime2 adapts a date and time of day with no time zone to a datetime2(7) column.  A Date of
+// date.Nil is written and read as NULL.
+type DateTime2 struct {
+	Date date.Value
+	Time timeofday.Value
+}
+
+// Value implements the driver.Valuer interface.  Values are written as text formatted as
+// YYYY-MM-DD hh:mm:ss.fffffff, truncated to the 100 nanosecond resolution of the column, and a Date of
+// date.Nil is written as NULL.  Any other invalid date or time of day returns ErrOutOfRange.
+func (dt DateTime2) Value() (driver.Value, error) {
+	if dt.Date == date.Nil {
+		return nil, nil
+	}
+	if !dt.Date.IsValid() || !dt.Time.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "%v %v", dt.Date, dt.Time)
+	}
+	return dt.Date.String() + " " + formatTime(dt.Time), nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// NULL is read with a Date of date.Nil.  go-mssqldb returns datetime2 columns as a time.Time, whose
+// date and clock in its own location are used, and ODBC drivers return text formatted as
+// YYYY-MM-DD hh:mm:ss with up to seven fractional digits.  A 'T' separator is also accepted.  All other
+// values will return an error.
+func (dt *DateTime2) Scan(src interface{}) error {
+	var (
+		res DateTime2
+		err error
+	)
+	switch tv := src.(type) {
+	case nil:
+		*dt = DateTime2{Date: date.Nil}
+		return nil
+	case time.Time:
+		h, m, s := tv.Clock()
+		res.Date, err = date.FromTime(tv)
+		if err == nil {
+			res.Time, err = timeofday.FromUnits(h, m, s, int64(tv.Nanosecond()))
+		}
+	case string:
+		res, err = parseDateTime2(tv)
+	case []byte:
+		res, err = parseDateTime2(string(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+	if err != nil {
+		return errors.Wrapf(ErrOutOfRange, "%v", src)
+	}
+	*dt = res
+	return nil
+}
+
+// parseDateTime2 parses text formatted as YYYY-MM-DD hh:mm:ss[.fffffff]
+func parseDateTime2(s string) (DateTime2, error) {
+	ds, ts, ok := strings.Cut(s, " ")
+	if !ok {
+		ds, ts, ok = strings.Cut(s, "T")
+	}
+	if !ok {
+		return DateTime2{}, errors.Wrapf(ErrInvalidFormat, "%q", s)
+	}
+	d, err := date.Parse("2006-01-02", ds)
+	if err != nil {
+		return DateTime2{}, err
+	}
+	t, err := timeofday.ParseTime(ts)
+	if err != nil {
+		return DateTime2{}, err
+	}
+	return DateTime2{Date: d, Time: t}, nil
+}
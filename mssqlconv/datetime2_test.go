@@ -0,0 +1,70 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package mssqlconv
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestDateTime2Value(t *testing.T) {
+	d := date.Must(date.FromUnits(2019, 7, 14))
+	cases := []struct {
+		name     string
+		dt       DateTime2
+		expected driver.Value
+		err      error
+	}{
+		{"valid", DateTime2{d, timeofday.Must(timeofday.FromUnits(13, 45, 30, 123456789))}, "2019-07-14 13:45:30.1234567", nil},
+		{"nil", DateTime2{Date: date.Nil}, nil, nil},
+		{"invalid date", DateTime2{Date: date.Max + 1}, nil, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.dt.Value()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDateTime2Scan(t *testing.T) {
+	expected := DateTime2{date.Must(date.FromUnits(2019, 7, 14)), timeofday.Must(timeofday.FromUnits(13, 45, 30, 123456700))}
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected DateTime2
+		err      error
+	}{
+		{"go-mssqldb time", time.Date(2019, 7, 14, 13, 45, 30, 123456700, time.UTC), expected, nil},
+		{"ODBC string", "2019-07-14 13:45:30.1234567", expected, nil},
+		{"T separator", []byte("2019-07-14T13:45:30.1234567"), expected, nil},
+		{"null", nil, DateTime2{Date: date.Nil}, nil},
+		{"no time", "2019-07-14", DateTime2{}, ErrOutOfRange},
+		{"invalid time", "2019-07-14 25:00:00", DateTime2{}, ErrOutOfRange},
+		{"unsupported type", 42, DateTime2{}, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got DateTime2
+			err := got.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
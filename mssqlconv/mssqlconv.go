@@ -0,0 +1,69 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package mssqlconv provides adapters and conversions for reading and writing the date and time of day
+// types in this module against SQL Server, through either github.com/microsoft/go-mssqldb or an ODBC
+// driver.
+//
+// SQL Server's time, datetime2 and datetimeoffset types have a resolution of 100 nanoseconds, which is
+// seven fractional digits.  go-mssqldb returns all of them as a time.Time, while ODBC drivers usually
+// return their text forms, so the adapters accept both:
+//
+//   - Date adapts a date.Value to a date column
+//   - TimeOfDay adapts a timeofday.Value to a time(7) column
+//   - DateTime2 adapts a date.Value and timeofday.Value pair to a datetime2(7) column
+//
+// Values are written as text with seven fractional digits, which SQL Server converts implicitly and
+// which round trips without loss.  ParseDateTimeOffset() and FormatDateTimeOffset() convert the text
+// form of datetimeoffset, such as "2019-07-14 13:45:30.1234567 -05:00", to and from time.Time.
+//
+// The driver packages are not imported.
+package mssqlconv
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by Scan() when the provided value cannot be converted to
+	// the target type
+	ErrUnsupportedSourceType = errors.Errorf("mssqlconv: cannot convert the source data to the target type")
+	// ErrOutOfRange is returned when a value is not valid for the target type
+	ErrOutOfRange = errors.Errorf("mssqlconv: the value is out of range for the target type")
+	// ErrInvalidFormat is returned when text is not in the format SQL Server uses for the target type
+	ErrInvalidFormat = errors.Errorf("mssqlconv: the specified text is not in the expected format")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+const (
+	// dateTimeOffsetLayout is the layout of the text form of datetimeoffset(7).  Parsing with it also
+	// accepts fewer fractional digits, or none, because time.Parse() allows a fraction after the
+	// seconds even when the layout has none.
+	dateTimeOffsetLayout = "2006-01-02 15:04:05.0000000 -07:00"
+	// dateTimeOffsetParseLayout is the layout used to parse the text form of datetimeoffset
+	dateTimeOffsetParseLayout = "2006-01-02 15:04:05 -07:00"
+	// tick is the resolution of the SQL Server time types
+	tick = 100 * time.Nanosecond
+)
+
+// ParseDateTimeOffset parses the text form of a SQL Server datetimeoffset, such as
+// "2019-07-14 13:45:30.1234567 -05:00", into a time.Time in a fixed zone with the same offset.  The
+// fraction may have up to seven digits, or be omitted.
+func ParseDateTimeOffset(s string) (time.Time, error) {
+	t, err := time.Parse(dateTimeOffsetParseLayout, s)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ErrInvalidFormat, "%q", s)
+	}
+	return t, nil
+}
+
+// FormatDateTimeOffset formats t as the text form of a SQL Server datetimeoffset(7), keeping the
+// offset of its location and truncating to 100 nanoseconds
+func FormatDateTimeOffset(t time.Time) string {
+	return t.Truncate(tick).Format(dateTimeOffsetLayout)
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package mssqlconv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseDateTimeOffset(t *testing.T) {
+	est := time.FixedZone("", -5*60*60)
+	cases := []struct {
+		name     string
+		s        string
+		expected time.Time
+		err      error
+	}{
+		{"7 digits", "2019-07-14 13:45:30.1234567 -05:00", time.Date(2019, 7, 14, 13, 45, 30, 123456700, est), nil},
+		{"3 digits", "2019-07-14 13:45:30.123 -05:00", time.Date(2019, 7, 14, 13, 45, 30, 123000000, est), nil},
+		{"no fraction", "2019-07-14 13:45:30 +00:00", time.Date(2019, 7, 14, 13, 45, 30, 0, time.UTC), nil},
+		{"missing offset", "2019-07-14 13:45:30.1234567", time.Time{}, ErrInvalidFormat},
+		{"RFC 3339", "2019-07-14T13:45:30Z", time.Time{}, ErrInvalidFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := ParseDateTimeOffset(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			// comparing the RFC 3339 forms also checks that the offset was preserved
+			if got.Format(time.RFC3339Nano) != tc.expected.Format(time.RFC3339Nano) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatDateTimeOffset(t *testing.T) {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	cases := []struct {
+		name     string
+		t        time.Time
+		expected string
+	}{
+		{"UTC", time.Date(2019, 7, 14, 13, 45, 30, 0, time.UTC), "2019-07-14 13:45:30.0000000 +00:00"},
+		{"offset", time.Date(2019, 7, 14, 13, 45, 30, 123456789, ist), "2019-07-14 13:45:30.1234567 +05:30"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := FormatDateTimeOffset(tc.t)
+			if got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+			back, err := ParseDateTimeOffset(got)
+			if err != nil || !back.Equal(tc.t.Truncate(tick)) {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.t.Truncate(tick), back, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package mssqlconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ driver.Valuer = TimeOfDay{}
+var _ sql.Scanner = (*TimeOfDay)(nil)
+
+// TimeOfDay adapts a timeofday.Value to a time(7) column
+type TimeOfDay timeofday.Value
+
+// Value implements the driver.Valuer interface.  Values are written as text formatted as
+// hh:mm:ss.fffffff, truncated to the 100 nanosecond resolution of the column.  An invalid time of day
+// returns ErrOutOfRange.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	v := timeofday.Value(t)
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "%v", v)
+	}
+	return formatTime(v), nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// go-mssqldb returns time columns as a time.Time on 1900-01-01, whose clock in its own location is
+// used, and ODBC drivers return text formatted as hh:mm:ss with up to seven fractional digits.  All
+// other values, including NULL, will return an error.
+func (t *TimeOfDay) Scan(src interface{}) error {
+	var (
+		v   timeofday.Value
+		err error
+	)
+	switch tv := src.(type) {
+	case time.Time:
+		h, m, s := tv.Clock()
+		v, err = timeofday.FromUnits(h, m, s, int64(tv.Nanosecond()))
+	case string:
+		v, err = timeofday.ParseTime(tv)
+	case []byte:
+		v, err = timeofday.ParseTime(string(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+	if err != nil {
+		return errors.Wrapf(ErrOutOfRange, "%v", src)
+	}
+	*t = TimeOfDay(v)
+	return nil
+}
+
+// formatTime formats t as hh:mm:ss.fffffff
+func formatTime(t timeofday.Value) string {
+	h, m, s, ns := t.ToUnits()
+	return fmt.Sprintf("%02d:%02d:%02d.%07d", h, m, s, ns/int64(tick))
+}
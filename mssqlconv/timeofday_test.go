@@ -0,0 +1,79 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package mssqlconv
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestTimeOfDayValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		t        timeofday.Value
+		expected driver.Value
+	}{
+		{"midnight", timeofday.Zero, "00:00:00.0000000"},
+		{"fraction", timeofday.Must(timeofday.FromUnits(13, 45, 30, 123456700)), "13:45:30.1234567"},
+		{"truncated", timeofday.Max, "23:59:59.9999999"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := TimeOfDay(tc.t).Value()
+			if err != nil || got != tc.expected {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayScan(t *testing.T) {
+	tod := timeofday.Must(timeofday.FromUnits(13, 45, 30, 123456700))
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected timeofday.Value
+		err      error
+	}{
+		{"go-mssqldb time", time.Date(1900, 1, 1, 13, 45, 30, 123456700, time.UTC), tod, nil},
+		{"ODBC string", "13:45:30.1234567", tod, nil},
+		{"bytes", []byte("13:45:30.1234567"), tod, nil},
+		{"whole seconds", "13:45:30", timeofday.Must(timeofday.FromUnits(13, 45, 30, 0)), nil},
+		{"invalid string", "24:00:00", timeofday.Max, ErrOutOfRange},
+		{"null", nil, timeofday.Max, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := TimeOfDay(timeofday.Max)
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if timeofday.Value(v) != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, timeofday.Value(v))
+			}
+		})
+	}
+}
+
+func TestTimeOfDayRoundTrip(t *testing.T) {
+	tod := timeofday.Must(timeofday.FromUnits(9, 30, 0, 123456789))
+	dv, err := TimeOfDay(tod).Value()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var got TimeOfDay
+	if err := got.Scan(dv); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := timeofday.Must(timeofday.FromUnits(9, 30, 0, 123456700))
+	if timeofday.Value(got) != expected {
+		t.Errorf("Expected %v, got %v", expected, timeofday.Value(got))
+	}
+}
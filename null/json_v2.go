@@ -0,0 +1,52 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package null
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+)
+
+// interface validations
+var _ jsonv2.MarshalerTo = Value[int]{}
+var _ jsonv2.UnmarshalerFrom = (*Value[int])(nil)
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface for null.Value values.  The JSON
+// encoding is the same as MarshalJSON().
+func (n Value[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	if m, ok := any(n.V).(jsonv2.MarshalerTo); ok {
+		return m.MarshalJSONTo(enc)
+	}
+	return jsonv2.MarshalEncode(enc, n.V)
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom interface for null.Value values.
+// As with UnmarshalJSON(), the JSON null token sets n to NULL and all other values are decoded by T.
+func (n *Value[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	var err error
+	if u, ok := any(&n.V).(jsonv2.UnmarshalerFrom); ok {
+		err = u.UnmarshalJSONFrom(dec)
+	} else {
+		err = jsonv2.UnmarshalDecode(dec, &n.V)
+	}
+	if err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package null
+
+import (
+	jsonv2 "encoding/json/v2"
+	"testing"
+)
+
+func TestJSONv2(t *testing.T) {
+	type wrapper struct {
+		N Value[int]   `json:"n,omitzero"`
+		U Value[upper] `json:"u"`
+	}
+	cases := []struct {
+		name     string
+		v        wrapper
+		expected string
+	}{
+		{"null", wrapper{}, `{"u":null}`},
+		{"values", wrapper{From(0), From(upper("abc"))}, `{"n":0,"u":"ABC"}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := jsonv2.Marshal(tc.v)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, data)
+			}
+			var got wrapper
+			if err := jsonv2.Unmarshal(data, &got); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.v {
+				tt.Errorf("Expected %v, got %v", tc.v, got)
+			}
+		})
+	}
+
+	got := From(3)
+	if err := jsonv2.Unmarshal([]byte(`null`), &got); err != nil || got.Valid {
+		t.Errorf("Expected a NULL value, got %v (err = %v)", got, err)
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package null
+
+import (
+	"log/slog"
+)
+
+// interface validations
+var _ slog.LogValuer = (*Value[int])(nil)
+
+// LogValue implements the slog.LogValuer interface for null.Value values.  NULL is logged as null and
+// all other values are logged as T, so a T that implements slog.LogValuer is resolved in turn.
+func (n Value[T]) LogValue() slog.Value {
+	if !n.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(n.V)
+}
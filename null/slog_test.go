@@ -0,0 +1,29 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package null
+
+import (
+	"log/slog"
+	"testing"
+)
+
+// secret implements slog.LogValuer to verify that wrapped values are resolved
+type secret string
+
+func (secret) LogValue() slog.Value {
+	return slog.StringValue("REDACTED")
+}
+
+func TestLogValue(t *testing.T) {
+	if lv := (Value[int]{}).LogValue(); lv.Kind() != slog.KindAny || lv.Any() != nil {
+		t.Errorf("Expected null, got %v", lv)
+	}
+	if lv := (Value[int]{V: 42, Valid: true}).LogValue(); lv.Kind() != slog.KindInt64 || lv.Int64() != 42 {
+		t.Errorf("Expected 42, got %v", lv)
+	}
+	if lv := (Value[secret]{V: "hunter2", Valid: true}).LogValue().Resolve(); lv.String() != "REDACTED" {
+		t.Errorf("Expected REDACTED, got %v", lv)
+	}
+}
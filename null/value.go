@@ -0,0 +1,189 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package null provides Value[T], a generic wrapper that adds a NULL state to any type for use with
+// database/sql and encoding/json, following the conventions of database/sql.NullString.
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// Value represents a T that may be NULL.  V holds the wrapped value and is only meaningful when
+// Valid is true.
+//
+// Database values are delegated to T when it implements driver.Valuer or sql.Scanner and are
+// otherwise converted by the database/sql package.  JSON values are delegated to T, with NULL
+// encoded as the special JSON null token.
+//
+// The zero value is NULL.
+type Value[T any] struct {
+	V     T
+	Valid bool
+}
+
+// interface validations
+var _ driver.Valuer = Value[int]{}
+var _ sql.Scanner = (*Value[int])(nil)
+var _ json.Marshaler = Value[int]{}
+var _ json.Unmarshaler = (*Value[int])(nil)
+var _ encoding.TextMarshaler = Value[int]{}
+var _ encoding.TextAppender = Value[int]{}
+var _ encoding.TextUnmarshaler = (*Value[int])(nil)
+
+// From returns a valid, non-NULL Value holding v
+func From[T any](v T) Value[T] {
+	return Value[T]{V: v, Valid: true}
+}
+
+// FromPtr returns a Value holding *p, or NULL if p is nil
+func FromPtr[T any](p *T) Value[T] {
+	if p == nil {
+		return Value[T]{}
+	}
+	return From(*p)
+}
+
+// ToPtr returns a pointer to a copy of the wrapped value, or nil if n is NULL
+func (n Value[T]) ToPtr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// IsZero returns true if n is NULL.  It is used by the "omitzero" JSON struct tag option to omit
+// NULL values.
+func (n Value[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// Value implements the driver.Valuer interface for null.Value values.
+//
+// NULL is returned as nil.  Otherwise, the result of T's Value() method is returned if T implements
+// driver.Valuer, or the wrapped value itself is returned for database/sql to convert.
+func (n Value[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if v, ok := any(n.V).(driver.Valuer); ok {
+		return v.Value()
+	}
+	return sql.Null[T]{V: n.V, Valid: true}.Value()
+}
+
+// Scan implements the sql.Scanner interface for null.Value values.
+//
+// A nil source sets n to NULL.  Otherwise, the source is passed to T's Scan() method if *T implements
+// sql.Scanner, or converted by the same rules as database/sql.Rows.Scan().  n is left unchanged if
+// the conversion fails.
+func (n *Value[T]) Scan(src interface{}) error {
+	if src == nil {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	// scan into a temporary so that a failing or partial T.Scan() cannot change n
+	var v T
+	if s, ok := any(&v).(sql.Scanner); ok {
+		if err := s.Scan(src); err != nil {
+			return err
+		}
+		n.V, n.Valid = v, true
+		return nil
+	}
+	var res sql.Null[T]
+	if err := res.Scan(src); err != nil {
+		return err
+	}
+	n.V, n.Valid = res.V, true
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for null.Value values.  NULL is encoded as the
+// special JSON null token and all other values are encoded by T.
+func (n Value[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for null.Value values.  The special JSON
+// null token sets n to NULL and all other values are decoded by T.
+func (n *Value[T]) UnmarshalJSON(d []byte) error {
+	if bytes.Equal(d, []byte("null")) {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	var err error
+	if u, ok := any(&n.V).(json.Unmarshaler); ok {
+		err = u.UnmarshalJSON(d)
+	} else {
+		err = json.Unmarshal(d, &n.V)
+	}
+	if err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for null.Value values.  NULL is encoded
+// as an empty string.  All other values are encoded by T's MarshalText() method if T implements
+// encoding.TextMarshaler, or formatted with fmt.Sprint() otherwise.
+func (n Value[T]) MarshalText() ([]byte, error) {
+	return n.AppendText([]byte{})
+}
+
+// AppendText implements the encoding.TextAppender interface for null.Value values.  It appends the
+// same encoding as MarshalText() to b, using T's AppendText() method if T implements
+// encoding.TextAppender.
+func (n Value[T]) AppendText(b []byte) ([]byte, error) {
+	if !n.Valid {
+		return b, nil
+	}
+	switch m := any(n.V).(type) {
+	case encoding.TextAppender:
+		return m.AppendText(b)
+	case encoding.TextMarshaler:
+		text, err := m.MarshalText()
+		if err != nil {
+			return b, err
+		}
+		return append(b, text...), nil
+	default:
+		return fmt.Append(b, n.V), nil
+	}
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for null.Value values, which
+// configuration loaders such as github.com/kelseyhightower/envconfig and github.com/caarlos0/env use
+// for types they don't otherwise support.
+//
+// Empty text sets n to NULL.  All other text is decoded by T's UnmarshalText() method if *T implements
+// encoding.TextUnmarshaler, or converted from a string by the same rules as Scan() otherwise.  n is
+// left unchanged if the conversion fails.
+func (n *Value[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	if u, ok := any(&n.V).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return err
+		}
+		n.Valid = true
+		return nil
+	}
+	return n.Scan(string(text))
+}
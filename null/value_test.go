@@ -0,0 +1,277 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// upper is a test type that implements driver.Valuer, sql.Scanner and the JSON interfaces
+type upper string
+
+var errNotString = errors.Errorf("upper: expected a string")
+
+func (u upper) Value() (driver.Value, error) {
+	return strings.ToUpper(string(u)), nil
+}
+
+func (u *upper) Scan(src interface{}) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.Wrapf(errNotString, "got %T", src)
+	}
+	*u = upper(strings.ToLower(s))
+	return nil
+}
+
+func (u upper) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strings.ToUpper(string(u)))
+}
+
+func (u *upper) UnmarshalJSON(d []byte) error {
+	var s string
+	if err := json.Unmarshal(d, &s); err != nil {
+		return errors.Wrapf(errNotString, "%v", err)
+	}
+	*u = upper(strings.ToLower(s))
+	return nil
+}
+
+// partial is a sql.Scanner that modifies the destination before it fails
+type partial string
+
+func (p *partial) Scan(src interface{}) error {
+	*p = "partial"
+	return errNotString
+}
+
+func TestConstructors(t *testing.T) {
+	if v := From(42); !v.Valid || v.V != 42 {
+		t.Errorf("Expected a valid 42, got %v", v)
+	}
+	if v := FromPtr[int](nil); v.Valid || v.ToPtr() != nil {
+		t.Errorf("Expected NULL, got %v", v)
+	}
+	n := 7
+	v := FromPtr(&n)
+	if !v.Valid || v.V != 7 {
+		t.Errorf("Expected a valid 7, got %v", v)
+	}
+	p := v.ToPtr()
+	if p == nil || *p != 7 || p == &n {
+		t.Errorf("Expected a pointer to a copy of 7, got %v", p)
+	}
+	if !(Value[int]{}).IsZero() || v.IsZero() {
+		t.Errorf("Expected IsZero() to be the inverse of Valid")
+	}
+}
+
+func TestValuer(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        driver.Valuer
+		expected driver.Value
+	}{
+		{"null int", Value[int64]{}, nil},
+		{"int", From[int64](42), int64(42)},
+		{"string", From("abc"), "abc"},
+		{"null valuer", Value[upper]{}, nil},
+		{"valuer", From(upper("abc")), "ABC"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.v.Value()
+			if err != nil || got != tc.expected {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestScanner(t *testing.T) {
+	t.Run("scanner", func(tt *testing.T) {
+		cases := []struct {
+			name     string
+			src      interface{}
+			expected Value[upper]
+			err      error
+		}{
+			{"null", nil, Value[upper]{}, nil},
+			{"string", "ABC", From(upper("abc")), nil},
+			{"invalid", 42, From(upper("xyz")), errNotString},
+		}
+		for _, tc := range cases {
+			tt.Run(tc.name, func(ttt *testing.T) {
+				got := From(upper("xyz"))
+				err := got.Scan(tc.src)
+				if errors.Cause(err) != tc.err {
+					ttt.Fatalf("Expected error %v, got %v", tc.err, err)
+				}
+				if got != tc.expected {
+					ttt.Errorf("Expected %v, got %v", tc.expected, got)
+				}
+			})
+		}
+	})
+	t.Run("failed scan leaves the value unchanged", func(tt *testing.T) {
+		got := From(partial("original"))
+		if err := got.Scan("anything"); errors.Cause(err) != errNotString {
+			tt.Fatalf("Expected error %v, got %v", errNotString, err)
+		}
+		if expected := From(partial("original")); got != expected {
+			tt.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+	t.Run("converted", func(tt *testing.T) {
+		cases := []struct {
+			name     string
+			src      interface{}
+			expected Value[int64]
+			isErr    bool
+		}{
+			{"null", nil, Value[int64]{}, false},
+			{"int", int64(42), From[int64](42), false},
+			{"numeric text", []byte("42"), From[int64](42), false},
+			{"invalid text", "forty-two", From[int64](1), true},
+		}
+		for _, tc := range cases {
+			tt.Run(tc.name, func(ttt *testing.T) {
+				got := From[int64](1)
+				err := got.Scan(tc.src)
+				if (err != nil) != tc.isErr {
+					ttt.Fatalf("Unexpected error %v", err)
+				}
+				if got != tc.expected {
+					ttt.Errorf("Expected %v, got %v", tc.expected, got)
+				}
+			})
+		}
+	})
+}
+
+func TestJSON(t *testing.T) {
+	type doc struct {
+		N Value[int]   `json:"n"`
+		U Value[upper] `json:"u"`
+	}
+	cases := []struct {
+		name     string
+		data     string
+		expected doc
+		err      error
+	}{
+		{"nulls", `{"n":null,"u":null}`, doc{}, nil},
+		{"values", `{"n":0,"u":"ABC"}`, doc{From(0), From(upper("abc"))}, nil},
+		{"invalid", `{"n":1,"u":1}`, doc{N: From(1)}, errNotString},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got doc
+			err := json.Unmarshal([]byte(tc.data), &got)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			if err != nil {
+				return
+			}
+			if data, err := json.Marshal(got); err != nil || string(data) != tc.data {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.data, data, err)
+			}
+		})
+	}
+
+	v := From(5)
+	if err := json.Unmarshal([]byte("null"), &v); err != nil || v.Valid || v.V != 0 {
+		t.Errorf("Expected null to reset the value, got %v (err = %v)", v, err)
+	}
+}
+
+func TestText(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected Value[int]
+		err      bool
+	}{
+		{"null", "", Value[int]{}, false},
+		{"value", "42", From(42), false},
+		{"invalid", "forty-two", From(7), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := From(7)
+			err := got.UnmarshalText([]byte(tc.text))
+			if (err != nil) != tc.err {
+				tt.Fatalf("Expected error = %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			if err != nil {
+				return
+			}
+			if text, err := got.MarshalText(); err != nil || string(text) != tc.text {
+				tt.Errorf("Expected %q, got %q (err = %v)", tc.text, text, err)
+			}
+		})
+	}
+}
+
+func TestAppendText(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        interface{ AppendText([]byte) ([]byte, error) }
+		expected string
+	}{
+		{"null", Value[int]{}, "x="},
+		{"formatted", From(42), "x=42"},
+		{"text marshaler", From(number(42)), "x=0x2a"},
+		{"text appender", From(netip.MustParseAddr("10.0.0.1")), "x=10.0.0.1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.v.AppendText([]byte("x="))
+			if err != nil || string(got) != tc.expected {
+				tt.Errorf("Expected %q, got %q (err = %v)", tc.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestTextDelegation(t *testing.T) {
+	var u Value[upper]
+	if err := u.UnmarshalText([]byte("ABC")); err != nil || u != From(upper("abc")) {
+		t.Errorf("Expected the text to be scanned by T, got %v (err = %v)", u, err)
+	}
+	var n Value[number]
+	if err := n.UnmarshalText([]byte("0x2a")); err != nil || n != From(number(42)) {
+		t.Errorf("Expected the text to be decoded by T, got %v (err = %v)", n, err)
+	}
+	if text, err := n.MarshalText(); err != nil || string(text) != "0x2a" {
+		t.Errorf("Expected the text to be encoded by T, got %q (err = %v)", text, err)
+	}
+}
+
+// number is a test type that implements the text interfaces using hex
+type number int
+
+func (n number) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%#x", int(n))), nil
+}
+
+func (n *number) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "%v", (*int)(n))
+	return err
+}
@@ -0,0 +1,134 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package numericdate
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidText is returned when a string is not a valid decimal number of seconds
+	ErrInvalidText = errors.Errorf("numericdate: the specified text is not a valid NumericDate")
+	// ErrInvalidTextData is returned from numericdate.Value.UnmarshalJSON() when the passed-in byte
+	// slice does not contain a JSON number
+	ErrInvalidTextData = errors.Errorf("numericdate.Value: can only decode JSON numbers")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// Parse parses a decimal number of seconds since the Unix epoch, such as "1563062400" or
+// "1563062400.25".  Exponent notation, such as "1.5630624e9", is also accepted because some JWT
+// libraries encode claims as floating point numbers.  Digits beyond nanosecond precision are
+// truncated.  An empty string is parsed as numericdate.Nil.
+func Parse(s string) (Value, error) {
+	if s == "" {
+		return Nil, nil
+	}
+	if strings.ContainsAny(s, "eE") {
+		return parseFloat(s)
+	}
+	whole, frac, hasFrac := strings.Cut(s, ".")
+	if whole == "" || whole == "-" || whole[0] == '+' || (hasFrac && frac == "") {
+		return Nil, errors.Wrapf(ErrInvalidText, "%q", s)
+	}
+	sec, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return Nil, errors.Wrapf(ErrInvalidText, "%q", s)
+	}
+	var nsec int64
+	for i := 0; i < len(frac); i++ {
+		c := frac[i]
+		if c < '0' || c > '9' {
+			return Nil, errors.Wrapf(ErrInvalidText, "%q", s)
+		}
+		if i < 9 {
+			nsec = nsec*10 + int64(c-'0')
+		}
+	}
+	for i := len(frac); i < 9; i++ {
+		nsec *= 10
+	}
+	if whole[0] == '-' {
+		nsec = -nsec
+	}
+	return Value{t: time.Unix(sec, nsec).UTC()}, nil
+}
+
+// parseFloat parses a number of seconds in exponent notation
+func parseFloat(s string) (Value, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || s[0] == '+' || math.IsInf(f, 0) || math.IsNaN(f) || math.Abs(f) >= math.MaxInt64 {
+		return Nil, errors.Wrapf(ErrInvalidText, "%q", s)
+	}
+	sec := math.Floor(f)
+	nsec := math.Round((f - sec) * float64(time.Second))
+	return Value{t: time.Unix(int64(sec), int64(nsec)).UTC()}, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for numericdate.Value values.
+// numericdate.Nil is encoded as an empty string.
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for numericdate.Value values
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	if v.IsNil() {
+		return b, nil
+	}
+	return v.appendSeconds(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for numericdate.Value values
+func (v *Value) UnmarshalText(text []byte) error {
+	tmp, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = tmp
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for numericdate.Value values.  Whole seconds
+// are encoded as a JSON integer and fractional seconds as a JSON number with up to nine decimal
+// places.  numericdate.Nil is encoded as null.
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return []byte("null"), nil
+	}
+	return v.appendSeconds(nil), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for numericdate.Value values.  Both
+// integer and floating point numbers are accepted, and null is decoded as numericdate.Nil.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		*v = Nil
+		return nil
+	}
+	if len(data) == 0 || data[0] == '"' {
+		return ErrInvalidTextData
+	}
+	tmp, err := Parse(string(data))
+	if err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%s", data)
+	}
+	*v = tmp
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package numericdate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected Value
+		err      error
+	}{
+		{"empty", "", Nil, nil},
+		{"integer", "1563062400", FromUnix(1563062400), nil},
+		{"fractional", "1563062400.25", FromTime(time.Unix(1563062400, 250000000)), nil},
+		{"trailing zeros", "1563062400.000", FromUnix(1563062400), nil},
+		{"truncated", "1563062400.0000000019", FromTime(time.Unix(1563062400, 1)), nil},
+		{"negative", "-1.5", FromTime(time.Unix(-2, 500000000)), nil},
+		{"negative fraction", "-0.25", FromTime(time.Unix(-1, 750000000)), nil},
+		{"exponent", "1.5630624e9", FromUnix(1563062400), nil},
+		{"fractional exponent", "15630624002.5E-1", FromTime(time.Unix(1563062400, 250000000)), nil},
+		{"leading plus", "+1563062400", Nil, ErrInvalidText},
+		{"leading dot", ".5", Nil, ErrInvalidText},
+		{"trailing dot", "1563062400.", Nil, ErrInvalidText},
+		{"bad fraction", "1563062400.2x", Nil, ErrInvalidText},
+		{"not a number", "tomorrow", Nil, ErrInvalidText},
+		{"overflow", "1e300", Nil, ErrInvalidText},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if !got.Time().Equal(tc.expected.Time()) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type claims struct {
+		Subject   string `json:"sub"`
+		ExpiresAt Value  `json:"exp"`
+		IssuedAt  Value  `json:"iat,omitzero"`
+	}
+	in := claims{"alice", FromUnix(1563062400), Nil}
+	data, err := json.Marshal(in)
+	if err != nil || string(data) != `{"sub":"alice","exp":1563062400}` {
+		t.Errorf("Expected the integer form with iat omitted, got %s (err = %v)", data, err)
+	}
+
+	var out claims
+	if err := json.Unmarshal([]byte(`{"sub":"alice","exp":1563062400.5,"iat":1563058800}`), &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !out.ExpiresAt.Time().Equal(time.Unix(1563062400, 500000000)) || out.IssuedAt != FromUnix(1563058800) {
+		t.Errorf("Expected exp = 1563062400.5 and iat = 1563058800, got %v and %v", out.ExpiresAt, out.IssuedAt)
+	}
+
+	data, _ = json.Marshal(out.ExpiresAt)
+	if string(data) != "1563062400.5" {
+		t.Errorf("Expected 1563062400.5, got %s", data)
+	}
+	if data, _ = json.Marshal(Nil); string(data) != "null" {
+		t.Errorf("Expected null, got %s", data)
+	}
+	v := FromUnix(1)
+	if err := json.Unmarshal([]byte("null"), &v); err != nil || !v.IsNil() {
+		t.Errorf("Expected null to decode as Nil, got %v (err = %v)", v, err)
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{"string", `"1563062400"`},
+		{"boolean", "true"},
+		{"object", "{}"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			if err := v.UnmarshalJSON([]byte(tc.data)); errors.Cause(err) != ErrInvalidTextData {
+				tt.Errorf("Expected %v, got %v", ErrInvalidTextData, err)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	v := FromTime(time.Unix(1563062400, 10))
+	text, _ := v.MarshalText()
+	var got Value
+	if err := got.UnmarshalText(text); err != nil || got != v {
+		t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+	}
+	if b, _ := v.AppendText([]byte("exp=")); string(b) != "exp=1563062400.00000001" {
+		t.Errorf("Expected %q, got %q", "exp=1563062400.00000001", b)
+	}
+	if text, _ = Nil.MarshalText(); len(text) != 0 {
+		t.Errorf("Expected Nil to encode as empty text, got %q", text)
+	}
+}
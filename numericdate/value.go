@@ -0,0 +1,101 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package numericdate provides the NumericDate type defined by RFC 7519 for the "exp", "nbf" and
+// "iat" claims of a JSON Web Token.
+package numericdate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Value is an RFC 7519 NumericDate, a UTC instant that is encoded as the number of seconds since
+// the Unix epoch.  The zero value, numericdate.Nil, represents an absent claim.
+type Value struct {
+	t time.Time
+}
+
+// Nil is the zero value, which represents an absent claim
+var Nil Value
+
+// FromTime converts t to a NumericDate.  Sub-second precision is preserved.  The zero time.Time
+// is converted to numericdate.Nil.
+func FromTime(t time.Time) Value {
+	if t.IsZero() {
+		return Nil
+	}
+	return Value{t: t.UTC()}
+}
+
+// FromUnix returns the NumericDate for the specified number of whole seconds since the Unix epoch
+func FromUnix(sec int64) Value {
+	return Value{t: time.Unix(sec, 0).UTC()}
+}
+
+// IsNil returns true if v is numericdate.Nil
+func (v Value) IsNil() bool {
+	return v.t.IsZero()
+}
+
+// IsZero returns true if v is numericdate.Nil, so that fields tagged with "omitzero" are omitted from
+// encoded claim sets
+func (v Value) IsZero() bool {
+	return v.IsNil()
+}
+
+// Time returns v as a UTC time.Time, or the zero time.Time if v is numericdate.Nil
+func (v Value) Time() time.Time {
+	return v.t
+}
+
+// Unix returns the number of whole seconds since the Unix epoch, truncating any fractional
+// seconds, or 0 if v is numericdate.Nil
+func (v Value) Unix() int64 {
+	if v.IsNil() {
+		return 0
+	}
+	return v.t.Unix()
+}
+
+// Before returns true if v is before t.  It is intended for checking the "exp" claim, and always
+// returns false if v is numericdate.Nil.
+func (v Value) Before(t time.Time) bool {
+	return !v.IsNil() && v.t.Before(t)
+}
+
+// After returns true if v is after t.  It is intended for checking the "nbf" and "iat" claims, and
+// always returns false if v is numericdate.Nil.
+func (v Value) After(t time.Time) bool {
+	return !v.IsNil() && v.t.After(t)
+}
+
+// String returns the number of seconds since the Unix epoch in decimal form, with up to nine
+// fractional digits, or an empty string if v is numericdate.Nil
+func (v Value) String() string {
+	if v.IsNil() {
+		return ""
+	}
+	return string(v.appendSeconds(nil))
+}
+
+// appendSeconds appends the decimal number of seconds since the Unix epoch to b.  The fraction is
+// formatted from the integer nanoseconds, rather than through a float64, so that no precision is lost.
+func (v Value) appendSeconds(b []byte) []byte {
+	sec, nsec := v.t.Unix(), int64(v.t.Nanosecond())
+	if sec < 0 && nsec > 0 {
+		// time.Time keeps the nanoseconds positive, so move them towards zero for negative values
+		sec, nsec = sec+1, int64(time.Second)-nsec
+		if sec == 0 {
+			b = append(b, '-')
+		}
+	}
+	b = strconv.AppendInt(b, sec, 10)
+	if nsec == 0 {
+		return b
+	}
+	frac := strconv.FormatInt(nsec+int64(time.Second), 10)[1:]
+	return append(append(b, '.'), strings.TrimRight(frac, "0")...)
+}
@@ -0,0 +1,69 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package numericdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromTime(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	cases := []struct {
+		name     string
+		t        time.Time
+		expected string
+		unix     int64
+	}{
+		{"zero", time.Time{}, "", 0},
+		{"epoch", time.Unix(0, 0), "0", 0},
+		{"whole seconds", time.Date(2019, 7, 14, 0, 0, 0, 0, time.UTC), "1563062400", 1563062400},
+		{"other zone", time.Date(2019, 7, 13, 19, 0, 0, 0, loc), "1563062400", 1563062400},
+		{"fractional", time.Date(2019, 7, 14, 0, 0, 0, 250000000, time.UTC), "1563062400.25", 1563062400},
+		{"nanoseconds", time.Unix(1563062400, 1), "1563062400.000000001", 1563062400},
+		{"before epoch", time.Unix(-1, 0), "-1", -1},
+		{"fractional before epoch", time.Unix(-2, 500000000), "-1.5", -2},
+		{"just before epoch", time.Unix(-1, 750000000), "-0.25", -1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := FromTime(tc.t)
+			if got := v.String(); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+			if got := v.Unix(); got != tc.unix {
+				tt.Errorf("Expected %d seconds, got %d", tc.unix, got)
+			}
+			if !v.Time().Equal(tc.t) || (!v.IsNil() && v.Time().Location() != time.UTC) {
+				tt.Errorf("Expected %v in UTC, got %v", tc.t, v.Time())
+			}
+		})
+	}
+}
+
+func TestFromUnix(t *testing.T) {
+	v := FromUnix(1563062400)
+	if !v.Time().Equal(time.Date(2019, 7, 14, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2019-07-14T00:00:00Z, got %v", v.Time())
+	}
+	if FromUnix(0).IsNil() {
+		t.Errorf("Expected the Unix epoch to be distinct from Nil")
+	}
+}
+
+func TestBeforeAfter(t *testing.T) {
+	now := time.Date(2019, 7, 14, 12, 0, 0, 0, time.UTC)
+	exp := FromTime(now.Add(-time.Second))
+	nbf := FromTime(now.Add(time.Second))
+	if !exp.Before(now) || exp.After(now) {
+		t.Errorf("Expected %v to be before %v", exp, now)
+	}
+	if !nbf.After(now) || nbf.Before(now) {
+		t.Errorf("Expected %v to be after %v", nbf, now)
+	}
+	if Nil.Before(now) || Nil.After(now) {
+		t.Errorf("Expected Nil to be neither before nor after %v", now)
+	}
+}
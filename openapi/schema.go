@@ -0,0 +1,266 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package openapi reports the JSON Schema fragment that describes the JSON encoding of each type in
+// this module, so that frameworks generating OpenAPI specifications from Go structs can document
+// fields of these types correctly instead of describing their internal Go representation.
+package openapi
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/dylan-bourque/go-types/b64bytes"
+	"github.com/dylan-bourque/go-types/b64urlbytes"
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/emailaddr"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/hexbytes"
+	"github.com/dylan-bourque/go-types/hostport"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/dylan-bourque/go-types/ulid"
+)
+
+// Schema is a JSON Schema fragment that describes the JSON encoding of a single type, using the subset
+// of keywords shared by JSON Schema and OpenAPI 3.  It marshals to JSON with the standard keyword
+// names, so it can be embedded directly in a generated specification or copied into the schema type
+// of an OpenAPI library.
+type Schema struct {
+	// Type is the JSON type of the encoded value, such as "string" or "integer"
+	Type string `json:"type"`
+	// Format is the OpenAPI format, such as "date" or "time", or a custom format name for types that
+	// have no standard one
+	Format string `json:"format,omitempty"`
+	// Pattern is a regular expression that every encoded string matches
+	Pattern string `json:"pattern,omitempty"`
+	// Minimum and Maximum are the inclusive bounds of encoded integers, or nil if unbounded
+	Minimum *int64 `json:"minimum,omitempty"`
+	Maximum *int64 `json:"maximum,omitempty"`
+	// Nullable is true if the type can be encoded as JSON null
+	Nullable bool `json:"nullable,omitempty"`
+	// Description is a human readable summary of the encoding
+	Description string `json:"description,omitempty"`
+	// Example is a representative encoded value
+	Example string `json:"example,omitempty"`
+}
+
+var (
+	mu      sync.RWMutex
+	schemas = make(map[reflect.Type]Schema)
+)
+
+func init() {
+	Register(reflect.TypeOf(date.Value(0)), Schema{
+		Type:        "string",
+		Format:      "date",
+		Pattern:     `^[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])$`,
+		Nullable:    true,
+		Description: "A calendar date, with no time or time zone, formatted as YYYY-MM-DD.",
+		Example:     "2019-07-14",
+	})
+	Register(reflect.TypeOf(timeofday.Value{}), Schema{
+		Type:        "string",
+		Format:      "time",
+		Pattern:     `^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]{1,9})?$`,
+		Description: "A time of day, with no date or time zone, formatted as hh:mm:ss with optional fractional seconds.",
+		Example:     "13:45:30.5",
+	})
+	Register(reflect.TypeOf(timeofday.NullTimeOfDay{}), Schema{
+		Type:        "string",
+		Format:      "time",
+		Pattern:     `^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]{1,9})?$`,
+		Nullable:    true,
+		Description: "A time of day, with no date or time zone, formatted as hh:mm:ss with optional fractional seconds.",
+		Example:     "13:45:30.5",
+	})
+	Register(reflect.TypeOf(partialdate.Value{}), Schema{
+		Type:        "string",
+		Pattern:     `^[0-9]{4}(-[0-9]{2}(-[0-9]{2})?)?$`,
+		Nullable:    true,
+		Description: "A calendar date with year, year-month or full day precision, formatted as YYYY, YYYY-MM or YYYY-MM-DD.",
+		Example:     "2024-07",
+	})
+	Register(reflect.TypeOf(langtag.Value{}), Schema{
+		Type:        "string",
+		Nullable:    true,
+		Description: "A canonical BCP 47 language tag.",
+		Example:     "en-US",
+	})
+	Register(reflect.TypeOf(ulid.Value{}), Schema{
+		Type:        "string",
+		Format:      "ulid",
+		Pattern:     `^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`,
+		Nullable:    true,
+		Description: "A ULID, formatted as 26 Crockford base32 characters.",
+		Example:     "01ARYZ6S41TSV4RRFFQ69G5FAV",
+	})
+	Register(reflect.TypeOf(int128.Value{}), Schema{
+		Type:        "string",
+		Format:      "int128",
+		Pattern:     `^-?[0-9]{1,39}$`,
+		Description: "A signed 128-bit integer, formatted as a decimal string.",
+		Example:     "-170141183460469231731687303715884105728",
+	})
+	Register(reflect.TypeOf(uint128.Value{}), Schema{
+		Type:        "string",
+		Format:      "uint128",
+		Pattern:     `^[0-9]{1,39}$`,
+		Description: "An unsigned 128-bit integer, formatted as a decimal string.",
+		Example:     "340282366920938463463374607431768211455",
+	})
+	Register(reflect.TypeOf(ratio.Value{}), Schema{
+		Type:        "string",
+		Format:      "ratio",
+		Pattern:     `^[+-]?[0-9]+(/[0-9]+)?$`,
+		Description: "An exact rational number, formatted as n/d, or as n when the denominator is 1.",
+		Example:     "3/4",
+	})
+	Register(reflect.TypeOf(bytesize.Value(0)), Schema{
+		Type:        "string",
+		Format:      "bytesize",
+		Pattern:     `^[+-]?[0-9]+(\.[0-9]*)?\s*([KkMmGgTtPpEe][Ii]?)?[Bb]?$`,
+		Description: "A size in bytes with an optional SI (kB, MB, ...) or IEC (KiB, MiB, ...) unit suffix.",
+		Example:     "10MiB",
+	})
+	Register(reflect.TypeOf(emailaddr.Value{}), Schema{
+		Type:        "string",
+		Format:      "email",
+		Description: "An RFC 5322 email address, optionally with a display name.",
+		Example:     "jane@example.com",
+	})
+	Register(reflect.TypeOf(emailaddr.NullAddress{}), Schema{
+		Type:        "string",
+		Format:      "email",
+		Nullable:    true,
+		Description: "An RFC 5322 email address, optionally with a display name.",
+		Example:     "jane@example.com",
+	})
+	Register(reflect.TypeOf(hostport.Value{}), Schema{
+		Type:        "string",
+		Description: "A DNS name or IP address with an optional port, formatted as host:port with brackets around IPv6 addresses.",
+		Example:     "db.example.com:5432",
+	})
+	Register(reflect.TypeOf(inet.Addr{}), Schema{
+		Type:        "string",
+		Description: "An IPv4 or IPv6 address.",
+		Example:     "192.168.1.1",
+	})
+	Register(reflect.TypeOf(inet.NullAddr{}), Schema{
+		Type:        "string",
+		Nullable:    true,
+		Description: "An IPv4 or IPv6 address.",
+		Example:     "192.168.1.1",
+	})
+	Register(reflect.TypeOf(inet.Prefix{}), Schema{
+		Type:        "string",
+		Description: "An IPv4 or IPv6 prefix in CIDR notation.",
+		Example:     "10.0.0.0/8",
+	})
+	Register(reflect.TypeOf(inet.NullPrefix{}), Schema{
+		Type:        "string",
+		Nullable:    true,
+		Description: "An IPv4 or IPv6 prefix in CIDR notation.",
+		Example:     "10.0.0.0/8",
+	})
+	Register(reflect.TypeOf(hexbytes.Value{}), Schema{
+		Type:        "string",
+		Pattern:     `^([0-9a-f]{2})*$`,
+		Nullable:    true,
+		Description: "Binary data encoded as lower case hex.",
+		Example:     "deadbeef",
+	})
+	Register(reflect.TypeOf(b64bytes.Value{}), Schema{
+		Type:        "string",
+		Format:      "byte",
+		Pattern:     `^([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`,
+		Nullable:    true,
+		Description: "Binary data encoded as padded, standard base64 (RFC 4648, section 4).",
+		Example:     "+/8=",
+	})
+	Register(reflect.TypeOf(b64urlbytes.Value{}), Schema{
+		Type:        "string",
+		Format:      "base64url",
+		Pattern:     `^[A-Za-z0-9_-]*$`,
+		Nullable:    true,
+		Description: "Binary data encoded as unpadded, URL-safe base64 (RFC 4648, section 5).",
+		Example:     "-_8",
+	})
+	Register(reflect.TypeOf(flexnum.Value{}), Schema{
+		Type:        "number",
+		Nullable:    true,
+		Description: "A number of arbitrary precision.  Strings containing a number are also accepted.",
+		Example:     "12.50",
+	})
+	Register(reflect.TypeOf(color.Value{}), Schema{
+		Type:        "string",
+		Format:      "color",
+		Description: "An RGBA color, formatted as #rrggbb or #rrggbbaa.  CSS rgb()/rgba() functions and named colors are also accepted.",
+		Example:     "#336699",
+	})
+	Register(reflect.TypeOf(color.NullColor{}), Schema{
+		Type:        "string",
+		Format:      "color",
+		Nullable:    true,
+		Description: "An RGBA color, formatted as #rrggbb or #rrggbbaa.  CSS rgb()/rgba() functions and named colors are also accepted.",
+		Example:     "#336699",
+	})
+	Register(reflect.TypeOf(snowflake.Nil), Schema{
+		Type:        "string",
+		Format:      "snowflake",
+		Pattern:     `^[0-9]{1,19}$`,
+		Description: "A 64-bit Snowflake ID, formatted as a decimal string.  JSON integers are also accepted.",
+		Example:     "1541815603606036480",
+	})
+	Register(reflect.TypeOf(snowflake.NullID{}), Schema{
+		Type:        "string",
+		Format:      "snowflake",
+		Pattern:     `^[0-9]{1,19}$`,
+		Nullable:    true,
+		Description: "A 64-bit Snowflake ID, formatted as a decimal string.  JSON integers are also accepted.",
+		Example:     "1541815603606036480",
+	})
+}
+
+// Register associates the specified schema with a type, replacing any existing registration.  Types
+// outside of this module can be registered so that callers have a single place to look up schemas.
+func Register(t reflect.Type, s Schema) {
+	mu.Lock()
+	defer mu.Unlock()
+	schemas[t] = s
+}
+
+// SchemaForType returns the schema registered for the specified type.  Pointer types resolve to the
+// schema of the element type, marked as nullable.
+//
+// The second return value is false if no schema is registered for the type.
+func SchemaForType(t reflect.Type) (Schema, bool) {
+	if t == nil {
+		return Schema{}, false
+	}
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		t, nullable = t.Elem(), true
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	s, ok := schemas[t]
+	if ok && nullable {
+		s.Nullable = true
+	}
+	return s, ok
+}
+
+// SchemaFor returns the schema registered for the dynamic type of v
+func SchemaFor(v interface{}) (Schema, bool) {
+	return SchemaForType(reflect.TypeOf(v))
+}
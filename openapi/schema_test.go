@@ -0,0 +1,129 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/b64bytes"
+	"github.com/dylan-bourque/go-types/b64urlbytes"
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/emailaddr"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/hexbytes"
+	"github.com/dylan-bourque/go-types/hostport"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/dylan-bourque/go-types/ulid"
+)
+
+func TestSchemaFor(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        interface{}
+		typ      string
+		nullable bool
+		ok       bool
+	}{
+		{"nil", nil, "", false, false},
+		{"unregistered type", 42, "", false, false},
+		{"date", date.Nil, "string", true, true},
+		{"time of day", timeofday.Zero, "string", false, true},
+		{"time of day pointer", &timeofday.Value{}, "string", true, true},
+		{"nullable time of day", timeofday.NullTimeOfDay{}, "string", true, true},
+		{"partial date", partialdate.Nil, "string", true, true},
+		{"language tag", langtag.Nil, "string", true, true},
+		{"ulid", ulid.Nil, "string", true, true},
+		{"int128", int128.Zero, "string", false, true},
+		{"uint128", uint128.Zero, "string", false, true},
+		{"ratio", ratio.Zero, "string", false, true},
+		{"bytesize", bytesize.MiB, "string", false, true},
+		{"email address", emailaddr.Nil, "string", false, true},
+		{"nullable email address", emailaddr.NullAddress{}, "string", true, true},
+		{"host and port", hostport.Nil, "string", false, true},
+		{"ip address", inet.Addr{}, "string", false, true},
+		{"nullable ip prefix", inet.NullPrefix{}, "string", true, true},
+		{"hex bytes", hexbytes.Value{}, "string", true, true},
+		{"base64 bytes", b64bytes.Value{}, "string", true, true},
+		{"base64url bytes", b64urlbytes.Value{}, "string", true, true},
+		{"flexible number", flexnum.Nil, "number", true, true},
+		{"color", color.White, "string", false, true},
+		{"nullable color", color.NullColor{}, "string", true, true},
+		{"snowflake", snowflake.Nil, "string", false, true},
+		{"nullable snowflake", snowflake.NullID{}, "string", true, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			s, ok := SchemaFor(tc.v)
+			if ok != tc.ok || s.Type != tc.typ || s.Nullable != tc.nullable {
+				tt.Errorf("Expected (%s, nullable=%v, %v), got (%s, nullable=%v, %v)", tc.typ, tc.nullable, tc.ok, s.Type, s.Nullable, ok)
+			}
+		})
+	}
+}
+
+func TestPatternsMatchEncodings(t *testing.T) {
+	cases := []struct {
+		name string
+		v    interface{}
+	}{
+		{"date/min", date.Min},
+		{"date/max", date.Max},
+		{"time of day/min", timeofday.Min},
+		{"time of day/max", timeofday.Max},
+		{"time of day/fraction", timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000))},
+		{"partial date/year", partialdate.Must(partialdate.FromYear(2024))},
+		{"partial date/month", partialdate.Must(partialdate.FromYearMonth(2024, 7))},
+		{"partial date/day", partialdate.Must(partialdate.FromUnits(2024, 7, 14))},
+		{"ulid", ulid.Max},
+		{"int128/min", int128.Min},
+		{"uint128/max", uint128.Max},
+		{"ratio/fraction", ratio.Must(ratio.New(-3, 4))},
+		{"ratio/integer", ratio.One},
+		{"bytesize/bytes", bytesize.Value(1500)},
+		{"bytesize/unit", -10 * bytesize.MiB},
+		{"hexbytes", hexbytes.Value{0xde, 0xad, 0xbe, 0xef}},
+		{"b64bytes/padded", b64bytes.Value{0xfb, 0xff}},
+		{"b64bytes/unpadded", b64bytes.Value{0xfb, 0xff, 0xbf}},
+		{"b64urlbytes", b64urlbytes.Value{0xfb, 0xff}},
+		{"snowflake", snowflake.Value(1<<63 - 1)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			s, _ := SchemaFor(tc.v)
+			data, err := json.Marshal(tc.v)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				tt.Fatalf("Expected a JSON string, got %s", data)
+			}
+			if !regexp.MustCompile(s.Pattern).MatchString(str) {
+				tt.Errorf("Pattern %q does not match %q", s.Pattern, str)
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	type custom struct{}
+	Register(reflect.TypeOf(custom{}), Schema{Type: "string", Format: "custom"})
+	s, ok := SchemaFor(custom{})
+	if !ok || s.Format != "custom" {
+		t.Errorf("Expected the registered schema, got %+v (ok = %v)", s, ok)
+	}
+}
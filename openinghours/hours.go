@@ -0,0 +1,212 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package openinghours
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidSpan is returned when a span is empty, reversed or overlaps another span on the same day
+	ErrInvalidSpan = errors.Errorf("openinghours: invalid or overlapping opening span")
+	// ErrInvalidDate is returned when an exception is added for date.Nil or an otherwise invalid date
+	ErrInvalidDate = errors.Errorf("openinghours: exceptions require a valid date")
+)
+
+// searchDays is the number of days that NextChange() will look ahead before giving up
+const searchDays = 366
+
+// Span defines a single period of time, within one day, during which a location is open.
+//
+// Open is inclusive and Close is exclusive.  A Close value of timeofday.Zero means the span lasts
+// until the end of the day (24:00).
+type Span struct {
+	Open, Close timeofday.Value
+}
+
+// NewSpan returns a Span for the specified open and close times, or ErrInvalidSpan if the close time
+// is not after the open time.  A close time of timeofday.Zero represents the end of the day.
+func NewSpan(open, close timeofday.Value) (Span, error) {
+	s := Span{Open: open, Close: close}
+	if !s.isValid() {
+		return Span{}, ErrInvalidSpan
+	}
+	return s, nil
+}
+
+// Contains returns true if the specified time of day falls within the span
+func (s Span) Contains(t timeofday.Value) bool {
+	return timeofday.ToDuration(s.Open) <= timeofday.ToDuration(t) && timeofday.ToDuration(t) < s.closeDuration()
+}
+
+// isValid returns true if both ends of the span are valid and the span is not empty
+func (s Span) isValid() bool {
+	return s.Open.IsValid() && s.Close.IsValid() && timeofday.ToDuration(s.Open) < s.closeDuration()
+}
+
+// closeDuration returns the close time as an offset from midnight, mapping Zero to 24h
+func (s Span) closeDuration() time.Duration {
+	if s.Close == timeofday.Zero {
+		return 24 * time.Hour
+	}
+	return timeofday.ToDuration(s.Close)
+}
+
+// Hours models the regular weekly opening hours of a location along with dated exceptions, such
+// as holiday closures or special hours, that replace the weekly hours on specific days.
+//
+// The zero value is a location that is never open.
+type Hours struct {
+	weekly     [7][]Span
+	exceptions map[date.Value][]Span
+}
+
+// SetWeekly replaces the regular opening hours for the specified day of the week.  Calling it with
+// no spans marks the location as closed on that day.
+func (h *Hours) SetWeekly(wd time.Weekday, spans ...Span) error {
+	if wd < time.Sunday || wd > time.Saturday {
+		return errors.Errorf("openinghours: invalid weekday: %d", wd)
+	}
+	sorted, err := normalizeSpans(spans)
+	if err != nil {
+		return err
+	}
+	h.weekly[wd] = sorted
+	return nil
+}
+
+// AddException replaces the opening hours on the specified date.  Calling it with no spans marks
+// the location as closed for the entire day.
+func (h *Hours) AddException(d date.Value, spans ...Span) error {
+	if !d.IsValid() {
+		return ErrInvalidDate
+	}
+	sorted, err := normalizeSpans(spans)
+	if err != nil {
+		return err
+	}
+	if h.exceptions == nil {
+		h.exceptions = make(map[date.Value][]Span)
+	}
+	h.exceptions[d] = sorted
+	return nil
+}
+
+// RemoveException removes any exception for the specified date so that the weekly hours apply
+func (h *Hours) RemoveException(d date.Value) {
+	delete(h.exceptions, d)
+}
+
+// Weekly returns the regular opening hours for the specified day of the week
+func (h Hours) Weekly(wd time.Weekday) []Span {
+	if wd < time.Sunday || wd > time.Saturday {
+		return nil
+	}
+	return append([]Span(nil), h.weekly[wd]...)
+}
+
+// SpansOn returns the opening hours that apply on the specified date, taking exceptions into account
+func (h Hours) SpansOn(d date.Value) []Span {
+	if !d.IsValid() {
+		return nil
+	}
+	if spans, ok := h.exceptions[d]; ok {
+		return append([]Span(nil), spans...)
+	}
+	return h.Weekly(d.Weekday())
+}
+
+// IsOpen returns true if the location is open at the instant t, evaluated on the wall clock of the
+// specified location/time zone.
+func (h Hours) IsOpen(t time.Time, loc *time.Location) bool {
+	d, tod, ok := split(t, loc)
+	if !ok {
+		return false
+	}
+	for _, s := range h.SpansOn(d) {
+		if s.Contains(tod) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextChange returns the first instant after t at which the location opens, if it is closed at t,
+// or closes, if it is open at t.  The second return value is false if there is no change within the
+// next year.
+//
+// Opening and closing times are evaluated on the wall clock of the specified location/time zone, so a
+// span that starts inside a skipped Daylight Savings Time interval begins at the normalized instant
+// produced by time.Date().
+func (h Hours) NextChange(t time.Time, loc *time.Location) (time.Time, bool) {
+	d, _, ok := split(t, loc)
+	if !ok {
+		return time.Time{}, false
+	}
+	open := h.IsOpen(t, loc)
+	for i := 0; i < searchDays; i++ {
+		day, err := d.AddDays(i)
+		if err != nil {
+			break
+		}
+		for _, b := range h.boundaries(day) {
+			at := b.ToDateTimeInLocation(day.Year(), time.Month(day.Month()), day.Day(), loc)
+			if at.After(t) && h.IsOpen(at, loc) != open {
+				return at, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// boundaries returns the times of day, in order, at which the open/closed state may change on the
+// specified date
+func (h Hours) boundaries(d date.Value) []timeofday.Value {
+	res := []timeofday.Value{timeofday.Zero}
+	for _, s := range h.SpansOn(d) {
+		res = append(res, s.Open)
+		if s.Close != timeofday.Zero {
+			res = append(res, s.Close)
+		}
+	}
+	return res
+}
+
+// split returns the date and time of day of t on the wall clock of loc
+func split(t time.Time, loc *time.Location) (date.Value, timeofday.Value, bool) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	lt := t.In(loc)
+	d, err := date.FromTime(lt)
+	if err != nil {
+		return date.Nil, timeofday.Zero, false
+	}
+	hh, mm, ss := lt.Clock()
+	return d, timeofday.Must(timeofday.FromUnits(hh, mm, ss, int64(lt.Nanosecond()))), true
+}
+
+// normalizeSpans validates the specified spans and returns a sorted copy
+func normalizeSpans(spans []Span) ([]Span, error) {
+	sorted := make([]Span, len(spans))
+	copy(sorted, spans)
+	sort.Slice(sorted, func(i, j int) bool {
+		return timeofday.ToDuration(sorted[i].Open) < timeofday.ToDuration(sorted[j].Open)
+	})
+	for i, s := range sorted {
+		if !s.isValid() {
+			return nil, ErrInvalidSpan
+		}
+		if i > 0 && timeofday.ToDuration(s.Open) < sorted[i-1].closeDuration() {
+			return nil, ErrInvalidSpan
+		}
+	}
+	return sorted, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package openinghours
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+)
+
+func tod(h, m int) timeofday.Value {
+	return timeofday.Must(timeofday.FromUnits(h, m, 0, 0))
+}
+
+func TestSpans(t *testing.T) {
+	var h Hours
+	if _, err := NewSpan(tod(9, 0), tod(9, 0)); err != ErrInvalidSpan {
+		t.Errorf("Expected ErrInvalidSpan for an empty span, got %v", err)
+	}
+	if err := h.SetWeekly(time.Monday, Span{tod(9, 0), tod(12, 0)}, Span{tod(11, 0), tod(13, 0)}); err != ErrInvalidSpan {
+		t.Errorf("Expected ErrInvalidSpan for overlapping spans, got %v", err)
+	}
+	if err := h.AddException(date.Nil); err != ErrInvalidDate {
+		t.Errorf("Expected ErrInvalidDate, got %v", err)
+	}
+	if err := h.SetWeekly(time.Monday, Span{tod(13, 0), tod(17, 0)}, Span{tod(9, 0), tod(12, 0)}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := h.Weekly(time.Monday)
+	if len(got) != 2 || got[0].Open != tod(9, 0) || got[1].Open != tod(13, 0) {
+		t.Errorf("Expected sorted spans, got %v", got)
+	}
+}
+
+func TestIsOpen(t *testing.T) {
+	h, err := Parse("Mo-Fr 09:00-12:00,13:00-17:00; Sa 22:00-02:00; 2024-12-25 off; 2024-12-24 09:00-12:00")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ny, _ := time.LoadLocation("America/New_York")
+	cases := []struct {
+		name     string
+		t        time.Time
+		expected bool
+	}{
+		{"weekday/before open", time.Date(2024, 7, 1, 8, 59, 59, 0, ny), false},
+		{"weekday/at open", time.Date(2024, 7, 1, 9, 0, 0, 0, ny), true},
+		{"weekday/lunch", time.Date(2024, 7, 1, 12, 30, 0, 0, ny), false},
+		{"weekday/at close", time.Date(2024, 7, 1, 17, 0, 0, 0, ny), false},
+		{"weekday/other time zone", time.Date(2024, 7, 1, 14, 0, 0, 0, time.UTC), true},
+		{"saturday/late", time.Date(2024, 7, 6, 23, 0, 0, 0, ny), true},
+		{"sunday/after midnight", time.Date(2024, 7, 7, 1, 0, 0, 0, ny), true},
+		{"sunday/after overnight close", time.Date(2024, 7, 7, 2, 0, 0, 0, ny), false},
+		{"holiday closure", time.Date(2024, 12, 25, 10, 0, 0, 0, ny), false},
+		{"special hours/open", time.Date(2024, 12, 24, 10, 0, 0, 0, ny), true},
+		{"special hours/closed", time.Date(2024, 12, 24, 14, 0, 0, 0, ny), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := h.IsOpen(tc.t, ny); got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestNextChange(t *testing.T) {
+	h, err := Parse("Mo-Fr 09:00-17:00; Sa 22:00-24:00; Su 00:00-02:00; 2024-07-04 off")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	cases := []struct {
+		name     string
+		t        time.Time
+		expected time.Time
+		ok       bool
+	}{
+		{"closed/opens today", time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC), true},
+		{"open/closes today", time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 17, 0, 0, 0, time.UTC), true},
+		{"closed/skips exception", time.Date(2024, 7, 3, 18, 0, 0, 0, time.UTC), time.Date(2024, 7, 5, 9, 0, 0, 0, time.UTC), true},
+		{"open/across midnight", time.Date(2024, 7, 6, 23, 0, 0, 0, time.UTC), time.Date(2024, 7, 7, 2, 0, 0, 0, time.UTC), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, ok := h.NextChange(tc.t, time.UTC)
+			if ok != tc.ok || !got.Equal(tc.expected) {
+				tt.Errorf("Expected (%v, %v), got (%v, %v)", tc.expected, tc.ok, got, ok)
+			}
+		})
+	}
+	var never Hours
+	if _, ok := never.NextChange(time.Now(), time.UTC); ok {
+		t.Errorf("Expected no change for a location that is never open")
+	}
+}
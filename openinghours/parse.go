@@ -0,0 +1,250 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package openinghours
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidSyntax is returned by Parse() when the text is not in the supported format
+	ErrInvalidSyntax = errors.Errorf("openinghours: invalid opening hours syntax")
+)
+
+// the two-letter weekday abbreviations used by the text format, indexed by time.Weekday
+var weekdayNames = [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"}
+
+// Parse constructs an Hours value from its compact text form, which is a list of rules separated
+// by semicolons.  Each rule is a selector followed by a list of spans or the keyword "off":
+//
+//	Mo-Fr 09:00-12:00,13:00-17:30; Sa 10:00-14:00; 2024-12-25 off; 2024-12-24 09:00-12:00
+//
+// A selector is either a comma-separated list of weekdays and weekday ranges (Mo, Tu, We, Th, Fr,
+// Sa, Su) or a comma-separated list of dates formatted as "YYYY-MM-DD".  Weekday rules set the weekly
+// hours, with later rules replacing earlier ones, and date rules add exceptions.
+//
+// Spans are formatted as "hh:mm-hh:mm" and a close time of "24:00" means the end of the day.  In
+// weekday rules, a span whose close time is before its open time, such as "22:00-02:00", continues
+// past midnight into the following day.
+func Parse(s string) (Hours, error) {
+	var (
+		h      Hours
+		weekly [7][]Span
+		spill  [7][]Span
+	)
+	for _, rule := range strings.Split(s, ";") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		fields := strings.Fields(rule)
+		if len(fields) != 2 {
+			return Hours{}, errors.Wrapf(ErrInvalidSyntax, "rule: %q", rule)
+		}
+		sel, times := fields[0], fields[1]
+
+		if days, ok := parseWeekdays(sel); ok {
+			spans, overnight, err := parseSpans(times)
+			if err != nil {
+				return Hours{}, err
+			}
+			for _, wd := range days {
+				weekly[wd] = spans
+				spill[(wd+1)%7] = overnight
+			}
+			continue
+		}
+
+		dates, err := parseDates(sel)
+		if err != nil {
+			return Hours{}, err
+		}
+		spans, overnight, err := parseSpans(times)
+		if err != nil {
+			return Hours{}, err
+		}
+		if len(overnight) > 0 {
+			return Hours{}, errors.Wrapf(ErrInvalidSpan, "exceptions cannot extend past midnight: %q", rule)
+		}
+		for _, d := range dates {
+			if err := h.AddException(d, spans...); err != nil {
+				return Hours{}, err
+			}
+		}
+	}
+	for wd := range weekly {
+		if err := h.SetWeekly(time.Weekday(wd), append(weekly[wd], spill[wd]...)...); err != nil {
+			return Hours{}, err
+		}
+	}
+	return h, nil
+}
+
+// String returns the compact text form of h, as accepted by Parse()
+func (h Hours) String() string {
+	var rules []string
+	// weekly hours, starting on Monday and grouping consecutive days with the same hours
+	for i := 0; i < 7; {
+		wd := time.Weekday((i + 1) % 7)
+		j := i + 1
+		for j < 7 && spansEqual(h.weekly[wd], h.weekly[(j+1)%7]) {
+			j++
+		}
+		if len(h.weekly[wd]) > 0 {
+			sel := weekdayNames[wd]
+			if j-i > 1 {
+				sel += "-" + weekdayNames[j%7]
+			}
+			rules = append(rules, sel+" "+formatSpans(h.weekly[wd]))
+		}
+		i = j
+	}
+	// exceptions, in date order
+	dates := make([]date.Value, 0, len(h.exceptions))
+	for d := range h.exceptions {
+		dates = append(dates, d)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i] < dates[j] })
+	for _, d := range dates {
+		rules = append(rules, d.String()+" "+formatSpans(h.exceptions[d]))
+	}
+	return strings.Join(rules, "; ")
+}
+
+// parseWeekdays parses a weekday selector such as "Mo-Fr,Su"
+func parseWeekdays(sel string) ([]time.Weekday, bool) {
+	var res []time.Weekday
+	for _, item := range strings.Split(sel, ",") {
+		parts := strings.Split(item, "-")
+		if len(parts) > 2 {
+			return nil, false
+		}
+		from, ok := weekdayIndex(parts[0])
+		if !ok {
+			return nil, false
+		}
+		to := from
+		if len(parts) == 2 {
+			if to, ok = weekdayIndex(parts[1]); !ok {
+				return nil, false
+			}
+		}
+		// ranges can wrap around the end of the week, e.g. "Fr-Mo"
+		for wd := from; ; wd = (wd + 1) % 7 {
+			res = append(res, wd)
+			if wd == to {
+				break
+			}
+		}
+	}
+	return res, true
+}
+
+// weekdayIndex returns the time.Weekday for a two-letter weekday abbreviation
+func weekdayIndex(s string) (time.Weekday, bool) {
+	for i, n := range weekdayNames {
+		if strings.EqualFold(n, s) {
+			return time.Weekday(i), true
+		}
+	}
+	return 0, false
+}
+
+// parseDates parses a date selector such as "2024-12-24,2024-12-25"
+func parseDates(sel string) ([]date.Value, error) {
+	var res []date.Value
+	for _, item := range strings.Split(sel, ",") {
+		d, err := date.Parse("2006-01-02", item)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidSyntax, "selector: %q", sel)
+		}
+		res = append(res, d)
+	}
+	return res, nil
+}
+
+// parseSpans parses a list of spans such as "09:00-12:00,13:00-17:00" or the keyword "off".
+//
+// The second return value contains the portions of any spans that continue past midnight.
+func parseSpans(s string) (spans, overnight []Span, err error) {
+	if strings.EqualFold(s, "off") || strings.EqualFold(s, "closed") {
+		return nil, nil, nil
+	}
+	for _, item := range strings.Split(s, ",") {
+		parts := strings.Split(item, "-")
+		if len(parts) != 2 {
+			return nil, nil, errors.Wrapf(ErrInvalidSyntax, "span: %q", item)
+		}
+		open, err := parseClock(parts[0], false)
+		if err != nil {
+			return nil, nil, err
+		}
+		close, err := parseClock(parts[1], true)
+		if err != nil {
+			return nil, nil, err
+		}
+		if close != timeofday.Zero && timeofday.ToDuration(close) <= timeofday.ToDuration(open) {
+			// split at midnight
+			spans = append(spans, Span{Open: open, Close: timeofday.Zero})
+			overnight = append(overnight, Span{Open: timeofday.Zero, Close: close})
+			continue
+		}
+		spans = append(spans, Span{Open: open, Close: close})
+	}
+	return spans, overnight, nil
+}
+
+// parseClock parses an "hh:mm" time, allowing "24:00" as the end of the day when end is true
+func parseClock(s string, end bool) (timeofday.Value, error) {
+	if end && s == "24:00" {
+		return timeofday.Zero, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil || len(s) != 5 {
+		return timeofday.Zero, errors.Wrapf(ErrInvalidSyntax, "time: %q", s)
+	}
+	return timeofday.FromUnits(t.Hour(), t.Minute(), 0, 0)
+}
+
+// formatSpans formats a list of spans for String()
+func formatSpans(spans []Span) string {
+	if len(spans) == 0 {
+		return "off"
+	}
+	items := make([]string, len(spans))
+	for i, s := range spans {
+		items[i] = formatClock(s.Open, false) + "-" + formatClock(s.Close, true)
+	}
+	return strings.Join(items, ",")
+}
+
+// formatClock formats a time as "hh:mm", using "24:00" for the end of the day when end is true
+func formatClock(t timeofday.Value, end bool) string {
+	if end && t == timeofday.Zero {
+		return "24:00"
+	}
+	h, m, _, _ := t.ToUnits()
+	return fmt.Sprintf("%02d:%02d", h, m)
+}
+
+// spansEqual returns true if both lists contain the same spans
+func spansEqual(a, b []Span) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
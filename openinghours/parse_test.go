@@ -0,0 +1,44 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package openinghours
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected string
+		err      error
+	}{
+		{"empty", "", "", nil},
+		{"weekday range", "Mo-Fr 09:00-17:00", "Mo-Fr 09:00-17:00", nil},
+		{"wrapping range", "Fr-Mo 10:00-14:00", "Mo 10:00-14:00; Fr-Su 10:00-14:00", nil},
+		{"later rules replace earlier", "Mo-Su 09:00-17:00; We off", "Mo-Tu 09:00-17:00; Th-Su 09:00-17:00", nil},
+		{"overnight", "Fr 22:00-02:00", "Fr 22:00-24:00; Sa 00:00-02:00", nil},
+		{"exceptions", "2024-12-25,2024-01-01 off; 2024-12-24 09:00-12:00", "2024-01-01 off; 2024-12-24 09:00-12:00; 2024-12-25 off", nil},
+		{"missing times", "Mo", "", ErrInvalidSyntax},
+		{"invalid weekday", "Xx 09:00-17:00", "", ErrInvalidSyntax},
+		{"invalid time", "Mo 9:00-17:00", "", ErrInvalidSyntax},
+		{"invalid span", "Mo 09:00", "", ErrInvalidSyntax},
+		{"overlapping spans", "Mo 09:00-12:00,11:00-13:00", "", ErrInvalidSpan},
+		{"overnight exception", "2024-12-31 22:00-02:00", "", ErrInvalidSpan},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			h, err := Parse(tc.text)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if got := h.String(); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package optional
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+)
+
+// interface validations
+var _ jsonv2.MarshalerTo = Value[int]{}
+var _ jsonv2.UnmarshalerFrom = (*Value[int])(nil)
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface for optional.Value values.  The
+// JSON encoding is the same as MarshalJSON().
+func (o Value[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !o.present {
+		return enc.WriteToken(jsontext.Null)
+	}
+	if m, ok := any(o.v).(jsonv2.MarshalerTo); ok {
+		return m.MarshalJSONTo(enc)
+	}
+	return jsonv2.MarshalEncode(enc, o.v)
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom interface for optional.Value
+// values.  As with UnmarshalJSON(), any JSON value makes o present and is decoded by T.
+func (o *Value[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var v T
+	var err error
+	if u, ok := any(&v).(jsonv2.UnmarshalerFrom); ok {
+		err = u.UnmarshalJSONFrom(dec)
+	} else {
+		err = jsonv2.UnmarshalDecode(dec, &v)
+	}
+	if err != nil {
+		return err
+	}
+	o.v, o.present = v, true
+	return nil
+}
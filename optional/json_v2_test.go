@@ -0,0 +1,43 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package optional
+
+import (
+	jsonv2 "encoding/json/v2"
+	"testing"
+)
+
+func TestJSONv2Patch(t *testing.T) {
+	type update struct {
+		Name  Patch[string] `json:"name,omitzero"`
+		Count Value[int]    `json:"count,omitzero"`
+	}
+	cases := []struct {
+		name     string
+		data     string
+		expected update
+	}{
+		{"absent", `{}`, update{}},
+		{"null", `{"name":null}`, update{Name: Clear[string]()}},
+		{"set", `{"name":"x","count":0}`, update{Set("x"), Of(0)}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got update
+			if err := jsonv2.Unmarshal([]byte(tc.data), &got); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			data, err := jsonv2.Marshal(got)
+			if err != nil || string(data) != tc.data {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.data, data, err)
+			}
+		})
+	}
+}
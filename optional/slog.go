@@ -0,0 +1,22 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package optional
+
+import (
+	"log/slog"
+)
+
+// interface validations
+var _ slog.LogValuer = (*Value[int])(nil)
+
+// LogValue implements the slog.LogValuer interface for optional.Value values.  Absent values are
+// logged as null and present values are logged as T, so a T that implements slog.LogValuer is
+// resolved in turn.
+func (o Value[T]) LogValue() slog.Value {
+	if !o.present {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.v)
+}
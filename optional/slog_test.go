@@ -0,0 +1,25 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package optional
+
+import (
+	"log/slog"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	if lv := None[string]().LogValue(); lv.Kind() != slog.KindAny || lv.Any() != nil {
+		t.Errorf("Expected null, got %v", lv)
+	}
+	if lv := Of("hello").LogValue(); lv.Kind() != slog.KindString || lv.String() != "hello" {
+		t.Errorf("Expected hello, got %v", lv)
+	}
+	if lv := Clear[int]().LogValue().Resolve(); lv.Kind() != slog.KindAny || lv.Any() != nil {
+		t.Errorf("Expected a cleared patch to resolve to null, got %v", lv)
+	}
+	if lv := Set(42).LogValue().Resolve(); lv.Kind() != slog.KindInt64 || lv.Int64() != 42 {
+		t.Errorf("Expected a set patch to resolve to 42, got %v", lv)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package optional provides Value[T], a generic wrapper that records whether a value is present, and
+// Patch[T], which combines it with null.Value[T] to distinguish absent, null and set fields in
+// PATCH-style partial updates.
+package optional
+
+import (
+	"encoding/json"
+
+	"github.com/dylan-bourque/go-types/null"
+)
+
+// Value represents a T that may or may not be present.  Unlike null.Value[T], which models a NULL
+// stored in a database or sent as JSON null, an absent Value means that no value was provided at
+// all, such as a field that was left out of a JSON document.
+//
+// When decoding JSON, a field is present if its key appears in the document, even if its value is
+// null.  Combined with the "omitzero" struct tag option, absent values are omitted when encoding.
+//
+// The zero value is absent.
+type Value[T any] struct {
+	v       T
+	present bool
+}
+
+// interface validations
+var _ json.Marshaler = Value[int]{}
+var _ json.Unmarshaler = (*Value[int])(nil)
+
+// Of returns a present Value holding v
+func Of[T any](v T) Value[T] {
+	return Value[T]{v: v, present: true}
+}
+
+// None returns an absent Value.  It is equivalent to the zero value, but can be clearer at call sites.
+func None[T any]() Value[T] {
+	return Value[T]{}
+}
+
+// FromPtr returns a Value holding *p, or an absent Value if p is nil
+func FromPtr[T any](p *T) Value[T] {
+	if p == nil {
+		return Value[T]{}
+	}
+	return Of(*p)
+}
+
+// Map returns a Value holding f applied to the value of o, or an absent Value if o is absent
+func Map[T, U any](o Value[T], f func(T) U) Value[U] {
+	if !o.present {
+		return Value[U]{}
+	}
+	return Of(f(o.v))
+}
+
+// IsPresent returns true if o holds a value
+func (o Value[T]) IsPresent() bool {
+	return o.present
+}
+
+// IsZero returns true if o is absent.  It is used by the "omitzero" JSON struct tag option to omit
+// absent values.
+func (o Value[T]) IsZero() bool {
+	return !o.present
+}
+
+// Get returns the value of o and true if it is present, or the zero value of T and false if not
+func (o Value[T]) Get() (T, bool) {
+	return o.v, o.present
+}
+
+// OrElse returns the value of o if it is present, or def if not
+func (o Value[T]) OrElse(def T) T {
+	if !o.present {
+		return def
+	}
+	return o.v
+}
+
+// ToPtr returns a pointer to a copy of the value of o, or nil if o is absent
+func (o Value[T]) ToPtr() *T {
+	if !o.present {
+		return nil
+	}
+	v := o.v
+	return &v
+}
+
+// Apply assigns the value of o to *dst if it is present and leaves *dst unchanged if not.  It returns
+// true if *dst was assigned.
+func (o Value[T]) Apply(dst *T) bool {
+	if !o.present {
+		return false
+	}
+	*dst = o.v
+	return true
+}
+
+// MarshalJSON implements the json.Marshaler interface for optional.Value values.  Present values are
+// encoded by T and absent values are encoded as the special JSON null token, so fields should use the
+// "omitzero" struct tag option to leave them out instead.
+func (o Value[T]) MarshalJSON() ([]byte, error) {
+	if !o.present {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.v)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for optional.Value values.  Any JSON value,
+// including null, makes o present and is decoded by T.  Use Patch[T] to tell null apart from other
+// values.
+func (o *Value[T]) UnmarshalJSON(d []byte) error {
+	var v T
+	var err error
+	if u, ok := any(&v).(json.Unmarshaler); ok {
+		err = u.UnmarshalJSON(d)
+	} else {
+		err = json.Unmarshal(d, &v)
+	}
+	if err != nil {
+		return err
+	}
+	o.v, o.present = v, true
+	return nil
+}
+
+// Patch is a field in a PATCH-style partial update, which has three states:
+//   - absent, when the field was not provided and the target should be left unchanged
+//   - null, when the field was provided as JSON null and the target should be cleared
+//   - set, when the field was provided with a value that should be stored in the target
+//
+// Fields should use the "omitzero" struct tag option so that absent fields are omitted when encoding.
+type Patch[T any] = Value[null.Value[T]]
+
+// Set returns a Patch that sets the target to v
+func Set[T any](v T) Patch[T] {
+	return Of(null.From(v))
+}
+
+// Clear returns a Patch that sets the target to NULL
+func Clear[T any]() Patch[T] {
+	return Of(null.Value[T]{})
+}
+
+// IsNull returns true if p is present and NULL, meaning that the target should be cleared
+func IsNull[T any](p Patch[T]) bool {
+	return p.present && !p.v.Valid
+}
+
+// ApplyTo applies p to a non-nullable target.  Absent patches leave *dst unchanged, NULL patches
+// reset *dst to the zero value of T, and set patches assign the new value.  It returns true if *dst
+// was assigned.
+func ApplyTo[T any](p Patch[T], dst *T) bool {
+	if !p.present {
+		return false
+	}
+	*dst = p.v.V
+	return true
+}
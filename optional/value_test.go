@@ -0,0 +1,163 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package optional
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/null"
+)
+
+func TestAccessors(t *testing.T) {
+	cases := []struct {
+		name    string
+		v       Value[int]
+		present bool
+		value   int
+		orElse  int
+	}{
+		{"absent", None[int](), false, 0, -1},
+		{"zero value", Value[int]{}, false, 0, -1},
+		{"present", Of(42), true, 42, 42},
+		{"present zero", Of(0), true, 0, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if tc.v.IsPresent() != tc.present || tc.v.IsZero() == tc.present {
+				tt.Errorf("Expected IsPresent() = %v", tc.present)
+			}
+			if v, ok := tc.v.Get(); v != tc.value || ok != tc.present {
+				tt.Errorf("Expected (%d, %v), got (%d, %v)", tc.value, tc.present, v, ok)
+			}
+			if v := tc.v.OrElse(-1); v != tc.orElse {
+				tt.Errorf("Expected %d, got %d", tc.orElse, v)
+			}
+			if p := tc.v.ToPtr(); (p != nil) != tc.present || (p != nil && *p != tc.value) {
+				tt.Errorf("Unexpected ToPtr() result %v", p)
+			}
+			if FromPtr(tc.v.ToPtr()) != tc.v {
+				tt.Errorf("Expected FromPtr(ToPtr()) to round-trip")
+			}
+			dst := -1
+			if applied := tc.v.Apply(&dst); applied != tc.present || dst != tc.orElse {
+				tt.Errorf("Expected Apply() to return %v and set %d, got %v and %d", tc.present, tc.orElse, applied, dst)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	if v := Map(Of(42), strconv.Itoa); v != Of("42") {
+		t.Errorf("Expected Of(\"42\"), got %v", v)
+	}
+	called := false
+	v := Map(None[int](), func(n int) string { called = true; return "" })
+	if v.IsPresent() || called {
+		t.Errorf("Expected an absent value without calling the function")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type doc struct {
+		N Value[int]    `json:"n,omitzero"`
+		S Value[string] `json:"s,omitzero"`
+	}
+	cases := []struct {
+		name     string
+		data     string
+		expected doc
+		encoded  string
+	}{
+		{"absent", `{}`, doc{}, `{}`},
+		{"present", `{"n":0,"s":"x"}`, doc{Of(0), Of("x")}, `{"n":0,"s":"x"}`},
+		{"null is present", `{"n":null}`, doc{N: Of(0)}, `{"n":0}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got doc
+			if err := json.Unmarshal([]byte(tc.data), &got); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			if data, err := json.Marshal(got); err != nil || string(data) != tc.encoded {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.encoded, data, err)
+			}
+		})
+	}
+
+	var got doc
+	if err := json.Unmarshal([]byte(`{"n":"x"}`), &got); err == nil || got.N.IsPresent() {
+		t.Errorf("Expected an error and an absent value, got %v (err = %v)", got, err)
+	}
+	if data, _ := json.Marshal(None[int]()); string(data) != "null" {
+		t.Errorf("Expected an absent value to be encoded as null, got %s", data)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	type update struct {
+		Name     Patch[string] `json:"name,omitzero"`
+		Nickname Patch[string] `json:"nickname,omitzero"`
+	}
+	cases := []struct {
+		name     string
+		data     string
+		expected update
+		isNull   [2]bool
+		result   [2]null.Value[string]
+	}{
+		{"absent", `{}`, update{}, [2]bool{}, [2]null.Value[string]{null.From("Jane"), null.From("JJ")}},
+		{"null", `{"nickname":null}`, update{Nickname: Clear[string]()}, [2]bool{false, true}, [2]null.Value[string]{null.From("Jane"), {}}},
+		{"set", `{"name":"Janet","nickname":"Jan"}`, update{Set("Janet"), Set("Jan")}, [2]bool{}, [2]null.Value[string]{null.From("Janet"), null.From("Jan")}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got update
+			if err := json.Unmarshal([]byte(tc.data), &got); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			if IsNull(got.Name) != tc.isNull[0] || IsNull(got.Nickname) != tc.isNull[1] {
+				tt.Errorf("Expected IsNull() results %v", tc.isNull)
+			}
+			target := [2]null.Value[string]{null.From("Jane"), null.From("JJ")}
+			got.Name.Apply(&target[0])
+			got.Nickname.Apply(&target[1])
+			if target != tc.result {
+				tt.Errorf("Expected %v, got %v", tc.result, target)
+			}
+			if data, err := json.Marshal(got); err != nil || string(data) != tc.data {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.data, data, err)
+			}
+		})
+	}
+}
+
+func TestApplyTo(t *testing.T) {
+	cases := []struct {
+		name     string
+		p        Patch[int]
+		applied  bool
+		expected int
+	}{
+		{"absent", None[null.Value[int]](), false, 7},
+		{"null", Clear[int](), true, 0},
+		{"set", Set(3), true, 3},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			dst := 7
+			if applied := ApplyTo(tc.p, &dst); applied != tc.applied || dst != tc.expected {
+				tt.Errorf("Expected (%v, %d), got (%v, %d)", tc.applied, tc.expected, applied, dst)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/dylan-bourque/go-types/otelconv
+
+go 1.25.0
+
+require (
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	go.opentelemetry.io/otel v1.46.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	golang.org/x/text v0.40.0 // indirect
+)
+
+replace github.com/dylan-bourque/go-types => ../
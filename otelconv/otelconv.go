@@ -0,0 +1,218 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package otelconv provides OpenTelemetry attribute constructors for the types in this module, so
+// spans and metrics record these values consistently instead of each caller choosing a format.
+//
+// Dates, times of day and the other value types are recorded as the same canonical strings as their
+// JSON encodings, such as "2024-07-14" and "13:45:30.5".  Durations and instants are recorded as
+// integer nanoseconds, as durations and Unix nanoseconds respectively, and byte sizes as integer byte
+// counts.
+//
+// Nil values, such as date.Nil, NULL null.Value values and absent optional.Value values, are recorded
+// as an empty value, whose type is attribute.EMPTY, which is the OpenTelemetry equivalent of null.
+package otelconv
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/emailaddr"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/hostport"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/dylan-bourque/go-types/optional"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/dylan-bourque/go-types/ulid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Date returns an attribute containing the date formatted as YYYY-MM-DD, or an empty attribute for
+// date.Nil
+func Date(key string, v date.Value) attribute.KeyValue {
+	if !v.IsValid() {
+		return empty(key)
+	}
+	return attribute.String(key, v.String())
+}
+
+// Dates returns an attribute containing the dates formatted as YYYY-MM-DD.  date.Nil and other
+// invalid dates are recorded as empty strings.
+func Dates(key string, vs []date.Value) attribute.KeyValue {
+	res := make([]string, len(vs))
+	for i, v := range vs {
+		if v.IsValid() {
+			res[i] = v.String()
+		}
+	}
+	return attribute.StringSlice(key, res)
+}
+
+// TimeOfDay returns an attribute containing the time formatted as hh:mm:ss with optional fractional
+// seconds
+func TimeOfDay(key string, v timeofday.Value) attribute.KeyValue {
+	return attribute.String(key, v.String())
+}
+
+// Duration returns an attribute containing the duration in nanoseconds
+func Duration(key string, d time.Duration) attribute.KeyValue {
+	return attribute.Int64(key, int64(d))
+}
+
+// Timestamp returns an attribute containing the number of nanoseconds since the Unix epoch, or an
+// invalid attribute for the zero time.Time
+func Timestamp(key string, t time.Time) attribute.KeyValue {
+	if t.IsZero() {
+		return empty(key)
+	}
+	return attribute.Int64(key, t.UnixNano())
+}
+
+// DateTime returns an attribute containing the number of nanoseconds since the Unix epoch of the
+// instant at which the date and time of day occur in the specified location, or an empty attribute
+// if the date is not valid
+func DateTime(key string, d date.Value, t timeofday.Value, loc *time.Location) attribute.KeyValue {
+	if !d.IsValid() {
+		return empty(key)
+	}
+	y, m, dd := date.ToUnits(d)
+	return attribute.Int64(key, t.ToDateTimeInLocation(y, time.Month(m), dd, loc).UnixNano())
+}
+
+// PartialDate returns an attribute containing the date formatted as YYYY, YYYY-MM or YYYY-MM-DD, or
+// an empty attribute for partialdate.Nil
+func PartialDate(key string, v partialdate.Value) attribute.KeyValue {
+	if v.IsNil() {
+		return empty(key)
+	}
+	return attribute.String(key, v.String())
+}
+
+// ULID returns an attribute containing the 26 character ULID string, or an empty attribute for
+// ulid.Nil
+func ULID(key string, v ulid.Value) attribute.KeyValue {
+	if v.IsNil() {
+		return empty(key)
+	}
+	return attribute.String(key, v.String())
+}
+
+// Snowflake returns an attribute containing the ID as a decimal string, which matches its JSON
+// encoding
+func Snowflake(key string, v snowflake.Value) attribute.KeyValue {
+	return attribute.String(key, v.String())
+}
+
+// Int128 returns an attribute containing the value as a decimal string
+func Int128(key string, v int128.Value) attribute.KeyValue {
+	return attribute.String(key, v.String())
+}
+
+// Uint128 returns an attribute containing the value as a decimal string
+func Uint128(key string, v uint128.Value) attribute.KeyValue {
+	return attribute.String(key, v.String())
+}
+
+// Ratio returns an attribute containing the value formatted as n/d, or n when the denominator is 1
+func Ratio(key string, v ratio.Value) attribute.KeyValue {
+	return attribute.String(key, v.String())
+}
+
+// FlexNum returns an attribute containing the exact decimal string, or an empty attribute for
+// flexnum.Nil
+func FlexNum(key string, v flexnum.Value) attribute.KeyValue {
+	if v.IsNil() {
+		return empty(key)
+	}
+	return attribute.String(key, v.String())
+}
+
+// ByteSize returns an attribute containing the size in bytes
+func ByteSize(key string, v bytesize.Value) attribute.KeyValue {
+	return attribute.Int64(key, int64(v))
+}
+
+// Color returns an attribute containing the color formatted as #rrggbb or #rrggbbaa
+func Color(key string, v color.Value) attribute.KeyValue {
+	return attribute.String(key, v.String())
+}
+
+// EmailAddress returns an attribute containing the address, with the display name if there is one
+func EmailAddress(key string, v emailaddr.Value) attribute.KeyValue {
+	return attribute.String(key, v.String())
+}
+
+// HostPort returns an attribute containing the value formatted as host:port
+func HostPort(key string, v hostport.Value) attribute.KeyValue {
+	return attribute.String(key, v.String())
+}
+
+// LangTag returns an attribute containing the canonical BCP 47 tag, or an empty attribute for
+// langtag.Nil
+func LangTag(key string, v langtag.Value) attribute.KeyValue {
+	if v.IsNil() {
+		return empty(key)
+	}
+	return attribute.String(key, v.String())
+}
+
+// Addr returns an attribute containing the IP address, or an empty attribute for the zero value
+func Addr(key string, v inet.Addr) attribute.KeyValue {
+	if !v.IsValid() {
+		return empty(key)
+	}
+	return attribute.String(key, v.String())
+}
+
+// Prefix returns an attribute containing the IP prefix in CIDR notation, or an empty attribute for
+// the zero value
+func Prefix(key string, v inet.Prefix) attribute.KeyValue {
+	if !v.IsValid() {
+		return empty(key)
+	}
+	return attribute.String(key, v.String())
+}
+
+// Null returns an attribute containing the string returned by the String() method of the wrapped
+// value, or an empty attribute if v is NULL
+func Null[T fmt.Stringer](key string, v null.Value[T]) attribute.KeyValue {
+	if !v.Valid {
+		return empty(key)
+	}
+	return attribute.String(key, v.V.String())
+}
+
+// Optional returns an attribute containing the string returned by the String() method of the wrapped
+// value, or an empty attribute if v is absent
+func Optional[T fmt.Stringer](key string, v optional.Value[T]) attribute.KeyValue {
+	s, ok := v.Get()
+	if !ok {
+		return empty(key)
+	}
+	return attribute.String(key, s.String())
+}
+
+// Strings returns an attribute containing the strings returned by the String() method of each value
+func Strings[T fmt.Stringer](key string, vs []T) attribute.KeyValue {
+	res := make([]string, len(vs))
+	for i, v := range vs {
+		res[i] = v.String()
+	}
+	return attribute.StringSlice(key, res)
+}
+
+// empty returns an attribute with no value
+func empty(key string) attribute.KeyValue {
+	return attribute.KeyValue{Key: attribute.Key(key)}
+}
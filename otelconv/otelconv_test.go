@@ -0,0 +1,78 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package otelconv
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/dylan-bourque/go-types/optional"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/ulid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestAttributes(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	tod := timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000))
+	instant := time.Date(2024, 7, 14, 13, 45, 30, 500000000, time.UTC)
+	cases := []struct {
+		name     string
+		kv       attribute.KeyValue
+		expected attribute.Value
+	}{
+		{"date", Date("k", d), attribute.StringValue("2024-07-14")},
+		{"nil date", Date("k", date.Nil), attribute.Value{}},
+		{"dates", Dates("k", []date.Value{d, date.Nil}), attribute.StringSliceValue([]string{"2024-07-14", ""})},
+		{"time of day", TimeOfDay("k", tod), attribute.StringValue("13:45:30.5")},
+		{"duration", Duration("k", 1500*time.Millisecond), attribute.Int64Value(1500000000)},
+		{"timestamp", Timestamp("k", instant), attribute.Int64Value(instant.UnixNano())},
+		{"zero timestamp", Timestamp("k", time.Time{}), attribute.Value{}},
+		{"date time", DateTime("k", d, tod, time.UTC), attribute.Int64Value(instant.UnixNano())},
+		{"nil date time", DateTime("k", date.Nil, tod, time.UTC), attribute.Value{}},
+		{"partial date", PartialDate("k", partialdate.Must(partialdate.FromYearMonth(2024, 7))), attribute.StringValue("2024-07")},
+		{"nil partial date", PartialDate("k", partialdate.Nil), attribute.Value{}},
+		{"ulid", ULID("k", ulid.Max), attribute.StringValue(ulid.Max.String())},
+		{"nil ulid", ULID("k", ulid.Nil), attribute.Value{}},
+		{"snowflake", Snowflake("k", snowflake.Value(1541815603606036480)), attribute.StringValue("1541815603606036480")},
+		{"int128", Int128("k", int128.Min), attribute.StringValue("-170141183460469231731687303715884105728")},
+		{"ratio", Ratio("k", ratio.Must(ratio.New(3, 4))), attribute.StringValue("3/4")},
+		{"flexnum", FlexNum("k", flexnum.Must(flexnum.Parse("12.50"))), attribute.StringValue("12.50")},
+		{"nil flexnum", FlexNum("k", flexnum.Nil), attribute.Value{}},
+		{"byte size", ByteSize("k", 10*bytesize.MiB), attribute.Int64Value(10 << 20)},
+		{"color", Color("k", color.RGB(0x33, 0x66, 0x99)), attribute.StringValue("#336699")},
+		{"nil language tag", LangTag("k", langtag.Nil), attribute.Value{}},
+		{"address", Addr("k", inet.Addr{Addr: netip.MustParseAddr("192.168.1.1")}), attribute.StringValue("192.168.1.1")},
+		{"zero address", Addr("k", inet.Addr{}), attribute.Value{}},
+		{"prefix", Prefix("k", inet.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")}), attribute.StringValue("10.0.0.0/8")},
+		{"null", Null("k", null.From(tod)), attribute.StringValue("13:45:30.5")},
+		{"null/NULL", Null("k", timeofday.NullTimeOfDay{}.ToNull()), attribute.Value{}},
+		{"optional", Optional("k", optional.Of(d)), attribute.StringValue("2024-07-14")},
+		{"optional/absent", Optional("k", optional.None[date.Value]()), attribute.Value{}},
+		{"strings", Strings("k", []timeofday.Value{tod, timeofday.Zero}), attribute.StringSliceValue([]string{"13:45:30.5", "00:00:00"})},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if tc.kv.Key != "k" {
+				tt.Errorf("Expected key %q, got %q", "k", tc.kv.Key)
+			}
+			if tc.kv.Value.Emit() != tc.expected.Emit() || tc.kv.Value.Type() != tc.expected.Type() {
+				tt.Errorf("Expected %s (%v), got %s (%v)", tc.expected.Emit(), tc.expected.Type(), tc.kv.Value.Emit(), tc.kv.Value.Type())
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package parquetconv provides column types that store the date and time of day types in this module
+// as the matching Parquet logical types when rows are written with github.com/parquet-go/parquet-go,
+// so analytics exports keep typed columns instead of degrading to strings.
+//
+//   - Date stores a date.Value as DATE, an INT32 containing the number of days since 1970-01-01.
+//     Use the "date" struct tag option, e.g. `parquet:"ship_date,date"`.
+//   - TimeOfDay stores a timeofday.Value as TIME(MICROS), an INT64 containing the number of
+//     microseconds since midnight.  Use the "time(microsecond)" struct tag option.
+//   - Timestamp stores an instant, from a time.Time or from a date.Value and timeofday.Value in a
+//     given location, as TIMESTAMP(MICROS) adjusted to UTC.  Use the "timestamp(microsecond)" struct
+//     tag option.
+//
+// Nullable DATE columns use a *Date field with the "optional" tag option.  DateNode(), TimeOfDayNode()
+// and TimestampNode() return the equivalent parquet.Node values for schemas that are built without
+// struct tags.
+//
+// Parquet times and timestamps have microsecond precision, so the conversions to them truncate any
+// smaller units.
+package parquetconv
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrOutOfRange is returned when a value cannot be represented by the target type
+	ErrOutOfRange = errors.Errorf("parquetconv: the value is out of range for the target type")
+)
+
+// epoch is the date.Value for 1970-01-01
+var epoch = date.Must(date.FromUnits(1970, 1, 1))
+
+// microsPerDay is the number of microseconds in a day
+const microsPerDay = int64(24 * time.Hour / time.Microsecond)
+
+// Date is a Parquet DATE column value, the number of days since 1970-01-01
+type Date int32
+
+// DateOf converts a date.Value to a Date.  If the date is date.Nil or is otherwise not valid,
+// ErrOutOfRange is returned.
+func DateOf(d date.Value) (Date, error) {
+	if !d.IsValid() {
+		return 0, errors.Wrapf(ErrOutOfRange, "date.Value(%d)", int64(d))
+	}
+	return Date(d - epoch), nil
+}
+
+// NullableDateOf converts a date.Value to a *Date for optional DATE columns.  date.Nil and other
+// invalid dates are converted to nil.
+func NullableDateOf(d date.Value) *Date {
+	v, err := DateOf(d)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+// Date converts v to a date.Value.  If the resulting date is outside of the range supported by
+// date.Value, ErrOutOfRange is returned.
+func (v Date) Date() (date.Value, error) {
+	d := epoch + date.Value(v)
+	if !d.IsValid() {
+		return date.Nil, errors.Wrapf(ErrOutOfRange, "%d days since the Unix epoch", int32(v))
+	}
+	return d, nil
+}
+
+// DateFromNullable converts a *Date read from an optional DATE column to a date.Value.  A nil pointer
+// is converted to date.Nil.
+func DateFromNullable(v *Date) (date.Value, error) {
+	if v == nil {
+		return date.Nil, nil
+	}
+	return v.Date()
+}
+
+// TimeOfDay is a Parquet TIME(MICROS) column value, the number of microseconds since midnight
+type TimeOfDay int64
+
+// TimeOfDayOf converts a timeofday.Value to a TimeOfDay, truncating to microseconds
+func TimeOfDayOf(t timeofday.Value) TimeOfDay {
+	return TimeOfDay(timeofday.ToDuration(t).Microseconds())
+}
+
+// TimeOfDay converts v to a timeofday.Value.  If v is negative or is 24 hours or more,
+// ErrOutOfRange is returned.
+func (v TimeOfDay) TimeOfDay() (timeofday.Value, error) {
+	if v < 0 || int64(v) >= microsPerDay {
+		return timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%d microseconds since midnight", int64(v))
+	}
+	return timeofday.FromDuration(time.Duration(v) * time.Microsecond)
+}
+
+// Timestamp is a Parquet TIMESTAMP(MICROS) column value, the number of microseconds since
+// 1970-01-01T00:00:00Z
+type Timestamp int64
+
+// TimestampOf converts a time.Time to a Timestamp, truncating to microseconds
+func TimestampOf(t time.Time) Timestamp {
+	return Timestamp(t.UnixMicro())
+}
+
+// TimestampFromDateTime converts a date.Value and a timeofday.Value in the specified location to a
+// Timestamp.  If the date or the time of day is not valid, ErrOutOfRange is returned.
+func TimestampFromDateTime(d date.Value, t timeofday.Value, loc *time.Location) (Timestamp, error) {
+	if !d.IsValid() || !t.IsValid() {
+		return 0, errors.Wrapf(ErrOutOfRange, "%v %v", d, t)
+	}
+	y, m, dd := date.ToUnits(d)
+	return TimestampOf(t.ToDateTimeInLocation(y, time.Month(m), dd, loc)), nil
+}
+
+// Time converts v to a time.Time in UTC
+func (v Timestamp) Time() time.Time {
+	return time.UnixMicro(int64(v)).UTC()
+}
+
+// DateNode returns the Parquet schema node for Date columns
+func DateNode() parquet.Node {
+	return parquet.Date()
+}
+
+// TimeOfDayNode returns the Parquet schema node for TimeOfDay columns
+func TimeOfDayNode() parquet.Node {
+	return parquet.Time(parquet.Microsecond)
+}
+
+// TimestampNode returns the Parquet schema node for Timestamp columns
+func TimestampNode() parquet.Node {
+	return parquet.Timestamp(parquet.Microsecond)
+}
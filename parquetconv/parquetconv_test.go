@@ -0,0 +1,202 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package parquetconv
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+)
+
+func TestDate(t *testing.T) {
+	cases := []struct {
+		name string
+		d    date.Value
+		v    Date
+		err  error
+	}{
+		{"epoch", date.Must(date.FromUnits(1970, 1, 1)), 0, nil},
+		{"after epoch", date.Must(date.FromUnits(2024, 7, 14)), 19918, nil},
+		{"before epoch", date.Must(date.FromUnits(1952, 4, 30)), -6455, nil},
+		{"min", date.Min, -79257, nil},
+		{"max", date.Max, 2932896, nil},
+		{"nil", date.Nil, 0, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := DateOf(tc.d)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				if p := NullableDateOf(tc.d); p != nil {
+					tt.Errorf("Expected nil, got %d", *p)
+				}
+				return
+			}
+			if v != tc.v {
+				tt.Errorf("Expected %d, got %d", tc.v, v)
+			}
+			if d, err := v.Date(); err != nil || d != tc.d {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.d, d, err)
+			}
+			if d, err := DateFromNullable(NullableDateOf(tc.d)); err != nil || d != tc.d {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.d, d, err)
+			}
+		})
+	}
+}
+
+func TestDateOutOfRange(t *testing.T) {
+	for _, v := range []Date{-79258, 2932897} {
+		if _, err := v.Date(); errors.Cause(err) != ErrOutOfRange {
+			t.Errorf("Expected %v for %d, got %v", ErrOutOfRange, v, err)
+		}
+	}
+	if d, err := DateFromNullable(nil); err != nil || d != date.Nil {
+		t.Errorf("Expected date.Nil, got %v (err = %v)", d, err)
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	cases := []struct {
+		name string
+		v    TimeOfDay
+		t    timeofday.Value
+		err  error
+	}{
+		{"midnight", 0, timeofday.Zero, nil},
+		{"afternoon", 49530500000, timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000)), nil},
+		{"max", TimeOfDay(microsPerDay - 1), timeofday.Must(timeofday.FromUnits(23, 59, 59, 999999000)), nil},
+		{"negative", -1, timeofday.Zero, ErrOutOfRange},
+		{"24 hours", TimeOfDay(microsPerDay), timeofday.Zero, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.v.TimeOfDay()
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.t {
+				tt.Errorf("Expected %v, got %v", tc.t, got)
+			}
+			if err == nil && TimeOfDayOf(tc.t) != tc.v {
+				tt.Errorf("Expected %d, got %d", tc.v, TimeOfDayOf(tc.t))
+			}
+		})
+	}
+	if got := TimeOfDayOf(timeofday.Must(timeofday.FromUnits(0, 0, 0, 1999))); got != 1 {
+		t.Errorf("Expected truncation to 1 microsecond, got %d", got)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("time zone data is not available: %v", err)
+	}
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	tod := timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000))
+	ts, err := TimestampFromDateTime(d, tod, loc)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := time.Date(2024, 7, 14, 18, 45, 30, 500000000, time.UTC)
+	if !ts.Time().Equal(expected) || ts.Time().Location() != time.UTC {
+		t.Errorf("Expected %v, got %v", expected, ts.Time())
+	}
+	if TimestampOf(expected) != ts {
+		t.Errorf("Expected %d, got %d", ts, TimestampOf(expected))
+	}
+	if _, err := TimestampFromDateTime(date.Nil, tod, loc); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+}
+
+type exportRow struct {
+	ID       int64     `parquet:"id"`
+	ShipDate Date      `parquet:"ship_date,date"`
+	Delivery *Date     `parquet:"delivery_date,date,optional"`
+	Cutoff   TimeOfDay `parquet:"cutoff,time(microsecond)"`
+	Created  Timestamp `parquet:"created,timestamp(microsecond)"`
+}
+
+func TestParquetRoundTrip(t *testing.T) {
+	ship := date.Must(date.FromUnits(2024, 7, 14))
+	cutoff := timeofday.Must(timeofday.FromUnits(17, 30, 0, 0))
+	shipDate, err := DateOf(ship)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rows := []exportRow{
+		{
+			ID:       1,
+			ShipDate: shipDate,
+			Delivery: NullableDateOf(date.Must(ship.AddDays(3))),
+			Cutoff:   TimeOfDayOf(cutoff),
+			Created:  TimestampOf(time.Date(2024, 7, 13, 9, 0, 0, 0, time.UTC)),
+		},
+		{
+			ID:       2,
+			ShipDate: shipDate,
+			Delivery: NullableDateOf(date.Nil),
+			Cutoff:   TimeOfDayOf(cutoff),
+			Created:  TimestampOf(time.Date(2024, 7, 13, 10, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := parquet.Write(&buf, rows); err != nil {
+		t.Fatalf("Unexpected error writing rows: %v", err)
+	}
+	f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Unexpected error opening file: %v", err)
+	}
+	expected := map[string]parquet.Node{
+		"ship_date":     DateNode(),
+		"delivery_date": parquet.Optional(DateNode()),
+		"cutoff":        TimeOfDayNode(),
+		"created":       TimestampNode(),
+	}
+	for name, node := range expected {
+		col, ok := f.Schema().Lookup(name)
+		if !ok {
+			t.Errorf("Column %q is missing", name)
+			continue
+		}
+		if got, want := col.Node.Type().String(), node.Type().String(); got != want {
+			t.Errorf("Expected column %q to be %s, got %s", name, want, got)
+		}
+		if col.Node.Optional() != node.Optional() {
+			t.Errorf("Expected column %q optional = %v", name, node.Optional())
+		}
+	}
+
+	got, err := parquet.Read[exportRow](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Unexpected error reading rows: %v", err)
+	}
+	if len(got) != len(rows) {
+		t.Fatalf("Expected %d rows, got %d", len(rows), len(got))
+	}
+	if d, err := got[0].ShipDate.Date(); err != nil || d != ship {
+		t.Errorf("Expected %v, got %v (err = %v)", ship, d, err)
+	}
+	if d, err := DateFromNullable(got[1].Delivery); err != nil || d != date.Nil {
+		t.Errorf("Expected date.Nil, got %v (err = %v)", d, err)
+	}
+	if tod, err := got[0].Cutoff.TimeOfDay(); err != nil || tod != cutoff {
+		t.Errorf("Expected %v, got %v (err = %v)", cutoff, tod, err)
+	}
+	if got[1].Created != rows[1].Created {
+		t.Errorf("Expected %v, got %v", rows[1].Created.Time(), got[1].Created.Time())
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package partialdate
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextFormat is returned from partialdate.Value.UnmarshalText() when the passed-in byte
+	// slice is not formatted as "YYYY", "YYYY-MM" or "YYYY-MM-DD"
+	ErrInvalidTextFormat = errors.Errorf("partialdate.Value: text data was not in the correct format")
+	// ErrInvalidTextData is returned from partialdate.Value.UnmarshalJSON() when the passed-in byte
+	// slice does not contain a string
+	ErrInvalidTextData = errors.Errorf("partialdate.Value: can only decode JSON strings")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// Parse parses a partial date formatted as "YYYY", "YYYY-MM" or "YYYY-MM-DD".
+func Parse(s string) (Value, error) {
+	var v Value
+	if err := v.UnmarshalText([]byte(s)); err != nil {
+		return Nil, err
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for partialdate.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for partialdate.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	return v.appendText(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for partialdate.Value values.
+//
+// The supported formats are "YYYY", "YYYY-MM" and "YYYY-MM-DD".  An empty slice is decoded as Nil.
+func (v *Value) UnmarshalText(text []byte) error {
+	var (
+		units [3]int
+		n     int
+	)
+	switch len(text) {
+	case 0:
+		*v = Nil
+		return nil
+	case 4:
+		n = 1
+	case 7:
+		n = 2
+	case 10:
+		n = 3
+	default:
+		return ErrInvalidTextFormat
+	}
+	// "YYYY" then "-MM" then "-DD"
+	widths := [3]int{4, 2, 2}
+	pos := 0
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			if text[pos] != '-' {
+				return ErrInvalidTextFormat
+			}
+			pos++
+		}
+		for _, c := range text[pos : pos+widths[i]] {
+			if c < '0' || c > '9' {
+				return ErrInvalidTextFormat
+			}
+			units[i] = units[i]*10 + int(c-'0')
+		}
+		pos += widths[i]
+	}
+
+	var (
+		res Value
+		err error
+	)
+	switch n {
+	case 1:
+		res, err = FromYear(units[0])
+	case 2:
+		res, err = FromYearMonth(units[0], units[1])
+	default:
+		res, err = FromUnits(units[0], units[1], units[2])
+	}
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for partialdate.Value values.
+//
+// Nil is encoded as the JSON null token and all other values are encoded as a JSON string
+// containing the same value as MarshalText().
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for partialdate.Value values.
+//
+// If the value is the special JSON null token, v is set to partialdate.Nil.  All other values are
+// delegated to UnmarshalText().
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		*v = Nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return v.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package partialdate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestUnmarshalText(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        []byte
+		expected Value
+		err      error
+	}{
+		{"empty buffer", []byte{}, Nil, nil},
+		{"year", []byte("2024"), Must(FromYear(2024)), nil},
+		{"year-month", []byte("2024-07"), Must(FromYearMonth(2024, 7)), nil},
+		{"full date", []byte("2024-07-14"), Must(FromUnits(2024, 7, 14)), nil},
+		{"invalid length", []byte("202"), Nil, ErrInvalidTextFormat},
+		{"invalid length/trailing separator", []byte("2024-"), Nil, ErrInvalidTextFormat},
+		{"invalid separator", []byte("2024/07"), Nil, ErrInvalidTextFormat},
+		{"invalid digit", []byte("2024-0x"), Nil, ErrInvalidTextFormat},
+		{"year out of range", []byte("1600"), Nil, ErrInvalidUnit},
+		{"month out of range", []byte("2024-13"), Nil, ErrInvalidUnit},
+		{"day out of range", []byte("2023-02-29"), Nil, ErrInvalidUnit},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got Value
+			err := got.UnmarshalText(tc.d)
+			if errors.Cause(err) != tc.err {
+				tt.Errorf("Expected error %v, got %v", tc.err, err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	type record struct {
+		Born Value `json:"born"`
+	}
+	cases := []struct {
+		name    string
+		v       Value
+		encoded string
+	}{
+		{"nil", Nil, `{"born":null}`},
+		{"year", Must(FromYear(1970)), `{"born":"1970"}`},
+		{"year-month", Must(FromYearMonth(1970, 1)), `{"born":"1970-01"}`},
+		{"full date", Must(FromUnits(1970, 1, 2)), `{"born":"1970-01-02"}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := json.Marshal(record{tc.v})
+			if err != nil || string(got) != tc.encoded {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.encoded, got, err)
+			}
+			var r record
+			if err := json.Unmarshal([]byte(tc.encoded), &r); err != nil || r.Born != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, r.Born, err)
+			}
+		})
+	}
+	var v Value
+	if err := json.Unmarshal([]byte("42"), &v); errors.Cause(err) != ErrInvalidTextData {
+		t.Errorf("Expected ErrInvalidTextData, got %v", err)
+	}
+}
+
+func TestAppendTextDoesNotAllocate(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"nil", Nil, ""},
+		{"year", Must(FromYear(2019)), "2019"},
+		{"month", Must(FromYearMonth(2019, 7)), "2019-07"},
+		{"day", Must(FromUnits(2019, 7, 14)), "2019-07-14"},
+	}
+	buf := make([]byte, 0, 64)
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				buf, _ = tc.v.AppendText(buf[:0])
+			})
+			if allocs != 0 {
+				tt.Errorf("Expected no allocations, got %v", allocs)
+			}
+			if string(buf) != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package partialdate
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ jsonv2.MarshalerTo = (*Value)(nil)
+var _ jsonv2.UnmarshalerFrom = (*Value)(nil)
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface for partialdate.Value values.  The
+// JSON encoding is the same as MarshalJSON().
+func (v Value) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if v.IsNil() {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.String(v.String()))
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom interface for partialdate.Value values.
+//
+// As with UnmarshalJSON(), the JSON null token sets v to partialdate.Nil and strings are delegated to
+// UnmarshalText().
+func (v *Value) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case 'n':
+		*v = Nil
+		return nil
+	case '"':
+		return v.UnmarshalText([]byte(tok.String()))
+	default:
+		return errors.Wrapf(ErrInvalidTextData, "unexpected JSON token: %v", tok.Kind())
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package partialdate
+
+import (
+	jsonv2 "encoding/json/v2"
+	"strings"
+	"testing"
+)
+
+func TestJSONv2RoundTrip(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"nil", Nil, `null`},
+		{"year", Must(FromYear(2024)), `"2024"`},
+		{"month", Must(FromYearMonth(2024, 7)), `"2024-07"`},
+		{"day", Must(FromUnits(2024, 7, 14)), `"2024-07-14"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := jsonv2.Marshal(tc.v)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, data)
+			}
+			got := Must(FromYear(1999))
+			if err := jsonv2.Unmarshal(data, &got); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.v {
+				tt.Errorf("Expected %v, got %v", tc.v, got)
+			}
+		})
+	}
+}
+
+func TestJSONv2UnmarshalErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{"number", `2024`},
+		{"bool", `true`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var got Value
+			if err := jsonv2.Unmarshal([]byte(tc.data), &got); err == nil || !strings.Contains(err.Error(), ErrInvalidTextData.Error()) {
+				tt.Errorf("Expected %v, got %v", ErrInvalidTextData, err)
+			}
+		})
+	}
+
+	var got Value
+	if err := jsonv2.Unmarshal([]byte(`"2024-13"`), &got); err == nil {
+		t.Errorf("Expected an error for an invalid month")
+	}
+}
+
+func TestJSONv2OmitZero(t *testing.T) {
+	type wrapper struct {
+		D Value `json:"d,omitzero"`
+	}
+	data, err := jsonv2.Marshal(wrapper{})
+	if err != nil || string(data) != `{}` {
+		t.Errorf("Expected {}, got %s (err = %v)", data, err)
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package partialdate
+
+import (
+	"fmt"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+// Precision identifies which components of a partialdate.Value are known
+type Precision int
+
+const (
+	// PrecisionNone indicates that no components are known, which is the case for partialdate.Nil
+	PrecisionNone Precision = iota
+	// PrecisionYear indicates that only the year is known
+	PrecisionYear
+	// PrecisionMonth indicates that the year and month are known
+	PrecisionMonth
+	// PrecisionDay indicates that the year, month and day are known
+	PrecisionDay
+)
+
+// String implements fmt.Stringer for Precision values
+func (p Precision) String() string {
+	switch p {
+	case PrecisionNone:
+		return "none"
+	case PrecisionYear:
+		return "year"
+	case PrecisionMonth:
+		return "month"
+	case PrecisionDay:
+		return "day"
+	default:
+		return fmt.Sprintf("Precision(%d)", int(p))
+	}
+}
+
+// Value represents a calendar date where the month, or the month and day, may be unknown, as with
+// the EDTF and FHIR "partial date" formats: "2024", "2024-07" or "2024-07-14".
+//
+// The zero value is partialdate.Nil, which has no known components.
+type Value struct {
+	y, m, d int
+}
+
+var (
+	// Nil represents a nil/null/undefined partial date
+	Nil = Value{}
+)
+
+var (
+	// ErrInvalidUnit is returned when an out-of-range year, month or day value is used
+	ErrInvalidUnit = errors.Errorf("partialdate: one or more of the specified date units were invalid")
+	// ErrInsufficientPrecision is returned by ToDate() when the day of the partial date is not known
+	ErrInsufficientPrecision = errors.Errorf("partialdate: the value does not have day precision")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in partialdate.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// FromYear returns a Value with year precision
+func FromYear(y int) (Value, error) {
+	if !date.IsValidYear(y) {
+		return Nil, ErrInvalidUnit
+	}
+	return Value{y: y}, nil
+}
+
+// FromYearMonth returns a Value with month precision
+func FromYearMonth(y, m int) (Value, error) {
+	if !date.IsValidYear(y) || !date.IsValidMonth(m) {
+		return Nil, ErrInvalidUnit
+	}
+	return Value{y: y, m: m}, nil
+}
+
+// FromUnits returns a Value with day precision
+func FromUnits(y, m, d int) (Value, error) {
+	if !date.IsValidUnits(y, m, d) {
+		return Nil, ErrInvalidUnit
+	}
+	return Value{y: y, m: m, d: d}, nil
+}
+
+// FromDate returns a Value with day precision that is equivalent to the specified date, or
+// partialdate.Nil if d is not a valid date.
+func FromDate(d date.Value) Value {
+	if !d.IsValid() {
+		return Nil
+	}
+	y, m, dd := date.ToUnits(d)
+	return Value{y: y, m: m, d: dd}
+}
+
+// Precision returns the precision of the partial date
+func (v Value) Precision() Precision {
+	switch {
+	case v.d != 0:
+		return PrecisionDay
+	case v.m != 0:
+		return PrecisionMonth
+	case v.y != 0:
+		return PrecisionYear
+	default:
+		return PrecisionNone
+	}
+}
+
+// IsNil returns true if no components of the partial date are known
+func (v Value) IsNil() bool {
+	return v == Nil
+}
+
+// IsZero returns true if v is Nil.  It is used by the "omitzero" JSON struct tag option to omit
+// Nil values.
+func (v Value) IsZero() bool {
+	return v.IsNil()
+}
+
+// Year returns the year or 0 if it is not known
+func (v Value) Year() int {
+	return v.y
+}
+
+// Month returns the month or 0 if it is not known
+func (v Value) Month() int {
+	return v.m
+}
+
+// Day returns the day of the month or 0 if it is not known
+func (v Value) Day() int {
+	return v.d
+}
+
+// WithMonth returns a copy of v with the month set to m and the day cleared, which upgrades a year
+// precision value to month precision.
+func (v Value) WithMonth(m int) (Value, error) {
+	if v.IsNil() {
+		return Nil, ErrInvalidUnit
+	}
+	return FromYearMonth(v.y, m)
+}
+
+// WithDay returns a copy of v with the day set to d, which upgrades a month precision value to day
+// precision.  The month must already be known.
+func (v Value) WithDay(d int) (Value, error) {
+	if v.m == 0 {
+		return Nil, ErrInsufficientPrecision
+	}
+	return FromUnits(v.y, v.m, d)
+}
+
+// Truncate returns a copy of v reduced to the specified precision.  If v is already less precise
+// than p, it is returned as is.
+func (v Value) Truncate(p Precision) Value {
+	if p < PrecisionDay {
+		v.d = 0
+	}
+	if p < PrecisionMonth {
+		v.m = 0
+	}
+	if p < PrecisionYear {
+		v.y = 0
+	}
+	return v
+}
+
+// ToDate returns the date.Value represented by v, which must have day precision.  Otherwise,
+// date.Nil and ErrInsufficientPrecision are returned.
+func (v Value) ToDate() (date.Value, error) {
+	if v.Precision() != PrecisionDay {
+		return date.Nil, ErrInsufficientPrecision
+	}
+	return date.FromUnits(v.y, v.m, v.d)
+}
+
+// Start returns the earliest date that is covered by the partial date, or date.Nil if v is Nil
+func (v Value) Start() date.Value {
+	switch v.Precision() {
+	case PrecisionYear:
+		return date.Must(date.FromUnits(v.y, 1, 1))
+	case PrecisionMonth:
+		return date.Must(date.FromUnits(v.y, v.m, 1))
+	case PrecisionDay:
+		return date.Must(date.FromUnits(v.y, v.m, v.d))
+	default:
+		return date.Nil
+	}
+}
+
+// End returns the latest date that is covered by the partial date, or date.Nil if v is Nil
+func (v Value) End() date.Value {
+	switch v.Precision() {
+	case PrecisionYear:
+		return date.Must(date.FromUnits(v.y, 12, 31))
+	case PrecisionMonth:
+		return date.Must(date.FromUnits(v.y, v.m, date.DaysInMonth(v.y, v.m)))
+	case PrecisionDay:
+		return date.Must(date.FromUnits(v.y, v.m, v.d))
+	default:
+		return date.Nil
+	}
+}
+
+// Contains returns true if the specified date falls within the span covered by the partial date
+func (v Value) Contains(d date.Value) bool {
+	if v.IsNil() || !d.IsValid() {
+		return false
+	}
+	return !d.Before(v.Start()) && !d.After(v.End())
+}
+
+// Compare compares two partial dates over the spans of time they cover.
+//
+// If every date covered by v1 is before every date covered by v2, the result is -1.  If the reverse
+// is true, the result is +1.  If both values have the same precision and components, the result is 0.
+// In all of those cases, the second return value is true.
+//
+// Otherwise, the order cannot be determined - as with "2024" and "2024-07" - and the function
+// returns 0 and false.  Nil is not ordered relative to any value, including itself.
+func Compare(v1, v2 Value) (int, bool) {
+	if v1.IsNil() || v2.IsNil() {
+		return 0, false
+	}
+	switch {
+	case v1 == v2:
+		return 0, true
+	case v1.End().Before(v2.Start()):
+		return -1, true
+	case v1.Start().After(v2.End()):
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// Equal returns true if v1 and v2 have the same precision and the same known components.
+//
+// *NOTE*
+// The Nil value is treated specially and is not less than, equal to, or greater than any value, so this
+// function returns false if either value is Nil.
+func Equal(v1, v2 Value) bool {
+	c, ok := Compare(v1, v2)
+	return ok && c == 0
+}
+
+// Before returns true if every date covered by v is before every date covered by v2
+func (v Value) Before(v2 Value) bool {
+	c, ok := Compare(v, v2)
+	return ok && c < 0
+}
+
+// After returns true if every date covered by v is after every date covered by v2
+func (v Value) After(v2 Value) bool {
+	c, ok := Compare(v, v2)
+	return ok && c > 0
+}
+
+// String implements fmt.Stringer for partialdate.Value instances.
+//
+// The returned string is formatted as "YYYY", "YYYY-MM" or "YYYY-MM-DD", depending on the precision,
+// or is empty for Nil.
+func (v Value) String() string {
+	var buf [10]byte
+	return string(v.appendText(buf[:0]))
+}
+
+// appendText appends the text representation of v, as returned by String(), to b
+func (v Value) appendText(b []byte) []byte {
+	p := v.Precision()
+	if p == PrecisionNone {
+		return b
+	}
+	// years are always in the range [1753..9999], so 4 digits is enough
+	b = append(b, byte('0'+v.y/1000), byte('0'+v.y/100%10), byte('0'+v.y/10%10), byte('0'+v.y%10))
+	if p >= PrecisionMonth {
+		b = append(b, '-', byte('0'+v.m/10), byte('0'+v.m%10))
+	}
+	if p == PrecisionDay {
+		b = append(b, '-', byte('0'+v.d/10), byte('0'+v.d%10))
+	}
+	return b
+}
@@ -0,0 +1,153 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package partialdate
+
+import (
+	"testing"
+
+	"github.com/dylan-bourque/go-types/date"
+)
+
+func TestConstructors(t *testing.T) {
+	cases := []struct {
+		name      string
+		v         Value
+		precision Precision
+		expected  string
+	}{
+		{"nil", Nil, PrecisionNone, ""},
+		{"year", Must(FromYear(2024)), PrecisionYear, "2024"},
+		{"year-month", Must(FromYearMonth(2024, 7)), PrecisionMonth, "2024-07"},
+		{"full date", Must(FromUnits(2024, 7, 14)), PrecisionDay, "2024-07-14"},
+		{"from date", FromDate(date.Must(date.FromUnits(1999, 12, 31))), PrecisionDay, "1999-12-31"},
+		{"from nil date", FromDate(date.Nil), PrecisionNone, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.v.Precision(); got != tc.precision {
+				tt.Errorf("Expected precision %v, got %v", tc.precision, got)
+			}
+			if got := tc.v.String(); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name string
+		f    func() (Value, error)
+	}{
+		{"year/underflow", func() (Value, error) { return FromYear(1752) }},
+		{"year/overflow", func() (Value, error) { return FromYear(10000) }},
+		{"month/underflow", func() (Value, error) { return FromYearMonth(2024, 0) }},
+		{"month/overflow", func() (Value, error) { return FromYearMonth(2024, 13) }},
+		{"day/non-leap year", func() (Value, error) { return FromUnits(2023, 2, 29) }},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := tc.f()
+			if err != ErrInvalidUnit {
+				tt.Errorf("Expected ErrInvalidUnit, got %v", err)
+			}
+			if v != Nil {
+				tt.Errorf("Expected Nil, got %v", v)
+			}
+		})
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	y := Must(FromYear(2024))
+	if _, err := y.WithDay(1); err != ErrInsufficientPrecision {
+		t.Errorf("Expected ErrInsufficientPrecision, got %v", err)
+	}
+	if _, err := y.ToDate(); err != ErrInsufficientPrecision {
+		t.Errorf("Expected ErrInsufficientPrecision, got %v", err)
+	}
+	ym, err := y.WithMonth(2)
+	if err != nil || ym.String() != "2024-02" {
+		t.Fatalf("Unexpected result: %v (err = %v)", ym, err)
+	}
+	if _, err := ym.WithDay(30); err != ErrInvalidUnit {
+		t.Errorf("Expected ErrInvalidUnit, got %v", err)
+	}
+	ymd, err := ym.WithDay(29)
+	if err != nil || ymd.String() != "2024-02-29" {
+		t.Fatalf("Unexpected result: %v (err = %v)", ymd, err)
+	}
+	d, err := ymd.ToDate()
+	if err != nil || d != date.Must(date.FromUnits(2024, 2, 29)) {
+		t.Errorf("Unexpected date: %v (err = %v)", d, err)
+	}
+	if got := ymd.Truncate(PrecisionYear); got != y {
+		t.Errorf("Expected %v, got %v", y, got)
+	}
+}
+
+func TestStartEnd(t *testing.T) {
+	cases := []struct {
+		name       string
+		v          Value
+		start, end date.Value
+	}{
+		{"nil", Nil, date.Nil, date.Nil},
+		{"year", Must(FromYear(2024)), date.Must(date.FromUnits(2024, 1, 1)), date.Must(date.FromUnits(2024, 12, 31))},
+		{"leap february", Must(FromYearMonth(2024, 2)), date.Must(date.FromUnits(2024, 2, 1)), date.Must(date.FromUnits(2024, 2, 29))},
+		{"day", Must(FromUnits(2024, 7, 14)), date.Must(date.FromUnits(2024, 7, 14)), date.Must(date.FromUnits(2024, 7, 14))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.v.Start(); got != tc.start {
+				tt.Errorf("Expected start %v, got %v", tc.start, got)
+			}
+			if got := tc.v.End(); got != tc.end {
+				tt.Errorf("Expected end %v, got %v", tc.end, got)
+			}
+		})
+	}
+	if !Must(FromYearMonth(2024, 7)).Contains(date.Must(date.FromUnits(2024, 7, 31))) {
+		t.Errorf("Expected 2024-07 to contain 2024-07-31")
+	}
+	if Must(FromYearMonth(2024, 7)).Contains(date.Must(date.FromUnits(2024, 8, 1))) {
+		t.Errorf("Expected 2024-07 to not contain 2024-08-01")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name     string
+		v1, v2   Value
+		expected int
+		ok       bool
+	}{
+		{"nil/nil", Nil, Nil, 0, false},
+		{"nil/year", Nil, Must(FromYear(2024)), 0, false},
+		{"same year", Must(FromYear(2024)), Must(FromYear(2024)), 0, true},
+		{"earlier year", Must(FromYear(2023)), Must(FromYear(2024)), -1, true},
+		{"later year", Must(FromYear(2025)), Must(FromYear(2024)), 1, true},
+		{"year contains month", Must(FromYear(2024)), Must(FromYearMonth(2024, 7)), 0, false},
+		{"month contains day", Must(FromYearMonth(2024, 7)), Must(FromUnits(2024, 7, 14)), 0, false},
+		{"month before day", Must(FromYearMonth(2024, 6)), Must(FromUnits(2024, 7, 1)), -1, true},
+		{"day after year", Must(FromUnits(2025, 1, 1)), Must(FromYear(2024)), 1, true},
+		{"same day", Must(FromUnits(2024, 7, 14)), Must(FromUnits(2024, 7, 14)), 0, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, ok := Compare(tc.v1, tc.v2)
+			if got != tc.expected || ok != tc.ok {
+				tt.Errorf("Expected (%d, %v), got (%d, %v)", tc.expected, tc.ok, got, ok)
+			}
+			if before := tc.v1.Before(tc.v2); before != (tc.ok && tc.expected < 0) {
+				tt.Errorf("Unexpected Before() result: %v", before)
+			}
+			if after := tc.v1.After(tc.v2); after != (tc.ok && tc.expected > 0) {
+				tt.Errorf("Unexpected After() result: %v", after)
+			}
+			if eq := Equal(tc.v1, tc.v2); eq != (tc.ok && tc.expected == 0) {
+				tt.Errorf("Unexpected Equal() result: %v", eq)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package protoconv
+
+import (
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/pkg/errors"
+	gdate "google.golang.org/genproto/googleapis/type/date"
+)
+
+// DateToProto converts a date.Value to a google.type.Date.  date.Nil is converted to nil.
+func DateToProto(v date.Value) (*gdate.Date, error) {
+	if v == date.Nil {
+		return nil, nil
+	}
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "date.Value(%d)", int64(v))
+	}
+	y, m, d := date.ToUnits(v)
+	return &gdate.Date{Year: int32(y), Month: int32(m), Day: int32(d)}, nil
+}
+
+// DateFromProto converts a google.type.Date to a date.Value.  A nil message is converted to date.Nil.
+//
+// The message must contain a full date.  If the year, month or day is zero, ErrPartialDate is
+// returned, and PartialDateFromProto() can be used instead.  If the date is not valid or is outside
+// of the range supported by date.Value, ErrOutOfRange is returned.
+func DateFromProto(p *gdate.Date) (date.Value, error) {
+	if p == nil {
+		return date.Nil, nil
+	}
+	if p.GetYear() == 0 || p.GetMonth() == 0 || p.GetDay() == 0 {
+		return date.Nil, errors.Wrapf(ErrPartialDate, "%04d-%02d-%02d", p.GetYear(), p.GetMonth(), p.GetDay())
+	}
+	v, err := date.FromUnits(int(p.GetYear()), int(p.GetMonth()), int(p.GetDay()))
+	if err != nil {
+		return date.Nil, errors.Wrapf(ErrOutOfRange, "%04d-%02d-%02d", p.GetYear(), p.GetMonth(), p.GetDay())
+	}
+	return v, nil
+}
+
+// PartialDateToProto converts a partialdate.Value to a google.type.Date, leaving the unknown
+// components zero.  partialdate.Nil is converted to nil.
+func PartialDateToProto(v partialdate.Value) *gdate.Date {
+	if v.IsNil() {
+		return nil
+	}
+	return &gdate.Date{Year: int32(v.Year()), Month: int32(v.Month()), Day: int32(v.Day())}
+}
+
+// PartialDateFromProto converts a google.type.Date with a full date, a year and month, or only a year
+// to a partialdate.Value.  A nil message is converted to partialdate.Nil.
+//
+// A month and day without a year, such as an anniversary, cannot be represented by partialdate.Value,
+// so ErrOutOfRange is returned for those and for invalid dates.
+func PartialDateFromProto(p *gdate.Date) (partialdate.Value, error) {
+	if p == nil {
+		return partialdate.Nil, nil
+	}
+	y, m, d := int(p.GetYear()), int(p.GetMonth()), int(p.GetDay())
+	var (
+		v   partialdate.Value
+		err error
+	)
+	switch {
+	case y != 0 && m != 0 && d != 0:
+		v, err = partialdate.FromUnits(y, m, d)
+	case y != 0 && m != 0 && d == 0:
+		v, err = partialdate.FromYearMonth(y, m)
+	case y != 0 && m == 0 && d == 0:
+		v, err = partialdate.FromYear(y)
+	default:
+		err = ErrOutOfRange
+	}
+	if err != nil {
+		return partialdate.Nil, errors.Wrapf(ErrOutOfRange, "%04d-%02d-%02d", y, m, d)
+	}
+	return v, nil
+}
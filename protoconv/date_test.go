@@ -0,0 +1,114 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package protoconv
+
+import (
+	"testing"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/pkg/errors"
+	gdate "google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDateRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		v    date.Value
+		p    *gdate.Date
+	}{
+		{"nil", date.Nil, nil},
+		{"min", date.Min, &gdate.Date{Year: 1753, Month: 1, Day: 1}},
+		{"max", date.Max, &gdate.Date{Year: 9999, Month: 12, Day: 31}},
+		{"leap day", date.Must(date.FromUnits(2024, 2, 29)), &gdate.Date{Year: 2024, Month: 2, Day: 29}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			p, err := DateToProto(tc.v)
+			if err != nil || !proto.Equal(p, tc.p) {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.p, p, err)
+			}
+			v, err := DateFromProto(tc.p)
+			if err != nil || v != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, v, err)
+			}
+		})
+	}
+}
+
+func TestDateToProtoInvalid(t *testing.T) {
+	if _, err := DateToProto(date.Max + 1); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+}
+
+func TestDateFromProtoErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		p    *gdate.Date
+		err  error
+	}{
+		{"zero day", &gdate.Date{Year: 2024, Month: 7}, ErrPartialDate},
+		{"zero year", &gdate.Date{Month: 7, Day: 14}, ErrPartialDate},
+		{"empty", &gdate.Date{}, ErrPartialDate},
+		{"invalid day", &gdate.Date{Year: 2023, Month: 2, Day: 29}, ErrOutOfRange},
+		{"before min", &gdate.Date{Year: 1752, Month: 12, Day: 31}, ErrOutOfRange},
+		{"negative", &gdate.Date{Year: -1, Month: 1, Day: 1}, ErrOutOfRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := DateFromProto(tc.p)
+			if errors.Cause(err) != tc.err || v != date.Nil {
+				tt.Errorf("Expected (%v, %v), got (%v, %v)", date.Nil, tc.err, v, err)
+			}
+		})
+	}
+}
+
+func TestPartialDateRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		v    partialdate.Value
+		p    *gdate.Date
+	}{
+		{"nil", partialdate.Nil, nil},
+		{"year", partialdate.Must(partialdate.FromYear(2024)), &gdate.Date{Year: 2024}},
+		{"month", partialdate.Must(partialdate.FromYearMonth(2024, 7)), &gdate.Date{Year: 2024, Month: 7}},
+		{"day", partialdate.Must(partialdate.FromUnits(2024, 7, 14)), &gdate.Date{Year: 2024, Month: 7, Day: 14}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if p := PartialDateToProto(tc.v); !proto.Equal(p, tc.p) {
+				tt.Errorf("Expected %v, got %v", tc.p, p)
+			}
+			v, err := PartialDateFromProto(tc.p)
+			if err != nil || v != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, v, err)
+			}
+		})
+	}
+}
+
+func TestPartialDateFromProtoErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		p    *gdate.Date
+	}{
+		{"month and day", &gdate.Date{Month: 7, Day: 14}},
+		{"year and day", &gdate.Date{Year: 2024, Day: 14}},
+		{"empty", &gdate.Date{}},
+		{"invalid month", &gdate.Date{Year: 2024, Month: 13}},
+		{"invalid day", &gdate.Date{Year: 2024, Month: 4, Day: 31}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := PartialDateFromProto(tc.p)
+			if errors.Cause(err) != ErrOutOfRange || !v.IsNil() {
+				tt.Errorf("Expected (nil, %v), got (%v, %v)", ErrOutOfRange, v, err)
+			}
+		})
+	}
+}
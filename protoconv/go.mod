@@ -0,0 +1,12 @@
+module github.com/dylan-bourque/go-types/protoconv
+
+go 1.25.0
+
+require (
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	github.com/pkg/errors v0.9.1
+	google.golang.org/genproto v0.0.0-20250603155806-513f23925822
+	google.golang.org/protobuf v1.36.11
+)
+
+replace github.com/dylan-bourque/go-types => ../
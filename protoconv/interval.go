@@ -0,0 +1,97 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package protoconv
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/freebusy"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+	ginterval "google.golang.org/genproto/googleapis/type/interval"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TimestampFromDateTime converts a date and time of day in the specified location to a
+// google.protobuf.Timestamp.  If the resulting instant is outside of the range of
+// google.protobuf.Timestamp, which is years 1 through 9999 in UTC, ErrOutOfRange is returned.
+func TimestampFromDateTime(d date.Value, t timeofday.Value, loc *time.Location) (*timestamppb.Timestamp, error) {
+	if !d.IsValid() || !t.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "%v %v", d, t)
+	}
+	y, m, dd := date.ToUnits(d)
+	return timestampFromTime(t.ToDateTimeInLocation(y, time.Month(m), dd, loc))
+}
+
+// DateTimeFromTimestamp converts a google.protobuf.Timestamp to the date and time of day it represents
+// in the specified location.  If the timestamp is nil or invalid, or the date is outside of the range
+// supported by date.Value, ErrOutOfRange is returned.
+func DateTimeFromTimestamp(p *timestamppb.Timestamp, loc *time.Location) (date.Value, timeofday.Value, error) {
+	if err := p.CheckValid(); err != nil {
+		return date.Nil, timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%v", err)
+	}
+	t := p.AsTime().In(loc)
+	d, err := date.FromTime(t)
+	if err != nil {
+		return date.Nil, timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%v", t)
+	}
+	h, m, s := t.Clock()
+	return d, timeofday.Must(timeofday.FromUnits(h, m, s, int64(t.Nanosecond()))), nil
+}
+
+// IntervalToProto converts a freebusy.Interval to a google.type.Interval.  Both types include the
+// start and exclude the end.  A zero start or end is converted to an unset timestamp, which
+// google.type.Interval uses for an unbounded end.
+func IntervalToProto(i freebusy.Interval) (*ginterval.Interval, error) {
+	res := &ginterval.Interval{}
+	var err error
+	if !i.Start.IsZero() {
+		if res.StartTime, err = timestampFromTime(i.Start); err != nil {
+			return nil, err
+		}
+	}
+	if !i.End.IsZero() {
+		if res.EndTime, err = timestampFromTime(i.End); err != nil {
+			return nil, err
+		}
+	}
+	if !i.Start.IsZero() && !i.End.IsZero() && i.End.Before(i.Start) {
+		return nil, errors.Wrapf(ErrOutOfRange, "the end %v is before the start %v", i.End, i.Start)
+	}
+	return res, nil
+}
+
+// IntervalFromProto converts a google.type.Interval to a freebusy.Interval, in UTC.  An unset start or
+// end is converted to the zero time.Time.  If either timestamp is invalid or the end is before the
+// start, ErrOutOfRange is returned.
+func IntervalFromProto(p *ginterval.Interval) (freebusy.Interval, error) {
+	var res freebusy.Interval
+	if st := p.GetStartTime(); st != nil {
+		if err := st.CheckValid(); err != nil {
+			return freebusy.Interval{}, errors.Wrapf(ErrOutOfRange, "start: %v", err)
+		}
+		res.Start = st.AsTime()
+	}
+	if et := p.GetEndTime(); et != nil {
+		if err := et.CheckValid(); err != nil {
+			return freebusy.Interval{}, errors.Wrapf(ErrOutOfRange, "end: %v", err)
+		}
+		res.End = et.AsTime()
+	}
+	if !res.Start.IsZero() && !res.End.IsZero() && res.End.Before(res.Start) {
+		return freebusy.Interval{}, errors.Wrapf(ErrOutOfRange, "the end %v is before the start %v", res.End, res.Start)
+	}
+	return res, nil
+}
+
+// timestampFromTime converts t to a google.protobuf.Timestamp, validating its range
+func timestampFromTime(t time.Time) (*timestamppb.Timestamp, error) {
+	ts := timestamppb.New(t)
+	if err := ts.CheckValid(); err != nil {
+		return nil, errors.Wrapf(ErrOutOfRange, "%v", err)
+	}
+	return ts, nil
+}
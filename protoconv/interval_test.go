@@ -0,0 +1,125 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package protoconv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/freebusy"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+	ginterval "google.golang.org/genproto/googleapis/type/interval"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDateTimeRoundTrip(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data is not available: %v", err)
+	}
+	cases := []struct {
+		name string
+		d    date.Value
+		t    timeofday.Value
+		loc  *time.Location
+		ts   *timestamppb.Timestamp
+	}{
+		{"utc", date.Must(date.FromUnits(2024, 7, 14)), timeofday.Must(timeofday.FromUnits(13, 45, 30, 500)), time.UTC, &timestamppb.Timestamp{Seconds: 1720964730, Nanos: 500}},
+		{"new york", date.Must(date.FromUnits(2024, 7, 14)), timeofday.Must(timeofday.FromUnits(9, 45, 30, 500)), ny, &timestamppb.Timestamp{Seconds: 1720964730, Nanos: 500}},
+		{"max", date.Max, timeofday.Max, time.UTC, &timestamppb.Timestamp{Seconds: 253402300799, Nanos: 999999999}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			ts, err := TimestampFromDateTime(tc.d, tc.t, tc.loc)
+			if err != nil || !proto.Equal(ts, tc.ts) {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.ts, ts, err)
+			}
+			d, tod, err := DateTimeFromTimestamp(tc.ts, tc.loc)
+			if err != nil || d != tc.d || tod != tc.t {
+				tt.Errorf("Expected (%v, %v), got (%v, %v) (err = %v)", tc.d, tc.t, d, tod, err)
+			}
+		})
+	}
+}
+
+func TestDateTimeErrors(t *testing.T) {
+	if _, err := TimestampFromDateTime(date.Nil, timeofday.Zero, time.UTC); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	// the max date in UTC-1 is in the year 10000 in UTC
+	if _, err := TimestampFromDateTime(date.Max, timeofday.Max, time.FixedZone("", -3600)); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	cases := []struct {
+		name string
+		ts   *timestamppb.Timestamp
+	}{
+		{"nil", nil},
+		{"invalid nanos", &timestamppb.Timestamp{Nanos: -1}},
+		{"before min date", timestamppb.New(time.Date(1752, 12, 31, 0, 0, 0, 0, time.UTC))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if _, _, err := DateTimeFromTimestamp(tc.ts, time.UTC); errors.Cause(err) != ErrOutOfRange {
+				tt.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+			}
+		})
+	}
+}
+
+func TestIntervalRoundTrip(t *testing.T) {
+	start := time.Date(2024, 7, 14, 9, 0, 0, 0, time.UTC)
+	end := start.Add(90 * time.Minute)
+	cases := []struct {
+		name string
+		i    freebusy.Interval
+		p    *ginterval.Interval
+	}{
+		{"bounded", freebusy.Interval{Start: start, End: end}, &ginterval.Interval{StartTime: timestamppb.New(start), EndTime: timestamppb.New(end)}},
+		{"empty", freebusy.Interval{Start: start, End: start}, &ginterval.Interval{StartTime: timestamppb.New(start), EndTime: timestamppb.New(start)}},
+		{"unbounded end", freebusy.Interval{Start: start}, &ginterval.Interval{StartTime: timestamppb.New(start)}},
+		{"unbounded", freebusy.Interval{}, &ginterval.Interval{}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			p, err := IntervalToProto(tc.i)
+			if err != nil || !proto.Equal(p, tc.p) {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.p, p, err)
+			}
+			i, err := IntervalFromProto(tc.p)
+			if err != nil || !i.Start.Equal(tc.i.Start) || !i.End.Equal(tc.i.End) {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.i, i, err)
+			}
+		})
+	}
+}
+
+func TestIntervalErrors(t *testing.T) {
+	start := time.Date(2024, 7, 14, 9, 0, 0, 0, time.UTC)
+	if _, err := IntervalToProto(freebusy.Interval{Start: start, End: start.Add(-time.Second)}); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := IntervalToProto(freebusy.Interval{Start: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)}); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	cases := []struct {
+		name string
+		p    *ginterval.Interval
+	}{
+		{"reversed", &ginterval.Interval{StartTime: timestamppb.New(start), EndTime: timestamppb.New(start.Add(-time.Second))}},
+		{"invalid start", &ginterval.Interval{StartTime: &timestamppb.Timestamp{Nanos: -1}}},
+		{"invalid end", &ginterval.Interval{EndTime: &timestamppb.Timestamp{Seconds: 1 << 40}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if _, err := IntervalFromProto(tc.p); errors.Cause(err) != ErrOutOfRange {
+				tt.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+			}
+		})
+	}
+}
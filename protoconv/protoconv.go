@@ -0,0 +1,25 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package protoconv converts between the types in this module and the Protocol Buffers well-known
+// types, google.protobuf.Timestamp and google.protobuf.Duration, and the common google.type messages
+// for dates, times of day and intervals, so that gRPC services can use the Go types in their business
+// logic and convert only at the API boundary.
+//
+// Every conversion validates its input in both directions.  Messages that are out of range for the Go
+// type, or Go values that the message cannot represent, return ErrOutOfRange instead of being silently
+// truncated or wrapped.
+package protoconv
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrOutOfRange is returned when a value cannot be represented by the target type
+	ErrOutOfRange = errors.Errorf("protoconv: the value is out of range for the target type")
+	// ErrPartialDate is returned when a google.type.Date with a zero year, month or day is converted
+	// to a type that requires a full date
+	ErrPartialDate = errors.Errorf("protoconv: the date is missing its year, month or day")
+)
@@ -0,0 +1,64 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package protoconv
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+	gtimeofday "google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// TimeOfDayToProto converts a timeofday.Value to a google.type.TimeOfDay
+func TimeOfDayToProto(v timeofday.Value) (*gtimeofday.TimeOfDay, error) {
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "%v", timeofday.ToDuration(v))
+	}
+	h, m, s, ns := v.ToUnits()
+	return &gtimeofday.TimeOfDay{Hours: int32(h), Minutes: int32(m), Seconds: int32(s), Nanos: int32(ns)}, nil
+}
+
+// TimeOfDayFromProto converts a google.type.TimeOfDay to a timeofday.Value.  A nil message is
+// converted to midnight, which is the time represented by an empty message.
+//
+// google.type.TimeOfDay allows "24:00:00" for the end of a business day and 60 seconds for leap
+// seconds, neither of which can be represented by timeofday.Value, so ErrOutOfRange is returned for
+// those and for other out-of-range values.
+func TimeOfDayFromProto(p *gtimeofday.TimeOfDay) (timeofday.Value, error) {
+	h, m, s, ns := int(p.GetHours()), int(p.GetMinutes()), int(p.GetSeconds()), int64(p.GetNanos())
+	v, err := timeofday.FromUnits(h, m, s, ns)
+	if err != nil {
+		return timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%02d:%02d:%02d.%09d", h, m, s, ns)
+	}
+	return v, nil
+}
+
+// TimeOfDayToDuration converts a timeofday.Value to a google.protobuf.Duration containing the time
+// since midnight, for APIs that represent times of day that way
+func TimeOfDayToDuration(v timeofday.Value) (*durationpb.Duration, error) {
+	if !v.IsValid() {
+		return nil, errors.Wrapf(ErrOutOfRange, "%v", timeofday.ToDuration(v))
+	}
+	return durationpb.New(timeofday.ToDuration(v)), nil
+}
+
+// TimeOfDayFromDuration converts a google.protobuf.Duration containing the time since midnight to a
+// timeofday.Value.  A nil message is converted to midnight.  If the duration is invalid, negative or
+// 24 hours or more, ErrOutOfRange is returned.
+func TimeOfDayFromDuration(p *durationpb.Duration) (timeofday.Value, error) {
+	if p == nil {
+		return timeofday.Zero, nil
+	}
+	if err := p.CheckValid(); err != nil {
+		return timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%v", err)
+	}
+	d := p.AsDuration()
+	if d < 0 || d >= 24*time.Hour {
+		return timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%v", d)
+	}
+	return timeofday.FromDuration(d)
+}
@@ -0,0 +1,93 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package protoconv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+	gtimeofday "google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestTimeOfDayRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		v    timeofday.Value
+		p    *gtimeofday.TimeOfDay
+		d    *durationpb.Duration
+	}{
+		{"midnight", timeofday.Zero, &gtimeofday.TimeOfDay{}, &durationpb.Duration{}},
+		{"afternoon", timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000)), &gtimeofday.TimeOfDay{Hours: 13, Minutes: 45, Seconds: 30, Nanos: 500000000}, &durationpb.Duration{Seconds: 49530, Nanos: 500000000}},
+		{"max", timeofday.Max, &gtimeofday.TimeOfDay{Hours: 23, Minutes: 59, Seconds: 59, Nanos: 999999999}, &durationpb.Duration{Seconds: 86399, Nanos: 999999999}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			p, err := TimeOfDayToProto(tc.v)
+			if err != nil || !proto.Equal(p, tc.p) {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.p, p, err)
+			}
+			if v, err := TimeOfDayFromProto(tc.p); err != nil || v != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, v, err)
+			}
+			d, err := TimeOfDayToDuration(tc.v)
+			if err != nil || !proto.Equal(d, tc.d) {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.d, d, err)
+			}
+			if v, err := TimeOfDayFromDuration(tc.d); err != nil || v != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, v, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayFromNil(t *testing.T) {
+	if v, err := TimeOfDayFromProto(nil); err != nil || v != timeofday.Zero {
+		t.Errorf("Expected %v, got %v (err = %v)", timeofday.Zero, v, err)
+	}
+	if v, err := TimeOfDayFromDuration(nil); err != nil || v != timeofday.Zero {
+		t.Errorf("Expected %v, got %v (err = %v)", timeofday.Zero, v, err)
+	}
+}
+
+func TestTimeOfDayFromProtoErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		p    *gtimeofday.TimeOfDay
+	}{
+		{"end of day", &gtimeofday.TimeOfDay{Hours: 24}},
+		{"leap second", &gtimeofday.TimeOfDay{Hours: 23, Minutes: 59, Seconds: 60}},
+		{"negative", &gtimeofday.TimeOfDay{Minutes: -1}},
+		{"invalid nanos", &gtimeofday.TimeOfDay{Nanos: 1000000000}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if _, err := TimeOfDayFromProto(tc.p); errors.Cause(err) != ErrOutOfRange {
+				tt.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayFromDurationErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		d    *durationpb.Duration
+	}{
+		{"negative", durationpb.New(-time.Second)},
+		{"24 hours", durationpb.New(24 * time.Hour)},
+		{"invalid", &durationpb.Duration{Seconds: 1, Nanos: -1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if _, err := TimeOfDayFromDuration(tc.d); errors.Cause(err) != ErrOutOfRange {
+				tt.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package queue provides Value[T], a generic first-in, first-out queue with a maximum size and a
+// policy for handling items that arrive when it is full.
+package queue
+
+import (
+	"iter"
+
+	"github.com/dylan-bourque/go-types/deque"
+	"github.com/pkg/errors"
+)
+
+// Overflow specifies what a full queue does with a new item
+type Overflow uint8
+
+const (
+	// Reject refuses the new item and returns ErrFull
+	Reject Overflow = iota
+	// DropOldest removes the item at the front of the queue to make room for the new item
+	DropOldest
+	// DropNewest discards the new item and leaves the queue unchanged
+	DropNewest
+)
+
+var (
+	// ErrFull is returned by Push() when the queue is full and its overflow policy is Reject
+	ErrFull = errors.Errorf("queue: the queue is full")
+	// ErrInvalidCapacity is returned by New() when the capacity is not positive
+	ErrInvalidCapacity = errors.Errorf("queue: the capacity must be greater than zero")
+	// ErrInvalidOverflow is returned by New() when the overflow policy is not one of the defined values
+	ErrInvalidOverflow = errors.Errorf("queue: invalid overflow policy")
+)
+
+// Value is a first-in, first-out queue that holds at most a fixed number of items.  Items are
+// stored in a deque.Value, so storage is only allocated as the queue fills up.
+//
+// Values are created by New() and are not safe for concurrent use without external synchronization.
+type Value[T any] struct {
+	items    deque.Value[T]
+	capacity int
+	overflow Overflow
+	dropped  int
+}
+
+// New returns an empty queue that holds at most capacity items and handles new items that arrive
+// when it is full according to the specified overflow policy
+func New[T any](capacity int, overflow Overflow) (*Value[T], error) {
+	if capacity <= 0 {
+		return nil, errors.Wrapf(ErrInvalidCapacity, "%d", capacity)
+	}
+	if overflow > DropNewest {
+		return nil, errors.Wrapf(ErrInvalidOverflow, "%d", overflow)
+	}
+	return &Value[T]{capacity: capacity, overflow: overflow}, nil
+}
+
+// Push adds item to the back of q.  If q is full, the item is handled by the overflow policy and
+// ErrFull is returned if the policy is Reject.
+func (q *Value[T]) Push(item T) error {
+	if q.items.Len() == q.capacity {
+		switch q.overflow {
+		case Reject:
+			return ErrFull
+		case DropNewest:
+			q.dropped++
+			return nil
+		case DropOldest:
+			q.items.PopFront()
+			q.dropped++
+		}
+	}
+	q.items.PushBack(item)
+	return nil
+}
+
+// Pop removes and returns the item at the front of q.  It returns false if q is empty.
+func (q *Value[T]) Pop() (T, bool) {
+	return q.items.PopFront()
+}
+
+// Peek returns the item at the front of q without removing it.  It returns false if q is empty.
+func (q *Value[T]) Peek() (T, bool) {
+	return q.items.Front()
+}
+
+// Len returns the number of items in q
+func (q *Value[T]) Len() int {
+	return q.items.Len()
+}
+
+// Cap returns the maximum number of items q can hold
+func (q *Value[T]) Cap() int {
+	return q.capacity
+}
+
+// IsFull returns true if q holds its maximum number of items
+func (q *Value[T]) IsFull() bool {
+	return q.items.Len() == q.capacity
+}
+
+// Overflow returns the overflow policy of q
+func (q *Value[T]) Overflow() Overflow {
+	return q.overflow
+}
+
+// Dropped returns the number of items that were discarded by the DropOldest or DropNewest overflow
+// policies.  Items refused by the Reject policy are not counted, since the caller is told about them.
+func (q *Value[T]) Dropped() int {
+	return q.dropped
+}
+
+// Clear removes all items from q.  The dropped count is not reset.
+func (q *Value[T]) Clear() {
+	q.items.Clear()
+}
+
+// All returns an iterator over the items in q, from front to back.  q must not be modified during the
+// iteration.
+func (q *Value[T]) All() iter.Seq[T] {
+	return q.items.All()
+}
@@ -0,0 +1,89 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name     string
+		capacity int
+		overflow Overflow
+		err      error
+	}{
+		{"valid", 3, DropOldest, nil},
+		{"zero capacity", 0, Reject, ErrInvalidCapacity},
+		{"negative capacity", -1, Reject, ErrInvalidCapacity},
+		{"invalid overflow", 3, Overflow(42), ErrInvalidOverflow},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			q, err := New[int](tc.capacity, tc.overflow)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && (q.Cap() != tc.capacity || q.Overflow() != tc.overflow || q.Len() != 0) {
+				tt.Errorf("Unexpected queue state")
+			}
+		})
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	cases := []struct {
+		name     string
+		overflow Overflow
+		errs     []error
+		expected []int
+		dropped  int
+	}{
+		{"reject", Reject, []error{nil, nil, nil, ErrFull, ErrFull}, []int{1, 2, 3}, 0},
+		{"drop oldest", DropOldest, []error{nil, nil, nil, nil, nil}, []int{3, 4, 5}, 2},
+		{"drop newest", DropNewest, []error{nil, nil, nil, nil, nil}, []int{1, 2, 3}, 2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			q, _ := New[int](3, tc.overflow)
+			for i, expected := range tc.errs {
+				if err := q.Push(i + 1); err != expected {
+					tt.Errorf("Expected Push(%d) to return %v, got %v", i+1, expected, err)
+				}
+			}
+			if !q.IsFull() || q.Dropped() != tc.dropped {
+				tt.Errorf("Expected a full queue with %d dropped items, got %d items and %d dropped", tc.dropped, q.Len(), q.Dropped())
+			}
+			if got := slices.Collect(q.All()); !slices.Equal(got, tc.expected) {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPopPeek(t *testing.T) {
+	q, _ := New[string](2, Reject)
+	if _, ok := q.Pop(); ok {
+		t.Errorf("Expected Pop() to fail on an empty queue")
+	}
+	_ = q.Push("a")
+	_ = q.Push("b")
+	if v, ok := q.Peek(); !ok || v != "a" || q.Len() != 2 {
+		t.Errorf("Expected Peek() to return a without removing it, got %q", v)
+	}
+	if v, ok := q.Pop(); !ok || v != "a" {
+		t.Errorf("Expected Pop() to return a, got %q", v)
+	}
+	if err := q.Push("c"); err != nil {
+		t.Errorf("Expected room for another item, got %v", err)
+	}
+	q.Clear()
+	if q.Len() != 0 || q.IsFull() {
+		t.Errorf("Expected an empty queue after Clear()")
+	}
+}
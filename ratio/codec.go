@@ -0,0 +1,166 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ratio
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidTextFormat is returned by Parse() and UnmarshalText() when the text is not formatted
+	// as "n", "n/d" or "n.fff"
+	ErrInvalidTextFormat = errors.Errorf("ratio.Value: text data was not in the correct format")
+	// ErrInvalidTextData is returned from ratio.Value.UnmarshalJSON() when the passed-in byte slice
+	// does not contain a string, a number or a {"num", "den"} object
+	ErrInvalidTextData = errors.Errorf("ratio.Value: can only decode JSON strings, numbers and {num, den} objects")
+)
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// Parse parses a ratio formatted as an integer ("3"), a fraction ("3/4") or a decimal ("0.75"), with
+// an optional leading sign.  The result is normalized, so "6/8" is parsed as 3/4.
+func Parse(s string) (Value, error) {
+	if !validSyntax(s) {
+		return Zero, errors.Wrapf(ErrInvalidTextFormat, "%q", s)
+	}
+	if i := strings.IndexByte(s, '/'); i >= 0 && strings.Trim(s[i+1:], "0") == "" {
+		return Zero, ErrZeroDenominator
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Zero, errors.Wrapf(ErrInvalidTextFormat, "%q", s)
+	}
+	return FromRat(r)
+}
+
+// validSyntax checks that s matches [+-]?[0-9]+([./][0-9]+)?, since math/big.Rat accepts a much
+// wider syntax, including exponents and base prefixes
+func validSyntax(s string) bool {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	var (
+		sep    byte
+		digits int
+	)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits++
+		case (c == '.' || c == '/') && sep == 0 && digits > 0:
+			sep, digits = c, 0
+		default:
+			return false
+		}
+	}
+	return digits > 0
+}
+
+// String implements fmt.Stringer for ratio.Value instances.
+//
+// The returned string is formatted as "n/d", or as "n" if the denominator is 1.
+func (v Value) String() string {
+	var buf [41]byte
+	return string(v.appendText(buf[:0]))
+}
+
+// appendText appends the text representation of v, as returned by String(), to b
+func (v Value) appendText(b []byte) []byte {
+	b = strconv.AppendInt(b, v.num, 10)
+	if v.IsInt() {
+		return b
+	}
+	return strconv.AppendInt(append(b, '/'), v.Den(), 10)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for ratio.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for ratio.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	return v.appendText(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for ratio.Value values.
+//
+// The text is parsed by Parse().
+func (v *Value) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// jsonObject is the {"num": n, "den": d} form accepted by UnmarshalJSON()
+type jsonObject struct {
+	Num *int64 `json:"num"`
+	Den *int64 `json:"den"`
+}
+
+// MarshalJSON implements the json.Marshaler interface for ratio.Value values.
+//
+// Values are encoded as a JSON string containing the same value as MarshalText(), such as "3/4".
+func (v Value) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for ratio.Value values.
+//
+// JSON strings are delegated to UnmarshalText(), and JSON numbers without exponents are parsed the same
+// way.  Objects of the form {"num": 3, "den": 4} are also accepted, where "den" defaults to 1.  As
+// with the built-in numeric types, the JSON null token leaves v unchanged.
+func (v *Value) UnmarshalJSON(p []byte) error {
+	switch {
+	case bytes.Equal(p, []byte("null")):
+		return nil
+	case len(p) > 0 && p[0] == '{':
+		var obj jsonObject
+		if err := json.Unmarshal(p, &obj); err != nil || obj.Num == nil {
+			return errors.Wrapf(ErrInvalidTextData, "%s", p)
+		}
+		den := int64(1)
+		if obj.Den != nil {
+			den = *obj.Den
+		}
+		res, err := New(*obj.Num, den)
+		if err != nil {
+			return err
+		}
+		*v = res
+		return nil
+	case len(p) > 0 && p[0] == '"':
+		var s string
+		if err := json.Unmarshal(p, &s); err != nil {
+			return errors.Wrapf(ErrInvalidTextData, "%v", err)
+		}
+		return v.UnmarshalText([]byte(s))
+	default:
+		res, err := Parse(string(p))
+		if err != nil {
+			return errors.Wrapf(ErrInvalidTextData, "%v", err)
+		}
+		*v = res
+		return nil
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ratio
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected string
+		err      error
+	}{
+		{"integer", "42", "42", nil},
+		{"negative integer", "-42", "-42", nil},
+		{"fraction", "6/8", "3/4", nil},
+		{"signed fraction", "+3/4", "3/4", nil},
+		{"decimal", "1.25", "5/4", nil},
+		{"negative decimal", "-0.5", "-1/2", nil},
+		{"zero denominator", "1/000", "0", ErrZeroDenominator},
+		{"overflow", "1/99999999999999999999", "0", ErrOverflow},
+		{"empty", "", "0", ErrInvalidTextFormat},
+		{"sign only", "-", "0", ErrInvalidTextFormat},
+		{"missing denominator", "3/", "0", ErrInvalidTextFormat},
+		{"missing integer part", ".5", "0", ErrInvalidTextFormat},
+		{"two separators", "1/2/3", "0", ErrInvalidTextFormat},
+		{"exponent", "1e3", "0", ErrInvalidTextFormat},
+		{"hex", "0x10", "0", ErrInvalidTextFormat},
+		{"negative denominator", "1/-2", "0", ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v.String() != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	for _, v := range sampleValues() {
+		text, _ := v.MarshalText()
+		var got Value
+		if err := got.UnmarshalText(text); err != nil || got != v {
+			t.Fatalf("Expected %s, got %s (err = %v)", v, got, err)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Must(New(3, 4)))
+	if err != nil || string(data) != `"3/4"` {
+		t.Errorf(`Expected "3/4", got %s (err = %v)`, data, err)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected string
+		err      error
+	}{
+		{"string", `"3/4"`, "3/4", nil},
+		{"integer", `5`, "5", nil},
+		{"decimal", `0.75`, "3/4", nil},
+		{"object", `{"num": 6, "den": 8}`, "3/4", nil},
+		{"object without den", `{"num": -2}`, "-2", nil},
+		{"null", `null`, "1/9", nil},
+		{"object without num", `{"den": 8}`, "1/9", ErrInvalidTextData},
+		{"object with zero den", `{"num": 1, "den": 0}`, "1/9", ErrZeroDenominator},
+		{"exponent", `1e3`, "1/9", ErrInvalidTextData},
+		{"bool", `true`, "1/9", ErrInvalidTextData},
+		{"invalid string", `"abc"`, "1/9", ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Must(New(1, 9))
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v.String() != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestAppendTextDoesNotAllocate(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"integer", Must(FromInt(-3)), "-3"},
+		{"fraction", Must(New(3, 4)), "3/4"},
+	}
+	buf := make([]byte, 0, 64)
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				buf, _ = tc.v.AppendText(buf[:0])
+			})
+			if allocs != 0 {
+				tt.Errorf("Expected no allocations, got %v", allocs)
+			}
+			if string(buf) != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ratio
+
+import (
+	"log/slog"
+)
+
+// interface validations
+var _ slog.LogValuer = (*Value)(nil)
+
+// LogValue implements the slog.LogValuer interface for ratio.Value values, so structured logs show
+// the n/d string returned by String() rather than the numerator and denominator fields.
+func (v Value) LogValue() slog.Value {
+	return slog.StringValue(v.String())
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ratio
+
+import (
+	"database/sql/driver"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// a ratio.Value value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a ratio.Value value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for ratio.Value values.  The returned value is the
+// same string as is returned by String(), such as "3/4".
+func (v Value) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for ratio.Value values.
+//
+// Strings and byte slices are handled by UnmarshalText(), which also accepts the decimal text that
+// drivers return for NUMERIC columns, and int64 values are converted directly.  All other values will
+// return an error
+func (v *Value) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case int64:
+		res, err := FromInt(tv)
+		if err != nil {
+			return err
+		}
+		*v = res
+		return nil
+	case []byte:
+		return v.UnmarshalText(tv)
+	case string:
+		return v.UnmarshalText([]byte(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
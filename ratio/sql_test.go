@@ -0,0 +1,47 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ratio
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestValue(t *testing.T) {
+	got, err := Must(New(-3, 4)).Value()
+	if err != nil || got != "-3/4" {
+		t.Errorf(`Expected "-3/4", got %v (err = %v)`, got, err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected string
+		err      error
+	}{
+		{"int64", int64(7), "7", nil},
+		{"min int64", int64(math.MinInt64), "0", ErrOverflow},
+		{"string", "3/4", "3/4", nil},
+		{"numeric bytes", []byte("12.50"), "25/2", nil},
+		{"invalid text", "abc", "0", ErrInvalidTextFormat},
+		{"unsupported type", 1.5, "0", ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v.String() != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
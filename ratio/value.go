@@ -0,0 +1,226 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ratio
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/pkg/errors"
+)
+
+// Value represents an exact rational number, such as 3/4, with a 64-bit numerator and denominator.
+//
+// Values are always normalized: the denominator is positive and shares no common factor with the
+// numerator, so equal ratios can be compared with == and used as map keys.  Intermediate results are
+// computed with 128 bits, and arithmetic returns ErrOverflow rather than losing precision when the
+// normalized result does not fit.  Use math/big.Rat for values that need more range.
+//
+// The zero value is ratio.Zero.
+type Value struct {
+	num int64
+	// denm1 is the denominator minus one, so that the zero value is 0/1
+	denm1 int64
+}
+
+var (
+	// Zero is the ratio.Value 0/1
+	Zero = Value{}
+	// One is the ratio.Value 1/1
+	One = Value{num: 1}
+)
+
+var (
+	// ErrZeroDenominator is returned when a ratio would have a denominator of zero, including division
+	// by zero
+	ErrZeroDenominator = errors.Errorf("ratio: the denominator cannot be zero")
+	// ErrOverflow is returned when the normalized numerator or denominator does not fit in 63 bits
+	ErrOverflow = errors.Errorf("ratio: the result cannot be represented with 64-bit terms")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in ratio.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// New returns the normalized ratio num/den.
+//
+// Since the normalized terms must be negatable, a result with a numerator or denominator of
+// math.MinInt64 returns ErrOverflow.
+func New(num, den int64) (Value, error) {
+	return normalize(int128.From64(num), int128.From64(den))
+}
+
+// FromInt returns the ratio n/1.  It returns ErrOverflow for math.MinInt64.
+func FromInt(n int64) (Value, error) {
+	if n == math.MinInt64 {
+		return Zero, ErrOverflow
+	}
+	return Value{num: n}, nil
+}
+
+// FromRat returns a Value equal to the specified *big.Rat, or ErrOverflow if its terms do not fit in
+// 64 bits
+func FromRat(r *big.Rat) (Value, error) {
+	num, den := r.Num(), r.Denom()
+	if !num.IsInt64() || !den.IsInt64() || num.Int64() == math.MinInt64 {
+		return Zero, ErrOverflow
+	}
+	return Value{num: num.Int64(), denm1: den.Int64() - 1}, nil
+}
+
+// normalize reduces num/den to lowest terms with a positive denominator
+func normalize(num, den int128.Value) (Value, error) {
+	if den.IsZero() {
+		return Zero, ErrZeroDenominator
+	}
+	if num.IsZero() {
+		return Zero, nil
+	}
+	if den.Sign() < 0 {
+		num, den = num.Neg(), den.Neg()
+	}
+	g := gcd(num.Abs(), den.Abs())
+	n, d := num.Abs().Quo(g), den.Abs().Quo(g)
+	// both terms must fit in 63 bits so that the numerator can be negated safely
+	if !n.IsUint64() || !d.IsUint64() || n.Uint64() > math.MaxInt64 || d.Uint64() > math.MaxInt64 {
+		return Zero, ErrOverflow
+	}
+	res := Value{num: int64(n.Uint64()), denm1: int64(d.Uint64()) - 1}
+	if num.Sign() < 0 {
+		res.num = -res.num
+	}
+	return res, nil
+}
+
+// gcd returns the greatest common divisor of a and b, which must not both be zero
+func gcd(a, b uint128.Value) uint128.Value {
+	for !b.IsZero() {
+		a, b = b, a.Rem(b)
+	}
+	return a
+}
+
+// Num returns the numerator, which carries the sign of the ratio
+func (v Value) Num() int64 {
+	return v.num
+}
+
+// Den returns the denominator, which is always positive
+func (v Value) Den() int64 {
+	return v.denm1 + 1
+}
+
+// IsZero returns true if v is 0
+func (v Value) IsZero() bool {
+	return v == Zero
+}
+
+// IsInt returns true if the denominator of v is 1
+func (v Value) IsInt() bool {
+	return v.denm1 == 0
+}
+
+// Sign returns -1, 0 or +1 depending on whether v is negative, zero or positive
+func (v Value) Sign() int {
+	switch {
+	case v.num < 0:
+		return -1
+	case v.num > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Float64 returns the nearest float64 to v
+func (v Value) Float64() float64 {
+	f, _ := v.Rat().Float64()
+	return f
+}
+
+// Rat returns v as a *big.Rat
+func (v Value) Rat() *big.Rat {
+	return big.NewRat(v.num, v.Den())
+}
+
+// Compare returns -1, 0 or +1 depending on whether v1 is less than, equal to, or greater than v2
+func Compare(v1, v2 Value) int {
+	// denominators are positive, so cross multiplication preserves the order
+	return int128.Compare(int128.From64(v1.num).Mul(int128.From64(v2.Den())), int128.From64(v2.num).Mul(int128.From64(v1.Den())))
+}
+
+// Neg returns -v
+func (v Value) Neg() Value {
+	v.num = -v.num
+	return v
+}
+
+// Abs returns the absolute value of v
+func (v Value) Abs() Value {
+	if v.num < 0 {
+		v.num = -v.num
+	}
+	return v
+}
+
+// Inv returns 1/v, or ErrZeroDenominator if v is 0
+func (v Value) Inv() (Value, error) {
+	if v.num == 0 {
+		return Zero, ErrZeroDenominator
+	}
+	if v.num < 0 {
+		return Value{num: -v.Den(), denm1: -v.num - 1}, nil
+	}
+	return Value{num: v.Den(), denm1: v.num - 1}, nil
+}
+
+// Add returns v + v2, or ErrOverflow if the normalized result does not fit
+func (v Value) Add(v2 Value) (Value, error) {
+	d1, d2 := int128.From64(v.Den()), int128.From64(v2.Den())
+	num := int128.From64(v.num).Mul(d2).Add(int128.From64(v2.num).Mul(d1))
+	return normalize(num, d1.Mul(d2))
+}
+
+// Sub returns v - v2, or ErrOverflow if the normalized result does not fit
+func (v Value) Sub(v2 Value) (Value, error) {
+	return v.Add(v2.Neg())
+}
+
+// Mul returns v * v2, or ErrOverflow if the normalized result does not fit
+func (v Value) Mul(v2 Value) (Value, error) {
+	num := int128.From64(v.num).Mul(int128.From64(v2.num))
+	return normalize(num, int128.From64(v.Den()).Mul(int128.From64(v2.Den())))
+}
+
+// Quo returns v / v2, ErrZeroDenominator if v2 is 0, or ErrOverflow if the normalized result does
+// not fit
+func (v Value) Quo(v2 Value) (Value, error) {
+	num := int128.From64(v.num).Mul(int128.From64(v2.Den()))
+	return normalize(num, int128.From64(v.Den()).Mul(int128.From64(v2.num)))
+}
+
+// Floor returns the largest integer less than or equal to v
+func (v Value) Floor() int64 {
+	q := v.num / v.Den()
+	if v.num%v.Den() != 0 && v.num < 0 {
+		q--
+	}
+	return q
+}
+
+// Ceil returns the smallest integer greater than or equal to v
+func (v Value) Ceil() int64 {
+	q := v.num / v.Den()
+	if v.num%v.Den() != 0 && v.num > 0 {
+		q++
+	}
+	return q
+}
@@ -0,0 +1,163 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ratio
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name     string
+		num, den int64
+		expected string
+		err      error
+	}{
+		{"zero", 0, 5, "0", nil},
+		{"normalized", 6, 8, "3/4", nil},
+		{"negative denominator", 3, -4, "-3/4", nil},
+		{"both negative", -3, -4, "3/4", nil},
+		{"integer", 10, 5, "2", nil},
+		{"max terms", math.MaxInt64, math.MaxInt64 - 1, "9223372036854775807/9223372036854775806", nil},
+		{"min numerator reduces", math.MinInt64, 2, "-4611686018427387904", nil},
+		{"min numerator overflows", math.MinInt64, 1, "0", ErrOverflow},
+		{"min denominator overflows", 1, math.MinInt64, "0", ErrOverflow},
+		{"zero denominator", 1, 0, "0", ErrZeroDenominator},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := New(tc.num, tc.den)
+			if err != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v.String() != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+	if Must(New(0, 7)) != Zero || Must(New(2, 4)) != Must(New(1, 2)) {
+		t.Errorf("Expected normalized values to be comparable with ==")
+	}
+}
+
+// sampleValues returns a mix of small, large and extreme ratios
+func sampleValues() []Value {
+	terms := []int64{0, 1, -1, 2, 3, -7, 10, 1 << 31, -(1 << 40) + 3, math.MaxInt64, -math.MaxInt64}
+	var vals []Value
+	for _, n := range terms {
+		for _, d := range []int64{1, 2, 3, 1000, 1<<32 + 1, math.MaxInt64} {
+			vals = append(vals, Must(New(n, d)))
+		}
+	}
+	return vals
+}
+
+func TestArithmeticMatchesBig(t *testing.T) {
+	vals := sampleValues()
+	ops := []struct {
+		name string
+		fn   func(a, b Value) (Value, error)
+		big  func(a, b *big.Rat) *big.Rat
+	}{
+		{"+", Value.Add, func(a, b *big.Rat) *big.Rat { return new(big.Rat).Add(a, b) }},
+		{"-", Value.Sub, func(a, b *big.Rat) *big.Rat { return new(big.Rat).Sub(a, b) }},
+		{"*", Value.Mul, func(a, b *big.Rat) *big.Rat { return new(big.Rat).Mul(a, b) }},
+		{"/", Value.Quo, func(a, b *big.Rat) *big.Rat {
+			if b.Sign() == 0 {
+				return nil
+			}
+			return new(big.Rat).Quo(a, b)
+		}},
+	}
+	for _, op := range ops {
+		for _, a := range vals {
+			for _, b := range vals {
+				got, err := op.fn(a, b)
+				exact := op.big(a.Rat(), b.Rat())
+				if exact == nil {
+					if err != ErrZeroDenominator {
+						t.Fatalf("%s %s %s: expected %v, got %v", a, op.name, b, ErrZeroDenominator, err)
+					}
+					continue
+				}
+				expected, expectedErr := FromRat(exact)
+				if err != expectedErr || got != expected {
+					t.Fatalf("%s %s %s: expected (%s, %v), got (%s, %v)", a, op.name, b, expected, expectedErr, got, err)
+				}
+			}
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	vals := sampleValues()
+	for _, a := range vals {
+		for _, b := range vals {
+			if got, expected := Compare(a, b), a.Rat().Cmp(b.Rat()); got != expected {
+				t.Fatalf("Compare(%s, %s): expected %d, got %d", a, b, expected, got)
+			}
+		}
+	}
+}
+
+func TestRounding(t *testing.T) {
+	cases := []struct {
+		name        string
+		v           Value
+		floor, ceil int64
+	}{
+		{"zero", Zero, 0, 0},
+		{"integer", Must(New(-4, 2)), -2, -2},
+		{"positive fraction", Must(New(7, 2)), 3, 4},
+		{"negative fraction", Must(New(-7, 2)), -4, -3},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if f, c := tc.v.Floor(), tc.v.Ceil(); f != tc.floor || c != tc.ceil {
+				tt.Errorf("Expected (%d, %d), got (%d, %d)", tc.floor, tc.ceil, f, c)
+			}
+		})
+	}
+}
+
+func TestInv(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+		err      error
+	}{
+		{"zero", Zero, "0", ErrZeroDenominator},
+		{"positive", Must(New(3, 4)), "4/3", nil},
+		{"negative", Must(New(-3, 4)), "-4/3", nil},
+		{"integer", Must(New(-5, 1)), "-1/5", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := tc.v.Inv()
+			if err != tc.err || v.String() != tc.expected {
+				tt.Errorf("Expected (%s, %v), got (%s, %v)", tc.expected, tc.err, v, err)
+			}
+		})
+	}
+}
+
+func TestAccessors(t *testing.T) {
+	v := Must(New(-3, 4))
+	if v.Num() != -3 || v.Den() != 4 || v.Sign() != -1 || v.IsInt() || v.Float64() != -0.75 {
+		t.Errorf("Unexpected accessor results for %s", v)
+	}
+	if v.Neg().String() != "3/4" || v.Abs().String() != "3/4" {
+		t.Errorf("Unexpected Neg()/Abs() results for %s", v)
+	}
+	if Zero.Den() != 1 || Zero.Sign() != 0 || !Zero.IsInt() {
+		t.Errorf("Expected the zero value to be 0/1")
+	}
+	if _, err := FromInt(math.MinInt64); err != ErrOverflow {
+		t.Errorf("Expected %v, got %v", ErrOverflow, err)
+	}
+}
@@ -0,0 +1,18 @@
+module github.com/dylan-bourque/go-types/redisconv
+
+go 1.25.0
+
+require (
+	github.com/alicebob/miniredis/v2 v2.37.0
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	github.com/pkg/errors v0.9.1
+	github.com/redis/go-redis/v9 v9.9.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
+)
+
+replace github.com/dylan-bourque/go-types => ../
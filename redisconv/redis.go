@@ -0,0 +1,90 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package redisconv
+
+import (
+	"context"
+	"encoding"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// Set stores the compact encoding of vs at the specified key, with the specified expiration.  An
+// expiration of zero means the key does not expire.
+func Set[T encoding.BinaryMarshaler](ctx context.Context, c redis.Cmdable, key string, vs []T, expiration time.Duration) error {
+	data, err := Encode(vs)
+	if err != nil {
+		return err
+	}
+	return c.Set(ctx, key, data, expiration).Err()
+}
+
+// Get loads and decodes the slice stored at the specified key.  If the key does not exist, redis.Nil
+// is returned.
+func Get[T any, PT binaryUnmarshaler[T]](ctx context.Context, c redis.Cmdable, key string) ([]T, error) {
+	data, err := c.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	res, err := Decode[T, PT](data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "key %q", key)
+	}
+	return res, nil
+}
+
+// SetMany stores the compact encoding of each slice in values at its key, with the specified
+// expiration, using a single pipeline.  All values are encoded before anything is sent to Redis, so an
+// encoding error leaves all of the keys unchanged.
+func SetMany[T encoding.BinaryMarshaler](ctx context.Context, c redis.Cmdable, values map[string][]T, expiration time.Duration) error {
+	encoded := make(map[string][]byte, len(values))
+	for key, vs := range values {
+		data, err := Encode(vs)
+		if err != nil {
+			return errors.Wrapf(err, "key %q", key)
+		}
+		encoded[key] = data
+	}
+	_, err := c.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for key, data := range encoded {
+			p.Set(ctx, key, data, expiration)
+		}
+		return nil
+	})
+	return err
+}
+
+// GetMany loads and decodes the slices stored at the specified keys using a single pipeline.  Keys
+// that do not exist are omitted from the result.
+func GetMany[T any, PT binaryUnmarshaler[T]](ctx context.Context, c redis.Cmdable, keys ...string) (map[string][]T, error) {
+	cmds := make([]*redis.StringCmd, len(keys))
+	_, err := c.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, key := range keys {
+			cmds[i] = p.Get(ctx, key)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	res := make(map[string][]T, len(keys))
+	for i, cmd := range cmds {
+		data, err := cmd.Bytes()
+		switch {
+		case err == redis.Nil:
+			continue
+		case err != nil:
+			return nil, err
+		}
+		vs, err := Decode[T, PT](data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %q", keys[i])
+		}
+		res[keys[i]] = vs
+	}
+	return res, nil
+}
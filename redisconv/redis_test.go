@@ -0,0 +1,78 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package redisconv
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+func newClient(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	srv := miniredis.RunT(t)
+	c := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { c.Close() })
+	return srv, c
+}
+
+func TestSetGet(t *testing.T) {
+	ctx := context.Background()
+	srv, c := newClient(t)
+	dates := sampleDates(10)
+	if err := Set(ctx, c, "dates", dates, time.Minute); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ttl := srv.TTL("dates"); ttl != time.Minute {
+		t.Errorf("Expected a TTL of 1m, got %v", ttl)
+	}
+	got, err := Get[date.Value](ctx, c, "dates")
+	if err != nil || !reflect.DeepEqual(got, dates) {
+		t.Errorf("Expected %v, got %v (err = %v)", dates, got, err)
+	}
+	if _, err := Get[date.Value](ctx, c, "missing"); err != redis.Nil {
+		t.Errorf("Expected redis.Nil, got %v", err)
+	}
+	srv.Set("corrupt", "\x09")
+	if _, err := Get[date.Value](ctx, c, "corrupt"); errors.Cause(err) != ErrUnsupportedVersion {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedVersion, err)
+	}
+}
+
+func TestSetManyGetMany(t *testing.T) {
+	ctx := context.Background()
+	srv, c := newClient(t)
+	values := map[string][]timeofday.Value{
+		"a": sampleTimes(3),
+		"b": sampleTimes(5),
+		"c": nil,
+	}
+	if err := SetMany(ctx, c, values, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, err := GetMany[timeofday.Value](ctx, c, "a", "b", "c", "missing")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(got) != 3 || !reflect.DeepEqual(got["a"], values["a"]) || !reflect.DeepEqual(got["b"], values["b"]) || len(got["c"]) != 0 {
+		t.Errorf("Expected %v, got %v", values, got)
+	}
+	if _, ok := got["missing"]; ok {
+		t.Errorf("Expected missing keys to be omitted")
+	}
+	if got, err := GetMany[timeofday.Value](ctx, c); err != nil || len(got) != 0 {
+		t.Errorf("Expected an empty result, got %v (err = %v)", got, err)
+	}
+	srv.Set("corrupt", "\x01")
+	if _, err := GetMany[timeofday.Value](ctx, c, "a", "corrupt"); errors.Cause(err) != ErrInvalidData {
+		t.Errorf("Expected %v, got %v", ErrInvalidData, err)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package redisconv
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func sampleDates(n int) []date.Value {
+	res := make([]date.Value, n)
+	for i := range res {
+		res[i] = date.Must(date.Must(date.FromUnits(2024, 1, 1)).AddDays(i))
+	}
+	return res
+}
+
+func sampleTimes(n int) []timeofday.Value {
+	res := make([]timeofday.Value, n)
+	for i := range res {
+		res[i] = timeofday.Must(timeofday.FromDuration(time.Duration(i) * 1500 * time.Millisecond))
+	}
+	return res
+}
+
+func TestEncodeDates(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	data, err := Encode([]date.Value{d, date.Nil})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []byte{versionFixed, 5, 2, 1, 0x00, 0x25, 0x8b, 0x5a, 1, 0xff, 0xff, 0xff, 0xfe}
+	if !bytes.Equal(data, expected) {
+		t.Errorf("Expected %x, got %x", expected, data)
+	}
+	got, err := Decode[date.Value](data)
+	if err != nil || !reflect.DeepEqual(got, []date.Value{d, date.Nil}) {
+		t.Errorf("Expected [%v %v], got %v (err = %v)", d, date.Nil, got, err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	dates := sampleDates(100)
+	data, err := Encode(dates)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotDates, err := Decode[date.Value](data); err != nil || !reflect.DeepEqual(gotDates, dates) {
+		t.Errorf("Dates did not round trip (err = %v)", err)
+	}
+	if js, _ := json.Marshal(dates); len(data) >= len(js) {
+		t.Errorf("Expected the encoding to be smaller than JSON, got %d vs %d bytes", len(data), len(js))
+	}
+
+	times := sampleTimes(100)
+	if data, err = Encode(times); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotTimes, err := Decode[timeofday.Value](data); err != nil || !reflect.DeepEqual(gotTimes, times) {
+		t.Errorf("Times did not round trip (err = %v)", err)
+	}
+
+	// time.Time encodings vary in length with the zone offset, which exercises the variable encoding
+	instants := []time.Time{
+		time.Date(2024, 7, 14, 13, 45, 30, 0, time.UTC),
+		time.Date(2024, 7, 14, 13, 45, 30, 0, time.FixedZone("", 5*3600+30*60+15)),
+	}
+	if data, err = Encode(instants); err != nil || data[0] != versionVariable {
+		t.Fatalf("Expected the variable encoding, got %x (err = %v)", data, err)
+	}
+	gotInstants, err := Decode[time.Time](data)
+	if err != nil || len(gotInstants) != len(instants) {
+		t.Fatalf("Expected %d values, got %v (err = %v)", len(instants), gotInstants, err)
+	}
+	for i := range instants {
+		if !gotInstants[i].Equal(instants[i]) {
+			t.Errorf("Expected %v, got %v", instants[i], gotInstants[i])
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	for _, vs := range [][]date.Value{nil, {}} {
+		data, err := Encode(vs)
+		if err != nil || !bytes.Equal(data, []byte{versionVariable, 0}) {
+			t.Errorf("Expected 0200, got %x (err = %v)", data, err)
+		}
+		got, err := Decode[date.Value](data)
+		if err != nil || len(got) != 0 {
+			t.Errorf("Expected an empty slice, got %v (err = %v)", got, err)
+		}
+	}
+}
+
+func TestAppendEncode(t *testing.T) {
+	prefix := []byte("dates:")
+	data, err := AppendEncode(prefix, []date.Value{date.Min})
+	if err != nil || !bytes.HasPrefix(data, prefix) {
+		t.Fatalf("Expected the encoding to be appended, got %x (err = %v)", data, err)
+	}
+	if got, err := Decode[date.Value](data[len(prefix):]); err != nil || len(got) != 1 || got[0] != date.Min {
+		t.Errorf("Expected [%v], got %v (err = %v)", date.Min, got, err)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"empty", nil, ErrInvalidData},
+		{"unsupported version", []byte{9, 0}, ErrUnsupportedVersion},
+		{"missing width", []byte{versionFixed}, ErrInvalidData},
+		{"zero width", []byte{versionFixed, 0, 1, 1}, ErrInvalidData},
+		{"missing count", []byte{versionVariable}, ErrInvalidData},
+		{"short fixed data", []byte{versionFixed, 5, 2, 1, 0, 0x25, 0x8b, 0x5a}, ErrInvalidData},
+		{"huge count", []byte{versionVariable, 0xff, 0xff, 0xff, 0xff, 0x0f}, ErrInvalidData},
+		{"element length overflow", []byte{versionVariable, 1, 9, 1, 0}, ErrInvalidData},
+		{"trailing bytes", []byte{versionVariable, 1, 5, 1, 0, 0x25, 0x8b, 0x5a, 0}, ErrInvalidData},
+		{"invalid element", []byte{versionFixed, 5, 1, 1, 0, 0, 0, 1}, date.ErrInvalidBinaryData},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := Decode[date.Value](tc.data)
+			if errors.Cause(err) != tc.err || got != nil {
+				tt.Errorf("Expected (nil, %v), got (%v, %v)", tc.err, got, err)
+			}
+		})
+	}
+}
+
+func BenchmarkEncodeDates(b *testing.B) {
+	dates := sampleDates(1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := Encode(dates); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeDatesJSON(b *testing.B) {
+	dates := sampleDates(1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := json.Marshal(dates); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeDates(b *testing.B) {
+	data, _ := Encode(sampleDates(1000))
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := Decode[date.Value](data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeDatesJSON(b *testing.B) {
+	data, _ := json.Marshal(sampleDates(1000))
+	b.ReportAllocs()
+	for b.Loop() {
+		var dates []date.Value
+		if err := json.Unmarshal(data, &dates); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeTimes(b *testing.B) {
+	times := sampleTimes(1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := Encode(times); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeTimesJSON(b *testing.B) {
+	times := sampleTimes(1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := json.Marshal(times); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeTimes(b *testing.B) {
+	data, _ := Encode(sampleTimes(1000))
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := Decode[timeofday.Value](data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeTimesJSON(b *testing.B) {
+	data, _ := json.Marshal(sampleTimes(1000))
+	b.ReportAllocs()
+	for b.Loop() {
+		var times []timeofday.Value
+		if err := json.Unmarshal(data, &times); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
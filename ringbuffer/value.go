@@ -0,0 +1,156 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package ringbuffer provides Value[T], a generic fixed-capacity buffer that keeps the most recently
+// added items by overwriting the oldest ones.
+package ringbuffer
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidCapacity is returned by New() when the capacity is not positive
+	ErrInvalidCapacity = errors.Errorf("ringbuffer: the capacity must be greater than zero")
+)
+
+// Value is a fixed-capacity circular buffer.  Once it is full, each new item overwrites the oldest
+// one, so it always holds the last Cap() items that were added, such as the last N latency samples
+// in a monitoring agent.  Storage is allocated once by New() and Add() never allocates.
+//
+// Values are created by New() and are not safe for concurrent use without external synchronization.
+type Value[T any] struct {
+	buf   []T
+	next  int // position of the next write
+	n     int
+	total uint64
+}
+
+// New returns an empty ring buffer that holds at most capacity items
+func New[T any](capacity int) (*Value[T], error) {
+	if capacity <= 0 {
+		return nil, errors.Wrapf(ErrInvalidCapacity, "%d", capacity)
+	}
+	return &Value[T]{buf: make([]T, capacity)}, nil
+}
+
+// Add appends item as the newest item in r, overwriting the oldest item if r is full.  The overwritten
+// item and true are returned in that case.
+func (r *Value[T]) Add(item T) (T, bool) {
+	var (
+		old         T
+		overwritten = r.n == len(r.buf)
+	)
+	if overwritten {
+		old = r.buf[r.next]
+	} else {
+		r.n++
+	}
+	r.buf[r.next] = item
+	r.next = (r.next + 1) % len(r.buf)
+	r.total++
+	return old, overwritten
+}
+
+// Len returns the number of items in r
+func (r *Value[T]) Len() int {
+	return r.n
+}
+
+// Cap returns the maximum number of items r can hold
+func (r *Value[T]) Cap() int {
+	return len(r.buf)
+}
+
+// IsFull returns true if the next call to Add() will overwrite the oldest item
+func (r *Value[T]) IsFull() bool {
+	return r.n == len(r.buf)
+}
+
+// Total returns the number of items added to r since it was created or last cleared, including items
+// that have since been overwritten
+func (r *Value[T]) Total() uint64 {
+	return r.total
+}
+
+// At returns the i-th oldest item in r, so At(0) is the oldest item and At(Len()-1) is the newest.
+// At panics if i is out of range.
+func (r *Value[T]) At(i int) T {
+	if i < 0 || i >= r.n {
+		panic(fmt.Sprintf("ringbuffer: index %d out of range [0, %d)", i, r.n))
+	}
+	return r.buf[r.index(i)]
+}
+
+// Oldest returns the oldest item in r, or false if r is empty
+func (r *Value[T]) Oldest() (T, bool) {
+	if r.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.index(0)], true
+}
+
+// Newest returns the most recently added item in r, or false if r is empty
+func (r *Value[T]) Newest() (T, bool) {
+	if r.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.index(r.n-1)], true
+}
+
+// Clear removes all items from r and resets Total() to zero, keeping the allocated storage
+func (r *Value[T]) Clear() {
+	clear(r.buf)
+	r.next, r.n, r.total = 0, 0, 0
+}
+
+// All returns an iterator over the items in r from oldest to newest.  r must not be modified during
+// iteration.
+func (r *Value[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < r.n; i++ {
+			if !yield(r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the items in r from newest to oldest.  r must not be modified
+// during iteration.
+func (r *Value[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := r.n - 1; i >= 0; i-- {
+			if !yield(r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns a copy of the items in r from oldest to newest.  The returned slice does not share
+// storage with r, so it is safe to keep after r is modified.
+func (r *Value[T]) Snapshot() []T {
+	return r.AppendTo(make([]T, 0, r.n))
+}
+
+// AppendTo appends the items in r, from oldest to newest, to dst and returns the extended slice
+func (r *Value[T]) AppendTo(dst []T) []T {
+	start := r.index(0)
+	if start+r.n <= len(r.buf) {
+		return append(dst, r.buf[start:start+r.n]...)
+	}
+	dst = append(dst, r.buf[start:]...)
+	return append(dst, r.buf[:r.next]...)
+}
+
+// index converts a position relative to the oldest item into an index into r.buf
+func (r *Value[T]) index(i int) int {
+	return (r.next - r.n + i + len(r.buf)) % len(r.buf)
+}
@@ -0,0 +1,112 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ringbuffer
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestNew(t *testing.T) {
+	for _, capacity := range []int{0, -1} {
+		if _, err := New[int](capacity); errors.Cause(err) != ErrInvalidCapacity {
+			t.Errorf("Expected ErrInvalidCapacity for %d, got %v", capacity, err)
+		}
+	}
+	r, err := New[int](3)
+	if err != nil || r.Cap() != 3 || r.Len() != 0 || r.IsFull() {
+		t.Errorf("Unexpected ring buffer state (err = %v)", err)
+	}
+	if _, ok := r.Oldest(); ok {
+		t.Errorf("Expected Oldest() to fail on an empty ring buffer")
+	}
+	if _, ok := r.Newest(); ok {
+		t.Errorf("Expected Newest() to fail on an empty ring buffer")
+	}
+	if got := r.Snapshot(); len(got) != 0 {
+		t.Errorf("Expected an empty snapshot, got %v", got)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	r, _ := New[int](3)
+	for i := 1; i <= 10; i++ {
+		old, overwritten := r.Add(i)
+		if expected := i > 3; overwritten != expected || (overwritten && old != i-3) {
+			t.Fatalf("Add(%d): expected (%d, %v), got (%d, %v)", i, i-3, expected, old, overwritten)
+		}
+
+		// the buffer should always hold the last min(i, 3) items
+		var expected []int
+		for j := max(1, i-2); j <= i; j++ {
+			expected = append(expected, j)
+		}
+		if got := r.Snapshot(); !slices.Equal(got, expected) {
+			t.Fatalf("Add(%d): expected %v, got %v", i, expected, got)
+		}
+		if got := slices.Collect(r.All()); !slices.Equal(got, expected) {
+			t.Fatalf("Add(%d): expected All() to yield %v, got %v", i, expected, got)
+		}
+		if r.At(0) != expected[0] || r.At(r.Len()-1) != i {
+			t.Fatalf("Add(%d): unexpected At() results", i)
+		}
+		if v, _ := r.Oldest(); v != expected[0] {
+			t.Fatalf("Add(%d): expected Oldest() to return %d, got %d", i, expected[0], v)
+		}
+		if v, _ := r.Newest(); v != i {
+			t.Fatalf("Add(%d): expected Newest() to return %d, got %d", i, i, v)
+		}
+		slices.Reverse(expected)
+		if got := slices.Collect(r.Backward()); !slices.Equal(got, expected) {
+			t.Fatalf("Add(%d): expected Backward() to yield %v, got %v", i, expected, got)
+		}
+	}
+	if r.Total() != 10 || !r.IsFull() {
+		t.Errorf("Expected a full ring buffer with 10 total items, got %d", r.Total())
+	}
+
+	r.Clear()
+	if r.Len() != 0 || r.Total() != 0 || len(r.Snapshot()) != 0 {
+		t.Errorf("Expected an empty ring buffer after Clear()")
+	}
+}
+
+func TestSnapshotIsACopy(t *testing.T) {
+	r, _ := New[int](2)
+	r.Add(1)
+	r.Add(2)
+	snap := r.Snapshot()
+	r.Add(3)
+	if !slices.Equal(snap, []int{1, 2}) {
+		t.Errorf("Expected the snapshot to be unaffected by Add(), got %v", snap)
+	}
+	if got := r.AppendTo([]int{0}); !slices.Equal(got, []int{0, 2, 3}) {
+		t.Errorf("Expected [0 2 3], got %v", got)
+	}
+}
+
+func TestAtPanics(t *testing.T) {
+	r, _ := New[int](3)
+	r.Add(1)
+	for _, i := range []int{-1, 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected At(%d) to panic", i)
+				}
+			}()
+			r.At(i)
+		}()
+	}
+}
+
+func TestAddDoesNotAllocate(t *testing.T) {
+	r, _ := New[int](4)
+	if n := testing.AllocsPerRun(100, func() { r.Add(42) }); n != 0 {
+		t.Errorf("Expected Add() to not allocate, got %v allocations", n)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidJSONData is returned from set.Value.UnmarshalJSON() when the passed-in byte slice
+	// does not contain a JSON array
+	ErrInvalidJSONData = errors.Errorf("set.Value: can only decode JSON arrays")
+)
+
+// interface validations
+var _ json.Marshaler = Value[int]{}
+var _ json.Unmarshaler = (*Value[int])(nil)
+
+// MarshalJSON implements the json.Marshaler interface for set.Value values.
+//
+// Sets are encoded as a JSON array.  The items are sorted by their encoded JSON so that the output is
+// deterministic.  An empty set is encoded as [].
+func (s Value[T]) MarshalJSON() ([]byte, error) {
+	items := make([][]byte, 0, len(s.m))
+	for item := range s.m {
+		d, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, d)
+	}
+	slices.SortFunc(items, bytes.Compare)
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, d := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(d)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for set.Value values.
+//
+// The JSON value must be an array, and duplicate items are ignored.  The special JSON null token
+// sets s to an empty set.  The existing items of s are replaced.
+func (s *Value[T]) UnmarshalJSON(d []byte) error {
+	if bytes.Equal(d, []byte("null")) {
+		*s = Value[T]{}
+		return nil
+	}
+	if len(d) == 0 || d[0] != '[' {
+		return errors.Wrapf(ErrInvalidJSONData, "%.20s", d)
+	}
+	var items []T
+	if err := json.Unmarshal(d, &items); err != nil {
+		return err
+	}
+	*s = Of(items...)
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        interface{}
+		expected string
+	}{
+		{"empty", Value[int]{}, `[]`},
+		{"strings", Of("b", "c", "a"), `["a","b","c"]`},
+		{"ints", Of(10, 9, 1), `[1,10,9]`},
+		{"in a struct", struct {
+			Tags Value[string] `json:"tags"`
+		}{Of("x")}, `{"tags":["x"]}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := json.Marshal(tc.v)
+			if err != nil || string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.expected, data, err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Value[string]
+		err      error
+	}{
+		{"array", `["a","b","a"]`, Of("a", "b"), nil},
+		{"empty array", `[]`, Value[string]{}, nil},
+		{"null", `null`, Value[string]{}, nil},
+		{"object", `{"a":1}`, Of("z"), ErrInvalidJSONData},
+		{"string", `"a"`, Of("z"), ErrInvalidJSONData},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			s := Of("z")
+			err := json.Unmarshal([]byte(tc.data), &s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if !s.Equal(tc.expected) {
+				tt.Errorf("Expected %v, got %v", Sorted(tc.expected), Sorted(s))
+			}
+		})
+	}
+
+	s := Of(1)
+	if err := json.Unmarshal([]byte(`["a"]`), &s); err == nil {
+		t.Errorf("Expected an error for items of the wrong type")
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package set provides Value[T], a generic set of comparable values backed by a map.
+package set
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Value is an unordered set of distinct T values.
+//
+// The zero value is an empty set that is ready to use.  Copies of a Value may share storage, so use
+// Clone() to make an independent copy.  A Value is not safe for concurrent use without external
+// synchronization.
+type Value[T comparable] struct {
+	m map[T]struct{}
+}
+
+// Of returns a new set containing the specified items
+func Of[T comparable](items ...T) Value[T] {
+	s := Value[T]{m: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.m[item] = struct{}{}
+	}
+	return s
+}
+
+// Collect returns a new set containing the values from the specified sequence
+func Collect[T comparable](seq iter.Seq[T]) Value[T] {
+	s := Value[T]{m: make(map[T]struct{})}
+	for item := range seq {
+		s.m[item] = struct{}{}
+	}
+	return s
+}
+
+// Add adds the specified items to s
+func (s *Value[T]) Add(items ...T) {
+	if s.m == nil {
+		s.m = make(map[T]struct{}, len(items))
+	}
+	for _, item := range items {
+		s.m[item] = struct{}{}
+	}
+}
+
+// Remove removes the specified items from s.  Items that are not in s are ignored.
+func (s *Value[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s.m, item)
+	}
+}
+
+// Clear removes all items from s
+func (s *Value[T]) Clear() {
+	clear(s.m)
+}
+
+// Contains returns true if item is in s
+func (s Value[T]) Contains(item T) bool {
+	_, ok := s.m[item]
+	return ok
+}
+
+// Len returns the number of items in s
+func (s Value[T]) Len() int {
+	return len(s.m)
+}
+
+// IsEmpty returns true if s has no items
+func (s Value[T]) IsEmpty() bool {
+	return len(s.m) == 0
+}
+
+// All returns an iterator over the items in s, in no particular order
+func (s Value[T]) All() iter.Seq[T] {
+	return maps.Keys(s.m)
+}
+
+// Items returns the items in s as a slice, in no particular order
+func (s Value[T]) Items() []T {
+	return slices.AppendSeq(make([]T, 0, len(s.m)), maps.Keys(s.m))
+}
+
+// Sorted returns the items in s as a sorted slice
+func Sorted[T cmp.Ordered](s Value[T]) []T {
+	return slices.Sorted(maps.Keys(s.m))
+}
+
+// Clone returns a new set with the same items as s
+func (s Value[T]) Clone() Value[T] {
+	return Value[T]{m: maps.Clone(s.m)}
+}
+
+// Equal returns true if s and other contain the same items
+func (s Value[T]) Equal(other Value[T]) bool {
+	return len(s.m) == len(other.m) && s.IsSubsetOf(other)
+}
+
+// IsSubsetOf returns true if every item in s is also in other
+func (s Value[T]) IsSubsetOf(other Value[T]) bool {
+	if len(s.m) > len(other.m) {
+		return false
+	}
+	for item := range s.m {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns a new set with the items that are in s, other, or both
+func (s Value[T]) Union(other Value[T]) Value[T] {
+	res := Value[T]{m: make(map[T]struct{}, max(len(s.m), len(other.m)))}
+	maps.Copy(res.m, s.m)
+	maps.Copy(res.m, other.m)
+	return res
+}
+
+// Intersection returns a new set with the items that are in both s and other
+func (s Value[T]) Intersection(other Value[T]) Value[T] {
+	small, large := s, other
+	if len(small.m) > len(large.m) {
+		small, large = large, small
+	}
+	res := Value[T]{m: make(map[T]struct{})}
+	for item := range small.m {
+		if large.Contains(item) {
+			res.m[item] = struct{}{}
+		}
+	}
+	return res
+}
+
+// Difference returns a new set with the items that are in s but not in other
+func (s Value[T]) Difference(other Value[T]) Value[T] {
+	res := Value[T]{m: make(map[T]struct{})}
+	for item := range s.m {
+		if !other.Contains(item) {
+			res.m[item] = struct{}{}
+		}
+	}
+	return res
+}
+
+// SymmetricDifference returns a new set with the items that are in either s or other, but not both
+func (s Value[T]) SymmetricDifference(other Value[T]) Value[T] {
+	res := s.Difference(other)
+	for item := range other.m {
+		if !s.Contains(item) {
+			res.m[item] = struct{}{}
+		}
+	}
+	return res
+}
@@ -0,0 +1,106 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAddRemove(t *testing.T) {
+	var s Value[string]
+	if !s.IsEmpty() || s.Len() != 0 || s.Contains("a") {
+		t.Errorf("Expected the zero value to be an empty set")
+	}
+	s.Remove("a")
+	s.Add("a", "b", "a")
+	if s.Len() != 2 || !s.Contains("a") || !s.Contains("b") || s.Contains("c") {
+		t.Errorf("Expected {a, b}, got %v", Sorted(s))
+	}
+	s.Remove("a", "c")
+	if s.Len() != 1 || s.Contains("a") {
+		t.Errorf("Expected {b}, got %v", Sorted(s))
+	}
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Errorf("Expected an empty set, got %v", Sorted(s))
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	s := Of(3, 1, 2, 3)
+	if got := Sorted(s); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	c := Collect(slices.Values([]int{2, 2, 5}))
+	if got := Sorted(c); !slices.Equal(got, []int{2, 5}) {
+		t.Errorf("Expected [2 5], got %v", got)
+	}
+	items := s.Items()
+	slices.Sort(items)
+	if !slices.Equal(items, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", items)
+	}
+	n := 0
+	for range s.All() {
+		n++
+	}
+	if n != 3 {
+		t.Errorf("Expected All() to yield 3 items, got %d", n)
+	}
+}
+
+func TestClone(t *testing.T) {
+	s := Of(1, 2)
+	c := s.Clone()
+	c.Add(3)
+	s.Remove(1)
+	if !s.Equal(Of(2)) || !c.Equal(Of(1, 2, 3)) {
+		t.Errorf("Expected independent sets, got %v and %v", Sorted(s), Sorted(c))
+	}
+}
+
+func TestOperations(t *testing.T) {
+	cases := []struct {
+		name                                       string
+		a, b                                       Value[int]
+		union, intersection, difference, symmetric []int
+		subset, equal                              bool
+	}{
+		{"overlapping", Of(1, 2, 3), Of(2, 3, 4), []int{1, 2, 3, 4}, []int{2, 3}, []int{1}, []int{1, 4}, false, false},
+		{"disjoint", Of(1, 2), Of(3), []int{1, 2, 3}, []int{}, []int{1, 2}, []int{1, 2, 3}, false, false},
+		{"subset", Of(1), Of(1, 2), []int{1, 2}, []int{1}, []int{}, []int{2}, true, false},
+		{"equal", Of(1, 2), Of(2, 1), []int{1, 2}, []int{1, 2}, []int{}, []int{}, true, true},
+		{"empty", Value[int]{}, Of(1), []int{1}, []int{}, []int{}, []int{1}, true, false},
+		{"both empty", Value[int]{}, Value[int]{}, []int{}, []int{}, []int{}, []int{}, true, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			check := func(op string, got Value[int], expected []int) {
+				if s := Sorted(got); !slices.Equal(s, expected) {
+					tt.Errorf("Expected %s to be %v, got %v", op, expected, s)
+				}
+			}
+			check("union", tc.a.Union(tc.b), tc.union)
+			check("intersection", tc.a.Intersection(tc.b), tc.intersection)
+			check("difference", tc.a.Difference(tc.b), tc.difference)
+			check("symmetric difference", tc.a.SymmetricDifference(tc.b), tc.symmetric)
+			if got := tc.a.IsSubsetOf(tc.b); got != tc.subset {
+				tt.Errorf("Expected IsSubsetOf() to return %v, got %v", tc.subset, got)
+			}
+			if got := tc.a.Equal(tc.b); got != tc.equal {
+				tt.Errorf("Expected Equal() to return %v, got %v", tc.equal, got)
+			}
+		})
+	}
+
+	// results must not share storage with the operands
+	a, b := Of(1), Of(2)
+	u := a.Union(b)
+	u.Add(3)
+	if a.Contains(3) || b.Contains(3) {
+		t.Errorf("Expected Union() to return a new set")
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package sheetconv converts the date and time of day types in this module to and from the serial
+// numbers that spreadsheet applications such as Excel, Google Sheets and LibreOffice use to store
+// dates and times.
+//
+// A serial number counts days from an epoch, and the fractional part is the time of day as a fraction
+// of 24 hours, so 43660.5 is noon on 2019-07-14 in the default Excel date system.  Three date systems
+// are supported:
+//
+//   - Epoch1900 is the default Excel date system, where serial 1 is 1900-01-01.  Excel treats 1900 as
+//     a leap year for compatibility with Lotus 1-2-3, so serial 60 is the non-existent 1900-02-29 and
+//     every serial before it is off by one day compared to the other systems.
+//   - Epoch1904 is the date system used by older versions of Excel for Mac, where serial 0 is
+//     1904-01-01.
+//   - Epoch1899 is the date system used by Google Sheets and LibreOffice, where serial 0 is 1899-12-30
+//     and earlier dates have negative serials.  It agrees with Epoch1900 from 1900-03-01 onwards.
+//
+// Times of day are rounded to the nearest millisecond when converted from serial numbers, which is the
+// resolution that spreadsheets display, so that floating point error does not turn 13:45:30 into
+// 13:45:29.999999999.
+package sheetconv
+
+import (
+	"math"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrOutOfRange is returned when a value cannot be represented by the target type or date system
+	ErrOutOfRange = errors.Errorf("sheetconv: the value is out of range for the target type")
+	// ErrNonexistentDate is returned when converting serial 60 in the Epoch1900 date system, which is
+	// the fictitious date 1900-02-29
+	ErrNonexistentDate = errors.Errorf("sheetconv: serial 60 is 1900-02-29, which does not exist")
+	// ErrInvalidEpoch is returned when the specified date system is not one of the defined constants
+	ErrInvalidEpoch = errors.Errorf("sheetconv: the specified date system is not valid")
+)
+
+// Epoch identifies the date system used to number days
+type Epoch int
+
+// Supported date systems.  The zero value is the default Excel date system.
+const (
+	// Epoch1900 is the default Excel date system, including the 1900 leap year bug
+	Epoch1900 Epoch = iota
+	// Epoch1904 is the Excel for Mac date system, where serial 0 is 1904-01-01
+	Epoch1904
+	// Epoch1899 is the Google Sheets and LibreOffice date system, where serial 0 is 1899-12-30
+	Epoch1899
+)
+
+// IsValid returns true if e is one of the defined date systems
+func (e Epoch) IsValid() bool {
+	return e >= Epoch1900 && e <= Epoch1899
+}
+
+// String returns the name of the date system
+func (e Epoch) String() string {
+	switch e {
+	case Epoch1900:
+		return "1900"
+	case Epoch1904:
+		return "1904"
+	case Epoch1899:
+		return "1899"
+	default:
+		return "invalid"
+	}
+}
+
+var (
+	// base is the date.Value for serial 0 in the Epoch1899 date system, which the other systems are
+	// defined relative to
+	base = date.Must(date.FromUnits(1899, 12, 30))
+	// offset1904 is the number of days from base to 1904-01-01
+	offset1904 = int64(date.Must(date.FromUnits(1904, 1, 1)) - base)
+)
+
+const (
+	// leapBugSerial is the Epoch1900 serial of the non-existent 1900-02-29
+	leapBugSerial = 60
+	// msPerDay is the number of milliseconds in a day
+	msPerDay = int64(24 * time.Hour / time.Millisecond)
+)
+
+// DateToSerial converts a date.Value to a whole serial number in the specified date system.  If the date
+// is not valid, or is before the first date of the Epoch1900 or Epoch1904 systems, ErrOutOfRange is
+// returned.
+func DateToSerial(d date.Value, e Epoch) (int64, error) {
+	if !e.IsValid() {
+		return 0, errors.Wrapf(ErrInvalidEpoch, "%d", int(e))
+	}
+	if !d.IsValid() {
+		return 0, errors.Wrapf(ErrOutOfRange, "date.Value(%d)", int64(d))
+	}
+	n := int64(d - base)
+	switch e {
+	case Epoch1904:
+		n -= offset1904
+		if n < 0 {
+			return 0, errors.Wrapf(ErrOutOfRange, "%v is before the 1904 date system", d)
+		}
+	case Epoch1900:
+		if n <= leapBugSerial {
+			// dates before 1900-03-01 are one lower than in the Epoch1899 system, and serial 0 is not
+			// a real date
+			n--
+		}
+		if n < 1 {
+			return 0, errors.Wrapf(ErrOutOfRange, "%v is before the 1900 date system", d)
+		}
+	}
+	return n, nil
+}
+
+// DateFromSerial converts a whole serial number in the specified date system to a date.Value.  If the
+// serial is before the start of the date system or the resulting date is outside the range supported
+// by date.Value, ErrOutOfRange is returned.  Serial 60 in the Epoch1900 system returns
+// ErrNonexistentDate.
+func DateFromSerial(serial int64, e Epoch) (date.Value, error) {
+	if !e.IsValid() {
+		return date.Nil, errors.Wrapf(ErrInvalidEpoch, "%d", int(e))
+	}
+	n := serial
+	switch e {
+	case Epoch1904:
+		if serial < 0 {
+			return date.Nil, errors.Wrapf(ErrOutOfRange, "serial %d in the %v date system", serial, e)
+		}
+		n += offset1904
+	case Epoch1900:
+		if serial < 1 {
+			return date.Nil, errors.Wrapf(ErrOutOfRange, "serial %d in the %v date system", serial, e)
+		}
+		if serial == leapBugSerial {
+			return date.Nil, ErrNonexistentDate
+		}
+		if serial < leapBugSerial {
+			n++
+		}
+	}
+	if n > int64(date.Max-base) || n < int64(date.Min-base) {
+		return date.Nil, errors.Wrapf(ErrOutOfRange, "serial %d in the %v date system", serial, e)
+	}
+	return base + date.Value(n), nil
+}
+
+// TimeOfDayToSerial converts a timeofday.Value to a fraction of a day, in the range [0, 1)
+func TimeOfDayToSerial(t timeofday.Value) float64 {
+	return float64(timeofday.ToDuration(t)) / float64(24*time.Hour)
+}
+
+// TimeOfDayFromSerial converts a fraction of a day to a timeofday.Value, rounded to the nearest
+// millisecond.  Fractions that round up to 24:00:00 are converted to midnight.  If the fraction is not
+// in the range [0, 1), ErrOutOfRange is returned.
+func TimeOfDayFromSerial(f float64) (timeofday.Value, error) {
+	if !(f >= 0 && f < 1) {
+		return timeofday.Zero, errors.Wrapf(ErrOutOfRange, "%v is not a fraction of a day", f)
+	}
+	ms := int64(math.Round(f*float64(msPerDay))) % msPerDay
+	return timeofday.FromDuration(time.Duration(ms) * time.Millisecond)
+}
+
+// DateTimeToSerial combines a date.Value and a timeofday.Value into a serial number in the specified
+// date system.  As in spreadsheets, the time of day is always added to the whole serial, so noon on
+// 1899-12-29 is -0.5 in the Epoch1899 system.
+func DateTimeToSerial(d date.Value, t timeofday.Value, e Epoch) (float64, error) {
+	n, err := DateToSerial(d, e)
+	if err != nil {
+		return 0, err
+	}
+	return float64(n) + TimeOfDayToSerial(t), nil
+}
+
+// DateTimeFromSerial splits a serial number in the specified date system into a date.Value and a
+// timeofday.Value.  The time of day is rounded to the nearest millisecond, carrying into the next day
+// if it rounds up to 24:00:00.
+func DateTimeFromSerial(serial float64, e Epoch) (date.Value, timeofday.Value, error) {
+	if math.IsNaN(serial) || math.IsInf(serial, 0) || math.Abs(serial) > math.MaxInt32 {
+		return date.Nil, timeofday.Zero, errors.Wrapf(ErrOutOfRange, "serial %v", serial)
+	}
+	whole := math.Floor(serial)
+	ms := int64(math.Round((serial - whole) * float64(msPerDay)))
+	if ms == msPerDay {
+		whole, ms = whole+1, 0
+	}
+	d, err := DateFromSerial(int64(whole), e)
+	if err != nil {
+		return date.Nil, timeofday.Zero, err
+	}
+	t, err := timeofday.FromDuration(time.Duration(ms) * time.Millisecond)
+	if err != nil {
+		return date.Nil, timeofday.Zero, err
+	}
+	return d, t, nil
+}
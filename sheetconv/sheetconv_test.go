@@ -0,0 +1,162 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package sheetconv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestDateSerials(t *testing.T) {
+	cases := []struct {
+		name   string
+		d      date.Value
+		e      Epoch
+		serial int64
+	}{
+		{"1900 first day", date.Must(date.FromUnits(1900, 1, 1)), Epoch1900, 1},
+		{"1900 before leap bug", date.Must(date.FromUnits(1900, 2, 28)), Epoch1900, 59},
+		{"1900 after leap bug", date.Must(date.FromUnits(1900, 3, 1)), Epoch1900, 61},
+		{"1900 recent", date.Must(date.FromUnits(2019, 7, 14)), Epoch1900, 43660},
+		{"1900 max", date.Max, Epoch1900, 2958465},
+		{"1904 first day", date.Must(date.FromUnits(1904, 1, 1)), Epoch1904, 0},
+		{"1904 recent", date.Must(date.FromUnits(2019, 7, 14)), Epoch1904, 42198},
+		{"1899 zero", date.Must(date.FromUnits(1899, 12, 30)), Epoch1899, 0},
+		{"1899 negative", date.Must(date.FromUnits(1899, 12, 29)), Epoch1899, -1},
+		{"1899 early 1900", date.Must(date.FromUnits(1900, 1, 1)), Epoch1899, 2},
+		{"1899 recent", date.Must(date.FromUnits(2019, 7, 14)), Epoch1899, 43660},
+		{"1899 min", date.Min, Epoch1899, -53688},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := DateToSerial(tc.d, tc.e)
+			if err != nil || got != tc.serial {
+				tt.Errorf("Expected %d, got %d (err = %v)", tc.serial, got, err)
+			}
+			d, err := DateFromSerial(tc.serial, tc.e)
+			if err != nil || d != tc.d {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.d, d, err)
+			}
+		})
+	}
+}
+
+func TestDateSerialErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		f    func() error
+		err  error
+	}{
+		{"nil date", func() error { _, err := DateToSerial(date.Nil, Epoch1900); return err }, ErrOutOfRange},
+		{"before 1900", func() error {
+			_, err := DateToSerial(date.Must(date.FromUnits(1899, 12, 31)), Epoch1900)
+			return err
+		}, ErrOutOfRange},
+		{"before 1904", func() error {
+			_, err := DateToSerial(date.Must(date.FromUnits(1903, 12, 31)), Epoch1904)
+			return err
+		}, ErrOutOfRange},
+		{"invalid epoch", func() error { _, err := DateToSerial(date.Max, Epoch(42)); return err }, ErrInvalidEpoch},
+		{"leap bug", func() error { _, err := DateFromSerial(60, Epoch1900); return err }, ErrNonexistentDate},
+		{"1900 zero", func() error { _, err := DateFromSerial(0, Epoch1900); return err }, ErrOutOfRange},
+		{"1904 negative", func() error { _, err := DateFromSerial(-1, Epoch1904); return err }, ErrOutOfRange},
+		{"after max", func() error { _, err := DateFromSerial(2958466, Epoch1900); return err }, ErrOutOfRange},
+		{"before min", func() error { _, err := DateFromSerial(-53689, Epoch1899); return err }, ErrOutOfRange},
+		{"huge", func() error { _, err := DateFromSerial(math.MaxInt64, Epoch1904); return err }, ErrOutOfRange},
+		{"invalid epoch from serial", func() error { _, err := DateFromSerial(1, Epoch(-1)); return err }, ErrInvalidEpoch},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if err := tc.f(); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDaySerials(t *testing.T) {
+	cases := []struct {
+		name     string
+		f        float64
+		expected timeofday.Value
+	}{
+		{"midnight", 0, timeofday.Zero},
+		{"noon", 0.5, timeofday.Must(timeofday.FromUnits(12, 0, 0, 0))},
+		{"afternoon", 49530.0 / 86400, timeofday.Must(timeofday.FromUnits(13, 45, 30, 0))},
+		{"rounded down", 49530.0/86400 - 1e-12, timeofday.Must(timeofday.FromUnits(13, 45, 30, 0))},
+		{"milliseconds", 0.25 + 0.25/86400, timeofday.Must(timeofday.FromUnits(6, 0, 0, 250000000))},
+		{"rounds to midnight", 1 - 1e-12, timeofday.Zero},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := TimeOfDayFromSerial(tc.f)
+			if err != nil || got != tc.expected {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.expected, got, err)
+			}
+			if back, _ := TimeOfDayFromSerial(TimeOfDayToSerial(got)); back != got {
+				tt.Errorf("Expected %v to round trip, got %v", got, back)
+			}
+		})
+	}
+	for _, f := range []float64{-0.1, 1, math.NaN()} {
+		if _, err := TimeOfDayFromSerial(f); errors.Cause(err) != ErrOutOfRange {
+			t.Errorf("Expected %v for %v, got %v", ErrOutOfRange, f, err)
+		}
+	}
+}
+
+func TestDateTimeSerials(t *testing.T) {
+	noon := timeofday.Must(timeofday.FromUnits(12, 0, 0, 0))
+	cases := []struct {
+		name   string
+		d      date.Value
+		t      timeofday.Value
+		e      Epoch
+		serial float64
+	}{
+		{"1900", date.Must(date.FromUnits(2019, 7, 14)), noon, Epoch1900, 43660.5},
+		{"1900 before leap bug", date.Must(date.FromUnits(1900, 1, 1)), timeofday.Must(timeofday.FromUnits(6, 0, 0, 0)), Epoch1900, 1.25},
+		{"1904", date.Must(date.FromUnits(2019, 7, 14)), noon, Epoch1904, 42198.5},
+		{"1899 negative", date.Must(date.FromUnits(1899, 12, 29)), timeofday.Must(timeofday.FromUnits(18, 0, 0, 0)), Epoch1899, -0.25},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := DateTimeToSerial(tc.d, tc.t, tc.e)
+			if err != nil || got != tc.serial {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.serial, got, err)
+			}
+			d, tod, err := DateTimeFromSerial(tc.serial, tc.e)
+			if err != nil || d != tc.d || tod != tc.t {
+				tt.Errorf("Expected (%v, %v), got (%v, %v) (err = %v)", tc.d, tc.t, d, tod, err)
+			}
+		})
+	}
+
+	d, tod, err := DateTimeFromSerial(43660.9999999999, Epoch1900)
+	if err != nil || d != date.Must(date.FromUnits(2019, 7, 15)) || tod != timeofday.Zero {
+		t.Errorf("Expected the time to carry into 2019-07-15, got (%v, %v) (err = %v)", d, tod, err)
+	}
+	if _, _, err := DateTimeFromSerial(60.5, Epoch1900); errors.Cause(err) != ErrNonexistentDate {
+		t.Errorf("Expected %v, got %v", ErrNonexistentDate, err)
+	}
+	if _, _, err := DateTimeFromSerial(math.Inf(1), Epoch1900); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := DateTimeToSerial(date.Nil, timeofday.Zero, Epoch1900); errors.Cause(err) != ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+}
+
+func TestEpochString(t *testing.T) {
+	for e, expected := range map[Epoch]string{Epoch1900: "1900", Epoch1904: "1904", Epoch1899: "1899", Epoch(9): "invalid"} {
+		if got := e.String(); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package snowflake
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidText is returned when a string is not a valid decimal or base62 ID
+	ErrInvalidText = errors.Errorf("snowflake: the specified text is not a valid ID")
+	// ErrInvalidTextData is returned from snowflake.Value.UnmarshalJSON() when the passed-in byte
+	// slice does not contain a string or an integer
+	ErrInvalidTextData = errors.Errorf("snowflake.Value: can only decode JSON strings or integers")
+)
+
+// base62Alphabet contains the base62 digits in ASCII order, so that base62 strings of equal length
+// sort in the same order as the IDs they encode
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// interface validations
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+// Parse parses the decimal form of an ID, such as "1541815603606036480".  An empty string is parsed as
+// snowflake.Nil.  Negative values are rejected.
+func Parse(s string) (Value, error) {
+	if s == "" {
+		return Nil, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 || s[0] == '+' {
+		return Nil, errors.Wrapf(ErrInvalidText, "%q", s)
+	}
+	return Value(n), nil
+}
+
+// ParseBase62 parses the base62 form of an ID, as returned by Base62().  An empty string is parsed
+// as snowflake.Nil.
+func ParseBase62(s string) (Value, error) {
+	if s == "" {
+		return Nil, nil
+	}
+	if len(s) > 11 {
+		return Nil, errors.Wrapf(ErrInvalidText, "%q", s)
+	}
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		d := base62Digit(s[i])
+		if d < 0 {
+			return Nil, errors.Wrapf(ErrInvalidText, "%q", s)
+		}
+		if n > (1<<63-1-uint64(d))/62 {
+			return Nil, errors.Wrapf(ErrInvalidText, "%q", s)
+		}
+		n = n*62 + uint64(d)
+	}
+	return Value(n), nil
+}
+
+// String implements fmt.Stringer for snowflake.Value values.
+//
+// The returned string is the decimal form of the ID.
+func (v Value) String() string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// Base62 returns the base62 form of v, using the digits 0-9, A-Z and a-z, which is at most 11
+// characters long
+func (v Value) Base62() string {
+	if v <= 0 {
+		return "0"
+	}
+	var buf [11]byte
+	i := len(buf)
+	for n := uint64(v); n > 0; n /= 62 {
+		i--
+		buf[i] = base62Alphabet[n%62]
+	}
+	return string(buf[i:])
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for snowflake.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for snowflake.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	return strconv.AppendInt(b, int64(v), 10), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for snowflake.Value values.
+//
+// The text is parsed by Parse().
+func (v *Value) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for snowflake.Value values.
+//
+// Values are encoded as a JSON string containing the decimal form of the ID, rather than as a JSON
+// number, because JavaScript numbers cannot represent every 64-bit integer exactly.
+func (v Value) MarshalJSON() ([]byte, error) {
+	res := append(make([]byte, 0, 21), '"')
+	res = strconv.AppendInt(res, int64(v), 10)
+	return append(res, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for snowflake.Value values.
+//
+// Both JSON strings and JSON integers are accepted.  If the value is the special JSON null token, v is
+// set to snowflake.Nil.
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		*v = Nil
+		return nil
+	}
+	if len(p) > 0 && p[0] != '"' {
+		return v.UnmarshalText(p)
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return v.UnmarshalText([]byte(s))
+}
+
+// base62Digit returns the value of a base62 digit, or -1 if c is not one
+func base62Digit(c byte) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c - '0')
+	case 'A' <= c && c <= 'Z':
+		return int(c-'A') + 10
+	case 'a' <= c && c <= 'z':
+		return int(c-'a') + 36
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package snowflake
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected Value
+		err      error
+	}{
+		{"empty", "", Nil, nil},
+		{"zero", "0", Nil, nil},
+		{"typical", "1541815603606036480", 1541815603606036480, nil},
+		{"max", "9223372036854775807", 1<<63 - 1, nil},
+		{"overflow", "9223372036854775808", Nil, ErrInvalidText},
+		{"negative", "-1", Nil, ErrInvalidText},
+		{"plus sign", "+1", Nil, ErrInvalidText},
+		{"not a number", "abc", Nil, ErrInvalidText},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestBase62(t *testing.T) {
+	cases := []struct {
+		name string
+		v    Value
+		s    string
+	}{
+		{"zero", Nil, "0"},
+		{"one", 1, "1"},
+		{"61", 61, "z"},
+		{"62", 62, "10"},
+		{"max", 1<<63 - 1, "AzL8n0Y58m7"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := tc.v.Base62(); got != tc.s {
+				tt.Errorf("Expected %s, got %s", tc.s, got)
+			}
+			if got, err := ParseBase62(tc.s); err != nil || got != tc.v {
+				tt.Errorf("Expected %d, got %d (err = %v)", tc.v, got, err)
+			}
+		})
+	}
+
+	for _, s := range []string{"AzL8n0Y58m8", "zzzzzzzzzzz", "000000000000", "abc-"} {
+		if _, err := ParseBase62(s); errors.Cause(err) != ErrInvalidText {
+			t.Errorf("Expected ErrInvalidText for %q, got %v", s, err)
+		}
+	}
+	if v, err := ParseBase62(""); err != nil || v != Nil {
+		t.Errorf("Expected an empty string to parse as Nil")
+	}
+
+	// base62 strings of the same length sort in ID order
+	ids := []Value{Must(FromParts(1<<40, 3, 2)), Must(FromParts(1<<40+1, 0, 0)), Must(FromParts(1<<40+1, 0, 1))}
+	strs := []string{ids[0].Base62(), ids[1].Base62(), ids[2].Base62()}
+	if !sort.StringsAreSorted(strs) {
+		t.Errorf("Expected %v to be sorted", strs)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		err      error
+	}{
+		{"string", `"1541815603606036480"`, 1541815603606036480, nil},
+		{"number", `1541815603606036480`, 1541815603606036480, nil},
+		{"null", `null`, Nil, nil},
+		{"boolean", `true`, Nil, ErrInvalidText},
+		{"object", `{}`, Nil, ErrInvalidText},
+		{"invalid", `"abc"`, Nil, ErrInvalidText},
+		{"fraction", `1.5`, Nil, ErrInvalidText},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Value(42)
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && v != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, v)
+			}
+		})
+	}
+
+	data, err := json.Marshal(Value(1541815603606036480))
+	if err != nil || string(data) != `"1541815603606036480"` {
+		t.Errorf("Unexpected encoding %s (err = %v)", data, err)
+	}
+	text, _ := Value(42).MarshalText()
+	var got Value
+	if err := got.UnmarshalText(text); err != nil || got != 42 {
+		t.Errorf("Expected 42, got %d (err = %v)", got, err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package snowflake
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dylan-bourque/go-types/clock"
+	"github.com/pkg/errors"
+)
+
+// Generator creates IDs for a single node that strictly increase.
+//
+// Within a millisecond, the sequence number of each new ID is the previous one plus one.  When the
+// sequence number is exhausted, or the clock moves backwards, the generator continues from the last
+// timestamp it used rather than waiting for the clock, so that it never blocks and ordering is
+// preserved.  The timestamps of the IDs catch up with the clock once the rate of requests falls below
+// 4096 per millisecond.
+//
+// Generators are created by NewGenerator() and are safe for concurrent use.  Each concurrently running
+// generator must use a different node number to guarantee unique IDs.
+type Generator struct {
+	node  int64
+	epoch time.Time
+	clock clock.Clock
+
+	mu      sync.Mutex
+	last    Value
+	started bool // false until the first ID is created, since it may be Nil
+}
+
+// NewGenerator returns a Generator for the specified node that uses snowflake.Epoch and reads the
+// current time from c.  If c is nil, clock.System is used.
+func NewGenerator(node int64, c clock.Clock) (*Generator, error) {
+	return NewGeneratorWithEpoch(node, Epoch, c)
+}
+
+// NewGeneratorWithEpoch returns a Generator for the specified node that creates IDs with timestamps
+// relative to epoch and reads the current time from c.  If c is nil, clock.System is used.
+func NewGeneratorWithEpoch(node int64, epoch time.Time, c clock.Clock) (*Generator, error) {
+	if node < 0 || node > MaxNode {
+		return nil, errors.Wrapf(ErrInvalidNode, "%d", node)
+	}
+	if c == nil {
+		c = clock.System
+	}
+	return &Generator{node: node, epoch: epoch, clock: c}, nil
+}
+
+// Node returns the node number of the IDs created by g
+func (g *Generator) Node() int64 {
+	return g.node
+}
+
+// Epoch returns the epoch of the timestamps in the IDs created by g
+func (g *Generator) Epoch() time.Time {
+	return g.epoch
+}
+
+// New returns the next ID, which is guaranteed to be greater than every ID previously returned by g.
+// If the current time is before the epoch or too far after it, ErrInvalidTimestamp is returned.
+func (g *Generator) New() (Value, error) {
+	now := g.clock.Now()
+	if now.Before(g.epoch) {
+		return Nil, errors.Wrapf(ErrInvalidTimestamp, "%v is before the epoch", now)
+	}
+	ms := now.Sub(g.epoch).Milliseconds()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	seq := int64(0)
+	if last := g.last.Timestamp(); g.started && ms <= last {
+		ms, seq = last, g.last.Sequence()+1
+		if seq > MaxSequence {
+			ms, seq = ms+1, 0
+		}
+	}
+	next, err := FromParts(ms, g.node, seq)
+	if err != nil {
+		return Nil, err
+	}
+	g.last, g.started = next, true
+	return next, nil
+}
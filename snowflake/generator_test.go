@@ -0,0 +1,121 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package snowflake
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/clock"
+	"github.com/pkg/errors"
+)
+
+func TestNewGenerator(t *testing.T) {
+	for _, node := range []int64{-1, MaxNode + 1} {
+		if _, err := NewGenerator(node, nil); errors.Cause(err) != ErrInvalidNode {
+			t.Errorf("Expected ErrInvalidNode for %d, got %v", node, err)
+		}
+	}
+	g, err := NewGenerator(7, nil)
+	if err != nil || g.Node() != 7 || !g.Epoch().Equal(Epoch) || g.clock != clock.System {
+		t.Errorf("Unexpected generator state (err = %v)", err)
+	}
+}
+
+func TestGeneratorSequence(t *testing.T) {
+	fake := clock.NewFake(Epoch.Add(time.Second))
+	g, _ := NewGenerator(5, fake)
+
+	first, err := g.New()
+	if err != nil || first.Timestamp() != 1000 || first.Node() != 5 || first.Sequence() != 0 {
+		t.Fatalf("Unexpected first ID (%d, %d, %d) (err = %v)", first.Timestamp(), first.Node(), first.Sequence(), err)
+	}
+	if !first.Time().Equal(Epoch.Add(time.Second)) {
+		t.Errorf("Expected the ID time to match the clock, got %v", first.Time())
+	}
+
+	// IDs in the same millisecond increment the sequence
+	second, _ := g.New()
+	if second.Timestamp() != 1000 || second.Sequence() != 1 {
+		t.Errorf("Expected (1000, 1), got (%d, %d)", second.Timestamp(), second.Sequence())
+	}
+
+	// a new millisecond resets the sequence
+	fake.Advance(time.Millisecond)
+	third, _ := g.New()
+	if third.Timestamp() != 1001 || third.Sequence() != 0 {
+		t.Errorf("Expected (1001, 0), got (%d, %d)", third.Timestamp(), third.Sequence())
+	}
+
+	// the clock moving backwards does not break ordering
+	fake.Advance(-time.Second)
+	fourth, _ := g.New()
+	if fourth.Timestamp() != 1001 || fourth.Sequence() != 1 {
+		t.Errorf("Expected (1001, 1), got (%d, %d)", fourth.Timestamp(), fourth.Sequence())
+	}
+}
+
+func TestGeneratorSequenceOverflow(t *testing.T) {
+	fake := clock.NewFake(Epoch)
+	g, _ := NewGenerator(0, fake)
+	prev, _ := g.New()
+	if prev != Nil {
+		t.Fatalf("Expected the very first ID to be Nil, got %d", prev)
+	}
+	for i := 0; i < 3*(int(MaxSequence)+1); i++ {
+		next, err := g.New()
+		if err != nil || next <= prev {
+			t.Fatalf("Expected %d to be greater than %d (err = %v)", next, prev, err)
+		}
+		prev = next
+	}
+	// three milliseconds worth of sequence numbers were borrowed from the future
+	if prev.Timestamp() != 3 || prev.Sequence() != 0 {
+		t.Errorf("Expected (3, 0), got (%d, %d)", prev.Timestamp(), prev.Sequence())
+	}
+}
+
+func TestGeneratorErrors(t *testing.T) {
+	epoch := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(epoch.Add(-time.Millisecond))
+	g, _ := NewGeneratorWithEpoch(0, epoch, fake)
+	if _, err := g.New(); errors.Cause(err) != ErrInvalidTimestamp {
+		t.Errorf("Expected ErrInvalidTimestamp before the epoch, got %v", err)
+	}
+	fake.Set(epoch.Add(time.Duration(MaxTimestamp+1) * time.Millisecond))
+	if _, err := g.New(); errors.Cause(err) != ErrInvalidTimestamp {
+		t.Errorf("Expected ErrInvalidTimestamp after the last representable time, got %v", err)
+	}
+}
+
+func TestGeneratorConcurrentUse(t *testing.T) {
+	g, _ := NewGenerator(1, nil)
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = make(map[Value]bool)
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				v, err := g.New()
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					return
+				}
+				mu.Lock()
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(seen) != 8000 {
+		t.Errorf("Expected 8000 unique IDs, got %d", len(seen))
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package snowflake
+
+import (
+	"log/slog"
+)
+
+// interface validations
+var _ slog.LogValuer = (*Value)(nil)
+
+// LogValue implements the slog.LogValuer interface for snowflake.Value values, so structured logs
+// show the decimal string returned by String(), which matches the JSON encoding.
+func (v Value) LogValue() slog.Value {
+	return slog.StringValue(v.String())
+}
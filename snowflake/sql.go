@@ -0,0 +1,52 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package snowflake
+
+import (
+	"database/sql/driver"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// a snowflake.Value value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a snowflake.Value value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for snowflake.Value values.  The returned value is an
+// int64, so IDs are stored in BIGINT columns.
+func (v Value) Value() (driver.Value, error) {
+	return int64(v), nil
+}
+
+// Scan implements the sql.Scanner interface for snowflake.Value values.
+//
+// Integers are used as is, and strings and byte slices are handled by UnmarshalText().  All other
+// values will return an error
+func (v *Value) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case int64:
+		if tv < 0 {
+			return errors.Wrapf(ErrInvalidText, "%d", tv)
+		}
+		*v = Value(tv)
+		return nil
+	case []byte:
+		return v.UnmarshalText(tv)
+	case string:
+		return v.UnmarshalText([]byte(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
+
+// NullID can be used with the standard sql package to represent a snowflake.Value value that can be
+// NULL in the database.  The wrapped value is in the V field.
+type NullID = null.Value[Value]
@@ -0,0 +1,63 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package snowflake
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"int64", int64(1541815603606036480), 1541815603606036480, nil},
+		{"string", "42", 42, nil},
+		{"bytes", []byte("42"), 42, nil},
+		{"negative", int64(-1), Nil, ErrInvalidText},
+		{"invalid string", "abc", Nil, ErrInvalidText},
+		{"null", nil, Nil, ErrUnsupportedSourceType},
+		{"unsupported type", 4.2, Nil, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestNullID(t *testing.T) {
+	cases := []struct {
+		name   string
+		v      NullID
+		sqlVal driver.Value
+	}{
+		{"null", NullID{}, nil},
+		{"valid", NullID{V: 42, Valid: true}, int64(42)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got, err := tc.v.Value(); err != nil || got != tc.sqlVal {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.sqlVal, got, err)
+			}
+			var scanned NullID
+			if err := scanned.Scan(tc.sqlVal); err != nil || scanned != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, scanned, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package snowflake provides Value, a 64-bit, time-sortable identifier in the layout popularized by
+// Twitter's Snowflake service, and a Generator that creates them.
+package snowflake
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Value represents a Snowflake ID, which is a positive 64-bit integer that contains a 41-bit
+// millisecond timestamp, a 10-bit node number and a 12-bit sequence number, from the most to the least
+// significant bits.  IDs sort in the same order as their timestamps, both numerically and in their
+// decimal and base62 text forms with equal lengths.
+//
+// The timestamp is relative to an epoch, which is snowflake.Epoch unless otherwise specified, so the
+// IDs created with one epoch can represent about 69 years of timestamps.
+//
+// The zero value is snowflake.Nil.
+type Value int64
+
+const (
+	// Nil represents a nil/null/undefined ID
+	Nil Value = 0
+
+	// TimestampBits is the number of bits used for the millisecond timestamp
+	TimestampBits = 41
+	// NodeBits is the number of bits used for the node number
+	NodeBits = 10
+	// SequenceBits is the number of bits used for the sequence number
+	SequenceBits = 12
+
+	// MaxTimestamp is the largest millisecond timestamp, relative to the epoch, that can be stored
+	MaxTimestamp int64 = 1<<TimestampBits - 1
+	// MaxNode is the largest node number
+	MaxNode int64 = 1<<NodeBits - 1
+	// MaxSequence is the largest sequence number
+	MaxSequence int64 = 1<<SequenceBits - 1
+)
+
+var (
+	// Epoch is the default epoch for timestamps, 2010-11-04T01:42:54.657Z, which is the epoch used by
+	// Twitter
+	Epoch = time.UnixMilli(1288834974657).UTC()
+)
+
+var (
+	// ErrInvalidTimestamp is returned when a time is before the epoch or more than MaxTimestamp
+	// milliseconds after it
+	ErrInvalidTimestamp = errors.Errorf("snowflake: the timestamp is outside of the range that can be stored in an ID")
+	// ErrInvalidNode is returned when a node number is negative or greater than MaxNode
+	ErrInvalidNode = errors.Errorf("snowflake: the node number must be between 0 and 1023")
+	// ErrInvalidSequence is returned when a sequence number is negative or greater than MaxSequence
+	ErrInvalidSequence = errors.Errorf("snowflake: the sequence number must be between 0 and 4095")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in snowflake.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// FromParts returns the ID with the specified millisecond timestamp, relative to the epoch, node number
+// and sequence number.
+func FromParts(ms, node, seq int64) (Value, error) {
+	switch {
+	case ms < 0 || ms > MaxTimestamp:
+		return Nil, errors.Wrapf(ErrInvalidTimestamp, "%d", ms)
+	case node < 0 || node > MaxNode:
+		return Nil, errors.Wrapf(ErrInvalidNode, "%d", node)
+	case seq < 0 || seq > MaxSequence:
+		return Nil, errors.Wrapf(ErrInvalidSequence, "%d", seq)
+	}
+	return Value(ms<<(NodeBits+SequenceBits) | node<<SequenceBits | seq), nil
+}
+
+// IsNil returns true if v is snowflake.Nil
+func (v Value) IsNil() bool {
+	return v == Nil
+}
+
+// IsValid returns true if v is a positive ID
+func (v Value) IsValid() bool {
+	return v > 0
+}
+
+// Timestamp returns the millisecond timestamp of v, relative to the epoch
+func (v Value) Timestamp() int64 {
+	return int64(v) >> (NodeBits + SequenceBits)
+}
+
+// Node returns the node number of v
+func (v Value) Node() int64 {
+	return int64(v) >> SequenceBits & MaxNode
+}
+
+// Sequence returns the sequence number of v
+func (v Value) Sequence() int64 {
+	return int64(v) & MaxSequence
+}
+
+// Time returns the time of v, in UTC, assuming it was created with the default snowflake.Epoch
+func (v Value) Time() time.Time {
+	return v.TimeSince(Epoch)
+}
+
+// TimeSince returns the time of v, in UTC, assuming it was created with the specified epoch
+func (v Value) TimeSince(epoch time.Time) time.Time {
+	return epoch.Add(time.Duration(v.Timestamp()) * time.Millisecond).UTC()
+}
+
+// Compare returns an integer comparing two IDs.  The result will be 0 if v1 == v2, -1 if v1 < v2,
+// and +1 if v1 > v2.
+func Compare(v1, v2 Value) int {
+	switch {
+	case v1 < v2:
+		return -1
+	case v1 > v2:
+		return 1
+	default:
+		return 0
+	}
+}
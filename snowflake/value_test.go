@@ -0,0 +1,67 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package snowflake
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestFromParts(t *testing.T) {
+	cases := []struct {
+		name          string
+		ms, node, seq int64
+		expected      Value
+		err           error
+	}{
+		{"zero", 0, 0, 0, Nil, nil},
+		{"typical", 367597485448, 378, 0, 1541815603606036480, nil},
+		{"max", MaxTimestamp, MaxNode, MaxSequence, 1<<63 - 1, nil},
+		{"negative timestamp", -1, 0, 0, Nil, ErrInvalidTimestamp},
+		{"timestamp too large", MaxTimestamp + 1, 0, 0, Nil, ErrInvalidTimestamp},
+		{"negative node", 0, -1, 0, Nil, ErrInvalidNode},
+		{"node too large", 0, MaxNode + 1, 0, Nil, ErrInvalidNode},
+		{"negative sequence", 0, 0, -1, Nil, ErrInvalidSequence},
+		{"sequence too large", 0, 0, MaxSequence + 1, Nil, ErrInvalidSequence},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := FromParts(tc.ms, tc.node, tc.seq)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Fatalf("Expected %d, got %d", tc.expected, v)
+			}
+			if err == nil && (v.Timestamp() != tc.ms || v.Node() != tc.node || v.Sequence() != tc.seq) {
+				tt.Errorf("Expected (%d, %d, %d), got (%d, %d, %d)", tc.ms, tc.node, tc.seq, v.Timestamp(), v.Node(), v.Sequence())
+			}
+		})
+	}
+}
+
+func TestTime(t *testing.T) {
+	v := Value(1541815603606036480)
+	expected := time.Date(2022, time.June, 28, 16, 7, 40, 105000000, time.UTC)
+	if got := v.Time(); !got.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	epoch := time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if got := Must(FromParts(1500, 0, 0)).TimeSince(epoch); !got.Equal(epoch.Add(1500 * time.Millisecond)) {
+		t.Errorf("Expected 1.5s after the epoch, got %v", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a, b := Must(FromParts(1, MaxNode, MaxSequence)), Must(FromParts(2, 0, 0))
+	if Compare(a, b) != -1 || Compare(b, a) != 1 || Compare(a, a) != 0 {
+		t.Errorf("Unexpected Compare() results")
+	}
+	if !Nil.IsNil() || Nil.IsValid() || !a.IsValid() || Value(-1).IsValid() {
+		t.Errorf("Unexpected IsNil()/IsValid() results")
+	}
+}
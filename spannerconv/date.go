@@ -0,0 +1,62 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package spannerconv
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/dylan-bourque/go-types/civilconv"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+// Date adapts a date.Value to a Spanner DATE column.  date.Nil is written and read as NULL.
+type Date date.Value
+
+// EncodeSpanner implements the spanner.Encoder interface.  Valid dates are encoded as a civil.Date and
+// date.Nil as a nil *civil.Date, which the Spanner client writes as a NULL DATE.
+func (d Date) EncodeSpanner() (interface{}, error) {
+	v := date.Value(d)
+	if v == date.Nil {
+		return (*civil.Date)(nil), nil
+	}
+	if !v.IsValid() {
+		return nil, errors.Wrapf(civilconv.ErrOutOfRange, "date.Value(%d)", int64(v))
+	}
+	return civilconv.DateToCivil(v), nil
+}
+
+// DecodeSpanner implements the spanner.Decoder interface.  The Spanner client passes DATE columns as
+// strings formatted as YYYY-MM-DD, and NULL as nil.  civil.Date and *civil.Date values are also accepted.
+func (d *Date) DecodeSpanner(input interface{}) error {
+	var (
+		cd  civil.Date
+		err error
+	)
+	switch tv := input.(type) {
+	case nil:
+		*d = Date(date.Nil)
+		return nil
+	case string:
+		if cd, err = civil.ParseDate(tv); err != nil {
+			return errors.Wrapf(civilconv.ErrOutOfRange, "%q", tv)
+		}
+	case civil.Date:
+		cd = tv
+	case *civil.Date:
+		if tv == nil {
+			*d = Date(date.Nil)
+			return nil
+		}
+		cd = *tv
+	default:
+		return unsupportedSourceType.Of(input)
+	}
+	v, err := civilconv.DateFromCivil(cd)
+	if err != nil {
+		return err
+	}
+	*d = Date(v)
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package spannerconv
+
+import (
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/dylan-bourque/go-types/civilconv"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/pkg/errors"
+)
+
+func TestDateEncodeSpanner(t *testing.T) {
+	got, err := Date(date.Must(date.FromUnits(2024, 7, 14))).EncodeSpanner()
+	if err != nil || got != (civil.Date{Year: 2024, Month: 7, Day: 14}) {
+		t.Errorf("Expected 2024-07-14, got %v (err = %v)", got, err)
+	}
+	got, err = Date(date.Nil).EncodeSpanner()
+	if p, ok := got.(*civil.Date); err != nil || !ok || p != nil {
+		t.Errorf("Expected a nil *civil.Date, got %#v (err = %v)", got, err)
+	}
+	if _, err = Date(date.Max + 1).EncodeSpanner(); errors.Cause(err) != civilconv.ErrOutOfRange {
+		t.Errorf("Expected %v, got %v", civilconv.ErrOutOfRange, err)
+	}
+}
+
+func TestDateDecodeSpanner(t *testing.T) {
+	d := date.Must(date.FromUnits(2024, 7, 14))
+	cases := []struct {
+		name     string
+		input    interface{}
+		expected date.Value
+		err      error
+	}{
+		{"string", "2024-07-14", d, nil},
+		{"civil date", civil.Date{Year: 2024, Month: 7, Day: 14}, d, nil},
+		{"civil date pointer", &civil.Date{Year: 2024, Month: 7, Day: 14}, d, nil},
+		{"nil", nil, date.Nil, nil},
+		{"nil civil date pointer", (*civil.Date)(nil), date.Nil, nil},
+		{"invalid string", "2024-02-30", date.Min, civilconv.ErrOutOfRange},
+		{"out of range", "1600-01-01", date.Min, civilconv.ErrOutOfRange},
+		{"unsupported type", int64(42), date.Min, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v := Date(date.Min)
+			err := v.DecodeSpanner(tc.input)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if date.Value(v) != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, date.Value(v))
+			}
+		})
+	}
+}
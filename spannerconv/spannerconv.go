@@ -0,0 +1,28 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package spannerconv provides adapters that implement the Encoder and Decoder interfaces of the
+// Google Cloud Spanner client (cloud.google.com/go/spanner) for the date and time of day types in
+// this module, so values can be written to and read from Spanner columns without manual conversion.
+//
+// The adapters are defined types with the same underlying type as the values they wrap, so converting
+// a value, or a pointer to one, is free:
+//
+//	row.Columns((*spannerconv.Date)(&order.ShipDate))
+//
+// The Spanner client package is not imported.  Its interfaces are satisfied by method signature alone.
+package spannerconv
+
+import (
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by DecodeSpanner() when the provided value cannot be
+	// converted to the target type
+	ErrUnsupportedSourceType = errors.Errorf("spannerconv: cannot convert the source data to the target type")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
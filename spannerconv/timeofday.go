@@ -0,0 +1,43 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package spannerconv
+
+import (
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+// TimeOfDay adapts a timeofday.Value to a Spanner STRING column.  Spanner has no time of day type, so
+// values are stored as hh:mm:ss with optional fractional seconds, which sorts in time order.
+type TimeOfDay timeofday.Value
+
+// EncodeSpanner implements the spanner.Encoder interface, encoding the value as the string returned
+// by timeofday.Value.String()
+func (t TimeOfDay) EncodeSpanner() (interface{}, error) {
+	v := timeofday.Value(t)
+	if !v.IsValid() {
+		return nil, errors.Wrapf(timeofday.ErrInvalidDuration, "%v", timeofday.ToDuration(v))
+	}
+	return v.String(), nil
+}
+
+// DecodeSpanner implements the spanner.Decoder interface.  Strings are parsed by
+// timeofday.ParseTime().  NULL is decoded as midnight.
+func (t *TimeOfDay) DecodeSpanner(input interface{}) error {
+	switch tv := input.(type) {
+	case nil:
+		*t = TimeOfDay(timeofday.Zero)
+		return nil
+	case string:
+		v, err := timeofday.ParseTime(tv)
+		if err != nil {
+			return err
+		}
+		*t = TimeOfDay(v)
+		return nil
+	default:
+		return unsupportedSourceType.Of(input)
+	}
+}
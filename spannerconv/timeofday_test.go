@@ -0,0 +1,49 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package spannerconv
+
+import (
+	"testing"
+
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/pkg/errors"
+)
+
+func TestTimeOfDayRoundTrip(t *testing.T) {
+	cases := []struct {
+		name    string
+		v       timeofday.Value
+		encoded string
+	}{
+		{"midnight", timeofday.Zero, "00:00:00"},
+		{"fraction", timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000)), "13:45:30.5"},
+		{"max", timeofday.Max, "23:59:59.999999999"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := TimeOfDay(tc.v).EncodeSpanner()
+			if err != nil || got != tc.encoded {
+				tt.Errorf("Expected %q, got %v (err = %v)", tc.encoded, got, err)
+			}
+			var v TimeOfDay
+			if err := v.DecodeSpanner(tc.encoded); err != nil || timeofday.Value(v) != tc.v {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.v, timeofday.Value(v), err)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayDecodeSpannerErrors(t *testing.T) {
+	v := TimeOfDay(timeofday.Max)
+	if err := v.DecodeSpanner(nil); err != nil || timeofday.Value(v) != timeofday.Zero {
+		t.Errorf("Expected %v, got %v (err = %v)", timeofday.Zero, timeofday.Value(v), err)
+	}
+	if err := v.DecodeSpanner(int64(42)); errors.Cause(err) != ErrUnsupportedSourceType {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedSourceType, err)
+	}
+	if err := v.DecodeSpanner("25:00:00"); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
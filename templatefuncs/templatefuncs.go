@@ -0,0 +1,183 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package templatefuncs provides a function map for text/template and html/template that formats and
+// manipulates the types in this module, for use in generated emails, reports and configuration
+// renderers.
+//
+// The functions take the value being operated on as their last argument so they can be used in
+// pipelines:
+//
+//	{{ .ShipDate | addDays 3 | formatDate "Mon, Jan 2" }}
+//
+// Methods of the types can also be called directly from templates, so the map focuses on parsing,
+// conversions and formatting that are not available as methods without extra arguments.
+package templatefuncs
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/ulid"
+	"github.com/pkg/errors"
+)
+
+// FuncMap returns a new map containing all of the functions in this package, keyed by the name used in
+// templates.  The result can be passed to Funcs() on both text/template and html/template templates.
+//
+//   - formatDate layout date: formats a date.Value using a time.Format() layout, or "" for date.Nil
+//   - formatDateLocalized layout tag date: formats a date.Value with localized month and weekday names
+//   - parseDate layout string: parses a date.Value using a time.Parse() layout
+//   - addDays n date: adds n days to a date.Value
+//   - weekday date: returns the name of the day of the week
+//   - startOfMonth date, endOfMonth date: return the first or last day of the month
+//   - formatTimeOfDay layout time: formats a timeofday.Value using a time.Format() layout
+//   - parseTimeOfDay string: parses a timeofday.Value formatted as hh:mm:ss[.fffffffff]
+//   - addTimeOfDay duration time: adds a time.Duration to a timeofday.Value, wrapping at midnight
+//   - timeInZone zone time: converts a time.Time to the named IANA time zone
+//   - humanizeDuration duration: formats a time.Duration in words, such as "2 hours 5 minutes"
+//   - formatBytes prec size, formatBytesSI prec size: format a bytesize.Value in IEC or SI units
+//   - percent prec ratio: formats a ratio.Value as a percentage with prec decimal places
+//   - cssColor color: formats a color.Value as a CSS color
+//   - ulidTime id, snowflakeTime id: return the time.Time embedded in an ID
+func FuncMap() map[string]any {
+	return map[string]any{
+		"formatDate":          formatDate,
+		"formatDateLocalized": formatDateLocalized,
+		"parseDate":           parseDate,
+		"addDays":             addDays,
+		"weekday":             weekday,
+		"startOfMonth":        date.Value.StartOfMonth,
+		"endOfMonth":          date.Value.EndOfMonth,
+		"formatTimeOfDay":     formatTimeOfDay,
+		"parseTimeOfDay":      timeofday.ParseTime,
+		"addTimeOfDay":        addTimeOfDay,
+		"timeInZone":          timeInZone,
+		"humanizeDuration":    HumanizeDuration,
+		"formatBytes":         formatBytes,
+		"formatBytesSI":       formatBytesSI,
+		"percent":             percent,
+		"cssColor":            color.Value.CSS,
+		"ulidTime":            ulid.Value.Time,
+		"snowflakeTime":       snowflake.Value.Time,
+	}
+}
+
+func formatDate(layout string, d date.Value) string {
+	if d == date.Nil {
+		return ""
+	}
+	return d.Format(layout)
+}
+
+func formatDateLocalized(layout, tag string, d date.Value) (string, error) {
+	if d == date.Nil {
+		return "", nil
+	}
+	return d.FormatLocalized(layout, tag)
+}
+
+func parseDate(layout, s string) (date.Value, error) {
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return date.Nil, err
+	}
+	return date.FromTime(t)
+}
+
+func addDays(n int, d date.Value) (date.Value, error) {
+	return d.AddDays(n)
+}
+
+func weekday(d date.Value) string {
+	return d.Weekday().String()
+}
+
+func formatTimeOfDay(layout string, t timeofday.Value) string {
+	return t.Format(layout)
+}
+
+func addTimeOfDay(d time.Duration, t timeofday.Value) timeofday.Value {
+	return t.Add(d)
+}
+
+func timeInZone(zone string, t time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "timeInZone")
+	}
+	return t.In(loc), nil
+}
+
+func formatBytes(prec int, v bytesize.Value) string {
+	return v.Format(bytesize.Binary, prec)
+}
+
+func formatBytesSI(prec int, v bytesize.Value) string {
+	return v.Format(bytesize.Decimal, prec)
+}
+
+func percent(prec int, v ratio.Value) string {
+	return strconv.FormatFloat(v.Float64()*100, 'f', prec, 64) + "%"
+}
+
+// humanizeUnits are the units used by HumanizeDuration(), largest first
+var humanizeUnits = []struct {
+	d    time.Duration
+	name string
+}{
+	{24 * time.Hour, "day"},
+	{time.Hour, "hour"},
+	{time.Minute, "minute"},
+	{time.Second, "second"},
+	{time.Millisecond, "millisecond"},
+}
+
+// HumanizeDuration formats d using its largest unit, from days down to milliseconds, followed by the
+// next smaller unit if it is non-zero, such as "1 day 3 hours", "2 hours" or "45 seconds".  Smaller
+// units are truncated, and durations under a millisecond are formatted as "0 seconds".
+func HumanizeDuration(d time.Duration) string {
+	var sb strings.Builder
+	if d < 0 {
+		sb.WriteByte('-')
+	}
+	// work with a uint64 magnitude so that the minimum duration does not overflow
+	rem, parts := uint64(d), 0
+	if d < 0 {
+		rem = -rem
+	}
+	for _, u := range humanizeUnits {
+		n := rem / uint64(u.d)
+		rem %= uint64(u.d)
+		if n == 0 {
+			if parts > 0 {
+				break
+			}
+			continue
+		}
+		if parts > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(strconv.FormatUint(n, 10))
+		sb.WriteByte(' ')
+		sb.WriteString(u.name)
+		if n != 1 {
+			sb.WriteByte('s')
+		}
+		if parts++; parts == 2 {
+			break
+		}
+	}
+	if parts == 0 {
+		return "0 seconds"
+	}
+	return sb.String()
+}
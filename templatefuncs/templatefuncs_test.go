@@ -0,0 +1,130 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package templatefuncs
+
+import (
+	htmltemplate "html/template"
+	"math"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/ulid"
+)
+
+func TestFuncMap(t *testing.T) {
+	data := map[string]any{
+		"date":      date.Must(date.FromUnits(2024, 7, 14)),
+		"nil":       date.Nil,
+		"time":      timeofday.Must(timeofday.FromUnits(23, 30, 0, 0)),
+		"instant":   time.Date(2024, 7, 14, 16, 0, 0, 0, time.UTC),
+		"duration":  26*time.Hour + 30*time.Minute,
+		"size":      1536 * bytesize.KiB,
+		"ratio":     ratio.Must(ratio.New(1, 3)),
+		"color":     color.Must(color.Parse("#33669980")),
+		"ulid":      ulid.Must(ulid.FromParts(1720972800000, [10]byte{})),
+		"snowflake": snowflake.Must(snowflake.FromParts(1000, 1, 1)),
+	}
+	cases := []struct {
+		name     string
+		tmpl     string
+		expected string
+	}{
+		{"formatDate", `{{ .date | formatDate "Mon, Jan 2 2006" }}`, "Sun, Jul 14 2024"},
+		{"formatDate nil", `{{ .nil | formatDate "2006" }}`, ""},
+		{"parseDate", `{{ parseDate "01/02/2006" "07/14/2024" }}`, "2024-07-14"},
+		{"addDays", `{{ .date | addDays 3 | formatDate "2006-01-02 Monday" }}`, "2024-07-17 Wednesday"},
+		{"weekday", `{{ weekday .date }}`, "Sunday"},
+		{"month bounds", `{{ startOfMonth .date }} {{ endOfMonth .date }}`, "2024-07-01 2024-07-31"},
+		{"formatTimeOfDay", `{{ .time | formatTimeOfDay "3:04 PM" }}`, "11:30 PM"},
+		{"parseTimeOfDay", `{{ parseTimeOfDay "08:15:00.25" }}`, "08:15:00.25"},
+		{"addTimeOfDay", `{{ .time | addTimeOfDay .duration }}`, "02:00:00"},
+		{"timeInZone", `{{ (.instant | timeInZone "Asia/Tokyo").Format "15:04 MST" }}`, "01:00 JST"},
+		{"humanizeDuration", `{{ humanizeDuration .duration }}`, "1 day 2 hours"},
+		{"formatBytes", `{{ .size | formatBytes 1 }} {{ .size | formatBytesSI 2 }}`, "1.5MiB 1.57MB"},
+		{"percent", `{{ .ratio | percent 1 }}`, "33.3%"},
+		{"cssColor", `{{ cssColor .color }}`, "rgba(51, 102, 153, 0.5)"},
+		{"ulidTime", `{{ (ulidTime .ulid).UTC.Format "2006-01-02" }}`, "2024-07-14"},
+		{"snowflakeTime", `{{ (snowflakeTime .snowflake).UTC.Format "2006-01-02T15:04:05.000" }}`, "2010-11-04T01:42:55.657"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			tmpl, err := template.New(tc.name).Funcs(FuncMap()).Parse(tc.tmpl)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			var sb strings.Builder
+			if err := tmpl.Execute(&sb, data); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if sb.String() != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, sb.String())
+			}
+		})
+	}
+}
+
+func TestFuncMapErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		tmpl string
+	}{
+		{"parseDate", `{{ parseDate "2006-01-02" "nope" }}`},
+		{"addDays", `{{ .max | addDays 1 }}`},
+		{"timeInZone", `{{ .instant | timeInZone "Not/AZone" }}`},
+	}
+	data := map[string]any{"max": date.Max, "instant": time.Now()}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			tmpl := template.Must(template.New(tc.name).Funcs(FuncMap()).Parse(tc.tmpl))
+			if err := tmpl.Execute(&strings.Builder{}, data); err == nil {
+				tt.Errorf("Expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestHTMLTemplate(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("html").Funcs(FuncMap()).Parse(`<time>{{ .| formatDate "Jan 2" }}</time>`))
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, date.Must(date.FromUnits(2024, 7, 14))); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := "<time>Jul 14</time>"; sb.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, sb.String())
+	}
+}
+
+func TestHumanizeDuration(t *testing.T) {
+	cases := []struct {
+		d        time.Duration
+		expected string
+	}{
+		{0, "0 seconds"},
+		{500 * time.Microsecond, "0 seconds"},
+		{1500 * time.Millisecond, "1 second 500 milliseconds"},
+		{45 * time.Second, "45 seconds"},
+		{time.Minute, "1 minute"},
+		{2*time.Hour + 5*time.Minute + 30*time.Second, "2 hours 5 minutes"},
+		{time.Hour + 5*time.Second, "1 hour"},
+		{49 * time.Hour, "2 days 1 hour"},
+		{-90 * time.Minute, "-1 hour 30 minutes"},
+		{math.MinInt64, "-106751 days 23 hours"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expected, func(tt *testing.T) {
+			if got := HumanizeDuration(tc.d); got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"github.com/dylan-bourque/go-types/internal/batcherr"
+	"github.com/dylan-bourque/go-types/null"
+)
+
+// IndexError records the error for a single element of a batch conversion
+type IndexError = batcherr.IndexError
+
+// BatchError is returned by the batch conversion functions when one or more elements could not be
+// converted.  It contains one IndexError for each failed element, in input order.
+type BatchError = batcherr.BatchError
+
+// ParseAll parses each string in ss with ParseTime(), allocating the result once for the whole
+// batch.
+//
+// The returned slice always has the same length as ss.  Zero is a valid time of day, so elements that
+// could not be parsed are returned as invalid null.Value instances, rather than as Zero, and are
+// reported in the returned BatchError.  Callers can keep the valid results without cross-checking the
+// error indexes.
+func ParseAll(ss []string) ([]null.Value[Value], error) {
+	var errs BatchError
+	res := make([]null.Value[Value], len(ss))
+	for i, s := range ss {
+		v, err := ParseTime(s)
+		if err != nil {
+			errs = append(errs, IndexError{Index: i, Err: err})
+			continue
+		}
+		res[i] = null.From(v)
+	}
+	if len(errs) > 0 {
+		return res, errs
+	}
+	return res, nil
+}
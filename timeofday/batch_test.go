@@ -0,0 +1,50 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/null"
+)
+
+func TestParseAll(t *testing.T) {
+	got, err := ParseAll([]string{"10:30:00", "25:00:00", "23:59:59.5", "noon"})
+	expected := []null.Value[Value]{
+		null.From(Must(FromUnits(10, 30, 0, 0))),
+		{},
+		null.From(Must(FromUnits(23, 59, 59, int64(500*time.Millisecond)))),
+		{},
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d values, got %d", len(expected), len(got))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Index %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+	be, ok := err.(BatchError)
+	if !ok {
+		t.Fatalf("Expected a BatchError, got %T (%v)", err, err)
+	}
+	if len(be) != 2 || be[0].Index != 1 || be[1].Index != 3 {
+		t.Fatalf("Expected errors at indexes 1 and 3, got %v", be)
+	}
+}
+
+func TestParseAllNoErrors(t *testing.T) {
+	got, err := ParseAll([]string{"00:00:00", "12:00:00"})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if got[0] != null.From(Min) || got[1] != null.From(Must(FromUnits(12, 0, 0, 0))) {
+		t.Errorf("Unexpected results %v", got)
+	}
+	if got, err := ParseAll(nil); len(got) != 0 || err != nil {
+		t.Errorf("Expected an empty result and no error, got %v, %v", got, err)
+	}
+}
@@ -9,17 +9,20 @@ import (
 	"encoding"
 	"encoding/binary"
 	"encoding/json"
-	"fmt"
 	"strings"
 	"time"
 
+	"github.com/dylan-bourque/go-types/internal/typeerr"
 	"github.com/pkg/errors"
 )
 
 var (
 	// ErrInvalidBinaryDataLen is returned from timeofday.Value.UnmarshalBinary() then the passed-in byte slice
-	// is not exactly 8 bytes long
-	ErrInvalidBinaryDataLen = errors.Errorf("timeofday.Value: binary data must be 8 bytes")
+	// is not the correct length for its version
+	ErrInvalidBinaryDataLen = errors.Errorf("timeofday.Value: binary data must be 8 bytes or a version byte plus 8 bytes")
+	// ErrUnsupportedBinaryVersion is returned from timeofday.Value.UnmarshalBinary() when the version byte
+	// of the passed-in data is not recognized
+	ErrUnsupportedBinaryVersion = errors.Errorf("timeofday.Value: unsupported binary encoding version")
 	// ErrInvalidTextDataLen is returned from timeofday.Value.UnmarshalText() when the passed-in byte slice
 	// is not between 8 and 18 bytes long
 	ErrInvalidTextDataLen = errors.Errorf("timeofday.Value: text data must be bewteen 8 and 18 bytes")
@@ -31,10 +34,22 @@ var (
 	ErrInvalidTimeFormat = errors.Errorf("timeofday.Value: text data was not in the correct format")
 )
 
+var unsupportedJSONType = typeerr.New(ErrInvalidTextData)
+
+const (
+	// binaryVersion1 identifies the binary encoding that contains a version byte followed by a 64-bit
+	// integer in big-endian byte order that contains the number of nanoseconds since midnight
+	binaryVersion1 byte = 1
+	// binaryVersion is the version of the binary encoding that is written by MarshalBinary()
+	binaryVersion = binaryVersion1
+)
+
 // interface validations
 var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
 var _ encoding.TextUnmarshaler = (*Value)(nil)
 var _ encoding.BinaryMarshaler = (*Value)(nil)
+var _ encoding.BinaryAppender = (*Value)(nil)
 var _ encoding.BinaryUnmarshaler = (*Value)(nil)
 var _ json.Marshaler = (*Value)(nil)
 var _ json.Unmarshaler = (*Value)(nil)
@@ -43,7 +58,13 @@ var _ json.Unmarshaler = (*Value)(nil)
 //
 // The encoded value is the same as is returned by the String() method
 func (t Value) MarshalText() ([]byte, error) {
-	return []byte(t.String()), nil
+	return t.AppendText(make([]byte, 0, maxTextLen))
+}
+
+// AppendText implements the encoding.TextAppender interface for timeofday.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (t Value) AppendText(b []byte) ([]byte, error) {
+	return t.appendText(b), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for timeofday.Value values.
@@ -54,12 +75,23 @@ func (t Value) MarshalText() ([]byte, error) {
 // . "ss" must be 2 decimal digits between 00 and 59, representing the second of the minute
 // . ".fffffffff" is optional, if specified it must be between 1 and 9 decimal digits, respresenting
 //   the fractional seconds up to nanosecond-level resolution
+//
+// If lenient parsing is enabled in the package configuration, "hh:mm" is also accepted.
 func (t *Value) UnmarshalText(text []byte) error {
-	if l := len(text); l < 8 || l > 18 {
+	return t.unmarshalText(text, CurrentConfig())
+}
+
+// unmarshalText implements UnmarshalText() using the specified configuration
+func (t *Value) unmarshalText(text []byte, c Config) error {
+	layout := `15:04:05.999999999`
+	switch l := len(text); {
+	case c.Lenient && l == 5:
+		layout = `15:04`
+	case l < 8 || l > 18:
 		return ErrInvalidTextDataLen
 	}
 	// defer to stdlib to parse the time string in UTC (so no DST)
-	tv, err := time.ParseInLocation(`15:04:05.999999999`, string(text), time.UTC)
+	tv, err := time.ParseInLocation(layout, string(text), time.UTC)
 	if err != nil {
 		return ErrInvalidTimeFormat
 	}
@@ -75,31 +107,49 @@ func (t *Value) UnmarshalText(text []byte) error {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for timeofday.Value values.
 //
-// The resulting data is a 64-bit integer in big-endian byte order that contains
-// the number of nanoseconds in the underlying time.Duration value.
+// The resulting data is a single version byte, currently 1, followed by a 64-bit integer in big-endian
+// byte order that contains the number of nanoseconds in the underlying time.Duration value.
 func (t Value) MarshalBinary() ([]byte, error) {
-	var buf bytes.Buffer
-	// this can't fail b/c we can always write a 64-bit into into 8 bytes
-	_ = binary.Write(&buf, binary.BigEndian, t.d.Nanoseconds())
-	return buf.Bytes(), nil
+	return t.AppendBinary(make([]byte, 0, 9))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for timeofday.Value values.  It appends the
+// same encoding as MarshalBinary() to b.
+func (t Value) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, binaryVersion)
+	return binary.BigEndian.AppendUint64(b, uint64(t.d.Nanoseconds())), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for timeofday.Value values.
 //
-// The provided value must be 8 bytes and contain a 64-bit integer value in big-endian byte order between
-// 0 (00:00:00) and 86,399,999,000,000 (23:59:59.999999999).
+// The provided value must be either a version 1 encoding - a version byte of 1 followed by 8 bytes - or
+// the legacy, unversioned encoding of exactly 8 bytes.  In both cases, the 8 bytes contain a 64-bit integer
+// value in big-endian byte order between 0 (00:00:00) and 86,399,999,999,999 (23:59:59.999999999).  The
+// two encodings can be distinguished by length alone.
 //
-// If data is not 8 bytes, ErrInvalidBinaryDataLen is returned.  If the unmarshalled integer value is
-// out of range, ErrInvalidDuration is returned.
+// If the version byte is not recognized, ErrUnsupportedBinaryVersion is returned.  If data is not the
+// correct length, ErrInvalidBinaryDataLen is returned.  If the unmarshalled integer value is out of
+// range, ErrInvalidDuration is returned.
 func (t *Value) UnmarshalBinary(data []byte) error {
-	if len(data) != 8 {
+	var payload []byte
+	switch {
+	case len(data) == 8:
+		// legacy encoding, written before the version byte was introduced
+		payload = data
+	case len(data) == 0:
+		return ErrInvalidBinaryDataLen
+	case data[0] == binaryVersion1:
+		if len(data) != 9 {
+			return ErrInvalidBinaryDataLen
+		}
+		payload = data[1:]
+	case len(data) < 8:
 		return ErrInvalidBinaryDataLen
+	default:
+		return errors.Wrapf(ErrUnsupportedBinaryVersion, "version: %d", data[0])
 	}
-	// this can't fail b/c any 8 bytes can be read into an int64 value
-	var d int64
-	_ = binary.Read(bytes.NewReader(data), binary.BigEndian, &d)
 	// convert to time.Duration and validate range
-	dur := time.Duration(d)
+	dur := time.Duration(int64(binary.BigEndian.Uint64(payload)))
 	if !IsValidDuration(dur) {
 		return ErrInvalidDuration
 	}
@@ -111,7 +161,10 @@ func (t *Value) UnmarshalBinary(data []byte) error {
 // MarshalJSON implements the json.Marshaler interface for timeofday.Value values.  The JSON
 // encoding is the same as MarshalText().
 func (t Value) MarshalJSON() ([]byte, error) {
-	return []byte(fmt.Sprintf("%q", t)), nil
+	b := make([]byte, 0, maxTextLen+2)
+	b = append(b, '"')
+	b = t.appendText(b)
+	return append(b, '"'), nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for timeofday.Value values.
@@ -123,9 +176,13 @@ func (t *Value) UnmarshalJSON(p []byte) error {
 		t.d = time.Duration(0)
 		return nil
 	}
-	var s string
-	if err := json.NewDecoder(bytes.NewReader(p)).Decode(&s); err != nil {
+	var raw interface{}
+	if err := json.NewDecoder(bytes.NewReader(p)).Decode(&raw); err != nil {
 		return errors.Wrapf(ErrInvalidTextData, "%v", err)
 	}
+	s, ok := raw.(string)
+	if !ok {
+		return unsupportedJSONType.Of(raw)
+	}
 	return t.UnmarshalText([]byte(strings.Trim(s, `"`)))
 }
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -175,10 +176,10 @@ func TestMarshalBinary(t *testing.T) {
 		v        Value
 		expected []byte
 	}{
-		{"zero value", Zero, genBinaryDataFromDuration(time.Duration(0))},
-		{"min value", Min, genBinaryDataFromDuration(time.Duration(0))},
-		{"max value", Max, genBinaryDataFromDuration(time.Duration(24*time.Hour - time.Nanosecond))},
-		{"12:34:56.789012345", Must(FromUnits(12, 34, 56, 789012345)), genBinaryDataFromDuration(time.Duration(12*time.Hour + 34*time.Minute + 56*time.Second + 789012345))},
+		{"zero value", Zero, genVersionedBinaryDataFromDuration(time.Duration(0))},
+		{"min value", Min, genVersionedBinaryDataFromDuration(time.Duration(0))},
+		{"max value", Max, genVersionedBinaryDataFromDuration(time.Duration(24*time.Hour - time.Nanosecond))},
+		{"12:34:56.789012345", Must(FromUnits(12, 34, 56, 789012345)), genVersionedBinaryDataFromDuration(time.Duration(12*time.Hour + 34*time.Minute + 56*time.Second + 789012345))},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(tt *testing.T) {
@@ -203,7 +204,11 @@ func TestUnmarshalBinary(t *testing.T) {
 		{"nil-buffer", nil, Zero, ErrInvalidBinaryDataLen},
 		{"empty-buffer", []byte{}, Zero, ErrInvalidBinaryDataLen},
 		{"short-buffer", []byte{1}, Zero, ErrInvalidBinaryDataLen},
-		{"long-buffer", []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}, Zero, ErrInvalidBinaryDataLen},
+		{"long-buffer", []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, Zero, ErrInvalidBinaryDataLen},
+		{"unsupported-version", []byte{2, 0, 0, 0, 0, 0, 0, 0, 0}, Zero, ErrUnsupportedBinaryVersion},
+		{"versioned/invalid-duration-value", genVersionedBinaryDataFromDuration(24 * time.Hour), Zero, ErrInvalidDuration},
+		{"versioned/max-value", genVersionedBinaryDataFromDuration(time.Duration(24*time.Hour - time.Nanosecond)), Max, nil},
+		{"versioned/12:34:56.789012345", genVersionedBinaryDataFromDuration(time.Duration(12*time.Hour + 34*time.Minute + 56*time.Second + 789012345)), Must(FromUnits(12, 34, 56, 789012345)), nil},
 		{"invalid-duration-value/negative-underflow", genBinaryDataFromDuration(time.Duration(-1)), Zero, ErrInvalidDuration},
 		{"invalid-duration-value/positive-overflow", genBinaryDataFromDuration(24 * time.Hour), Zero, ErrInvalidDuration},
 		{"zero-value", genBinaryDataFromDuration(time.Duration(0)), Zero, nil},
@@ -234,3 +239,64 @@ func genBinaryDataFromDuration(dur time.Duration) []byte {
 	_ = binary.Write(&buf, binary.BigEndian, dur.Nanoseconds())
 	return buf.Bytes()
 }
+
+// genVersionedBinaryDataFromDuration constructs the expected version 1 binary encoding for a given
+// timeofday.Value value from the provided time.Duration
+// . the value is a version byte of 1 followed by the 8 bytes of the legacy encoding
+func genVersionedBinaryDataFromDuration(dur time.Duration) []byte {
+	return append([]byte{1}, genBinaryDataFromDuration(dur)...)
+}
+
+func TestMarshalMatchesString(t *testing.T) {
+	for _, v := range []Value{Min, Max, Must(FromUnits(9, 5, 7, 120000000)), Must(FromUnits(23, 0, 0, 1))} {
+		text, _ := v.MarshalText()
+		if string(text) != v.String() {
+			t.Errorf("MarshalText: expected %q, got %q", v.String(), text)
+		}
+		js, _ := v.MarshalJSON()
+		if expected := fmt.Sprintf("%q", v.String()); string(js) != expected {
+			t.Errorf("MarshalJSON: expected %s, got %s", expected, js)
+		}
+	}
+}
+
+var (
+	benchBytes  []byte
+	benchString string
+)
+
+func BenchmarkMarshalText(b *testing.B) {
+	v := Must(FromUnits(9, 5, 7, 120000000))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchBytes, _ = v.MarshalText()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	v := Must(FromUnits(9, 5, 7, 120000000))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchBytes, _ = v.MarshalJSON()
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	v := Must(FromUnits(9, 5, 7, 120000000))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchString = v.String()
+	}
+}
+
+func TestUnmarshalJSONUnsupportedTypeIsCached(t *testing.T) {
+	var v Value
+	err1 := v.UnmarshalJSON([]byte("42"))
+	err2 := v.UnmarshalJSON([]byte("3.14"))
+	if errors.Cause(err1) != ErrInvalidTextData {
+		t.Fatalf("Expected %v, got %v", ErrInvalidTextData, err1)
+	}
+	if err1 != err2 {
+		t.Errorf("Expected the same cached error for both numbers, got %v and %v", err1, err2)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"database/sql/driver"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// SQLMode selects the representation that Value() writes to a database
+type SQLMode int
+
+const (
+	// SQLText writes the "hh:mm:ss.fffffffff" text form, formatted according to Config.Precision.
+	// This is the default.
+	SQLText SQLMode = iota
+	// SQLNanoseconds writes the number of nanoseconds since midnight as an int64, for columns that
+	// store a time of day as an integer
+	SQLNanoseconds
+)
+
+// Precision selects the number of fractional second digits in the text that Value() writes in
+// SQLText mode.  Extra digits are truncated, not rounded.
+type Precision int
+
+const (
+	// PrecisionDefault writes up to 9 digits with trailing zeros removed, the same as String().  This
+	// is the default.
+	PrecisionDefault Precision = iota
+	// PrecisionSeconds writes no fractional seconds
+	PrecisionSeconds
+	// PrecisionMilliseconds always writes 3 digits
+	PrecisionMilliseconds
+	// PrecisionMicroseconds always writes 6 digits, which matches the TIME columns of most databases
+	PrecisionMicroseconds
+	// PrecisionNanoseconds always writes 9 digits
+	PrecisionNanoseconds
+)
+
+var (
+	// ErrInvalidConfig is returned by SetConfig() when a setting is not one of the defined constants
+	ErrInvalidConfig = errors.Errorf("timeofday: the configuration contains an invalid setting")
+)
+
+// Config contains the package-level settings that change how values are stored in and read from
+// databases and text.  The zero value is the default configuration.
+//
+// A Config is an immutable snapshot: SetConfig() replaces the whole configuration atomically, so
+// goroutines that read it concurrently always see either the old or the new settings, never a mix.
+type Config struct {
+	// SQLMode selects the representation written by Value()
+	SQLMode SQLMode
+	// Precision selects the number of fractional second digits written by Value() in SQLText mode
+	Precision Precision
+	// Lenient allows UnmarshalText(), UnmarshalJSON(), Scan() and ParseText() to accept "hh:mm"
+	// without seconds in addition to the strict "hh:mm:ss.fffffffff" form
+	Lenient bool
+}
+
+// Option overrides a single setting of the current configuration for one call
+type Option func(*Config)
+
+// WithSQLMode overrides Config.SQLMode
+func WithSQLMode(m SQLMode) Option {
+	return func(c *Config) { c.SQLMode = m }
+}
+
+// WithPrecision overrides Config.Precision
+func WithPrecision(p Precision) Option {
+	return func(c *Config) { c.Precision = p }
+}
+
+// WithLenient overrides Config.Lenient
+func WithLenient(lenient bool) Option {
+	return func(c *Config) { c.Lenient = lenient }
+}
+
+// With returns a copy of c with the specified options applied
+func (c Config) With(opts ...Option) Config {
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// validate returns an error if any of the settings are not one of the defined constants
+func (c Config) validate() error {
+	if c.SQLMode < SQLText || c.SQLMode > SQLNanoseconds {
+		return errors.Wrapf(ErrInvalidConfig, "SQL mode: %d", c.SQLMode)
+	}
+	if c.Precision < PrecisionDefault || c.Precision > PrecisionNanoseconds {
+		return errors.Wrapf(ErrInvalidConfig, "precision: %d", c.Precision)
+	}
+	return nil
+}
+
+// config holds the current configuration.  A nil pointer is the default, zero-value Config.
+var config atomic.Pointer[Config]
+
+// CurrentConfig returns a copy of the current package-level configuration
+func CurrentConfig() Config {
+	if c := config.Load(); c != nil {
+		return *c
+	}
+	return Config{}
+}
+
+// SetConfig atomically replaces the package-level configuration and returns the previous one so that
+// it can be restored later.  An error is returned, and the configuration is left unchanged, if any
+// of the settings are invalid.
+//
+// The configuration is shared by every goroutine and package that uses timeofday.Value, so it should
+// normally be set once during program start up.  Use the Option-based functions to change a setting
+// for a single call.
+func SetConfig(c Config) (Config, error) {
+	if err := c.validate(); err != nil {
+		return CurrentConfig(), err
+	}
+	if prev := config.Swap(&c); prev != nil {
+		return *prev, nil
+	}
+	return Config{}, nil
+}
+
+// currentConfig returns the current configuration with the specified options applied
+func currentConfig(opts []Option) Config {
+	return CurrentConfig().With(opts...)
+}
+
+// ParseText parses the "hh:mm:ss.fffffffff" text form, or "hh:mm" if lenient parsing is enabled by
+// the current configuration or the specified options, by the same rules as UnmarshalText().
+func ParseText(s string, opts ...Option) (Value, error) {
+	var t Value
+	err := t.unmarshalText([]byte(s), currentConfig(opts))
+	return t, err
+}
+
+// SQLValue returns the value that Value() would write with the current configuration and the
+// specified options applied
+func (t Value) SQLValue(opts ...Option) (driver.Value, error) {
+	return t.sqlValue(currentConfig(opts)), nil
+}
+
+// sqlValue returns the driver.Value for t according to c
+func (t Value) sqlValue(c Config) driver.Value {
+	if c.SQLMode == SQLNanoseconds {
+		return t.d.Nanoseconds()
+	}
+	if c.Precision == PrecisionDefault {
+		return t.String()
+	}
+	b := make([]byte, 0, maxTextLen)
+	h, m, s, ns := t.ToUnits()
+	b = append2Digits(b, h)
+	b = append(b, ':')
+	b = append2Digits(b, m)
+	b = append(b, ':')
+	b = append2Digits(b, s)
+	if digits := 3 * int(c.Precision-PrecisionSeconds); digits > 0 {
+		var frac [9]byte
+		for i := len(frac) - 1; i >= 0; i-- {
+			frac[i] = byte('0' + ns%10)
+			ns /= 10
+		}
+		b = append(b, '.')
+		b = append(b, frac[:digits]...)
+	}
+	return string(b)
+}
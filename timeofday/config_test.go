@@ -0,0 +1,168 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"database/sql/driver"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// setConfig replaces the package configuration for the duration of a test
+func setConfig(t *testing.T, c Config) {
+	t.Helper()
+	prev, err := SetConfig(c)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	t.Cleanup(func() { _, _ = SetConfig(prev) })
+}
+
+func TestSetConfig(t *testing.T) {
+	if got := CurrentConfig(); got != (Config{}) {
+		t.Fatalf("Expected the default configuration, got %+v", got)
+	}
+	c := Config{SQLMode: SQLNanoseconds, Precision: PrecisionMicroseconds, Lenient: true}
+	prev, err := SetConfig(c)
+	if err != nil || prev != (Config{}) {
+		t.Fatalf("Expected the default configuration and no error, got %+v (err = %v)", prev, err)
+	}
+	if got := CurrentConfig(); got != c {
+		t.Errorf("Expected %+v, got %+v", c, got)
+	}
+	if prev, err = SetConfig(Config{}); err != nil || prev != c {
+		t.Errorf("Expected %+v and no error, got %+v (err = %v)", c, prev, err)
+	}
+}
+
+func TestSetConfigInvalid(t *testing.T) {
+	cases := []struct {
+		name string
+		c    Config
+	}{
+		{"SQL mode", Config{SQLMode: SQLNanoseconds + 1}},
+		{"negative SQL mode", Config{SQLMode: -1}},
+		{"precision", Config{Precision: PrecisionNanoseconds + 1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := SetConfig(tc.c)
+			if errors.Cause(err) != ErrInvalidConfig {
+				tt.Errorf("Expected %v, got %v", ErrInvalidConfig, err)
+			}
+			if got != (Config{}) || CurrentConfig() != (Config{}) {
+				tt.Errorf("Expected the configuration to be unchanged")
+			}
+		})
+	}
+}
+
+func TestSQLValue(t *testing.T) {
+	v := Must(FromUnits(13, 45, 30, 123456789))
+	cases := []struct {
+		name     string
+		opts     []Option
+		expected driver.Value
+	}{
+		{"default", nil, "13:45:30.123456789"},
+		{"seconds", []Option{WithPrecision(PrecisionSeconds)}, "13:45:30"},
+		{"milliseconds", []Option{WithPrecision(PrecisionMilliseconds)}, "13:45:30.123"},
+		{"microseconds", []Option{WithPrecision(PrecisionMicroseconds)}, "13:45:30.123456"},
+		{"nanoseconds", []Option{WithPrecision(PrecisionNanoseconds)}, "13:45:30.123456789"},
+		{"integer", []Option{WithSQLMode(SQLNanoseconds)}, int64(49530123456789)},
+		{"last option wins", []Option{WithSQLMode(SQLNanoseconds), WithSQLMode(SQLText)}, "13:45:30.123456789"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := v.SQLValue(tc.opts...)
+			if err != nil || got != tc.expected {
+				tt.Errorf("Expected %#v, got %#v (err = %v)", tc.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestValueUsesConfig(t *testing.T) {
+	v := Must(FromUnits(13, 45, 30, 500000000))
+	setConfig(t, Config{Precision: PrecisionMicroseconds})
+	if got, _ := v.Value(); got != "13:45:30.500000" {
+		t.Errorf("Expected %q, got %#v", "13:45:30.500000", got)
+	}
+	// per-call options override the package configuration
+	if got, _ := v.SQLValue(WithPrecision(PrecisionDefault)); got != "13:45:30.5" {
+		t.Errorf("Expected %q, got %#v", "13:45:30.5", got)
+	}
+
+	setConfig(t, Config{SQLMode: SQLNanoseconds})
+	dv, _ := v.Value()
+	var got Value
+	if err := got.Scan(dv); err != nil || got != v {
+		t.Errorf("Expected %v, got %v (err = %v)", v, got, err)
+	}
+}
+
+func TestScanInt64OutOfRange(t *testing.T) {
+	var v Value
+	if err := v.Scan(int64(-1)); errors.Cause(err) != ErrInvalidDuration {
+		t.Errorf("Expected %v, got %v", ErrInvalidDuration, err)
+	}
+}
+
+func TestLenientParsing(t *testing.T) {
+	expected := Must(FromUnits(9, 30, 0, 0))
+	if _, err := ParseText("09:30"); errors.Cause(err) != ErrInvalidTextDataLen {
+		t.Errorf("Expected %v parsing hh:mm by default, got %v", ErrInvalidTextDataLen, err)
+	}
+	if got, err := ParseText("09:30", WithLenient(true)); err != nil || got != expected {
+		t.Errorf("Expected %v, got %v (err = %v)", expected, got, err)
+	}
+
+	setConfig(t, Config{Lenient: true})
+	var got Value
+	if err := got.UnmarshalText([]byte("09:30")); err != nil || got != expected {
+		t.Errorf("Expected %v, got %v (err = %v)", expected, got, err)
+	}
+	if err := got.UnmarshalJSON([]byte(`"09:30"`)); err != nil || got != expected {
+		t.Errorf("Expected %v, got %v (err = %v)", expected, got, err)
+	}
+	if _, err := ParseText("09:30", WithLenient(false)); errors.Cause(err) != ErrInvalidTextDataLen {
+		t.Errorf("Expected the option to override the configuration, got %v", err)
+	}
+	if _, err := ParseText("24:30"); errors.Cause(err) != ErrInvalidTimeFormat {
+		t.Errorf("Expected %v, got %v", ErrInvalidTimeFormat, err)
+	}
+}
+
+func TestConcurrentConfig(t *testing.T) {
+	t.Cleanup(func() { _, _ = SetConfig(Config{}) })
+	a := Config{}
+	b := Config{SQLMode: SQLNanoseconds, Precision: PrecisionMicroseconds, Lenient: true}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if j%2 == 0 {
+					_, _ = SetConfig(a)
+				} else {
+					_, _ = SetConfig(b)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if c := CurrentConfig(); c != a && c != b {
+					t.Errorf("Observed a torn configuration: %+v", c)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package timeofday
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+
+	"github.com/pkg/errors"
+)
+
+// interface validations
+var _ jsonv2.MarshalerTo = (*Value)(nil)
+var _ jsonv2.UnmarshalerFrom = (*Value)(nil)
+var _ jsonv2.MarshalerTo = (*NullTimeOfDay)(nil)
+var _ jsonv2.UnmarshalerFrom = (*NullTimeOfDay)(nil)
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface for timeofday.Value values.  The
+// JSON encoding is the same as MarshalJSON().
+func (t Value) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.String(t.String()))
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom interface for timeofday.Value values.
+//
+// As with UnmarshalJSON(), the JSON null token sets t to timeofday.Zero and strings are delegated to
+// UnmarshalText().
+func (t *Value) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case 'n':
+		*t = Zero
+		return nil
+	case '"':
+		return t.UnmarshalText([]byte(tok.String()))
+	default:
+		return errors.Wrapf(ErrInvalidTextData, "unexpected JSON token: %v", tok.Kind())
+	}
+}
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface for NullTimeOfDay values
+func (t NullTimeOfDay) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return t.ToNull().MarshalJSONTo(enc)
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom interface for NullTimeOfDay values
+func (t *NullTimeOfDay) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	n := t.ToNull()
+	if err := n.UnmarshalJSONFrom(dec); err != nil {
+		return err
+	}
+	*t = NullTimeOfDayFrom(n)
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2 && go1.27
+
+package timeofday
+
+import (
+	jsonv2 "encoding/json/v2"
+	"strings"
+	"testing"
+)
+
+func TestJSONv2RoundTrip(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"zero value", Zero, `"00:00:00"`},
+		{"max value", Max, `"23:59:59.999999999"`},
+		{"12:34:56.5", Must(FromUnits(12, 34, 56, 500000000)), `"12:34:56.5"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := jsonv2.Marshal(tc.v)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, data)
+			}
+			var got Value
+			if err := jsonv2.Unmarshal(data, &got); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.v {
+				tt.Errorf("Expected %v, got %v", tc.v, got)
+			}
+		})
+	}
+}
+
+func TestJSONv2Unmarshal(t *testing.T) {
+	cases := []struct {
+		name        string
+		data        string
+		expected    Value
+		expectedErr error
+	}{
+		{"null", `null`, Zero, nil},
+		{"valid string", `"08:30:00"`, Must(FromUnits(8, 30, 0, 0)), nil},
+		{"invalid string", `"25:00:00"`, Zero, ErrInvalidTimeFormat},
+		{"number", `42`, Zero, ErrInvalidTextData},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := Max
+			err := jsonv2.Unmarshal([]byte(tc.data), &got)
+			if tc.expectedErr != nil {
+				// encoding/json/v2 wraps the error in a *json.SemanticError, so match on the message
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr.Error()) {
+					tt.Errorf("Expected error %v, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestJSONv2NullTimeOfDay(t *testing.T) {
+	type wrapper struct {
+		T NullTimeOfDay `json:"t,omitzero"`
+	}
+	cases := []struct {
+		name     string
+		v        wrapper
+		expected string
+	}{
+		{"null is omitted", wrapper{}, `{}`},
+		{"valid", wrapper{NullTimeOfDay{TimeOfDay: Must(FromUnits(8, 30, 0, 0)), Valid: true}}, `{"t":"08:30:00"}`},
+		{"valid midnight", wrapper{NullTimeOfDay{TimeOfDay: Zero, Valid: true}}, `{"t":"00:00:00"}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := jsonv2.Marshal(tc.v)
+			if err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, data)
+			}
+			var got wrapper
+			if err := jsonv2.Unmarshal(data, &got); err != nil {
+				tt.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.v {
+				tt.Errorf("Expected %v, got %v", tc.v, got)
+			}
+		})
+	}
+
+	got := NullTimeOfDay{TimeOfDay: Max, Valid: true}
+	if err := jsonv2.Unmarshal([]byte(`null`), &got); err != nil || got.Valid {
+		t.Errorf("Expected a NULL value, got %v (err = %v)", got, err)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"time"
+
+	"github.com/dylan-bourque/go-types/internal/localized"
+)
+
+// Locale defines the month names, weekday names, AM/PM markers and first day of the week used for
+// localized formatting.  The same locale registry is shared by the date and timeofday packages.
+type Locale = localized.Locale
+
+// RegisterLocale adds the specified locale to the registry used by FormatLocalized(), replacing any
+// existing locale with the same tag.
+func RegisterLocale(l Locale) error {
+	return localized.Register(l)
+}
+
+// Format returns a textual representation of the time of day according to the same rules as
+// time.Time.Format().  Only the clock elements of the layout are meaningful.
+func (t Value) Format(layout string) string {
+	return t.ToDateTimeUTC(1, time.January, 1).Format(layout)
+}
+
+// FormatLocalized returns a textual representation of the time of day according to the same rules as
+// Format(), with the AM/PM markers taken from the locale registered for the specified tag.
+//
+// If no locale is registered for the tag, an error is returned.
+func (t Value) FormatLocalized(layout, tag string) (string, error) {
+	l, err := localized.Lookup(tag)
+	if err != nil {
+		return "", err
+	}
+	return localized.Format(t.ToDateTimeUTC(1, time.January, 1), layout, l), nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import "testing"
+
+func TestFormatLocalized(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		tag      string
+		layout   string
+		expected string
+		valid    bool
+	}{
+		{"unknown locale", Zero, "xx", "3:04 PM", "", false},
+		{"english/AM", Must(FromUnits(9, 5, 0, 0)), "en", "3:04 PM", "9:05 AM", true},
+		{"english/PM", Must(FromUnits(21, 5, 0, 0)), "en", "3:04 PM", "9:05 PM", true},
+		{"french canadian/PM", Must(FromUnits(21, 5, 0, 0)), "fr-CA", "3:04 PM", "9:05 p.m.", true},
+		{"dutch/lower AM", Must(FromUnits(9, 5, 0, 0)), "nl", "03:04:05 pm", "09:05:00 a.m.", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.v.FormatLocalized(tc.layout, tc.tag)
+			if tc.valid != (err == nil) {
+				tt.Errorf("Unexpected error result: %v", err)
+			}
+			if got != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	v := Must(FromUnits(13, 4, 5, 600000000))
+	if got, expected := v.Format("15:04:05.000"), "13:04:05.600"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+	if got, expected := v.Format("3:04 PM"), "1:04 PM"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
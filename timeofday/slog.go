@@ -0,0 +1,25 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"log/slog"
+)
+
+// interface validations
+var _ slog.LogValuer = (*Value)(nil)
+var _ slog.LogValuer = (*NullTimeOfDay)(nil)
+
+// LogValue implements the slog.LogValuer interface for timeofday.Value values, so structured logs
+// show the hh:mm:ss string returned by String() rather than the underlying duration.
+func (t Value) LogValue() slog.Value {
+	return slog.StringValue(t.String())
+}
+
+// LogValue implements the slog.LogValuer interface for NullTimeOfDay values.  NULL is logged as null
+// and all other values are logged as the wrapped timeofday.Value.
+func (t NullTimeOfDay) LogValue() slog.Value {
+	return t.ToNull().LogValue()
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidSlotSize indicates that a slot size is not positive or does not divide 24 hours evenly
+	ErrInvalidSlotSize = errors.Errorf("The slot size must be a positive duration that divides 24 hours evenly")
+	// ErrInvalidSlotIndex indicates that a slot index is outside the range of slots in a day
+	ErrInvalidSlotIndex = errors.Errorf("The slot index is outside the valid range for the slot size")
+)
+
+// validateSlotSize returns ErrInvalidSlotSize if size does not divide the day into equal slots
+func validateSlotSize(size time.Duration) error {
+	if size <= 0 || day%size != 0 {
+		return errors.Wrapf(ErrInvalidSlotSize, "%v", size)
+	}
+	return nil
+}
+
+// SlotsPerDay returns the number of slots of the specified size in a day, such as 96 for 15 minute
+// slots.
+//
+// An error is returned if size is not positive or does not divide 24 hours evenly.
+func SlotsPerDay(size time.Duration) (int, error) {
+	if err := validateSlotSize(size); err != nil {
+		return 0, err
+	}
+	return int(day / size), nil
+}
+
+// SlotIndex returns the index of the slot of the specified size that contains t, where slot 0 starts
+// at midnight.  For example, with 15 minute slots 09:40 is in slot 38, which starts at 09:30.
+//
+// An error is returned if size is not positive or does not divide 24 hours evenly.
+func SlotIndex(t Value, size time.Duration) (int, error) {
+	if err := validateSlotSize(size); err != nil {
+		return 0, err
+	}
+	return int(t.d / size), nil
+}
+
+// SlotStart returns the time of day at which slot i of the specified size starts.  It is the inverse
+// of SlotIndex().
+//
+// An error is returned if size is not positive or does not divide 24 hours evenly, or if i is not in
+// the range [0, SlotsPerDay(size)).
+func SlotStart(i int, size time.Duration) (Value, error) {
+	n, err := SlotsPerDay(size)
+	if err != nil {
+		return Zero, err
+	}
+	if i < 0 || i >= n {
+		return Zero, errors.Wrapf(ErrInvalidSlotIndex, "slot %d of %d", i, n)
+	}
+	return Value{d: time.Duration(i) * size}, nil
+}
+
+// SlotsBetween returns the start times of the slots of the specified size that lie entirely within
+// [a, b), in order.  A slot that starts before a or ends after b is not included, so the result lists
+// the slots that can be booked in the period.
+//
+// If b is before a, the period wraps past midnight, so SlotsBetween(22:00, 02:00, time.Hour) returns
+// 22:00, 23:00, 00:00 and 01:00.  If a and b are equal, the period is empty.
+//
+// An error is returned if size is not positive or does not divide 24 hours evenly.
+func SlotsBetween(a, b Value, size time.Duration) ([]Value, error) {
+	if err := validateSlotSize(size); err != nil {
+		return nil, err
+	}
+	end := b.d
+	if end < a.d {
+		end += day
+	}
+	// round the start up to the next slot boundary
+	start := (a.d + size - 1) / size * size
+	var res []Value
+	if start < end {
+		res = make([]Value, 0, (end-start)/size)
+	}
+	for s := start; s+size <= end; s += size {
+		res = append(res, Value{d: s % day})
+	}
+	return res, nil
+}
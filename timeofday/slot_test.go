@@ -0,0 +1,98 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestSlotIndexAndStart(t *testing.T) {
+	cases := []struct {
+		name  string
+		t     Value
+		size  time.Duration
+		index int
+		start Value
+	}{
+		{"midnight", Zero, 15 * time.Minute, 0, Zero},
+		{"mid slot", Must(FromUnits(9, 40, 0, 0)), 15 * time.Minute, 38, Must(FromUnits(9, 30, 0, 0))},
+		{"slot boundary", Must(FromUnits(9, 45, 0, 0)), 15 * time.Minute, 39, Must(FromUnits(9, 45, 0, 0))},
+		{"last slot", Max, 15 * time.Minute, 95, Must(FromUnits(23, 45, 0, 0))},
+		{"hourly", Must(FromUnits(13, 59, 59, 0)), time.Hour, 13, Must(FromUnits(13, 0, 0, 0))},
+		{"whole day", Max, 24 * time.Hour, 0, Zero},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			i, err := SlotIndex(tc.t, tc.size)
+			if err != nil || i != tc.index {
+				tt.Errorf("Expected slot %d, got %d (err = %v)", tc.index, i, err)
+			}
+			start, err := SlotStart(i, tc.size)
+			if err != nil || start != tc.start {
+				tt.Errorf("Expected slot %d to start at %v, got %v (err = %v)", i, tc.start, start, err)
+			}
+		})
+	}
+}
+
+func TestSlotErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		f    func() error
+		err  error
+	}{
+		{"zero size", func() error { _, err := SlotIndex(Zero, 0); return err }, ErrInvalidSlotSize},
+		{"negative size", func() error { _, err := SlotsPerDay(-time.Hour); return err }, ErrInvalidSlotSize},
+		{"uneven size", func() error { _, err := SlotStart(0, 7*time.Minute); return err }, ErrInvalidSlotSize},
+		{"longer than a day", func() error { _, err := SlotsBetween(Zero, Max, 48*time.Hour); return err }, ErrInvalidSlotSize},
+		{"negative index", func() error { _, err := SlotStart(-1, time.Hour); return err }, ErrInvalidSlotIndex},
+		{"index past end of day", func() error { _, err := SlotStart(24, time.Hour); return err }, ErrInvalidSlotIndex},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if err := tc.f(); errors.Cause(err) != tc.err {
+				tt.Errorf("Expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestSlotsBetween(t *testing.T) {
+	hm := func(h, m int) Value { return Must(FromUnits(h, m, 0, 0)) }
+	cases := []struct {
+		name     string
+		a, b     Value
+		size     time.Duration
+		expected []Value
+	}{
+		{"aligned", hm(9, 0), hm(10, 0), 15 * time.Minute, []Value{hm(9, 0), hm(9, 15), hm(9, 30), hm(9, 45)}},
+		{"unaligned start", hm(9, 10), hm(10, 0), 15 * time.Minute, []Value{hm(9, 15), hm(9, 30), hm(9, 45)}},
+		{"partial last slot", hm(9, 0), hm(9, 50), 15 * time.Minute, []Value{hm(9, 0), hm(9, 15), hm(9, 30)}},
+		{"shorter than a slot", hm(9, 5), hm(9, 20), 15 * time.Minute, nil},
+		{"empty", hm(9, 0), hm(9, 0), 15 * time.Minute, nil},
+		{"wraps midnight", hm(22, 0), hm(2, 0), time.Hour, []Value{hm(22, 0), hm(23, 0), hm(0, 0), hm(1, 0)}},
+		{"to end of day", hm(22, 0), Zero, time.Hour, []Value{hm(22, 0), hm(23, 0)}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := SlotsBetween(tc.a, tc.b, tc.size)
+			if err != nil {
+				tt.Fatalf("Unexpected error %v", err)
+			}
+			if len(got) != len(tc.expected) {
+				tt.Fatalf("Expected %v, got %v", tc.expected, got)
+			}
+			for i := range got {
+				if got[i] != tc.expected[i] {
+					tt.Errorf("Expected %v, got %v", tc.expected, got)
+					break
+				}
+			}
+		})
+	}
+}
@@ -5,10 +5,11 @@
 package timeofday
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"encoding/json"
+	"time"
 
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/dylan-bourque/go-types/null"
 	"github.com/pkg/errors"
 )
 
@@ -18,74 +19,121 @@ var (
 	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a timeofday.Value value")
 )
 
-// Value implements the driver.Valuer interface for Value values.  The returned value is the
-// default string encoding, hh:mm:ss.fffffffff.
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for Value values.  By default, the returned value is
+// the string encoding, hh:mm:ss.fffffffff.  The SQLMode and Precision settings of the package
+// configuration can select an int64 number of nanoseconds or a fixed number of fractional digits.
 func (t Value) Value() (driver.Value, error) {
-	return t.String(), nil
+	return t.sqlValue(CurrentConfig()), nil
 }
 
 // Scan implements the sql.Scanner interface for Value values.
 //
-// An 8-byte slice is handled by UnmarshalBinary() and a string is handled by UnmarshalText().  All other
-// values will return an error
+// A byte slice, either versioned or the legacy 8-byte form, is handled by UnmarshalBinary(), a string
+// is handled by UnmarshalText() and an int64 is the number of nanoseconds since midnight, as written
+// in SQLNanoseconds mode.  A time.Time, which drivers such as go-mssqldb return for TIME columns,
+// provides its clock in its own location.  All other values will return an error
 func (t *Value) Scan(src interface{}) error {
 	switch tv := src.(type) {
 	case []byte:
 		return t.UnmarshalBinary(tv)
 	case string:
 		return t.UnmarshalText([]byte(tv))
+	case int64:
+		v, err := FromDuration(time.Duration(tv))
+		if err != nil {
+			return err
+		}
+		*t = v
+		return nil
+	case time.Time:
+		h, m, s := tv.Clock()
+		v, err := FromUnits(h, m, s, int64(tv.Nanosecond()))
+		if err != nil {
+			return err
+		}
+		*t = v
+		return nil
 	default:
-		return errors.Wrapf(ErrUnsupportedSourceType, "Unsupported type: %T", src)
+		return unsupportedSourceType.Of(src)
 	}
 }
 
 // NullTimeOfDay can be used with the standard sql package to represent a Value value that can
 // be NULL in the database.
+//
+// It behaves exactly like null.Value[Value], to which every method delegates, and ToNull() and
+// NullTimeOfDayFrom() convert between the two.
 type NullTimeOfDay struct {
 	TimeOfDay Value
 	Valid     bool
 }
 
+// NullTimeOfDayFrom converts a null.Value[Value] to a NullTimeOfDay
+func NullTimeOfDayFrom(n null.Value[Value]) NullTimeOfDay {
+	return NullTimeOfDay{TimeOfDay: n.V, Valid: n.Valid}
+}
+
+// ToNull converts t to the equivalent null.Value[Value]
+func (t NullTimeOfDay) ToNull() null.Value[Value] {
+	return null.Value[Value]{V: t.TimeOfDay, Valid: t.Valid}
+}
+
+// IsZero returns true if t is NULL.  It is used by the "omitzero" JSON struct tag option to omit
+// NULL values.
+func (t NullTimeOfDay) IsZero() bool {
+	return !t.Valid
+}
+
 // Value implements the driver.Valuer interface for NullTimeOfDay values
 func (t NullTimeOfDay) Value() (driver.Value, error) {
-	if !t.Valid {
-		return nil, nil
-	}
-	return t.TimeOfDay.Value()
+	return t.ToNull().Value()
 }
 
 // Scan implements the sql.Scanner interface for NullTimeOfDay values
 func (t *NullTimeOfDay) Scan(src interface{}) error {
-	if src == nil {
-		t.TimeOfDay, t.Valid = Zero, false
-		return nil
-	}
-	if err := t.TimeOfDay.Scan(src); err != nil {
+	n := t.ToNull()
+	if err := n.Scan(src); err != nil {
 		return err
 	}
-	t.Valid = true
+	*t = NullTimeOfDayFrom(n)
 	return nil
 }
 
 // MarshalJSON implements the json.Marshaler interface for NullTimeOfDay values
 func (t NullTimeOfDay) MarshalJSON() ([]byte, error) {
-	if !t.Valid {
-		return json.Marshal(nil)
-	}
-	return json.Marshal(t.TimeOfDay)
+	return t.ToNull().MarshalJSON()
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for NullTimeOfDay values
 func (t *NullTimeOfDay) UnmarshalJSON(d []byte) error {
-	if bytes.Equal(d, []byte("null")) {
-		t.TimeOfDay, t.Valid = Zero, false
-		return nil
+	n := t.ToNull()
+	if err := n.UnmarshalJSON(d); err != nil {
+		return err
 	}
+	*t = NullTimeOfDayFrom(n)
+	return nil
+}
 
-	if err := json.Unmarshal(d, &t.TimeOfDay); err != nil {
+// MarshalText implements the encoding.TextMarshaler interface for NullTimeOfDay values.  NULL is
+// encoded as an empty string.
+func (t NullTimeOfDay) MarshalText() ([]byte, error) {
+	return t.ToNull().MarshalText()
+}
+
+// AppendText implements the encoding.TextAppender interface for NullTimeOfDay values
+func (t NullTimeOfDay) AppendText(b []byte) ([]byte, error) {
+	return t.ToNull().AppendText(b)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for NullTimeOfDay values.  Empty
+// text sets t to NULL.
+func (t *NullTimeOfDay) UnmarshalText(text []byte) error {
+	n := t.ToNull()
+	if err := n.UnmarshalText(text); err != nil {
 		return err
 	}
-
-	t.Valid = true
+	*t = NullTimeOfDayFrom(n)
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dylan-bourque/go-types/null"
 	"github.com/pkg/errors"
 )
 
@@ -67,6 +68,8 @@ func TestScanner(t *testing.T) {
 		{"short text input", "blah", Zero, ErrInvalidTextDataLen},
 		{"invalid text input", "24:00:00", Zero, ErrInvalidTimeFormat},
 		{"valid text input", "12:34:56.789012345", Must(FromUnits(12, 34, 56, 789012345)), nil},
+		{"7-digit text input", "12:34:56.7890123", Must(FromUnits(12, 34, 56, 789012300)), nil},
+		{"time input", time.Date(1900, 1, 1, 12, 34, 56, 789012300, time.UTC), Must(FromUnits(12, 34, 56, 789012300)), nil},
 	}
 
 	for _, tc := range cases {
@@ -206,3 +209,28 @@ func TestNullTimeOfDayUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestNullTimeOfDayConversions(t *testing.T) {
+	v := Must(FromUnits(8, 30, 0, 0))
+	nt := NullTimeOfDay{TimeOfDay: v, Valid: true}
+	if n := nt.ToNull(); n.V != v || !n.Valid {
+		t.Errorf("Expected a valid %v, got %+v", v, n)
+	}
+	if got := NullTimeOfDayFrom(null.From(v)); got != nt {
+		t.Errorf("Expected %+v, got %+v", nt, got)
+	}
+
+	var got NullTimeOfDay
+	if err := got.UnmarshalText([]byte("08:30:00")); err != nil || got != nt {
+		t.Errorf("Expected %+v, got %+v (err = %v)", nt, got, err)
+	}
+	if text, err := got.MarshalText(); err != nil || string(text) != "08:30:00" {
+		t.Errorf("Expected 08:30:00, got %q (err = %v)", text, err)
+	}
+	if err := got.UnmarshalText(nil); err != nil || got.Valid {
+		t.Errorf("Expected NULL, got %+v (err = %v)", got, err)
+	}
+	if lv := nt.LogValue(); lv.Resolve().String() != "08:30:00" {
+		t.Errorf("Expected 08:30:00, got %v", lv)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNoValues indicates that a statistic was requested for an empty slice
+	ErrNoValues = errors.Errorf("At least one value is required to compute the statistic")
+	// ErrUndefinedMean indicates that the values are spread evenly around the clock, such as 00:00 and
+	// 12:00, so they have no mean direction
+	ErrUndefinedMean = errors.Errorf("The values are spread evenly around the clock and have no mean")
+)
+
+// meanEpsilon is the smallest mean resultant length, relative to the number of values, for which the
+// mean direction is considered to be defined
+const meanEpsilon = 1e-9
+
+// toAngle converts a time of day to an angle in radians around the 24 hour clock
+func toAngle(d time.Duration) float64 {
+	return 2 * math.Pi * float64(d) / float64(day)
+}
+
+// fromAngle converts an angle in radians around the 24 hour clock to a time of day
+func fromAngle(a float64) Value {
+	d := time.Duration(math.Round(a / (2 * math.Pi) * float64(day)))
+	return Value{}.Add(d)
+}
+
+// Mean returns the circular mean of the specified times of day, which treats the clock as a circle so
+// that times on either side of midnight average correctly: the mean of 23:50 and 00:10 is 00:00
+// rather than 12:00.
+//
+// The result is accurate to within a few nanoseconds.  An error is returned if vs is empty or if the
+// values are spread so evenly around the clock, such as 06:00 and 18:00, that there is no mean.
+func Mean(vs []Value) (Value, error) {
+	a, err := meanAngle(vs)
+	if err != nil {
+		return Zero, err
+	}
+	return fromAngle(a), nil
+}
+
+// meanAngle returns the mean direction of the values in radians
+func meanAngle(vs []Value) (float64, error) {
+	if len(vs) == 0 {
+		return 0, ErrNoValues
+	}
+	var sin, cos float64
+	for _, v := range vs {
+		s, c := math.Sincos(toAngle(v.d))
+		sin += s
+		cos += c
+	}
+	if math.Hypot(sin, cos)/float64(len(vs)) < meanEpsilon {
+		return 0, errors.Wrapf(ErrUndefinedMean, "%d values", len(vs))
+	}
+	return math.Atan2(sin, cos), nil
+}
+
+// Median returns the circular median of the specified times of day.  The clock is cut at the time
+// opposite the circular mean, and the median is the middle value along the resulting line, so a
+// cluster of values around midnight is not split in two.  For an even number of values the result is
+// the midpoint of the two middle values.
+//
+// An error is returned if vs is empty or if the values have no mean, as described for Mean().
+func Median(vs []Value) (Value, error) {
+	a, err := meanAngle(vs)
+	if err != nil {
+		return Zero, err
+	}
+	// measure every value as an offset from the cut point, half a day away from the mean
+	cut := fromAngle(a).Add(day / 2)
+	offsets := make([]time.Duration, len(vs))
+	for i, v := range vs {
+		offsets[i] = v.Sub(cut.d).d
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	mid := len(offsets) / 2
+	m := offsets[mid]
+	if len(offsets)%2 == 0 {
+		m = offsets[mid-1] + (offsets[mid]-offsets[mid-1])/2
+	}
+	return cut.Add(m), nil
+}
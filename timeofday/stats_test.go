@@ -0,0 +1,78 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package timeofday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// circularDistance returns the shortest distance between a and b around the clock
+func circularDistance(a, b Value) time.Duration {
+	d := a.Sub(b.d).d
+	if d > day/2 {
+		d = day - d
+	}
+	return d
+}
+
+func TestMeanAndMedian(t *testing.T) {
+	hm := func(h, m int) Value { return Must(FromUnits(h, m, 0, 0)) }
+	cases := []struct {
+		name   string
+		vs     []Value
+		mean   Value
+		median Value
+	}{
+		{"single value", []Value{hm(9, 30)}, hm(9, 30), hm(9, 30)},
+		{"across midnight", []Value{hm(23, 50), hm(0, 10)}, hm(0, 0), hm(0, 0)},
+		{"same side", []Value{hm(9, 0), hm(11, 0)}, hm(10, 0), hm(10, 0)},
+		{"skewed cluster", []Value{hm(23, 0), hm(23, 30), hm(0, 30), hm(3, 0)}, hm(0, 28), hm(0, 0)},
+		{"odd count across midnight", []Value{hm(23, 40), hm(0, 5), hm(0, 20)}, hm(0, 2), hm(0, 5)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			mean, err := Mean(tc.vs)
+			if err != nil {
+				tt.Fatalf("Unexpected error %v", err)
+			}
+			if circularDistance(mean, tc.mean) > time.Minute {
+				tt.Errorf("Expected a mean of about %v, got %v", tc.mean, mean)
+			}
+			median, err := Median(tc.vs)
+			if err != nil {
+				tt.Fatalf("Unexpected error %v", err)
+			}
+			if circularDistance(median, tc.median) > time.Microsecond {
+				tt.Errorf("Expected a median of %v, got %v", tc.median, median)
+			}
+		})
+	}
+}
+
+func TestStatsErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		vs   []Value
+		err  error
+	}{
+		{"nil", nil, ErrNoValues},
+		{"empty", []Value{}, ErrNoValues},
+		{"opposite", []Value{Must(FromUnits(6, 0, 0, 0)), Must(FromUnits(18, 0, 0, 0))}, ErrUndefinedMean},
+		{"evenly spread", []Value{Zero, Must(FromUnits(8, 0, 0, 0)), Must(FromUnits(16, 0, 0, 0))}, ErrUndefinedMean},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if _, err := Mean(tc.vs); errors.Cause(err) != tc.err {
+				tt.Errorf("Mean: expected %v, got %v", tc.err, err)
+			}
+			if _, err := Median(tc.vs); errors.Cause(err) != tc.err {
+				tt.Errorf("Median: expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -35,6 +35,53 @@ var (
 	ErrInvalidDuration = errors.Errorf("The specified duration is outside the valid range for a Value value")
 )
 
+// RangeError is returned by FromUnits() and FromDuration() when a value is out of range.  It
+// identifies the invalid unit and its valid range.
+//
+// Err is ErrInvalidUnit or ErrInvalidDuration, and is returned by both Cause() and Unwrap() so that
+// errors.Cause() and errors.Is() continue to match it.  Use errors.As() to retrieve the details.
+type RangeError struct {
+	// Err is the sentinel error that describes the failure
+	Err error
+	// Field is the name of the invalid unit: "hour", "minute", "second", "nanosecond" or "duration"
+	Field string
+	// Value is the invalid value, in nanoseconds for durations
+	Value int64
+	// Min and Max are the inclusive bounds of the valid range
+	Min, Max int64
+}
+
+// Error implements the error interface for timeofday.RangeError values
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("%s %d is outside the valid range [%d, %d]: %v", e.Field, e.Value, e.Min, e.Max, e.Err)
+}
+
+// Cause returns the sentinel error for compatibility with errors.Cause()
+func (e *RangeError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the sentinel error for compatibility with errors.Is()
+func (e *RangeError) Unwrap() error {
+	return e.Err
+}
+
+// unitError returns a RangeError for the first invalid unit
+func unitError(h, m, s int, ns int64) error {
+	e := &RangeError{Err: ErrInvalidUnit}
+	switch {
+	case h < 0 || h > 23:
+		e.Field, e.Value, e.Max = "hour", int64(h), 23
+	case m < 0 || m > 59:
+		e.Field, e.Value, e.Max = "minute", int64(m), 59
+	case s < 0 || s > 59:
+		e.Field, e.Value, e.Max = "second", int64(s), 59
+	default:
+		e.Field, e.Value, e.Max = "nanosecond", ns, nsecsPerSecond-1
+	}
+	return e
+}
+
 // Must is a helper that wraps a call to a function that returns (clock.Value, error)
 // and panics if err is non-nil.
 func Must(t Value, err error) Value {
@@ -63,6 +110,8 @@ const (
 	nsecsPerSecond int64 = 1000 * 1000 * 1000
 	nsecsPerMinute int64 = 60 * nsecsPerSecond
 	nsecsPerHour   int64 = 60 * nsecsPerMinute
+
+	day = 24 * time.Hour
 )
 
 // ToUnits returns the hour, minute, second and fractional components of a Value value
@@ -87,7 +136,7 @@ func (t Value) ToUnits() (h, m, s int, ns int64) {
 // of the supported range - [00:00:00 - 24:00:00) - an error is returned
 func FromUnits(h, m, s int, ns int64) (Value, error) {
 	if !IsValidUnits(h, m, s, ns) {
-		return Zero, ErrInvalidUnit
+		return Zero, unitError(h, m, s, ns)
 	}
 	return Value{
 		d: time.Duration((int64(h) * nsecsPerHour) + (int64(m) * nsecsPerMinute) + (int64(s) * nsecsPerSecond) + ns),
@@ -113,7 +162,7 @@ func ToDuration(t Value) time.Duration {
 // If the provided duration is outside of the supported range - [00:00:00 - 24:00:00) - an error is returned.
 func FromDuration(d time.Duration) (Value, error) {
 	if !IsValidDuration(d) {
-		return Zero, ErrInvalidDuration
+		return Zero, &RangeError{Err: ErrInvalidDuration, Field: "duration", Value: int64(d), Max: int64(Max.d)}
 	}
 	return Value{d: d}, nil
 }
@@ -140,12 +189,29 @@ func (t Value) ToDateTimeInLocation(year int, month time.Month, day int, loc *ti
 // String returns a string representation of the Value value, formatted as "hh:mm:ss.fffffffff",
 // with the fractional portion omitted if it is zero or trailing zeros trimmed otherwise
 func (t Value) String() string {
+	return string(t.appendText(make([]byte, 0, maxTextLen)))
+}
+
+// maxTextLen is the length of the longest text representation of a Value, "hh:mm:ss.fffffffff"
+const maxTextLen = 18
+
+// appendText appends the text representation of t, as returned by String(), to b
+func (t Value) appendText(b []byte) []byte {
 	h, m, s, ns := t.ToUnits()
-	result := fmt.Sprintf("%02d:%02d:%02d", h, m, s)
+	b = append2Digits(b, h)
+	b = append(b, ':')
+	b = append2Digits(b, m)
+	b = append(b, ':')
+	b = append2Digits(b, s)
 	if ns > 0 {
-		result += fmtFrac(uint64(ns))
+		b = appendFrac(b, uint64(ns))
 	}
-	return result
+	return b
+}
+
+// append2Digits appends v, which must be in the range [0..99], to b as 2 zero-padded decimal digits
+func append2Digits(b []byte, v int) []byte {
+	return append(b, byte('0'+v/10), byte('0'+v%10))
 }
 
 // ParseDuration constructs a value from the specified duration string
@@ -167,14 +233,14 @@ func ParseTime(s string) (Value, error) {
 	return FromUnits(hr, min, sec, int64(t.Nanosecond()))
 }
 
-// fmtFrac formats the fraction of v/10**9 (e.g., ".12345") into a string, omitting trailing zeros.
+// appendFrac appends the fraction of v/10**9 (e.g., ".12345") to b, omitting trailing zeros.
 // It omits the decimal point too if the fraction is 0.
 //
 // NOTE: shamelessly "borrowed" from the Go source code for formatting the fractional portion of
 // time.Duration values
-func fmtFrac(v uint64) string {
+func appendFrac(b []byte, v uint64) []byte {
 	// v is always in the range [0..10^9], so we need a max. of 10 characters
-	buf := make([]byte, 10)
+	var buf [10]byte
 	w, print := len(buf), false
 	for i := 0; i < 9; i++ {
 		digit := v % 10
@@ -189,23 +255,24 @@ func fmtFrac(v uint64) string {
 		w--
 		buf[w] = '.'
 	}
-	return string(buf[w:])
+	return append(b, buf[w:]...)
 }
 
 // Add adds the specified duration to t, normalizing the result to [00:00:00...24:00:00)
+//
+// The normalization is done with modulo arithmetic, so it takes constant time and cannot overflow
+// for any duration.
 func (t Value) Add(d time.Duration) Value {
-	res := time.Duration(t.d + d)
-	// adjust the result until we're within the supported range
+	// reduce d first so that t.d + d cannot overflow
+	res := (t.d + d%day) % day
 	if res < 0 {
-		res = (24 * time.Hour) - ((-1 * res) % (24 * time.Hour))
-	}
-	if res >= 24*time.Hour {
-		res %= 24 * time.Hour
+		res += day
 	}
 	return Value{d: res}
 }
 
 // Sub adds the specified duration from t, normalizing the result to [00:00:00...24:00:00)
 func (t Value) Sub(d time.Duration) Value {
-	return t.Add(-1 * d)
+	// negate the reduced duration because -d overflows for math.MinInt64
+	return t.Add(-(d % day))
 }
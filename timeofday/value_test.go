@@ -5,7 +5,9 @@
 package timeofday
 
 import (
+	stderrors "errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"testing"
@@ -50,7 +52,7 @@ func TestConstructTimeFromInvalidUnits(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(tt *testing.T) {
 			got, err := FromUnits(tc.h, tc.m, tc.s, tc.ns)
-			if got != Zero || err != ErrInvalidUnit {
+			if got != Zero || errors.Cause(err) != ErrInvalidUnit {
 				t.Errorf("%02d:%02d:%02d.%d - Expected error, got (%s, <nil>)", tc.h, tc.m, tc.s, tc.ns, got.d)
 			}
 		})
@@ -84,7 +86,7 @@ func TestConstructTimeFromInvalidDuration(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(tt *testing.T) {
 			got, err := FromDuration(tc.dur)
-			if err != ErrInvalidDuration {
+			if errors.Cause(err) != ErrInvalidDuration {
 				t.Errorf("Expected %v, got %v", ErrInvalidDuration, err)
 			}
 			if got != Zero {
@@ -420,3 +422,110 @@ func TestFromTimeString(t *testing.T) {
 		})
 	}
 }
+
+// addByLoop is a reference implementation of Value.Add() that normalizes by repeatedly adding or
+// subtracting 24 hours
+func addByLoop(t Value, d time.Duration) Value {
+	res := t.d
+	for days := d / (24 * time.Hour); days != 0; {
+		if days > 0 {
+			days--
+			d -= 24 * time.Hour
+		} else {
+			days++
+			d += 24 * time.Hour
+		}
+	}
+	res += d
+	for res < 0 {
+		res += 24 * time.Hour
+	}
+	for res >= 24*time.Hour {
+		res -= 24 * time.Hour
+	}
+	return Value{d: res}
+}
+
+func TestAddMatchesLoopNormalization(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 10000; i++ {
+		tv := Value{d: time.Duration(rng.Int63n(int64(24 * time.Hour)))}
+		// up to +/- 1000 days so that the loop stays cheap
+		d := time.Duration(rng.Int63n(int64(2000*24*time.Hour))) - 1000*24*time.Hour
+		if got, expected := tv.Add(d), addByLoop(tv, d); got != expected {
+			t.Fatalf("%v.Add(%v): expected %v, got %v", tv, d, expected, got)
+		}
+		if got, expected := tv.Sub(d), addByLoop(tv, -d); got != expected {
+			t.Fatalf("%v.Sub(%v): expected %v, got %v", tv, d, expected, got)
+		}
+	}
+}
+
+func TestAddExtremeDurations(t *testing.T) {
+	// compare against time.Time arithmetic, which handles the full time.Duration range
+	base := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	reference := func(tv Value, d time.Duration) Value {
+		res := base.Add(tv.d).Add(d)
+		return Must(FromUnits(res.Hour(), res.Minute(), res.Second(), int64(res.Nanosecond())))
+	}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	durations := []time.Duration{math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, math.MinInt64 + 1}
+	for i := 0; i < 1000; i++ {
+		durations = append(durations, time.Duration(rng.Uint64()))
+	}
+	for _, tv := range []Value{Min, Max, Must(FromUnits(12, 0, 0, 0))} {
+		for _, d := range durations {
+			got := tv.Add(d)
+			if !got.IsValid() {
+				t.Fatalf("%v.Add(%d) returned invalid value %v", tv, d, got.d)
+			}
+			if expected := reference(tv, d); got != expected {
+				t.Fatalf("%v.Add(%d): expected %v, got %v", tv, d, expected, got)
+			}
+		}
+	}
+	// Sub() must not overflow when negating math.MinInt64
+	if got, expected := Min.Sub(math.MinInt64), Min.Add(math.MaxInt64).Add(time.Nanosecond); got != expected {
+		t.Errorf("Sub(math.MinInt64): expected %v, got %v", expected, got)
+	}
+}
+
+func TestRangeError(t *testing.T) {
+	cases := []struct {
+		name     string
+		f        func() (Value, error)
+		expected RangeError
+	}{
+		{"hour", func() (Value, error) { return FromUnits(24, 0, 0, 0) }, RangeError{ErrInvalidUnit, "hour", 24, 0, 23}},
+		{"minute", func() (Value, error) { return FromUnits(0, -1, 0, 0) }, RangeError{ErrInvalidUnit, "minute", -1, 0, 59}},
+		{"second", func() (Value, error) { return FromUnits(0, 0, 60, 0) }, RangeError{ErrInvalidUnit, "second", 60, 0, 59}},
+		{"nanosecond", func() (Value, error) { return FromUnits(0, 0, 0, 1e9) }, RangeError{ErrInvalidUnit, "nanosecond", 1e9, 0, 999999999}},
+		{"duration", func() (Value, error) { return FromDuration(24 * time.Hour) }, RangeError{ErrInvalidDuration, "duration", int64(24 * time.Hour), 0, int64(Max.d)}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := tc.f()
+			if errors.Cause(err) != tc.expected.Err || !stderrors.Is(err, tc.expected.Err) {
+				tt.Errorf("Expected the error to match %v, got %v", tc.expected.Err, err)
+			}
+			var re *RangeError
+			if !stderrors.As(err, &re) {
+				tt.Fatalf("Expected a *RangeError, got %T", err)
+			}
+			if *re != tc.expected {
+				tt.Errorf("Expected %+v, got %+v", tc.expected, *re)
+			}
+		})
+	}
+}
+
+func TestWrappedErrorsMatchErrorsIs(t *testing.T) {
+	_, err := ParseDuration("25h")
+	if !stderrors.Is(err, ErrInvalidDuration) {
+		t.Errorf("Expected errors.Is() to match %v through the wrapped error, got %v", ErrInvalidDuration, err)
+	}
+	var v Value
+	if err := v.UnmarshalBinary([]byte{9, 0, 0, 0, 0, 0, 0, 0, 0}); !stderrors.Is(err, ErrUnsupportedBinaryVersion) {
+		t.Errorf("Expected errors.Is() to match %v, got %v", ErrUnsupportedBinaryVersion, err)
+	}
+}
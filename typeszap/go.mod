@@ -0,0 +1,16 @@
+module github.com/dylan-bourque/go-types/typeszap
+
+go 1.25.0
+
+require (
+	github.com/dylan-bourque/go-types v0.0.0-00010101000000-000000000000
+	go.uber.org/zap v1.28.0
+)
+
+require (
+	github.com/pkg/errors v0.9.1 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+)
+
+replace github.com/dylan-bourque/go-types => ../
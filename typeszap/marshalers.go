@@ -0,0 +1,72 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typeszap
+
+import (
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/freebusy"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// interface validations
+var _ zapcore.ArrayMarshaler = DateArray(nil)
+var _ zapcore.ObjectMarshaler = DateSpanObject{}
+var _ zapcore.ObjectMarshaler = IntervalObject{}
+
+// DateArray implements zapcore.ArrayMarshaler for a slice of dates, logging each as YYYY-MM-DD or
+// null for date.Nil
+type DateArray []date.Value
+
+// MarshalLogArray implements the zapcore.ArrayMarshaler interface
+func (a DateArray) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range a {
+		if v == date.Nil {
+			if err := enc.AppendReflected(nil); err != nil {
+				return err
+			}
+			continue
+		}
+		enc.AppendString(v.String())
+	}
+	return nil
+}
+
+// Dates returns a field containing an array of dates
+func Dates(key string, vs []date.Value) zap.Field {
+	return zap.Array(key, DateArray(vs))
+}
+
+// DateSpanObject implements zapcore.ObjectMarshaler for a freebusy.DateSpan, logging it as an object
+// with "start" and "end" dates
+type DateSpanObject freebusy.DateSpan
+
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface
+func (o DateSpanObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	Date("start", o.Start).AddTo(enc)
+	Date("end", o.End).AddTo(enc)
+	return nil
+}
+
+// DateSpan returns a field containing a freebusy.DateSpan as an object with "start" and "end" dates
+func DateSpan(key string, v freebusy.DateSpan) zap.Field {
+	return zap.Object(key, DateSpanObject(v))
+}
+
+// IntervalObject implements zapcore.ObjectMarshaler for a freebusy.Interval, logging it as an object
+// with "start" and "end" times encoded by the configured time encoder
+type IntervalObject freebusy.Interval
+
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface
+func (o IntervalObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddTime("start", o.Start)
+	enc.AddTime("end", o.End)
+	return nil
+}
+
+// Interval returns a field containing a freebusy.Interval as an object with "start" and "end" times
+func Interval(key string, v freebusy.Interval) zap.Field {
+	return zap.Object(key, IntervalObject(v))
+}
@@ -0,0 +1,40 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typeszap
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/freebusy"
+)
+
+func TestDates(t *testing.T) {
+	got := encode(Dates("k", []date.Value{date.Must(date.FromUnits(2024, 7, 14)), date.Nil}))
+	expected := []interface{}{"2024-07-14", nil}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestDateSpan(t *testing.T) {
+	got := encode(DateSpan("k", freebusy.DateSpan{Start: date.Must(date.FromUnits(2024, 7, 14)), End: date.Nil}))
+	expected := map[string]interface{}{"start": "2024-07-14", "end": nil}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestInterval(t *testing.T) {
+	start := time.Date(2024, 7, 14, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	got := encode(Interval("k", freebusy.Interval{Start: start, End: end}))
+	expected := map[string]interface{}{"start": start, "end": end}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+// Package typeszap provides zap field constructors for the types in this module, so that zap
+// (go.uber.org/zap) logs show the same canonical strings as the JSON and slog encodings rather than
+// reflected internals, such as the Julian day number of a date.
+//
+// Each constructor formats the value eagerly into a string field, which avoids the interface boxing
+// and reflection of zap.Any().  Nil values, such as date.Nil, NULL null.Value values and absent
+// optional.Value values, are logged as null.  Slices of most types can be logged with zap.Stringers().
+package typeszap
+
+import (
+	"fmt"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/emailaddr"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/hostport"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/dylan-bourque/go-types/optional"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/dylan-bourque/go-types/ulid"
+	"go.uber.org/zap"
+)
+
+// Date returns a field containing the date formatted as YYYY-MM-DD, or null for date.Nil
+func Date(key string, v date.Value) zap.Field {
+	if v == date.Nil {
+		return nullField(key)
+	}
+	return zap.String(key, v.String())
+}
+
+// TimeOfDay returns a field containing the time formatted as hh:mm:ss with optional fractional seconds
+func TimeOfDay(key string, v timeofday.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// PartialDate returns a field containing the date formatted as YYYY, YYYY-MM or YYYY-MM-DD, or null
+// for partialdate.Nil
+func PartialDate(key string, v partialdate.Value) zap.Field {
+	if v.IsNil() {
+		return nullField(key)
+	}
+	return zap.String(key, v.String())
+}
+
+// ULID returns a field containing the 26 character ULID string, or null for ulid.Nil
+func ULID(key string, v ulid.Value) zap.Field {
+	if v.IsNil() {
+		return nullField(key)
+	}
+	return zap.String(key, v.String())
+}
+
+// Snowflake returns a field containing the ID as a decimal string, which matches its JSON encoding
+func Snowflake(key string, v snowflake.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Int128 returns a field containing the value as a decimal string
+func Int128(key string, v int128.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Uint128 returns a field containing the value as a decimal string
+func Uint128(key string, v uint128.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Ratio returns a field containing the value formatted as n/d, or n when the denominator is 1
+func Ratio(key string, v ratio.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// FlexNum returns a field containing the exact decimal string, or null for flexnum.Nil
+func FlexNum(key string, v flexnum.Value) zap.Field {
+	if v.IsNil() {
+		return nullField(key)
+	}
+	return zap.String(key, v.String())
+}
+
+// ByteSize returns a field containing the size with a unit suffix, such as "10MiB"
+func ByteSize(key string, v bytesize.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// Color returns a field containing the color formatted as #rrggbb or #rrggbbaa
+func Color(key string, v color.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// EmailAddress returns a field containing the address, with the display name if there is one
+func EmailAddress(key string, v emailaddr.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// HostPort returns a field containing the value formatted as host:port
+func HostPort(key string, v hostport.Value) zap.Field {
+	return zap.String(key, v.String())
+}
+
+// LangTag returns a field containing the canonical BCP 47 tag, or null for langtag.Nil
+func LangTag(key string, v langtag.Value) zap.Field {
+	if v.IsNil() {
+		return nullField(key)
+	}
+	return zap.String(key, v.String())
+}
+
+// Addr returns a field containing the IP address, or null for the zero value
+func Addr(key string, v inet.Addr) zap.Field {
+	if !v.IsValid() {
+		return nullField(key)
+	}
+	return zap.String(key, v.String())
+}
+
+// Prefix returns a field containing the IP prefix in CIDR notation, or null for the zero value
+func Prefix(key string, v inet.Prefix) zap.Field {
+	if !v.IsValid() {
+		return nullField(key)
+	}
+	return zap.String(key, v.String())
+}
+
+// Null returns a field containing the string returned by the String() method of the wrapped value,
+// or null if v is NULL
+func Null[T fmt.Stringer](key string, v null.Value[T]) zap.Field {
+	if !v.Valid {
+		return nullField(key)
+	}
+	return zap.String(key, v.V.String())
+}
+
+// Optional returns a field containing the string returned by the String() method of the wrapped
+// value, or null if v is absent
+func Optional[T fmt.Stringer](key string, v optional.Value[T]) zap.Field {
+	s, ok := v.Get()
+	if !ok {
+		return nullField(key)
+	}
+	return zap.String(key, s.String())
+}
+
+// nullField returns a field that is encoded as null
+func nullField(key string) zap.Field {
+	return zap.Reflect(key, nil)
+}
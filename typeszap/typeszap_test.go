@@ -0,0 +1,90 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typeszap
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/emailaddr"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/hostport"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/null"
+	"github.com/dylan-bourque/go-types/optional"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/dylan-bourque/go-types/ulid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// encode adds f to a map encoder and returns the encoded value
+func encode(f zap.Field) interface{} {
+	enc := zapcore.NewMapObjectEncoder()
+	f.AddTo(enc)
+	return enc.Fields[f.Key]
+}
+
+func TestFields(t *testing.T) {
+	cases := []struct {
+		name     string
+		f        zap.Field
+		expected interface{}
+	}{
+		{"date", Date("k", date.Must(date.FromUnits(2024, 7, 14))), "2024-07-14"},
+		{"nil date", Date("k", date.Nil), nil},
+		{"time of day", TimeOfDay("k", timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000))), "13:45:30.5"},
+		{"partial date", PartialDate("k", partialdate.Must(partialdate.FromYearMonth(2024, 7))), "2024-07"},
+		{"nil partial date", PartialDate("k", partialdate.Nil), nil},
+		{"ulid", ULID("k", ulid.Max), "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		{"nil ulid", ULID("k", ulid.Nil), nil},
+		{"snowflake", Snowflake("k", snowflake.Value(1541815603606036480)), "1541815603606036480"},
+		{"int128", Int128("k", int128.From64(-42)), "-42"},
+		{"uint128", Uint128("k", uint128.Max), "340282366920938463463374607431768211455"},
+		{"ratio", Ratio("k", ratio.Must(ratio.New(-3, 4))), "-3/4"},
+		{"flexnum", FlexNum("k", flexnum.Must(flexnum.Parse("12.50"))), "12.50"},
+		{"nil flexnum", FlexNum("k", flexnum.Nil), nil},
+		{"bytesize", ByteSize("k", 10*bytesize.MiB), "10MiB"},
+		{"color", Color("k", color.Must(color.Parse("rebeccapurple"))), "#663399"},
+		{"email address", EmailAddress("k", emailaddr.Must(emailaddr.Parse("jane@example.com"))), "jane@example.com"},
+		{"host and port", HostPort("k", hostport.Must(hostport.New("::1", 8080))), "[::1]:8080"},
+		{"language tag", LangTag("k", langtag.Must(langtag.Parse("en-us"))), "en-US"},
+		{"nil language tag", LangTag("k", langtag.Nil), nil},
+		{"addr", Addr("k", inet.MustParseAddr("10.0.0.1")), "10.0.0.1"},
+		{"zero addr", Addr("k", inet.Addr{}), nil},
+		{"prefix", Prefix("k", inet.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")}), "10.0.0.0/8"},
+		{"zero prefix", Prefix("k", inet.Prefix{}), nil},
+		{"null", Null("k", null.Value[timeofday.Value]{V: timeofday.Zero, Valid: true}), "00:00:00"},
+		{"null null", Null("k", null.Value[timeofday.Value]{}), nil},
+		{"optional", Optional("k", optional.Of(color.White)), "#ffffff"},
+		{"absent optional", Optional("k", optional.None[color.Value]()), nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := encode(tc.f); got != tc.expected {
+				tt.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFieldAllocations(t *testing.T) {
+	v := ulid.Max
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = ULID("k", v)
+	})
+	if allocs > 1 {
+		t.Errorf("Expected at most 1 allocation, got %v", allocs)
+	}
+}
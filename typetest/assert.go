@@ -0,0 +1,185 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typetest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// appendPrefix is passed to AppendText() and AppendBinary() to check that they append to, rather
+// than overwrite, the existing contents of the buffer
+const appendPrefix = "prefix:"
+
+// AssertText checks that v survives a round trip through its MarshalText() and UnmarshalText()
+// methods.  The test fails if T does not implement both.  If T also implements
+// encoding.TextAppender, AppendText() must append the same text as MarshalText() returns.
+func AssertText[T any](tb testing.TB, v T) {
+	tb.Helper()
+	m, ok := any(v).(encoding.TextMarshaler)
+	if !ok {
+		tb.Fatalf("%T does not implement encoding.TextMarshaler", v)
+	}
+	var got T
+	u, ok := any(&got).(encoding.TextUnmarshaler)
+	if !ok {
+		tb.Fatalf("*%T does not implement encoding.TextUnmarshaler", v)
+	}
+	text, err := m.MarshalText()
+	if err != nil {
+		tb.Fatalf("MarshalText(%v) failed: %v", v, err)
+	}
+	if a, ok := any(v).(encoding.TextAppender); ok {
+		appended, err := a.AppendText([]byte(appendPrefix))
+		if err != nil {
+			tb.Fatalf("AppendText(%v) failed: %v", v, err)
+		}
+		if string(appended) != appendPrefix+string(text) {
+			tb.Errorf("AppendText(%v) appended %q, expected %q", v, appended, appendPrefix+string(text))
+		}
+	}
+	if err := u.UnmarshalText(text); err != nil {
+		tb.Fatalf("UnmarshalText(%q) failed: %v", text, err)
+	}
+	if !equal(v, got) {
+		tb.Errorf("Text round trip of %v through %q returned %v", v, text, got)
+	}
+}
+
+// AssertBinary checks that v survives a round trip through its MarshalBinary() and
+// UnmarshalBinary() methods.  The test fails if T does not implement both.  If T also implements
+// encoding.BinaryAppender, AppendBinary() must append the same data as MarshalBinary() returns.
+func AssertBinary[T any](tb testing.TB, v T) {
+	tb.Helper()
+	m, ok := any(v).(encoding.BinaryMarshaler)
+	if !ok {
+		tb.Fatalf("%T does not implement encoding.BinaryMarshaler", v)
+	}
+	var got T
+	u, ok := any(&got).(encoding.BinaryUnmarshaler)
+	if !ok {
+		tb.Fatalf("*%T does not implement encoding.BinaryUnmarshaler", v)
+	}
+	data, err := m.MarshalBinary()
+	if err != nil {
+		tb.Fatalf("MarshalBinary(%v) failed: %v", v, err)
+	}
+	if a, ok := any(v).(encoding.BinaryAppender); ok {
+		appended, err := a.AppendBinary([]byte(appendPrefix))
+		if err != nil {
+			tb.Fatalf("AppendBinary(%v) failed: %v", v, err)
+		}
+		if string(appended) != appendPrefix+string(data) {
+			tb.Errorf("AppendBinary(%v) appended %x, expected %x", v, appended, appendPrefix+string(data))
+		}
+	}
+	if err := u.UnmarshalBinary(data); err != nil {
+		tb.Fatalf("UnmarshalBinary(%x) failed: %v", data, err)
+	}
+	if !equal(v, got) {
+		tb.Errorf("Binary round trip of %v through %x returned %v", v, data, got)
+	}
+}
+
+// AssertJSON checks that v survives a round trip through encoding/json
+func AssertJSON[T any](tb testing.TB, v T) {
+	tb.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		tb.Fatalf("json.Marshal(%v) failed: %v", v, err)
+	}
+	var got T
+	if err := json.Unmarshal(data, &got); err != nil {
+		tb.Fatalf("json.Unmarshal(%s) failed: %v", data, err)
+	}
+	if !equal(v, got) {
+		tb.Errorf("JSON round trip of %v through %s returned %v", v, data, got)
+	}
+}
+
+// AssertSQL checks that v survives a round trip through its Value() and Scan() methods, and that
+// Value() returns one of the types allowed by driver.Value.  The test fails if T does not implement
+// driver.Valuer or *T does not implement sql.Scanner.
+func AssertSQL[T any](tb testing.TB, v T) {
+	tb.Helper()
+	valuer, ok := any(v).(driver.Valuer)
+	if !ok {
+		tb.Fatalf("%T does not implement driver.Valuer", v)
+	}
+	var got T
+	scanner, ok := any(&got).(sql.Scanner)
+	if !ok {
+		tb.Fatalf("*%T does not implement sql.Scanner", v)
+	}
+	dv, err := valuer.Value()
+	if err != nil {
+		tb.Fatalf("Value() of %v failed: %v", v, err)
+	}
+	if !driver.IsValue(dv) {
+		tb.Fatalf("Value() of %v returned %T, which is not a valid driver.Value", v, dv)
+	}
+	if err := scanner.Scan(dv); err != nil {
+		tb.Fatalf("Scan(%#v) failed: %v", dv, err)
+	}
+	if !equal(v, got) {
+		tb.Errorf("SQL round trip of %v through %#v returned %v", v, dv, got)
+	}
+}
+
+// AssertRoundTrip runs AssertText(), AssertBinary() and AssertSQL() for each of the values, skipping
+// the encodings that T does not implement, and AssertJSON().  Each value is checked in a separate
+// subtest when tb is a *testing.T.
+func AssertRoundTrip[T any](tb testing.TB, vs ...T) {
+	tb.Helper()
+	var zero T
+	_, text := any(zero).(encoding.TextMarshaler)
+	_, binary := any(zero).(encoding.BinaryMarshaler)
+	_, valuer := any(zero).(driver.Valuer)
+	check := func(tb testing.TB, v T) {
+		tb.Helper()
+		if text {
+			AssertText(tb, v)
+		}
+		if binary {
+			AssertBinary(tb, v)
+		}
+		AssertJSON(tb, v)
+		if valuer {
+			AssertSQL(tb, v)
+		}
+	}
+	for _, v := range vs {
+		if t, ok := tb.(*testing.T); ok {
+			t.Run(stringOf(v), func(tt *testing.T) {
+				check(tt, v)
+			})
+			continue
+		}
+		check(tb, v)
+	}
+}
+
+// equal reports whether a and b are the same value.  Nil and empty slices are considered equal.
+func equal[T any](a, b T) bool {
+	if av := reflect.ValueOf(a); av.Kind() == reflect.Slice {
+		bv := reflect.ValueOf(b)
+		if av.Len() == 0 && bv.Len() == 0 {
+			return true
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// stringOf returns a subtest name for v
+func stringOf(v any) string {
+	if s, ok := v.(interface{ String() string }); ok {
+		return s.String()
+	}
+	return reflect.TypeOf(v).String()
+}
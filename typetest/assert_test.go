@@ -0,0 +1,149 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typetest
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// n is the number of generated values checked for each type
+const n = 200
+
+func TestRoundTrips(t *testing.T) {
+	r := NewRand(42)
+	t.Run("date", func(tt *testing.T) {
+		vs := append(Values(DateCorpus()), Generate(r, n, Date)...)
+		for _, v := range vs {
+			AssertBinary(tt, v)
+			AssertJSON(tt, v)
+		}
+	})
+	t.Run("timeofday", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(TimeOfDayCorpus()), Generate(r, n, TimeOfDay)...)...)
+	})
+	t.Run("partialdate", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(PartialDateCorpus()), Generate(r, n, PartialDate)...)...)
+	})
+	t.Run("ulid", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(ULIDCorpus()), Generate(r, n, ULID)...)...)
+	})
+	t.Run("snowflake", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(SnowflakeCorpus()), Generate(r, n, Snowflake)...)...)
+	})
+	t.Run("int128", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(Int128Corpus()), Generate(r, n, Int128)...)...)
+	})
+	t.Run("uint128", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(Uint128Corpus()), Generate(r, n, Uint128)...)...)
+	})
+	t.Run("ratio", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(RatioCorpus()), Generate(r, n, Ratio)...)...)
+	})
+	t.Run("bytesize", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(ByteSizeCorpus()), Generate(r, n, ByteSize)...)...)
+	})
+	t.Run("color", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(ColorCorpus()), Generate(r, n, Color)...)...)
+	})
+	t.Run("flexnum", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(FlexNumCorpus()), Generate(r, n, FlexNum)...)...)
+	})
+	t.Run("hexbytes", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(HexBytesCorpus()), Generate(r, n, HexBytes)...)...)
+	})
+	t.Run("b64bytes", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(B64BytesCorpus()), Generate(r, n, B64Bytes)...)...)
+	})
+	t.Run("b64urlbytes", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(B64URLBytesCorpus()), Generate(r, n, B64URLBytes)...)...)
+	})
+	t.Run("addr", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(AddrCorpus()), Generate(r, n, Addr)...)...)
+	})
+	t.Run("prefix", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(PrefixCorpus()), Generate(r, n, Prefix)...)...)
+	})
+	t.Run("emailaddr", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(EmailAddressCorpus()), Generate(r, n, EmailAddress)...)...)
+	})
+	t.Run("hostport", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(HostPortCorpus()), Generate(r, n, HostPort)...)...)
+	})
+	t.Run("langtag", func(tt *testing.T) {
+		AssertRoundTrip(tt, append(Values(LangTagCorpus()), Generate(r, n, LangTag)...)...)
+	})
+}
+
+// recorder is a testing.TB that records failures instead of reporting them
+type recorder struct {
+	testing.TB
+	failed bool
+	msg    string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failed, r.msg = true, fmt.Sprintf(format, args...)
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// run calls f with a recorder on a separate goroutine, so that Fatalf() can stop it
+func run(f func(tb testing.TB)) *recorder {
+	r := &recorder{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		f(r)
+	}()
+	wg.Wait()
+	return r
+}
+
+// lossy is a test type whose encodings drop the sign
+type lossy int
+
+func (l lossy) MarshalText() ([]byte, error) {
+	if l < 0 {
+		l = -l
+	}
+	return []byte(fmt.Sprint(int(l))), nil
+}
+
+func (l *lossy) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscan(string(text), (*int)(l))
+	return err
+}
+
+func TestAssertFailures(t *testing.T) {
+	cases := []struct {
+		name string
+		f    func(tb testing.TB)
+	}{
+		{"lossy text", func(tb testing.TB) { AssertText(tb, lossy(-1)) }},
+		{"no text", func(tb testing.TB) { AssertText(tb, 42) }},
+		{"no binary", func(tb testing.TB) { AssertBinary(tb, lossy(1)) }},
+		{"no sql", func(tb testing.TB) { AssertSQL(tb, lossy(1)) }},
+		{"unsupported json", func(tb testing.TB) { AssertJSON(tb, make(chan int)) }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if r := run(tc.f); !r.failed {
+				tt.Errorf("Expected the assertion to fail")
+			}
+		})
+	}
+	if r := run(func(tb testing.TB) { AssertText(tb, lossy(1)) }); r.failed {
+		t.Errorf("Unexpected failure: %s", r.msg)
+	}
+}
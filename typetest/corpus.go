@@ -0,0 +1,233 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typetest
+
+import (
+	"net/netip"
+
+	"github.com/dylan-bourque/go-types/b64bytes"
+	"github.com/dylan-bourque/go-types/b64urlbytes"
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/emailaddr"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/hexbytes"
+	"github.com/dylan-bourque/go-types/hostport"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/dylan-bourque/go-types/ulid"
+)
+
+// DateCorpus returns boundary dates: the supported range, the Unix epoch, leap days and century
+// years.  date.Nil is not included because it has no string form.
+func DateCorpus() []Case[date.Value] {
+	return []Case[date.Value]{
+		{"min", date.Min, "1753-01-01"},
+		{"max", date.Max, "9999-12-31"},
+		{"unix epoch", date.Must(date.FromUnits(1970, 1, 1)), "1970-01-01"},
+		{"day before unix epoch", date.Must(date.FromUnits(1969, 12, 31)), "1969-12-31"},
+		{"leap day", date.Must(date.FromUnits(2024, 2, 29)), "2024-02-29"},
+		{"leap century", date.Must(date.FromUnits(2000, 2, 29)), "2000-02-29"},
+		{"non-leap century", date.Must(date.FromUnits(1900, 2, 28)), "1900-02-28"},
+		{"end of year", date.Must(date.FromUnits(1999, 12, 31)), "1999-12-31"},
+	}
+}
+
+// TimeOfDayCorpus returns boundary times of day: midnight, noon, the last nanosecond of the day and
+// times with each fractional precision
+func TimeOfDayCorpus() []Case[timeofday.Value] {
+	return []Case[timeofday.Value]{
+		{"midnight", timeofday.Zero, "00:00:00"},
+		{"noon", timeofday.Must(timeofday.FromUnits(12, 0, 0, 0)), "12:00:00"},
+		{"max", timeofday.Max, "23:59:59.999999999"},
+		{"milliseconds", timeofday.Must(timeofday.FromUnits(13, 45, 30, 500000000)), "13:45:30.5"},
+		{"microseconds", timeofday.Must(timeofday.FromUnits(0, 0, 0, 1000)), "00:00:00.000001"},
+		{"nanoseconds", timeofday.Must(timeofday.FromUnits(0, 0, 0, 1)), "00:00:00.000000001"},
+	}
+}
+
+// PartialDateCorpus returns partial dates with each precision, plus partialdate.Nil
+func PartialDateCorpus() []Case[partialdate.Value] {
+	return []Case[partialdate.Value]{
+		{"nil", partialdate.Nil, ""},
+		{"min year", partialdate.Must(partialdate.FromYear(1753)), "1753"},
+		{"max year", partialdate.Must(partialdate.FromYear(9999)), "9999"},
+		{"year and month", partialdate.Must(partialdate.FromYearMonth(2024, 2)), "2024-02"},
+		{"leap day", partialdate.Must(partialdate.FromUnits(2024, 2, 29)), "2024-02-29"},
+	}
+}
+
+// ULIDCorpus returns ulid.Nil, ulid.Max and ULIDs at the edges of the timestamp and entropy ranges
+func ULIDCorpus() []Case[ulid.Value] {
+	return []Case[ulid.Value]{
+		{"nil", ulid.Nil, "00000000000000000000000000"},
+		{"max", ulid.Max, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		{"min timestamp", ulid.Must(ulid.FromParts(0, [10]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1})), "00000000000000000000000001"},
+		{"max timestamp", ulid.Must(ulid.FromParts(1<<48-1, [10]byte{})), "7ZZZZZZZZZ0000000000000000"},
+	}
+}
+
+// SnowflakeCorpus returns snowflake.Nil, the largest ID and IDs with each component at its maximum
+func SnowflakeCorpus() []Case[snowflake.Value] {
+	return []Case[snowflake.Value]{
+		{"nil", snowflake.Nil, "0"},
+		{"max", snowflake.Value(1<<63 - 1), "9223372036854775807"},
+		{"max node", snowflake.Must(snowflake.FromParts(0, snowflake.MaxNode, 0)), "4190208"},
+		{"max sequence", snowflake.Must(snowflake.FromParts(0, 0, snowflake.MaxSequence)), "4095"},
+	}
+}
+
+// Int128Corpus returns zero, one, the extremes and values at the boundary between the two halves
+func Int128Corpus() []Case[int128.Value] {
+	return []Case[int128.Value]{
+		{"zero", int128.Zero, "0"},
+		{"one", int128.One, "1"},
+		{"minus one", int128.From64(-1), "-1"},
+		{"min", int128.Min, "-170141183460469231731687303715884105728"},
+		{"max", int128.Max, "170141183460469231731687303715884105727"},
+		{"max uint64", int128.FromParts(0, 1<<64-1), "18446744073709551615"},
+	}
+}
+
+// Uint128Corpus returns zero, one, the maximum and values at the boundary between the two halves
+func Uint128Corpus() []Case[uint128.Value] {
+	return []Case[uint128.Value]{
+		{"zero", uint128.Zero, "0"},
+		{"one", uint128.One, "1"},
+		{"max", uint128.Max, "340282366920938463463374607431768211455"},
+		{"max uint64", uint128.From64(1<<64 - 1), "18446744073709551615"},
+		{"2^64", uint128.FromParts(1, 0), "18446744073709551616"},
+	}
+}
+
+// RatioCorpus returns zero, one, negative values and fractions that are reduced when constructed
+func RatioCorpus() []Case[ratio.Value] {
+	return []Case[ratio.Value]{
+		{"zero", ratio.Zero, "0"},
+		{"one", ratio.One, "1"},
+		{"negative fraction", ratio.Must(ratio.New(-3, 4)), "-3/4"},
+		{"negative denominator", ratio.Must(ratio.New(3, -4)), "-3/4"},
+		{"reduced", ratio.Must(ratio.New(6, 8)), "3/4"},
+		{"integer", ratio.Must(ratio.New(10, 5)), "2"},
+	}
+}
+
+// ByteSizeCorpus returns zero, the extremes and sizes with and without exact unit multiples
+func ByteSizeCorpus() []Case[bytesize.Value] {
+	return []Case[bytesize.Value]{
+		{"zero", 0, "0B"},
+		{"bytes", 1500, "1500B"},
+		{"mebibyte", bytesize.MiB, "1MiB"},
+		{"negative", -10 * bytesize.MiB, "-10MiB"},
+		{"min", bytesize.Value(-1 << 63), "-8EiB"},
+	}
+}
+
+// ColorCorpus returns transparent, black, white and a translucent color
+func ColorCorpus() []Case[color.Value] {
+	return []Case[color.Value]{
+		{"transparent", color.Transparent, "#00000000"},
+		{"black", color.Black, "#000000"},
+		{"white", color.White, "#ffffff"},
+		{"translucent", color.Value{R: 0x33, G: 0x66, B: 0x99, A: 0x80}, "#33669980"},
+	}
+}
+
+// FlexNumCorpus returns flexnum.Nil and numbers whose exact text must be preserved
+func FlexNumCorpus() []Case[flexnum.Value] {
+	return []Case[flexnum.Value]{
+		{"nil", flexnum.Nil, ""},
+		{"zero", flexnum.FromInt64(0), "0"},
+		{"trailing zeros", flexnum.Must(flexnum.Parse("12.50")), "12.50"},
+		{"negative", flexnum.Must(flexnum.Parse("-0.001")), "-0.001"},
+		{"beyond float64", flexnum.Must(flexnum.Parse("12345678901234567890.123456789")), "12345678901234567890.123456789"},
+	}
+}
+
+// HexBytesCorpus returns nil, a single byte and bytes at the edges of the byte range
+func HexBytesCorpus() []Case[hexbytes.Value] {
+	return []Case[hexbytes.Value]{
+		{"nil", nil, ""},
+		{"single byte", hexbytes.Value{0x0a}, "0a"},
+		{"edges", hexbytes.Value{0x00, 0xff, 0xde, 0xad, 0xbe, 0xef}, "00ffdeadbeef"},
+	}
+}
+
+// B64BytesCorpus returns nil and byte slices that need each amount of padding
+func B64BytesCorpus() []Case[b64bytes.Value] {
+	return []Case[b64bytes.Value]{
+		{"nil", nil, ""},
+		{"two pads", b64bytes.Value{0xfb}, "+w=="},
+		{"one pad", b64bytes.Value{0xfb, 0xff}, "+/8="},
+		{"no padding", b64bytes.Value{0xfb, 0xff, 0xbf}, "+/+/"},
+	}
+}
+
+// B64URLBytesCorpus returns nil and byte slices that use the URL-safe characters
+func B64URLBytesCorpus() []Case[b64urlbytes.Value] {
+	return []Case[b64urlbytes.Value]{
+		{"nil", nil, ""},
+		{"url-safe characters", b64urlbytes.Value{0xfb, 0xff}, "-_8"},
+		{"no padding", b64urlbytes.Value{0xfb, 0xff, 0xbf}, "-_-_"},
+	}
+}
+
+// AddrCorpus returns IPv4, IPv6, IPv4-mapped IPv6 and zoned addresses
+func AddrCorpus() []Case[inet.Addr] {
+	return []Case[inet.Addr]{
+		{"ipv4", inet.Addr{Addr: netip.MustParseAddr("192.168.1.1")}, "192.168.1.1"},
+		{"ipv4 broadcast", inet.Addr{Addr: netip.MustParseAddr("255.255.255.255")}, "255.255.255.255"},
+		{"ipv6 loopback", inet.Addr{Addr: netip.IPv6Loopback()}, "::1"},
+		{"ipv4-mapped ipv6", inet.Addr{Addr: netip.MustParseAddr("::ffff:10.0.0.1")}, "::ffff:10.0.0.1"},
+		{"zoned ipv6", inet.Addr{Addr: netip.MustParseAddr("fe80::1%eth0")}, "fe80::1%eth0"},
+	}
+}
+
+// PrefixCorpus returns IPv4 and IPv6 prefixes with the shortest and longest prefix lengths
+func PrefixCorpus() []Case[inet.Prefix] {
+	return []Case[inet.Prefix]{
+		{"ipv4 default route", inet.Prefix{Prefix: netip.MustParsePrefix("0.0.0.0/0")}, "0.0.0.0/0"},
+		{"ipv4 host", inet.Prefix{Prefix: netip.MustParsePrefix("10.0.0.1/32")}, "10.0.0.1/32"},
+		{"ipv6 default route", inet.Prefix{Prefix: netip.MustParsePrefix("::/0")}, "::/0"},
+		{"ipv6 network", inet.Prefix{Prefix: netip.MustParsePrefix("2001:db8::/32")}, "2001:db8::/32"},
+	}
+}
+
+// EmailAddressCorpus returns addresses with and without display names and with quoted local parts
+func EmailAddressCorpus() []Case[emailaddr.Value] {
+	return []Case[emailaddr.Value]{
+		{"address", emailaddr.Must(emailaddr.Parse("jane@example.com")), "jane@example.com"},
+		{"display name", emailaddr.Must(emailaddr.Parse("Jane Doe <jane@example.com>")), `"Jane Doe" <jane@example.com>`},
+		{"plus addressing", emailaddr.Must(emailaddr.Parse("jane+news@example.com")), "jane+news@example.com"},
+	}
+}
+
+// HostPortCorpus returns hostport.Nil and DNS names and IPv4 and IPv6 addresses with ports
+func HostPortCorpus() []Case[hostport.Value] {
+	return []Case[hostport.Value]{
+		{"name and port", hostport.Must(hostport.Parse("db.example.com:5432")), "db.example.com:5432"},
+		{"nil", hostport.Nil, ""},
+		{"ipv4 and max port", hostport.Must(hostport.Parse("10.0.0.1:65535")), "10.0.0.1:65535"},
+		{"ipv6 and port", hostport.Must(hostport.Parse("[::1]:8080")), "[::1]:8080"},
+	}
+}
+
+// LangTagCorpus returns langtag.Nil and tags with region, script and numeric region subtags
+func LangTagCorpus() []Case[langtag.Value] {
+	return []Case[langtag.Value]{
+		{"nil", langtag.Nil, ""},
+		{"language", langtag.Must(langtag.Parse("en")), "en"},
+		{"region", langtag.Must(langtag.Parse("EN-us")), "en-US"},
+		{"script", langtag.Must(langtag.Parse("zh-hant-tw")), "zh-Hant-TW"},
+		{"numeric region", langtag.Must(langtag.Parse("es-419")), "es-419"},
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typetest
+
+import (
+	"fmt"
+	"testing"
+)
+
+func checkGolden[T fmt.Stringer](t *testing.T, cases []Case[T]) {
+	t.Helper()
+	for _, c := range cases {
+		if got := c.Value.String(); got != c.String {
+			t.Errorf("%s: expected %q, got %q", c.Name, c.String, got)
+		}
+	}
+}
+
+func TestCorpusGolden(t *testing.T) {
+	t.Run("date", func(tt *testing.T) { checkGolden(tt, DateCorpus()) })
+	t.Run("timeofday", func(tt *testing.T) { checkGolden(tt, TimeOfDayCorpus()) })
+	t.Run("partialdate", func(tt *testing.T) { checkGolden(tt, PartialDateCorpus()) })
+	t.Run("ulid", func(tt *testing.T) { checkGolden(tt, ULIDCorpus()) })
+	t.Run("snowflake", func(tt *testing.T) { checkGolden(tt, SnowflakeCorpus()) })
+	t.Run("int128", func(tt *testing.T) { checkGolden(tt, Int128Corpus()) })
+	t.Run("uint128", func(tt *testing.T) { checkGolden(tt, Uint128Corpus()) })
+	t.Run("ratio", func(tt *testing.T) { checkGolden(tt, RatioCorpus()) })
+	t.Run("bytesize", func(tt *testing.T) { checkGolden(tt, ByteSizeCorpus()) })
+	t.Run("color", func(tt *testing.T) { checkGolden(tt, ColorCorpus()) })
+	t.Run("flexnum", func(tt *testing.T) { checkGolden(tt, FlexNumCorpus()) })
+	t.Run("hexbytes", func(tt *testing.T) { checkGolden(tt, HexBytesCorpus()) })
+	t.Run("b64bytes", func(tt *testing.T) { checkGolden(tt, B64BytesCorpus()) })
+	t.Run("b64urlbytes", func(tt *testing.T) { checkGolden(tt, B64URLBytesCorpus()) })
+	t.Run("addr", func(tt *testing.T) { checkGolden(tt, AddrCorpus()) })
+	t.Run("prefix", func(tt *testing.T) { checkGolden(tt, PrefixCorpus()) })
+	t.Run("emailaddr", func(tt *testing.T) { checkGolden(tt, EmailAddressCorpus()) })
+	t.Run("hostport", func(tt *testing.T) { checkGolden(tt, HostPortCorpus()) })
+	t.Run("langtag", func(tt *testing.T) { checkGolden(tt, LangTagCorpus()) })
+}
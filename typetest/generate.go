@@ -0,0 +1,186 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typetest
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net/netip"
+	"time"
+
+	"github.com/dylan-bourque/go-types/b64bytes"
+	"github.com/dylan-bourque/go-types/b64urlbytes"
+	"github.com/dylan-bourque/go-types/bytesize"
+	"github.com/dylan-bourque/go-types/color"
+	"github.com/dylan-bourque/go-types/date"
+	"github.com/dylan-bourque/go-types/emailaddr"
+	"github.com/dylan-bourque/go-types/flexnum"
+	"github.com/dylan-bourque/go-types/hexbytes"
+	"github.com/dylan-bourque/go-types/hostport"
+	"github.com/dylan-bourque/go-types/inet"
+	"github.com/dylan-bourque/go-types/int128"
+	"github.com/dylan-bourque/go-types/langtag"
+	"github.com/dylan-bourque/go-types/partialdate"
+	"github.com/dylan-bourque/go-types/ratio"
+	"github.com/dylan-bourque/go-types/snowflake"
+	"github.com/dylan-bourque/go-types/timeofday"
+	"github.com/dylan-bourque/go-types/uint128"
+	"github.com/dylan-bourque/go-types/ulid"
+)
+
+// langTags are the language tags returned by LangTag()
+var langTags = []string{"en", "en-US", "en-GB", "de", "de-CH", "es-419", "fr-CA", "ja", "pt-BR", "zh-Hant-TW"}
+
+// Date returns a valid date between date.Min and date.Max
+func Date(r *rand.Rand) date.Value {
+	return date.Min + date.Value(r.Int64N(int64(date.Max-date.Min)+1))
+}
+
+// TimeOfDay returns a time of day with nanosecond precision
+func TimeOfDay(r *rand.Rand) timeofday.Value {
+	return timeofday.Must(timeofday.FromDuration(time.Duration(r.Int64N(int64(24 * time.Hour)))))
+}
+
+// PartialDate returns a partial date with year, year-month or full day precision
+func PartialDate(r *rand.Rand) partialdate.Value {
+	y, m, d := date.ToUnits(Date(r))
+	switch r.IntN(3) {
+	case 0:
+		return partialdate.Must(partialdate.FromYear(y))
+	case 1:
+		return partialdate.Must(partialdate.FromYearMonth(y, m))
+	default:
+		return partialdate.Must(partialdate.FromUnits(y, m, d))
+	}
+}
+
+// ULID returns a ULID with a random timestamp and entropy
+func ULID(r *rand.Rand) ulid.Value {
+	var entropy [10]byte
+	for i := range entropy {
+		entropy[i] = byte(r.Uint32())
+	}
+	return ulid.Must(ulid.FromParts(r.Uint64N(1<<48), entropy))
+}
+
+// Snowflake returns a Snowflake ID with a random timestamp, node and sequence number
+func Snowflake(r *rand.Rand) snowflake.Value {
+	return snowflake.Must(snowflake.FromParts(
+		r.Int64N(snowflake.MaxTimestamp+1),
+		r.Int64N(snowflake.MaxNode+1),
+		r.Int64N(snowflake.MaxSequence+1),
+	))
+}
+
+// Int128 returns a signed 128-bit integer, with all bit patterns equally likely
+func Int128(r *rand.Rand) int128.Value {
+	return int128.FromParts(r.Uint64(), r.Uint64())
+}
+
+// Uint128 returns an unsigned 128-bit integer, with all bit patterns equally likely
+func Uint128(r *rand.Rand) uint128.Value {
+	return uint128.FromParts(r.Uint64(), r.Uint64())
+}
+
+// Ratio returns a ratio with a numerator between -1000 and 1000 and a denominator between 1 and 1000
+func Ratio(r *rand.Rand) ratio.Value {
+	return ratio.Must(ratio.New(r.Int64N(2001)-1000, r.Int64N(1000)+1))
+}
+
+// ByteSize returns a non-negative size whose magnitude is uniformly distributed between bytes and
+// exbibytes
+func ByteSize(r *rand.Rand) bytesize.Value {
+	return bytesize.Value(r.Int64N(1 << r.IntN(63)))
+}
+
+// Color returns a color with random channels, including alpha
+func Color(r *rand.Rand) color.Value {
+	c := r.Uint32()
+	return color.Value{R: uint8(c >> 24), G: uint8(c >> 16), B: uint8(c >> 8), A: uint8(c)}
+}
+
+// FlexNum returns a decimal number with up to 4 fractional digits
+func FlexNum(r *rand.Rand) flexnum.Value {
+	s := fmt.Sprintf("%d", r.Int64N(2_000_001)-1_000_000)
+	if n := r.IntN(5); n > 0 {
+		s += fmt.Sprintf(".%0*d", n, r.IntN(pow10(n)))
+	}
+	return flexnum.Must(flexnum.Parse(s))
+}
+
+// HexBytes returns up to 32 random bytes
+func HexBytes(r *rand.Rand) hexbytes.Value {
+	return hexbytes.Value(randomBytes(r))
+}
+
+// B64Bytes returns up to 32 random bytes
+func B64Bytes(r *rand.Rand) b64bytes.Value {
+	return b64bytes.Value(randomBytes(r))
+}
+
+// B64URLBytes returns up to 32 random bytes
+func B64URLBytes(r *rand.Rand) b64urlbytes.Value {
+	return b64urlbytes.Value(randomBytes(r))
+}
+
+// Addr returns an IPv4 or IPv6 address
+func Addr(r *rand.Rand) inet.Addr {
+	if r.IntN(2) == 0 {
+		var a [4]byte
+		for i := range a {
+			a[i] = byte(r.Uint32())
+		}
+		return inet.Addr{Addr: netip.AddrFrom4(a)}
+	}
+	var a [16]byte
+	for i := range a {
+		a[i] = byte(r.Uint32())
+	}
+	return inet.Addr{Addr: netip.AddrFrom16(a)}
+}
+
+// Prefix returns a masked IPv4 or IPv6 prefix
+func Prefix(r *rand.Rand) inet.Prefix {
+	a := Addr(r).Addr
+	return inet.Prefix{Prefix: netip.PrefixFrom(a, r.IntN(a.BitLen()+1)).Masked()}
+}
+
+// EmailAddress returns an address in one of the example.com domains, with a display name half of
+// the time
+func EmailAddress(r *rand.Rand) emailaddr.Value {
+	s := fmt.Sprintf("user%d@mail%d.example.com", r.IntN(10_000), r.IntN(100))
+	if r.IntN(2) == 0 {
+		s = fmt.Sprintf("User %d <%s>", r.IntN(10_000), s)
+	}
+	return emailaddr.Must(emailaddr.Parse(s))
+}
+
+// HostPort returns a DNS name in the example.com domain with a random port
+func HostPort(r *rand.Rand) hostport.Value {
+	return hostport.Must(hostport.New(fmt.Sprintf("host%d.example.com", r.IntN(1000)), uint16(r.IntN(65535)+1)))
+}
+
+// LangTag returns one of a fixed set of common language tags
+func LangTag(r *rand.Rand) langtag.Value {
+	return langtag.Must(langtag.Parse(langTags[r.IntN(len(langTags))]))
+}
+
+// randomBytes returns up to 32 random bytes
+func randomBytes(r *rand.Rand) []byte {
+	res := make([]byte, r.IntN(33))
+	for i := range res {
+		res[i] = byte(r.Uint32())
+	}
+	return res
+}
+
+// pow10 returns 10 raised to the power n
+func pow10(n int) int {
+	res := 1
+	for ; n > 0; n-- {
+		res *= 10
+	}
+	return res
+}
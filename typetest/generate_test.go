@@ -0,0 +1,36 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package typetest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	a := Generate(NewRand(7), 50, ULID)
+	b := Generate(NewRand(7), 50, ULID)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Expected the same values for the same seed")
+	}
+	if c := Generate(NewRand(8), 50, ULID); reflect.DeepEqual(a, c) {
+		t.Errorf("Expected different values for a different seed")
+	}
+}
+
+func TestGeneratedValuesAreValid(t *testing.T) {
+	r := NewRand(1)
+	for i := 0; i < 1000; i++ {
+		if d := Date(r); !d.IsValid() {
+			t.Fatalf("Generated an invalid date: %d", int64(d))
+		}
+		if tod := TimeOfDay(r); !tod.IsValid() {
+			t.Fatalf("Generated an invalid time of day: %v", tod)
+		}
+		if p := Prefix(r); p.Prefix != p.Prefix.Masked() {
+			t.Fatalf("Generated an unmasked prefix: %v", p)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package uint128
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, v := range sampleValues() {
+		data, _ := v.MarshalBinary()
+		var got Value
+		if err := got.UnmarshalBinary(data); err != nil || got != v {
+			t.Fatalf("Expected %s, got %s (err = %v)", v, got, err)
+		}
+	}
+	var v Value
+	if err := v.UnmarshalBinary(make([]byte, 15)); err != ErrInvalidBinaryDataLen {
+		t.Errorf("Expected %v, got %v", ErrInvalidBinaryDataLen, err)
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Max)
+	if err != nil || string(data) != `"340282366920938463463374607431768211455"` {
+		t.Errorf("Unexpected encoding %s (err = %v)", data, err)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected Value
+		err      error
+	}{
+		{"string", `"18446744073709551616"`, FromParts(1, 0), nil},
+		{"hex string", `"0xff"`, From64(255), nil},
+		{"number", `18446744073709551616`, FromParts(1, 0), nil},
+		{"fractional number", `1.5`, Zero, ErrInvalidTextData},
+		{"negative number", `-1`, Zero, ErrInvalidTextData},
+		{"bool", `true`, Zero, ErrInvalidTextData},
+		{"null", `null`, Zero, nil},
+		{"invalid string", `"abc"`, Zero, ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := json.Unmarshal([]byte(tc.data), &v)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestAppendTextDoesNotAllocate(t *testing.T) {
+	cases := []struct {
+		name     string
+		v        Value
+		expected string
+	}{
+		{"zero", Zero, "0"},
+		{"small", From64(42), "42"},
+		{"max", Max, "340282366920938463463374607431768211455"},
+	}
+	buf := make([]byte, 0, 64)
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				buf, _ = tc.v.AppendText(buf[:0])
+			})
+			if allocs != 0 {
+				tt.Errorf("Expected no allocations, got %v", allocs)
+			}
+			if string(buf) != tc.expected {
+				tt.Errorf("Expected %q, got %q", tc.expected, buf)
+			}
+		})
+	}
+}
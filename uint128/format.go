@@ -0,0 +1,140 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package uint128
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidBase is returned by Parse() when the base is not 0 or between 2 and 36
+	ErrInvalidBase = errors.Errorf("uint128: the base must be 0 or between 2 and 36")
+	// ErrInvalidTextFormat is returned by Parse() and UnmarshalText() when the text is not a valid
+	// unsigned integer in the requested base
+	ErrInvalidTextFormat = errors.Errorf("uint128.Value: text data was not in the correct format")
+)
+
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// Parse parses an unsigned integer in the specified base, which must be between 2 and 36.
+//
+// If base is 0, the base is taken from the prefix of s: "0x" or "0X" for base 16, "0o" or "0O" for
+// base 8, "0b" or "0B" for base 2, and base 10 otherwise.  Letters may be upper or lower case.
+// Values larger than Max return ErrOverflow.
+func Parse(s string, base int) (Value, error) {
+	if base == 0 {
+		base = 10
+		if len(s) > 2 && s[0] == '0' {
+			switch s[1] {
+			case 'x', 'X':
+				base, s = 16, s[2:]
+			case 'o', 'O':
+				base, s = 8, s[2:]
+			case 'b', 'B':
+				base, s = 2, s[2:]
+			}
+		}
+	}
+	if base < 2 || base > 36 {
+		return Zero, ErrInvalidBase
+	}
+	if s == "" {
+		return Zero, ErrInvalidTextFormat
+	}
+	var (
+		v   Value
+		b   = From64(uint64(base))
+		err error
+	)
+	for i := 0; i < len(s); i++ {
+		d := digitValue(s[i])
+		if d < 0 || d >= base {
+			return Zero, errors.Wrapf(ErrInvalidTextFormat, "%q", s)
+		}
+		if v, err = v.MulChecked(b); err != nil {
+			return Zero, err
+		}
+		if v, err = v.AddChecked(From64(uint64(d))); err != nil {
+			return Zero, err
+		}
+	}
+	return v, nil
+}
+
+// digitValue returns the value of an ASCII digit or letter, or -1 if c is neither
+func digitValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + 10
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	default:
+		return -1
+	}
+}
+
+// Format returns v in the specified base, which must be between 2 and 36, using lower case letters
+// for digits above 9 and no prefix.  It panics if the base is out of range.
+func (v Value) Format(base int) string {
+	var buf [128]byte
+	i := v.formatBits(&buf, base)
+	return string(buf[i:])
+}
+
+// AppendFormat appends the same text as Format(base) to b and returns the extended buffer.  It panics
+// if the base is out of range.
+func (v Value) AppendFormat(b []byte, base int) []byte {
+	var buf [128]byte
+	i := v.formatBits(&buf, base)
+	return append(b, buf[i:]...)
+}
+
+// formatBits writes the digits of v in the specified base to the end of buf and returns the index of
+// the first digit
+func (v Value) formatBits(buf *[128]byte, base int) int {
+	if base < 2 || base > 36 {
+		panic(ErrInvalidBase)
+	}
+	i := len(buf)
+	if v.IsZero() {
+		i--
+		buf[i] = '0'
+		return i
+	}
+	var r uint64
+	for !v.IsZero() {
+		v, r = v.QuoRem64(uint64(base))
+		i--
+		buf[i] = digits[r]
+	}
+	return i
+}
+
+// String implements fmt.Stringer for uint128.Value instances.  The returned string is the decimal
+// representation of v.
+func (v Value) String() string {
+	return v.Format(10)
+}
+
+// Big returns v as a *big.Int
+func (v Value) Big() *big.Int {
+	b := v.Bytes()
+	return new(big.Int).SetBytes(b[:])
+}
+
+// FromBig returns a Value equal to the specified *big.Int, or ErrOverflow if it is negative or does
+// not fit in 128 bits
+func FromBig(b *big.Int) (Value, error) {
+	if b.Sign() < 0 || b.BitLen() > 128 {
+		return Zero, ErrOverflow
+	}
+	var buf [16]byte
+	b.FillBytes(buf[:])
+	return FromBytes(buf), nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package uint128
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		base     int
+		expected Value
+		err      error
+	}{
+		{"zero", "0", 10, Zero, nil},
+		{"decimal", "18446744073709551616", 10, FromParts(1, 0), nil},
+		{"max", "340282366920938463463374607431768211455", 10, Max, nil},
+		{"overflow", "340282366920938463463374607431768211456", 10, Zero, ErrOverflow},
+		{"hex", "ffffffffffffffffffffffffffffffff", 16, Max, nil},
+		{"upper case hex", "FF", 16, From64(255), nil},
+		{"hex prefix", "0x10", 0, From64(16), nil},
+		{"octal prefix", "0o17", 0, From64(15), nil},
+		{"binary prefix", "0b101", 0, From64(5), nil},
+		{"no prefix", "0123", 0, From64(123), nil},
+		{"base 36", "zz", 36, From64(1295), nil},
+		{"empty", "", 10, Zero, ErrInvalidTextFormat},
+		{"prefix only", "0x", 0, Zero, ErrInvalidTextFormat},
+		{"digit out of range", "12a", 10, Zero, ErrInvalidTextFormat},
+		{"sign", "-1", 10, Zero, ErrInvalidTextFormat},
+		{"control character", "1\x10", 10, Zero, ErrInvalidTextFormat},
+		{"invalid base", "1", 37, Zero, ErrInvalidBase},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := Parse(tc.s, tc.base)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for _, v := range sampleValues() {
+		for _, base := range []int{2, 8, 10, 16, 36} {
+			s := v.Format(base)
+			if expected := v.Big().Text(base); s != expected {
+				t.Fatalf("Format(%d): expected %s, got %s", base, expected, s)
+			}
+			if got := Must(Parse(s, base)); got != v {
+				t.Fatalf("Parse(%q, %d): expected %s, got %s", s, base, v, got)
+			}
+		}
+	}
+}
+
+func TestFromBig(t *testing.T) {
+	cases := []struct {
+		name string
+		b    *big.Int
+		err  error
+	}{
+		{"zero", big.NewInt(0), nil},
+		{"max", new(big.Int).Sub(two128, big.NewInt(1)), nil},
+		{"too large", two128, ErrOverflow},
+		{"negative", big.NewInt(-1), ErrOverflow},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := FromBig(tc.b)
+			if err != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && v.Big().Cmp(tc.b) != 0 {
+				tt.Errorf("Expected %s, got %s", tc.b, v)
+			}
+		})
+	}
+}
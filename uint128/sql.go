@@ -0,0 +1,57 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package uint128
+
+import (
+	"database/sql/driver"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// a uint128.Value value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a uint128.Value value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for uint128.Value values.  The returned value is the
+// decimal string, which can be stored in NUMERIC(39) and similar columns.
+func (v Value) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for uint128.Value values.
+//
+// Strings and byte slices are parsed as decimal text, and non-negative int64 values are converted
+// directly.  All other values will return an error
+func (v *Value) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case int64:
+		if tv < 0 {
+			return ErrOverflow
+		}
+		*v = From64(uint64(tv))
+		return nil
+	case []byte:
+		return v.scanText(string(tv))
+	case string:
+		return v.scanText(tv)
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
+
+// scanText parses the decimal text returned by a database driver
+func (v *Value) scanText(s string) error {
+	res, err := Parse(s, 10)
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package uint128
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestValue(t *testing.T) {
+	got, err := Max.Value()
+	if err != nil || got != "340282366920938463463374607431768211455" {
+		t.Errorf("Unexpected value %v (err = %v)", got, err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"int64", int64(42), From64(42), nil},
+		{"negative int64", int64(-1), Zero, ErrOverflow},
+		{"string", "18446744073709551616", FromParts(1, 0), nil},
+		{"bytes", []byte("12345"), From64(12345), nil},
+		{"invalid text", "12.5", Zero, ErrInvalidTextFormat},
+		{"unsupported type", 1.5, Zero, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if v != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
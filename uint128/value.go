@@ -0,0 +1,286 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package uint128
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/pkg/errors"
+)
+
+// Value represents an unsigned 128-bit integer.
+//
+// Arithmetic wraps around on overflow, as with Go's built-in unsigned integer types.  The *Checked
+// variants report overflow as an error instead.  Values can be compared with == and used as map keys.
+//
+// The zero value is uint128.Zero.
+type Value struct {
+	hi, lo uint64
+}
+
+var (
+	// Zero is the uint128.Value with value 0
+	Zero = Value{}
+	// One is the uint128.Value with value 1
+	One = Value{lo: 1}
+	// Max is the largest uint128.Value, 2^128 - 1
+	Max = Value{hi: ^uint64(0), lo: ^uint64(0)}
+)
+
+var (
+	// ErrOverflow is returned when the result of an operation does not fit in 128 bits
+	ErrOverflow = errors.Errorf("uint128: the result overflows 128 bits")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in uint128.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// From64 returns a Value equal to the specified 64-bit integer
+func From64(v uint64) Value {
+	return Value{lo: v}
+}
+
+// FromParts returns a Value from its upper and lower 64 bits
+func FromParts(hi, lo uint64) Value {
+	return Value{hi: hi, lo: lo}
+}
+
+// FromBytes returns a Value from 16 bytes in big-endian order, such as an IPv6 address
+func FromBytes(b [16]byte) Value {
+	return Value{hi: binary.BigEndian.Uint64(b[:8]), lo: binary.BigEndian.Uint64(b[8:])}
+}
+
+// Parts returns the upper and lower 64 bits of v
+func (v Value) Parts() (hi, lo uint64) {
+	return v.hi, v.lo
+}
+
+// Bytes returns v as 16 bytes in big-endian order
+func (v Value) Bytes() [16]byte {
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], v.hi)
+	binary.BigEndian.PutUint64(b[8:], v.lo)
+	return b
+}
+
+// IsUint64 returns true if v can be represented as a uint64
+func (v Value) IsUint64() bool {
+	return v.hi == 0
+}
+
+// Uint64 returns the lower 64 bits of v, which is v itself if IsUint64() returns true
+func (v Value) Uint64() uint64 {
+	return v.lo
+}
+
+// IsZero returns true if v is 0
+func (v Value) IsZero() bool {
+	return v == Zero
+}
+
+// Compare returns -1, 0 or +1 depending on whether v1 is less than, equal to, or greater than v2
+func Compare(v1, v2 Value) int {
+	switch {
+	case v1.hi < v2.hi, v1.hi == v2.hi && v1.lo < v2.lo:
+		return -1
+	case v1 == v2:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Add returns v + v2, wrapping around on overflow
+func (v Value) Add(v2 Value) Value {
+	lo, carry := bits.Add64(v.lo, v2.lo, 0)
+	hi, _ := bits.Add64(v.hi, v2.hi, carry)
+	return Value{hi: hi, lo: lo}
+}
+
+// AddChecked returns v + v2, or ErrOverflow if the result does not fit in 128 bits
+func (v Value) AddChecked(v2 Value) (Value, error) {
+	lo, carry := bits.Add64(v.lo, v2.lo, 0)
+	hi, carry := bits.Add64(v.hi, v2.hi, carry)
+	if carry != 0 {
+		return Zero, ErrOverflow
+	}
+	return Value{hi: hi, lo: lo}, nil
+}
+
+// Sub returns v - v2, wrapping around on underflow
+func (v Value) Sub(v2 Value) Value {
+	lo, borrow := bits.Sub64(v.lo, v2.lo, 0)
+	hi, _ := bits.Sub64(v.hi, v2.hi, borrow)
+	return Value{hi: hi, lo: lo}
+}
+
+// SubChecked returns v - v2, or ErrOverflow if v2 is greater than v
+func (v Value) SubChecked(v2 Value) (Value, error) {
+	lo, borrow := bits.Sub64(v.lo, v2.lo, 0)
+	hi, borrow := bits.Sub64(v.hi, v2.hi, borrow)
+	if borrow != 0 {
+		return Zero, ErrOverflow
+	}
+	return Value{hi: hi, lo: lo}, nil
+}
+
+// Mul returns v * v2, wrapping around on overflow
+func (v Value) Mul(v2 Value) Value {
+	hi, lo := bits.Mul64(v.lo, v2.lo)
+	hi += v.hi*v2.lo + v.lo*v2.hi
+	return Value{hi: hi, lo: lo}
+}
+
+// MulChecked returns v * v2, or ErrOverflow if the result does not fit in 128 bits
+func (v Value) MulChecked(v2 Value) (Value, error) {
+	if v.hi != 0 && v2.hi != 0 {
+		return Zero, ErrOverflow
+	}
+	hi, lo := bits.Mul64(v.lo, v2.lo)
+	c1hi, c1 := bits.Mul64(v.hi, v2.lo)
+	c2hi, c2 := bits.Mul64(v.lo, v2.hi)
+	if c1hi != 0 || c2hi != 0 {
+		return Zero, ErrOverflow
+	}
+	hi, carry := bits.Add64(hi, c1, 0)
+	if carry != 0 {
+		return Zero, ErrOverflow
+	}
+	hi, carry = bits.Add64(hi, c2, 0)
+	if carry != 0 {
+		return Zero, ErrOverflow
+	}
+	return Value{hi: hi, lo: lo}, nil
+}
+
+// QuoRem returns the quotient and remainder of v / v2.  As with Go's built-in integer types, it
+// panics if v2 is 0.
+func (v Value) QuoRem(v2 Value) (q, r Value) {
+	if v2.hi == 0 {
+		q, r64 := v.QuoRem64(v2.lo)
+		return q, From64(r64)
+	}
+	// normalize the divisor so that its most significant bit is set, then estimate the quotient
+	// from the upper 64 bits.  The estimate is at most one too small.
+	n := uint(bits.LeadingZeros64(v2.hi))
+	v1 := v2.Lsh(n)
+	u1 := v.Rsh(1)
+	tq, _ := bits.Div64(u1.hi, u1.lo, v1.hi)
+	tq >>= 63 - n
+	if tq != 0 {
+		tq--
+	}
+	q = From64(tq)
+	r = v.Sub(v2.Mul(q))
+	if Compare(r, v2) >= 0 {
+		q = q.Add(One)
+		r = r.Sub(v2)
+	}
+	return q, r
+}
+
+// QuoRem64 returns the quotient and remainder of v / d.  It panics if d is 0.
+func (v Value) QuoRem64(d uint64) (q Value, r uint64) {
+	if v.hi < d {
+		q.lo, r = bits.Div64(v.hi, v.lo, d)
+		return q, r
+	}
+	q.hi, r = bits.Div64(0, v.hi, d)
+	q.lo, r = bits.Div64(r, v.lo, d)
+	return q, r
+}
+
+// Quo returns v / v2, truncated.  It panics if v2 is 0.
+func (v Value) Quo(v2 Value) Value {
+	q, _ := v.QuoRem(v2)
+	return q
+}
+
+// Rem returns the remainder of v / v2.  It panics if v2 is 0.
+func (v Value) Rem(v2 Value) Value {
+	_, r := v.QuoRem(v2)
+	return r
+}
+
+// And returns the bitwise AND of v and v2
+func (v Value) And(v2 Value) Value {
+	return Value{hi: v.hi & v2.hi, lo: v.lo & v2.lo}
+}
+
+// Or returns the bitwise OR of v and v2
+func (v Value) Or(v2 Value) Value {
+	return Value{hi: v.hi | v2.hi, lo: v.lo | v2.lo}
+}
+
+// Xor returns the bitwise XOR of v and v2
+func (v Value) Xor(v2 Value) Value {
+	return Value{hi: v.hi ^ v2.hi, lo: v.lo ^ v2.lo}
+}
+
+// AndNot returns the bitwise AND of v and the complement of v2
+func (v Value) AndNot(v2 Value) Value {
+	return Value{hi: v.hi &^ v2.hi, lo: v.lo &^ v2.lo}
+}
+
+// Not returns the bitwise complement of v
+func (v Value) Not() Value {
+	return Value{hi: ^v.hi, lo: ^v.lo}
+}
+
+// Lsh returns v << n.  Bits shifted past the most significant bit are discarded.
+func (v Value) Lsh(n uint) Value {
+	switch {
+	case n >= 128:
+		return Zero
+	case n >= 64:
+		return Value{hi: v.lo << (n - 64)}
+	default:
+		return Value{hi: v.hi<<n | v.lo>>(64-n), lo: v.lo << n}
+	}
+}
+
+// Rsh returns v >> n
+func (v Value) Rsh(n uint) Value {
+	switch {
+	case n >= 128:
+		return Zero
+	case n >= 64:
+		return Value{lo: v.hi >> (n - 64)}
+	default:
+		return Value{hi: v.hi >> n, lo: v.lo>>n | v.hi<<(64-n)}
+	}
+}
+
+// LeadingZeros returns the number of leading zero bits in v, which is 128 for 0
+func (v Value) LeadingZeros() int {
+	if v.hi != 0 {
+		return bits.LeadingZeros64(v.hi)
+	}
+	return 64 + bits.LeadingZeros64(v.lo)
+}
+
+// TrailingZeros returns the number of trailing zero bits in v, which is 128 for 0
+func (v Value) TrailingZeros() int {
+	if v.lo != 0 {
+		return bits.TrailingZeros64(v.lo)
+	}
+	return 64 + bits.TrailingZeros64(v.hi)
+}
+
+// OnesCount returns the number of one bits in v
+func (v Value) OnesCount() int {
+	return bits.OnesCount64(v.hi) + bits.OnesCount64(v.lo)
+}
+
+// BitLen returns the minimum number of bits required to represent v, which is 0 for 0
+func (v Value) BitLen() int {
+	return 128 - v.LeadingZeros()
+}
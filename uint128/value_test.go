@@ -0,0 +1,135 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package uint128
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+var (
+	two128 = new(big.Int).Lsh(big.NewInt(1), 128)
+	mask   = new(big.Int).Sub(two128, big.NewInt(1))
+)
+
+// sampleValues returns edge cases plus pseudo-random values of various bit lengths
+func sampleValues() []Value {
+	vals := []Value{Zero, One, Max, From64(2), From64(10), From64(^uint64(0)), FromParts(1, 0), FromParts(1<<63, 0), FromParts(0, 1<<63)}
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 40; i++ {
+		v := FromParts(r.Uint64(), r.Uint64())
+		vals = append(vals, v.Rsh(uint(r.Intn(128))))
+	}
+	return vals
+}
+
+func TestArithmeticMatchesBig(t *testing.T) {
+	vals := sampleValues()
+	for _, a := range vals {
+		for _, b := range vals {
+			ba, bb := a.Big(), b.Big()
+
+			sum := new(big.Int).Add(ba, bb)
+			if got := a.Add(b).Big(); got.Cmp(new(big.Int).And(sum, mask)) != 0 {
+				t.Fatalf("%s + %s: expected %s, got %s", a, b, new(big.Int).And(sum, mask), got)
+			}
+			if _, err := a.AddChecked(b); (err != nil) != (sum.Cmp(mask) > 0) {
+				t.Fatalf("%s + %s: unexpected overflow result %v", a, b, err)
+			}
+
+			diff := new(big.Int).Sub(ba, bb)
+			if got := a.Sub(b).Big(); got.Cmp(new(big.Int).And(diff, mask)) != 0 {
+				t.Fatalf("%s - %s: expected %s, got %s", a, b, new(big.Int).And(diff, mask), got)
+			}
+			if _, err := a.SubChecked(b); (err != nil) != (diff.Sign() < 0) {
+				t.Fatalf("%s - %s: unexpected overflow result %v", a, b, err)
+			}
+
+			prod := new(big.Int).Mul(ba, bb)
+			if got := a.Mul(b).Big(); got.Cmp(new(big.Int).And(prod, mask)) != 0 {
+				t.Fatalf("%s * %s: expected %s, got %s", a, b, new(big.Int).And(prod, mask), got)
+			}
+			if _, err := a.MulChecked(b); (err != nil) != (prod.Cmp(mask) > 0) {
+				t.Fatalf("%s * %s: unexpected overflow result %v", a, b, err)
+			}
+
+			if !b.IsZero() {
+				q, r := a.QuoRem(b)
+				bq, br := new(big.Int).QuoRem(ba, bb, new(big.Int))
+				if q.Big().Cmp(bq) != 0 || r.Big().Cmp(br) != 0 {
+					t.Fatalf("%s / %s: expected (%s, %s), got (%s, %s)", a, b, bq, br, q, r)
+				}
+			}
+
+			if got, expected := Compare(a, b), ba.Cmp(bb); got != expected {
+				t.Fatalf("Compare(%s, %s): expected %d, got %d", a, b, expected, got)
+			}
+		}
+	}
+}
+
+func TestBitOperations(t *testing.T) {
+	for _, v := range sampleValues() {
+		bv := v.Big()
+		for _, n := range []uint{0, 1, 63, 64, 65, 127, 128, 200} {
+			if got, expected := v.Lsh(n).Big(), new(big.Int).And(new(big.Int).Lsh(bv, n), mask); got.Cmp(expected) != 0 {
+				t.Fatalf("%s << %d: expected %s, got %s", v, n, expected, got)
+			}
+			if got, expected := v.Rsh(n).Big(), new(big.Int).Rsh(bv, n); got.Cmp(expected) != 0 {
+				t.Fatalf("%s >> %d: expected %s, got %s", v, n, expected, got)
+			}
+		}
+		if v.BitLen() != bv.BitLen() {
+			t.Fatalf("BitLen(%s): expected %d, got %d", v, bv.BitLen(), v.BitLen())
+		}
+		if v.Not().Xor(v) != Max || v.And(v.Not()) != Zero || v.Or(v.Not()) != Max || v.AndNot(v) != Zero {
+			t.Fatalf("Bitwise identities do not hold for %s", v)
+		}
+	}
+}
+
+func TestBitCounts(t *testing.T) {
+	cases := []struct {
+		name                    string
+		v                       Value
+		leading, trailing, ones int
+	}{
+		{"zero", Zero, 128, 128, 0},
+		{"one", One, 127, 0, 1},
+		{"max", Max, 0, 0, 128},
+		{"top bit", FromParts(1<<63, 0), 0, 127, 1},
+		{"bit 64", FromParts(1, 0), 63, 64, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if l, tr, o := tc.v.LeadingZeros(), tc.v.TrailingZeros(), tc.v.OnesCount(); l != tc.leading || tr != tc.trailing || o != tc.ones {
+				tt.Errorf("Expected (%d, %d, %d), got (%d, %d, %d)", tc.leading, tc.trailing, tc.ones, l, tr, o)
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	v := FromParts(0x0123456789abcdef, 0xfedcba9876543210)
+	if FromBytes(v.Bytes()) != v {
+		t.Errorf("Bytes()/FromBytes() did not round trip")
+	}
+	if hi, lo := v.Parts(); hi != 0x0123456789abcdef || lo != 0xfedcba9876543210 {
+		t.Errorf("Unexpected parts: %x %x", hi, lo)
+	}
+	if !From64(42).IsUint64() || From64(42).Uint64() != 42 || v.IsUint64() {
+		t.Errorf("Unexpected IsUint64()/Uint64() results")
+	}
+}
+
+func TestQuoRemDivideByZero(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	One.Quo(Zero)
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ulid
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidBinaryDataLen is returned from ulid.Value.UnmarshalBinary() when the passed-in byte
+	// slice is not 16 bytes long
+	ErrInvalidBinaryDataLen = errors.Errorf("ulid.Value: binary data must be 16 bytes")
+	// ErrInvalidTextFormat is returned from ulid.Value.UnmarshalText() when the passed-in byte slice
+	// is not 26 Crockford base32 characters or the value overflows 128 bits
+	ErrInvalidTextFormat = errors.Errorf("ulid.Value: text data was not in the correct format")
+	// ErrInvalidTextData is returned from ulid.Value.UnmarshalJSON() when the passed-in byte slice
+	// does not contain a string
+	ErrInvalidTextData = errors.Errorf("ulid.Value: can only decode JSON strings")
+)
+
+// interface validations
+var _ encoding.BinaryMarshaler = (*Value)(nil)
+var _ encoding.BinaryAppender = (*Value)(nil)
+var _ encoding.BinaryUnmarshaler = (*Value)(nil)
+var _ encoding.TextMarshaler = (*Value)(nil)
+var _ encoding.TextAppender = (*Value)(nil)
+var _ encoding.TextUnmarshaler = (*Value)(nil)
+var _ json.Marshaler = (*Value)(nil)
+var _ json.Unmarshaler = (*Value)(nil)
+
+const (
+	// encodedLen is the length of the Crockford base32 text encoding
+	encodedLen = 26
+	// alphabet is the Crockford base32 alphabet, which omits I, L, O and U
+	alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+// decoding maps an ASCII character to its Crockford base32 digit, or 0xff if it is not valid.  Lower
+// case letters are accepted.
+var decoding = func() [256]byte {
+	var d [256]byte
+	for i := range d {
+		d[i] = 0xff
+	}
+	for i := 0; i < len(alphabet); i++ {
+		d[alphabet[i]] = byte(i)
+		d[alphabet[i]|0x20] = byte(i)
+	}
+	return d
+}()
+
+// Parse parses a ULID from its 26-character Crockford base32 text form.  Parsing is case-insensitive.
+func Parse(s string) (Value, error) {
+	var v Value
+	if err := v.UnmarshalText([]byte(s)); err != nil {
+		return Nil, err
+	}
+	return v, nil
+}
+
+// encode writes the 26-character text form of v into dst, 5 bits at a time from the most
+// significant end.  The 128-bit value is treated as 130 bits with two leading zero bits.
+func (v Value) encode(dst []byte) {
+	hi, lo := binary.BigEndian.Uint64(v[:8]), binary.BigEndian.Uint64(v[8:])
+	for i := encodedLen - 1; i >= 0; i-- {
+		dst[i] = alphabet[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for ulid.Value values.  The
+// encoded value is the 16 bytes of the ULID.
+func (v Value) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, len(v)))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for ulid.Value values.  It appends the
+// same encoding as MarshalBinary() to b.
+func (v Value) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, v[:]...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for ulid.Value values.
+func (v *Value) UnmarshalBinary(data []byte) error {
+	if len(data) != len(v) {
+		return ErrInvalidBinaryDataLen
+	}
+	copy(v[:], data)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for ulid.Value values.
+//
+// The encoded value is the same as is returned by the String() method
+func (v Value) MarshalText() ([]byte, error) {
+	return v.AppendText(make([]byte, 0, encodedLen))
+}
+
+// AppendText implements the encoding.TextAppender interface for ulid.Value values.  It appends the
+// same encoding as MarshalText() to b.
+func (v Value) AppendText(b []byte) ([]byte, error) {
+	var buf [encodedLen]byte
+	v.encode(buf[:])
+	return append(b, buf[:]...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for ulid.Value values.
+//
+// The text must be exactly 26 Crockford base32 characters.  Since 26 characters hold 130 bits, the
+// first character must be between '0' and '7'.
+func (v *Value) UnmarshalText(text []byte) error {
+	if len(text) != encodedLen {
+		return ErrInvalidTextFormat
+	}
+	var hi, lo uint64
+	for i, c := range text {
+		d := decoding[c]
+		if d == 0xff || (i == 0 && d > 7) {
+			return ErrInvalidTextFormat
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(d)
+	}
+	binary.BigEndian.PutUint64(v[:8], hi)
+	binary.BigEndian.PutUint64(v[8:], lo)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for ulid.Value values.
+//
+// Nil is encoded as the JSON null token and all other values are encoded as a JSON string
+// containing the same value as MarshalText().
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return []byte("null"), nil
+	}
+	buf := make([]byte, encodedLen+2)
+	buf[0], buf[encodedLen+1] = '"', '"'
+	v.encode(buf[1 : encodedLen+1])
+	return buf, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for ulid.Value values.
+//
+// If the value is the special JSON null token, v is set to ulid.Nil.  All other values are
+// delegated to UnmarshalText().
+func (v *Value) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		*v = Nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return errors.Wrapf(ErrInvalidTextData, "%v", err)
+	}
+	return v.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ulid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestUnmarshalText(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected Value
+		err      error
+	}{
+		{"nil", "00000000000000000000000000", Nil, nil},
+		{"max", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", Max, nil},
+		{"lower case", "7zzzzzzzzzzzzzzzzzzzzzzzzz", Max, nil},
+		{"overflow", "80000000000000000000000000", Nil, ErrInvalidTextFormat},
+		{"too short", "0000000000000000000000000", Nil, ErrInvalidTextFormat},
+		{"too long", "000000000000000000000000000", Nil, ErrInvalidTextFormat},
+		{"excluded letter", "0000000000000000000000000U", Nil, ErrInvalidTextFormat},
+		{"not base32", "0000000000000000000000000-", Nil, ErrInvalidTextFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var v Value
+			err := v.UnmarshalText([]byte(tc.text))
+			if err != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && v != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	v := Must(Parse("01ARYZ6S41TSV4RRFFQ69G5FAV"))
+	data, _ := v.MarshalBinary()
+	var got Value
+	if err := got.UnmarshalBinary(data); err != nil || got != v {
+		t.Errorf("Expected %s, got %s (err = %v)", v, got, err)
+	}
+	if err := got.UnmarshalBinary(data[:15]); err != ErrInvalidBinaryDataLen {
+		t.Errorf("Expected %v, got %v", ErrInvalidBinaryDataLen, err)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	v := Must(Parse("01ARYZ6S41TSV4RRFFQ69G5FAV"))
+	cases := []struct {
+		name string
+		v    Value
+		data string
+	}{
+		{"nil", Nil, "null"},
+		{"value", v, `"01ARYZ6S41TSV4RRFFQ69G5FAV"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			data, err := json.Marshal(tc.v)
+			if err != nil || string(data) != tc.data {
+				tt.Fatalf("Expected %s, got %s (err = %v)", tc.data, data, err)
+			}
+			got := Max
+			if err := json.Unmarshal(data, &got); err != nil || got != tc.v {
+				tt.Errorf("Expected %s, got %s (err = %v)", tc.v, got, err)
+			}
+		})
+	}
+
+	var got Value
+	if err := got.UnmarshalJSON([]byte("42")); errors.Cause(err) != ErrInvalidTextData {
+		t.Errorf("Expected %v, got %v", ErrInvalidTextData, err)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ulid
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+
+	"github.com/dylan-bourque/go-types/clock"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrMonotonicOverflow is returned by Generator.New() when more ULIDs have been requested within
+	// a single millisecond than the 80-bit entropy can count
+	ErrMonotonicOverflow = errors.Errorf("ulid: monotonic entropy overflowed within a single millisecond")
+)
+
+// Generator creates ULIDs that strictly increase, even when several are generated in the same
+// millisecond.
+//
+// Within a millisecond, the entropy of each new value is the previous entropy plus one, as described
+// by the ULID specification.  If the clock moves backwards, the generator keeps using the last
+// timestamp it saw so that ordering is preserved.
+//
+// The zero value is ready to use and reads the system clock and crypto/rand.  A Generator is safe
+// for concurrent use.
+type Generator struct {
+	clock   clock.Clock
+	entropy io.Reader
+
+	mu   sync.Mutex
+	last Value
+}
+
+// NewGenerator returns a Generator that reads the current time from c and random bytes from entropy.
+// If c is nil, clock.System is used, and if entropy is nil, crypto/rand.Reader is used.  Passing a
+// clock.Fake and a fixed entropy source makes the generated values reproducible in tests.
+func NewGenerator(c clock.Clock, entropy io.Reader) *Generator {
+	if c == nil {
+		c = clock.System
+	}
+	return &Generator{clock: c, entropy: entropy}
+}
+
+// defaultGenerator is the process-wide Generator used by Next()
+var defaultGenerator Generator
+
+// Next returns the next ULID from a process-wide Generator that reads the system clock and
+// crypto/rand.  Every value returned by Next() sorts after the values previously returned to any
+// goroutine in the process.
+func Next() (Value, error) {
+	return defaultGenerator.New()
+}
+
+// New returns the next ULID, which is guaranteed to sort after every ULID previously returned by g.
+func (g *Generator) New() (Value, error) {
+	c, entropy := g.clock, g.entropy
+	if c == nil {
+		c = clock.System
+	}
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+
+	var next Value
+	if err := next.setTime(c.Now()); err != nil {
+		return Nil, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.last.IsNil() && next.Timestamp() <= g.last.Timestamp() {
+		next = g.last
+		if !incrementEntropy(&next) {
+			return Nil, ErrMonotonicOverflow
+		}
+	} else if _, err := io.ReadFull(entropy, next[6:]); err != nil {
+		return Nil, errors.Wrapf(ErrEntropyUnavailable, "%v", err)
+	}
+	g.last = next
+	return next, nil
+}
+
+// incrementEntropy adds one to the 80-bit entropy of v, returning false if it overflows
+func incrementEntropy(v *Value) bool {
+	for i := len(v) - 1; i >= 6; i-- {
+		v[i]++
+		if v[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ulid
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dylan-bourque/go-types/clock"
+	"github.com/pkg/errors"
+)
+
+func TestGeneratorMonotonic(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	g := NewGenerator(clock.Func(func() time.Time { return now }), bytes.NewReader(bytes.Repeat([]byte{0x01}, 100)))
+
+	first, err := g.New()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, _ := g.New()
+	if !second.After(first) || second.Timestamp() != first.Timestamp() {
+		t.Errorf("Expected %s to sort after %s in the same millisecond", second, first)
+	}
+	if e := second.Entropy(); e[9] != 0x02 {
+		t.Errorf("Expected the entropy to be incremented, got %x", e)
+	}
+
+	// the clock moving backwards keeps the last timestamp
+	now = now.Add(-time.Second)
+	third, _ := g.New()
+	if !third.After(second) || third.Timestamp() != second.Timestamp() {
+		t.Errorf("Expected %s to sort after %s after the clock moved backwards", third, second)
+	}
+
+	// a new millisecond reads fresh entropy
+	now = now.Add(2 * time.Second)
+	fourth, _ := g.New()
+	if !fourth.After(third) || fourth.Timestamp() != uint64(now.UnixMilli()) {
+		t.Errorf("Expected %s to use the new timestamp", fourth)
+	}
+}
+
+func TestGeneratorOverflow(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	g := NewGenerator(clock.Func(func() time.Time { return now }), bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)))
+	if _, err := g.New(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := g.New(); err != ErrMonotonicOverflow {
+		t.Errorf("Expected %v, got %v", ErrMonotonicOverflow, err)
+	}
+}
+
+func TestGeneratorErrors(t *testing.T) {
+	g := NewGenerator(clock.Func(func() time.Time { return time.Unix(-1, 0) }), nil)
+	if _, err := g.New(); err != ErrInvalidTimestamp {
+		t.Errorf("Expected %v, got %v", ErrInvalidTimestamp, err)
+	}
+	g = NewGenerator(nil, bytes.NewReader(nil))
+	if _, err := g.New(); errors.Cause(err) != ErrEntropyUnavailable {
+		t.Errorf("Expected %v, got %v", ErrEntropyUnavailable, err)
+	}
+}
+
+func TestGeneratorConcurrent(t *testing.T) {
+	var (
+		g    Generator
+		mu   sync.Mutex
+		seen = make(map[Value]bool)
+		wg   sync.WaitGroup
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				v, err := g.New()
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					return
+				}
+				mu.Lock()
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(seen) != 800 {
+		t.Errorf("Expected 800 unique values, got %d", len(seen))
+	}
+}
+
+func TestGeneratorWithClockIsReproducible(t *testing.T) {
+	start := time.UnixMilli(1700000000000)
+	generate := func() []Value {
+		c := clock.NewFake(start)
+		g := NewGenerator(c, bytes.NewReader(bytes.Repeat([]byte{0x42}, 100)))
+		var res []Value
+		for i := 0; i < 3; i++ {
+			v, err := g.New()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			res = append(res, v)
+		}
+		c.Advance(time.Millisecond)
+		v, _ := g.New()
+		return append(res, v)
+	}
+	first, second := generate(), generate()
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("Expected the same value at index %d, got %s and %s", i, first[i], second[i])
+		}
+		if i > 0 && !first[i].After(first[i-1]) {
+			t.Errorf("Expected %s to sort after %s", first[i], first[i-1])
+		}
+	}
+	if got := first[0].Time(); !got.Equal(start) {
+		t.Errorf("Expected the timestamp to come from the clock, got %v", got)
+	}
+	if got := first[3].Timestamp(); got != uint64(start.UnixMilli())+1 {
+		t.Errorf("Expected the advanced clock to be used, got %d", got)
+	}
+}
+
+func TestNext(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		all []Value
+		wg  sync.WaitGroup
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var local []Value
+			for j := 0; j < 100; j++ {
+				v, err := Next()
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					return
+				}
+				if len(local) > 0 && !v.After(local[len(local)-1]) {
+					t.Errorf("Expected %s to sort after %s", v, local[len(local)-1])
+				}
+				local = append(local, v)
+			}
+			mu.Lock()
+			all = append(all, local...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	seen := make(map[Value]bool, len(all))
+	for _, v := range all {
+		seen[v] = true
+	}
+	if len(seen) != 400 {
+		t.Errorf("Expected 400 unique values, got %d", len(seen))
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ulid
+
+import (
+	"database/sql/driver"
+
+	"github.com/dylan-bourque/go-types/internal/typeerr"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnsupportedSourceType is returned by .Scan() when the provided value cannot be converted to
+	// a ulid.Value value
+	ErrUnsupportedSourceType = errors.Errorf("Cannot convert the source data to a ulid.Value value")
+)
+
+var unsupportedSourceType = typeerr.New(ErrUnsupportedSourceType)
+
+// Value implements the driver.Valuer interface for ulid.Value values.  Nil is stored as NULL and all
+// other values are stored as the 26-character text form, which sorts correctly in text columns.
+func (v Value) Value() (driver.Value, error) {
+	if v.IsNil() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for ulid.Value values.
+//
+// NULL is decoded as Nil, a string is handled by UnmarshalText() and a byte slice is handled by
+// UnmarshalBinary() if it is 16 bytes long, for binary/UUID columns, or UnmarshalText() otherwise.
+// All other values will return an error
+func (v *Value) Scan(src interface{}) error {
+	switch tv := src.(type) {
+	case nil:
+		*v = Nil
+		return nil
+	case []byte:
+		if len(tv) == len(v) {
+			return v.UnmarshalBinary(tv)
+		}
+		return v.UnmarshalText(tv)
+	case string:
+		return v.UnmarshalText([]byte(tv))
+	default:
+		return unsupportedSourceType.Of(src)
+	}
+}
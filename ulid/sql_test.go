@@ -0,0 +1,62 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ulid
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestValue(t *testing.T) {
+	v := Must(Parse("01ARYZ6S41TSV4RRFFQ69G5FAV"))
+	cases := []struct {
+		name     string
+		v        Value
+		expected driver.Value
+	}{
+		{"nil", Nil, nil},
+		{"value", v, "01ARYZ6S41TSV4RRFFQ69G5FAV"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := tc.v.Value()
+			if err != nil || got != tc.expected {
+				tt.Errorf("Expected %v, got %v (err = %v)", tc.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	v := Must(Parse("01ARYZ6S41TSV4RRFFQ69G5FAV"))
+	bin, _ := v.MarshalBinary()
+	cases := []struct {
+		name     string
+		src      interface{}
+		expected Value
+		err      error
+	}{
+		{"null", nil, Nil, nil},
+		{"string", "01ARYZ6S41TSV4RRFFQ69G5FAV", v, nil},
+		{"text bytes", []byte("01ARYZ6S41TSV4RRFFQ69G5FAV"), v, nil},
+		{"binary bytes", bin, v, nil},
+		{"invalid bytes", []byte{1, 2, 3}, Nil, ErrInvalidTextFormat},
+		{"unsupported type", 42, Nil, ErrUnsupportedSourceType},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := Max
+			err := got.Scan(tc.src)
+			if errors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && got != tc.expected {
+				tt.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
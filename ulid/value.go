@@ -0,0 +1,151 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ulid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Value represents a Universally Unique Lexicographically Sortable Identifier, as defined by the
+// specification at https://github.com/ulid/spec.
+//
+// A ULID is 128 bits: a 48-bit big-endian timestamp, in milliseconds since the Unix epoch, followed by
+// 80 bits of entropy.  Both the binary form and the 26-character Crockford base32 text form sort in
+// the same order as the embedded timestamps.
+//
+// The zero value is ulid.Nil.
+type Value [16]byte
+
+var (
+	// Nil represents a nil/null/undefined ULID
+	Nil = Value{}
+	// Max is the largest valid ULID, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"
+	Max = Value{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+const (
+	// MaxTimestamp is the largest millisecond timestamp that can be stored in a ULID, which is in
+	// the year 10889
+	MaxTimestamp uint64 = 1<<48 - 1
+)
+
+var (
+	// ErrInvalidTimestamp is returned when a time is before the Unix epoch or after MaxTimestamp
+	ErrInvalidTimestamp = errors.Errorf("ulid: the timestamp is outside of the range that can be stored in a ULID")
+	// ErrEntropyUnavailable is returned when the entropy source fails to provide 10 bytes
+	ErrEntropyUnavailable = errors.Errorf("ulid: unable to read from the entropy source")
+)
+
+// Must panics if the passed-in error is non-nil; otherwise, it returns the passed-in ulid.Value
+func Must(v Value, err error) Value {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// New returns a ULID with the timestamp of t and 80 bits of entropy read from the specified source.
+//
+// Successive calls are not guaranteed to be ordered within the same millisecond.  Use a Generator
+// for monotonic values.
+func New(t time.Time, entropy io.Reader) (Value, error) {
+	var v Value
+	if err := v.setTime(t); err != nil {
+		return Nil, err
+	}
+	if _, err := io.ReadFull(entropy, v[6:]); err != nil {
+		return Nil, errors.Wrapf(ErrEntropyUnavailable, "%v", err)
+	}
+	return v, nil
+}
+
+// FromParts returns a ULID with the specified millisecond timestamp and entropy.
+func FromParts(ms uint64, entropy [10]byte) (Value, error) {
+	if ms > MaxTimestamp {
+		return Nil, ErrInvalidTimestamp
+	}
+	var v Value
+	v.putTimestamp(ms)
+	copy(v[6:], entropy[:])
+	return v, nil
+}
+
+// setTime stores the millisecond timestamp of t in the first 6 bytes of v
+func (v *Value) setTime(t time.Time) error {
+	ms := t.UnixMilli()
+	if ms < 0 || uint64(ms) > MaxTimestamp {
+		return ErrInvalidTimestamp
+	}
+	v.putTimestamp(uint64(ms))
+	return nil
+}
+
+// putTimestamp stores the 48-bit timestamp in the first 6 bytes of v
+func (v *Value) putTimestamp(ms uint64) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], ms)
+	copy(v[:6], buf[2:])
+}
+
+// IsNil returns true if v is the Nil ULID
+func (v Value) IsNil() bool {
+	return v == Nil
+}
+
+// IsZero returns true if v is Nil.  It is used by the "omitzero" JSON struct tag option to omit
+// Nil values.
+func (v Value) IsZero() bool {
+	return v.IsNil()
+}
+
+// Timestamp returns the embedded timestamp, in milliseconds since the Unix epoch
+func (v Value) Timestamp() uint64 {
+	var buf [8]byte
+	copy(buf[2:], v[:6])
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+// Time returns the embedded timestamp as a UTC time.Time
+func (v Value) Time() time.Time {
+	return time.UnixMilli(int64(v.Timestamp())).UTC()
+}
+
+// Entropy returns the 80 bits of entropy that follow the timestamp
+func (v Value) Entropy() [10]byte {
+	var e [10]byte
+	copy(e[:], v[6:])
+	return e
+}
+
+// Compare returns -1, 0 or +1 depending on whether v1 sorts before, the same as, or after v2.  The
+// order is the same as the order of the text and binary encodings.
+func Compare(v1, v2 Value) int {
+	return bytes.Compare(v1[:], v2[:])
+}
+
+// Before returns true if v sorts before v2
+func (v Value) Before(v2 Value) bool {
+	return Compare(v, v2) < 0
+}
+
+// After returns true if v sorts after v2
+func (v Value) After(v2 Value) bool {
+	return Compare(v, v2) > 0
+}
+
+// String implements fmt.Stringer for ulid.Value instances.
+//
+// The returned string is the 26-character Crockford base32 encoding, including for Nil, which is
+// "00000000000000000000000000".
+func (v Value) String() string {
+	var buf [encodedLen]byte
+	v.encode(buf[:])
+	return string(buf[:])
+}
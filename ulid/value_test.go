@@ -0,0 +1,109 @@
+// Copyright 2019 Dylan Bourque. All rights reserved.
+//
+// Use of this source code is governed by the MIT open source license that can be found in the LICENSE file.
+
+package ulid
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+func TestNew(t *testing.T) {
+	ts := time.UnixMilli(1469918176385)
+	entropy := bytes.Repeat([]byte{0xab}, 10)
+	cases := []struct {
+		name    string
+		t       time.Time
+		entropy []byte
+		err     error
+	}{
+		{"valid", ts, entropy, nil},
+		{"epoch", time.Unix(0, 0), entropy, nil},
+		{"before the epoch", time.Unix(-1, 0), entropy, ErrInvalidTimestamp},
+		{"after max timestamp", time.UnixMilli(int64(MaxTimestamp) + 1), entropy, ErrInvalidTimestamp},
+		{"short entropy", ts, entropy[:5], ErrEntropyUnavailable},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			v, err := New(tc.t, bytes.NewReader(tc.entropy))
+			if pkgerrors.Cause(err) != tc.err {
+				tt.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if !v.Time().Equal(tc.t) {
+				tt.Errorf("Expected time %v, got %v", tc.t, v.Time())
+			}
+			if e := v.Entropy(); !bytes.Equal(e[:], tc.entropy) {
+				tt.Errorf("Expected entropy %x, got %x", tc.entropy, e)
+			}
+		})
+	}
+
+	if _, err := New(ts, failingReader{}); pkgerrors.Cause(err) != ErrEntropyUnavailable {
+		t.Errorf("Expected %v, got %v", ErrEntropyUnavailable, err)
+	}
+}
+
+func TestFromParts(t *testing.T) {
+	var e [10]byte
+	v, err := FromParts(MaxTimestamp, e)
+	if err != nil || v.Timestamp() != MaxTimestamp {
+		t.Errorf("Expected timestamp %d, got %d (err = %v)", MaxTimestamp, v.Timestamp(), err)
+	}
+	if _, err := FromParts(MaxTimestamp+1, e); err != ErrInvalidTimestamp {
+		t.Errorf("Expected %v, got %v", ErrInvalidTimestamp, err)
+	}
+}
+
+func TestSpecExample(t *testing.T) {
+	// from https://github.com/ulid/spec: the timestamp 1469918176385 encodes as "01ARYZ6S41"
+	v := Must(Parse("01ARYZ6S41TSV4RRFFQ69G5FAV"))
+	if v.Timestamp() != 1469918176385 {
+		t.Errorf("Expected timestamp 1469918176385, got %d", v.Timestamp())
+	}
+	if s := v.String(); s != "01ARYZ6S41TSV4RRFFQ69G5FAV" {
+		t.Errorf("Expected the original text, got %s", s)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a := Must(Parse("01ARYZ6S41TSV4RRFFQ69G5FAV"))
+	b := Must(Parse("01ARYZ6S420000000000000000"))
+	cases := []struct {
+		name     string
+		v1, v2   Value
+		expected int
+	}{
+		{"equal", a, a, 0},
+		{"earlier timestamp", a, b, -1},
+		{"later timestamp", b, a, 1},
+		{"nil sorts first", Nil, a, -1},
+		{"max sorts last", Max, b, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if got := Compare(tc.v1, tc.v2); got != tc.expected {
+				tt.Errorf("Expected %d, got %d", tc.expected, got)
+			}
+			if tc.v1.Before(tc.v2) != (tc.expected < 0) || tc.v1.After(tc.v2) != (tc.expected > 0) {
+				tt.Errorf("Before()/After() disagree with Compare()")
+			}
+			if (tc.v1.String() < tc.v2.String()) != (tc.expected < 0) {
+				tt.Errorf("Text order disagrees with Compare()")
+			}
+		})
+	}
+}